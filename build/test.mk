@@ -58,6 +58,10 @@ test-get-envtools:
 test-validate-cli: ## Run cli integration tests
 	CGO_ENABLED=1 $(GOTEST_TOOL) -coverpkg= ./pkg/cli/cmd/... ./cmd/rad/... -timeout ${TEST_TIMEOUT} -v -parallel 5 $(GOTEST_OPTS)
 
+.PHONY: test-store-postgres
+test-store-postgres: ## Runs the storage conformance tests against PostgreSQL. Uses an embedded server unless TEST_POSTGRESQL_URL is set, and skips them if it cannot be started
+	$(GOTEST_TOOL) ./pkg/ucp/store/postgresstore/... -v -count=1 $(GOTEST_OPTS)
+
 test-functional-all: test-functional-ucp test-functional-kubernetes test-functional-shared test-functional-msgrp test-functional-daprrp ## Runs all functional tests
 
 test-functional-kubernetes: ## Runs Kubernetes functional tests
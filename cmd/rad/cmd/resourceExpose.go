@@ -42,10 +42,15 @@ var resourceExposeCmd = &cobra.Command{
 	Long: `Exposes a port inside a resource for network traffic using a local port.
 This command is useful for testing resources that accept network traffic but are not exposed to the public internet. Exposing a port for testing allows you to send TCP traffic from your local machine to the resource.
 
+If the environment of the resource targets a cluster registered as a Kubernetes plane, the port is forwarded with the kube context of your kubeconfig named after the plane. The '--kubecontext \<name\>' option can specify a different kube context of the cluster.
+
 Press CTRL+C to exit the command and terminate the tunnel.`,
 	Example: `# expose port 80 on the 'orders' resource of the 'icecream-store' application
 # on local port 5000
-rad resource expose --application icecream-store containers orders --port 5000 --remote-port 80`,
+rad resource expose --application icecream-store containers orders --port 5000 --remote-port 80
+
+# expose port 80 on the 'orders' resource deployed to a remote cluster using the 'prod-cluster' kube context
+rad resource expose --application icecream-store containers orders --port 5000 --remote-port 80 --kubecontext prod-cluster`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		workspace, err := cli.RequireWorkspace(cmd, ConfigFromContext(cmd.Context()), DirectoryConfigFromContext(cmd.Context()))
 		if err != nil {
@@ -119,6 +124,11 @@ rad resource expose --application icecream-store containers orders --port 5000 -
 			return err
 		}
 
+		kubeContext, err := cmd.Flags().GetString("kubecontext")
+		if err != nil {
+			return err
+		}
+
 		if remotePort == -1 {
 			remotePort = localPort
 		}
@@ -135,7 +145,8 @@ rad resource expose --application icecream-store containers orders --port 5000 -
 			Resource:    resourceName,
 			Port:        localPort,
 			RemotePort:  remotePort,
-			Replica:     replica})
+			Replica:     replica,
+			KubeContext: kubeContext})
 
 		if err != nil {
 			return err
@@ -166,6 +177,7 @@ func init() {
 	resourceExposeCmd.Flags().IntP("remote-port", "", -1, "specify the remote port")
 	resourceExposeCmd.Flags().String("replica", "", "specify the replica to expose")
 	resourceExposeCmd.Flags().IntP("port", "p", -1, "specify the local port")
+	resourceExposeCmd.Flags().String("kubecontext", "", "specify the kube context of the cluster of a Kubernetes plane, defaults to the name of the plane")
 	commonflags.AddResourceGroupFlag(resourceExposeCmd)
 	err := resourceExposeCmd.MarkFlagRequired("port")
 	if err != nil {
@@ -41,7 +41,9 @@ This command allows you to access logs of a deployed application and output thos
 
 'rad resource logs' will output logs from the resource's primary container. In scenarios like Dapr where multiple containers are in use, the '--container \<name\>' option can specify the desired container.
 
-Specify the '--follow' option to stream additional logs as they are emitted by the resource. When following, press CTRL+C to exit the command and terminate the stream.`,
+Specify the '--follow' option to stream additional logs as they are emitted by the resource. When following, press CTRL+C to exit the command and terminate the stream.
+
+If the environment of the resource targets a cluster registered as a Kubernetes plane, the logs are read with the kube context of your kubeconfig named after the plane. The '--kubecontext \<name\>' option can specify a different kube context of the cluster.`,
 	Example: `# read logs from the 'webapp' resource of the current default app
 rad resource logs containers webapp
 
@@ -52,7 +54,10 @@ rad resource logs containers orders --application icecream-store
 rad resource logs containers orders --application icecream-store --follow
 
 # read logs from the 'daprd' sidecar container of the 'orders' resource of the 'icecream-store' application
-rad resource logs containers orders --application icecream-store --container daprd`,
+rad resource logs containers orders --application icecream-store --container daprd
+
+# read logs from the 'orders' resource deployed to a remote cluster using the 'prod-cluster' kube context
+rad resource logs containers orders --application icecream-store --kubecontext prod-cluster`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		workspace, err := cli.RequireWorkspace(cmd, ConfigFromContext(cmd.Context()), DirectoryConfigFromContext(cmd.Context()))
 		if err != nil {
@@ -87,6 +92,11 @@ rad resource logs containers orders --application icecream-store --container dap
 			return err
 		}
 
+		kubeContext, err := cmd.Flags().GetString("kubecontext")
+		if err != nil {
+			return err
+		}
+
 		var client clients.DiagnosticsClient
 		client, err = connections.DefaultFactory.CreateDiagnosticsClient(cmd.Context(), *workspace)
 		if err != nil {
@@ -97,7 +107,8 @@ rad resource logs containers orders --application icecream-store --container dap
 			Application: application,
 			Resource:    resourceName,
 			Follow:      follow,
-			Container:   container})
+			Container:   container,
+			KubeContext: kubeContext})
 		if err != nil {
 			return err
 		}
@@ -181,6 +192,7 @@ func init() {
 	resourceLogsCmd.Flags().String("container", "", "specify the container from which logs should be streamed")
 	resourceLogsCmd.Flags().BoolP("follow", "f", false, "specify that logs should be stream until the command is canceled")
 	resourceLogsCmd.Flags().String("replica", "", "specify the replica to collect logs from")
+	resourceLogsCmd.Flags().String("kubecontext", "", "specify the kube context of the cluster of a Kubernetes plane, defaults to the name of the plane")
 	commonflags.AddResourceGroupFlag(resourceLogsCmd)
 	resourceCmd.AddCommand(resourceLogsCmd)
 }
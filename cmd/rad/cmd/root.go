@@ -38,7 +38,9 @@ import (
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	"github.com/radius-project/radius/pkg/cli/cmd/deployment"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_delete "github.com/radius-project/radius/pkg/cli/cmd/env/delete"
 	env_switch "github.com/radius-project/radius/pkg/cli/cmd/env/envswitch"
@@ -50,11 +52,17 @@ import (
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
+	recipe_install "github.com/radius-project/radius/pkg/cli/cmd/recipe/install"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
+	recipe_search "github.com/radius-project/radius/pkg/cli/cmd/recipe/search"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
+	recipe_state "github.com/radius-project/radius/pkg/cli/cmd/recipe/state"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
+	recipe_upgrade "github.com/radius-project/radius/pkg/cli/cmd/recipe/upgrade"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_drift "github.com/radius-project/radius/pkg/cli/cmd/resource/drift"
+	resource_history "github.com/radius-project/radius/pkg/cli/cmd/resource/history"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
@@ -221,6 +229,12 @@ func initSubCommands() {
 	deleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(deleteCmd)
 
+	historyCmd, _ := resource_history.NewCommand(framework)
+	resourceCmd.AddCommand(historyCmd)
+
+	driftCmd, _ := resource_drift.NewCommand(framework)
+	resourceCmd.AddCommand(driftCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
@@ -233,12 +247,30 @@ func initSubCommands() {
 	unregisterRecipeCmd, _ := recipe_unregister.NewCommand(framework)
 	recipeCmd.AddCommand(unregisterRecipeCmd)
 
+	stateRecipeCmd := recipe_state.NewCommand(framework)
+	recipeCmd.AddCommand(stateRecipeCmd)
+
+	upgradeRecipeCmd, _ := recipe_upgrade.NewCommand(framework)
+	recipeCmd.AddCommand(upgradeRecipeCmd)
+
+	searchRecipeCmd, _ := recipe_search.NewCommand(framework)
+	recipeCmd.AddCommand(searchRecipeCmd)
+
+	installRecipeCmd, _ := recipe_install.NewCommand(framework)
+	recipeCmd.AddCommand(installRecipeCmd)
+
 	providerCmd := credential.NewCommand(framework)
 	RootCmd.AddCommand(providerCmd)
 
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	deadletterCmd := deadletter.NewCommand(framework)
+	RootCmd.AddCommand(deadletterCmd)
+
+	deploymentCmd := deployment.NewCommand(framework)
+	RootCmd.AddCommand(deploymentCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	RootCmd.AddCommand(initCmd)
 
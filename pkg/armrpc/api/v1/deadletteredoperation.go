@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DeadLetteredOperation represents an async operation whose request message was moved to the dead-letter queue.
+type DeadLetteredOperation struct {
+	// ID represents the id of the dead-lettered operation.
+	ID string `json:"id"`
+
+	// Name represents the name of the dead-lettered operation and is set to the async operation id.
+	Name string `json:"name"`
+
+	// Type represents the resource type of the dead-lettered operation.
+	Type string `json:"type"`
+
+	// Properties represents the properties of the dead-lettered operation.
+	Properties DeadLetteredOperationProperties `json:"properties"`
+}
+
+// DeadLetteredOperationProperties represents the properties of a dead-lettered operation.
+type DeadLetteredOperationProperties struct {
+	// ResourceID represents the id of the resource which the async operation was processing.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// OperationType represents the type of the async operation.
+	OperationType string `json:"operationType,omitempty"`
+
+	// Reason represents the reason why the operation was dead-lettered.
+	Reason string `json:"reason"`
+
+	// LastError represents the last error observed while processing the operation.
+	LastError string `json:"lastError,omitempty"`
+
+	// DequeueCount represents the number of times the request message was dequeued.
+	DequeueCount int `json:"dequeueCount"`
+
+	// EnqueuedAt represents the time when the request message was enqueued.
+	EnqueuedAt time.Time `json:"enqueuedAt"`
+
+	// DeadLetteredAt represents the time when the request message was dead-lettered.
+	DeadLetteredAt *time.Time `json:"deadLetteredAt,omitempty"`
+
+	// History represents the delivery history of the request message.
+	History []DeadLetteredOperationAttempt `json:"history,omitempty"`
+
+	// Payload represents the original request message.
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// DeadLetteredOperationAttempt represents a single delivery of the request message of a dead-lettered operation.
+type DeadLetteredOperationAttempt struct {
+	// DequeueCount represents the dequeue count of the request message for this delivery.
+	DequeueCount int `json:"dequeueCount"`
+
+	// DequeuedAt represents the time when the request message was processed.
+	DequeuedAt time.Time `json:"dequeuedAt"`
+
+	// Error represents the error observed while processing the request message.
+	Error string `json:"error,omitempty"`
+}
@@ -41,6 +41,9 @@ const (
 	// Used for CodeInvalidAuthenticationInfo.
 	CodeInvalidAuthenticationInfo = "InvalidAuthenticationInfo"
 
+	// Used for the cases when the caller is not authorized to perform the request.
+	CodeAuthorizationFailed = "AuthorizationFailed"
+
 	// Used for the cases when the precondition of a request fails.
 	CodePreconditionFailed = "PreconditionFailed"
 
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+)
+
+// OperationHistoryEntry represents a PUT or DELETE operation which was performed on a resource.
+type OperationHistoryEntry struct {
+	// ID represents the id of the operation history entry.
+	ID string `json:"id"`
+
+	// Name represents the name of the operation history entry and is set to the operation id.
+	Name string `json:"name"`
+
+	// Type represents the resource type of the operation history entry.
+	Type string `json:"type"`
+
+	// Properties represents the properties of the operation history entry.
+	Properties OperationHistoryEntryProperties `json:"properties"`
+}
+
+// OperationHistoryEntryProperties represents the properties of an operation history entry.
+type OperationHistoryEntryProperties struct {
+	// ResourceID represents the id of the resource on which the operation was performed.
+	ResourceID string `json:"resourceId"`
+
+	// OperationType represents the type of the operation.
+	OperationType string `json:"operationType"`
+
+	// Status represents the provisioning state resulting from the operation.
+	Status ProvisioningState `json:"status"`
+
+	// StartTime represents the time when the operation was requested.
+	StartTime time.Time `json:"startTime"`
+
+	// EndTime represents the time when the operation completed.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Error represents the error details of the operation if the operation failed.
+	Error *ErrorDetails `json:"error,omitempty"`
+
+	// RequestedBy represents the client which requested the operation.
+	RequestedBy OperationRequester `json:"requestedBy"`
+}
+
+// OperationRequester represents the client which requested an operation.
+type OperationRequester struct {
+	// ClientObjectID represents the object id of the client identity.
+	ClientObjectID string `json:"clientObjectId,omitempty"`
+
+	// ClientPrincipalName represents the principal name of the client identity.
+	ClientPrincipalName string `json:"clientPrincipalName,omitempty"`
+
+	// ClientPrincipalID represents the principal id of the client identity.
+	ClientPrincipalID string `json:"clientPrincipalId,omitempty"`
+
+	// ClientApplicationID represents the application id of the client identity.
+	ClientApplicationID string `json:"clientApplicationId,omitempty"`
+
+	// ClientTenantID represents the tenant id of the client.
+	ClientTenantID string `json:"clientTenantId,omitempty"`
+
+	// HomeTenantID represents the tenant id of the service principal backed by the client identity.
+	HomeTenantID string `json:"homeTenantId,omitempty"`
+
+	// UserAgent represents the user agent of the request.
+	UserAgent string `json:"userAgent,omitempty"`
+
+	// ClientRequestID represents the client request id of the request.
+	ClientRequestID string `json:"clientRequestId,omitempty"`
+
+	// CorrelationID represents the correlation id of the request.
+	CorrelationID string `json:"correlationId,omitempty"`
+}
+
+// NewOperationRequester creates the OperationRequester from the identity headers of the request.
+func NewOperationRequester(sCtx *ARMRequestContext) OperationRequester {
+	return OperationRequester{
+		ClientObjectID:      sCtx.ClientObjectID,
+		ClientPrincipalName: sCtx.ClientPrincipalName,
+		ClientPrincipalID:   sCtx.ClientPrincipalID,
+		ClientApplicationID: sCtx.ClientApplicationID,
+		ClientTenantID:      sCtx.ClientTenantID,
+		HomeTenantID:        sCtx.HomeTenantID,
+		UserAgent:           sCtx.UserAgent,
+		ClientRequestID:     sCtx.ClientRequestID,
+		CorrelationID:       sCtx.CorrelationID,
+	}
+}
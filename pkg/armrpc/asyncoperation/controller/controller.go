@@ -53,6 +53,14 @@ type Controller interface {
 	StorageClient() store.StorageClient
 }
 
+// FailureHandler is implemented by the controllers which need to update their resource when their operation doesn't
+// succeed, for example because it was canceled, timed out or exceeded the maximum retry count. The worker calls OnFailure
+// after the operation status is updated, including when the operation is completed without the controller being run.
+type FailureHandler interface {
+	// OnFailure is called with the failed or canceled result of the operation.
+	OnFailure(ctx context.Context, request *Request, result Result) error
+}
+
 // BaseController is the base struct of async operation controller.
 type BaseController struct {
 	options Options
@@ -0,0 +1,245 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// MaxHistoryRecordsPerResource is the number of history records kept for each resource. The records of the oldest
+	// operations on a resource are deleted when a new operation on the resource is recorded.
+	MaxHistoryRecordsPerResource = 100
+
+	// normalizedResourceIDField is the field of the history records used to query the history of a resource.
+	normalizedResourceIDField = "normalizedResourceId"
+)
+
+// HistoryRecord is the datamodel for the record of an operation performed on a resource. Unlike the operation status,
+// the history record is kept after the resource is deleted so that the operations on the resource can be audited.
+type HistoryRecord struct {
+	// ID is the resource id of the history record.
+	ID string `json:"id"`
+
+	// OperationID is the id of the operation.
+	OperationID string `json:"operationId"`
+
+	// ResourceID is the id of the resource on which the operation was performed.
+	ResourceID string `json:"resourceId"`
+
+	// NormalizedResourceID is the lower-cased ResourceID. Resource ids are case-insensitive, so the history of a
+	// resource is queried with the normalized id.
+	NormalizedResourceID string `json:"normalizedResourceId"`
+
+	// OperationType is the type of the operation such as APPLICATIONS.CORE/ENVIRONMENTS|PUT.
+	OperationType string `json:"operationType"`
+
+	// Status is the provisioning state of the operation.
+	Status v1.ProvisioningState `json:"status"`
+
+	// StartTime is the time when the operation was requested.
+	StartTime time.Time `json:"startTime"`
+
+	// EndTime is the time when the operation completed.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Error is the error details of the operation.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+
+	// RequestedBy is the client which requested the operation.
+	RequestedBy v1.OperationRequester `json:"requestedBy"`
+
+	// LastUpdatedTime is the time when the record was last updated.
+	LastUpdatedTime time.Time `json:"lastUpdatedTime"`
+}
+
+// HistoryResourceType returns the resource type of the history records for the given resource provider namespace.
+func HistoryResourceType(namespace string) string {
+	return namespace + "/operationhistory"
+}
+
+// HistoryResourceFilter returns the query filter which selects the history records of the given resource.
+func HistoryResourceFilter(resourceID string) store.QueryFilter {
+	return store.QueryFilter{Field: normalizedResourceIDField, Value: strings.ToLower(resourceID)}
+}
+
+// newHistoryRecord creates the history record of the operation requested by the given request.
+func newHistoryRecord(id string, sCtx *v1.ARMRequestContext, state v1.ProvisioningState, startTime time.Time) *HistoryRecord {
+	return &HistoryRecord{
+		ID:                   id,
+		OperationID:          sCtx.OperationID.String(),
+		ResourceID:           sCtx.ResourceID.String(),
+		NormalizedResourceID: strings.ToLower(sCtx.ResourceID.String()),
+		OperationType:        sCtx.OperationType.String(),
+		Status:               state,
+		StartTime:            startTime,
+		RequestedBy:          v1.NewOperationRequester(sCtx),
+		LastUpdatedTime:      startTime,
+	}
+}
+
+// operationHistoryResourceID builds the resource id of the history record. History records are stored next to the
+// operation statuses of the resource provider namespace.
+func (aom *statusManager) operationHistoryResourceID(id resources.ID, operationID uuid.UUID) string {
+	return fmt.Sprintf("%s/providers/%s/locations/%s/operationhistory/%s", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), aom.location, operationID)
+}
+
+func (aom *statusManager) getHistoryClient(ctx context.Context, id resources.ID) (store.StorageClient, error) {
+	return aom.storeProvider.GetStorageClient(ctx, HistoryResourceType(id.ProviderNamespace()))
+}
+
+// RecordOperation records a synchronous operation which has already completed in the operation history.
+func (aom *statusManager) RecordOperation(ctx context.Context, sCtx *v1.ARMRequestContext, state v1.ProvisioningState, opError *v1.ErrorDetails) error {
+	if sCtx == nil {
+		return errors.New("*servicecontext.ARMRequestContext is unset")
+	}
+
+	now := time.Now().UTC()
+	record := newHistoryRecord(aom.operationHistoryResourceID(sCtx.ResourceID, sCtx.OperationID), sCtx, state, now)
+	record.EndTime = &now
+	record.Error = opError
+
+	return aom.saveHistory(ctx, sCtx.ResourceID, record)
+}
+
+func (aom *statusManager) saveHistory(ctx context.Context, id resources.ID, record *HistoryRecord) error {
+	client, err := aom.getHistoryClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = client.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: record.ID},
+		Data:     record,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The operation is recorded even if the old records can't be deleted, they are deleted with the next operation.
+	if err := pruneHistory(ctx, client, record); err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "failed to delete the old history records of the resource", "resourceID", record.ResourceID)
+	}
+
+	return nil
+}
+
+// pruneHistory deletes the records of the oldest operations on the resource of the record so that at most
+// MaxHistoryRecordsPerResource records are kept for the resource.
+func pruneHistory(ctx context.Context, client store.StorageClient, record *HistoryRecord) error {
+	id, err := resources.ParseResource(record.ID)
+	if err != nil {
+		return err
+	}
+
+	query := store.Query{
+		RootScope:    id.RootScope(),
+		ResourceType: id.Type(),
+		Filters:      []store.QueryFilter{HistoryResourceFilter(record.ResourceID)},
+	}
+
+	records := []*HistoryRecord{}
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			r := &HistoryRecord{}
+			if err := item.As(r); err != nil {
+				return err
+			}
+			records = append(records, r)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	if len(records) <= MaxHistoryRecordsPerResource {
+		return nil
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].StartTime.Before(records[j].StartTime)
+	})
+
+	for _, r := range records[:len(records)-MaxHistoryRecordsPerResource] {
+		if err := client.Delete(ctx, r.ID); err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateHistory applies the update to the history record of the operation. Operations which were queued before the
+// operation history was recorded do not have a history record, so a missing record is not an error.
+func (aom *statusManager) updateHistory(ctx context.Context, id resources.ID, operationID uuid.UUID, update func(*HistoryRecord)) error {
+	client, err := aom.getHistoryClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := client.Get(ctx, aom.operationHistoryResourceID(id, operationID))
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	record := &HistoryRecord{}
+	if err := obj.As(record); err != nil {
+		return err
+	}
+
+	update(record)
+	record.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = record
+	return client.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
+func (aom *statusManager) deleteHistory(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+	client, err := aom.getHistoryClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = client.Delete(ctx, aom.operationHistoryResourceID(id, operationID))
+	if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+		return err
+	}
+
+	return nil
+}
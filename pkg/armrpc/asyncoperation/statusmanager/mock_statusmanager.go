@@ -12,6 +12,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	controller "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -38,6 +39,21 @@ func (m *MockStatusManager) EXPECT() *MockStatusManagerMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockStatusManager) Cancel(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID) (*Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockStatusManagerMockRecorder) Cancel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockStatusManager)(nil).Cancel), arg0, arg1, arg2)
+}
+
 // Delete mocks base method.
 func (m *MockStatusManager) Delete(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -81,6 +97,34 @@ func (mr *MockStatusManagerMockRecorder) QueueAsyncOperation(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueAsyncOperation", reflect.TypeOf((*MockStatusManager)(nil).QueueAsyncOperation), arg0, arg1, arg2)
 }
 
+// RecordOperation mocks base method.
+func (m *MockStatusManager) RecordOperation(arg0 context.Context, arg1 *v1.ARMRequestContext, arg2 v1.ProvisioningState, arg3 *v1.ErrorDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOperation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordOperation indicates an expected call of RecordOperation.
+func (mr *MockStatusManagerMockRecorder) RecordOperation(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOperation", reflect.TypeOf((*MockStatusManager)(nil).RecordOperation), arg0, arg1, arg2, arg3)
+}
+
+// Requeue mocks base method.
+func (m *MockStatusManager) Requeue(arg0 context.Context, arg1 *controller.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockStatusManagerMockRecorder) Requeue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockStatusManager)(nil).Requeue), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockStatusManager) Update(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 v1.ProvisioningState, arg4 *time.Time, arg5 *v1.ErrorDetails) error {
 	m.ctrl.T.Helper()
@@ -43,4 +43,7 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
+
+	// CancelRequested indicates that the cancellation of the async operation was requested.
+	CancelRequested bool `json:"cancelRequested,omitempty"`
 }
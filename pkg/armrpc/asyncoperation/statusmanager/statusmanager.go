@@ -35,6 +35,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// ErrOperationCompleted is returned when an async operation cannot be canceled because it is already in a terminal state.
+var ErrOperationCompleted = errors.New("the async operation has already completed")
+
 // statusManager includes the necessary functions to manage asynchronous operations.
 type statusManager struct {
 	storeProvider dataprovider.DataStorageProvider
@@ -62,6 +65,12 @@ type StatusManager interface {
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
+	// Requeue resets an existing async operation status to Accepted and queues the async operation request again.
+	Requeue(ctx context.Context, req *ctrl.Request) error
+	// Cancel requests the cancellation of an async operation.
+	Cancel(ctx context.Context, id resources.ID, operationID uuid.UUID) (*Status, error)
+	// RecordOperation records a completed synchronous operation in the operation history.
+	RecordOperation(ctx context.Context, sCtx *v1.ARMRequestContext, state v1.ProvisioningState, opError *v1.ErrorDetails) error
 }
 
 // New creates statusManager instance.
@@ -82,8 +91,8 @@ func (aom *statusManager) getClient(ctx context.Context, id resources.ID) (store
 	return aom.storeProvider.GetStorageClient(ctx, id.ProviderNamespace()+"/operationstatuses")
 }
 
-// QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore, and queues
-// a request message. If an error occurs, the status is deleted using the storeClient.
+// QueueAsyncOperation creates and saves a new status resource and history record with the given parameters in datastore,
+// and queues a request message. If an error occurs, the status and the history record are deleted.
 func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error {
 	ctx, span := trace.StartProducerSpan(ctx, "statusmanager.QueueAsyncOperation publish", trace.FrontendTracerName)
 	defer span.End()
@@ -125,7 +134,18 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
+	record := newHistoryRecord(aom.operationHistoryResourceID(sCtx.ResourceID, sCtx.OperationID), sCtx, v1.ProvisioningStateAccepted, aos.StartTime)
+	if err = aom.saveHistory(ctx, sCtx.ResourceID, record); err != nil {
+		if delErr := storeClient.Delete(ctx, opID); delErr != nil {
+			return delErr
+		}
+		return err
+	}
+
 	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+		if delErr := aom.deleteHistory(ctx, sCtx.ResourceID, sCtx.OperationID); delErr != nil {
+			return delErr
+		}
 		delErr := storeClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -159,7 +179,7 @@ func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID
 }
 
 // Update retrieves an existing operation status resource from the store, updates its fields with the
-// given parameters, and saves it back to the store.
+// given parameters, and saves it back to the store. The history record of the operation is updated accordingly.
 func (aom *statusManager) Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
 	opID := aom.operationStatusResourceID(id, operationID)
 	storeClient, err := aom.getClient(ctx, id)
@@ -190,7 +210,15 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 
 	obj.Data = s
 
-	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	return aom.updateHistory(ctx, id, operationID, func(r *HistoryRecord) {
+		r.Status = s.Status
+		r.EndTime = s.EndTime
+		r.Error = s.Error
+	})
 }
 
 // Delete deletes the operation status resource associated with the given ID and
@@ -203,6 +231,123 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 	return storeClient.Delete(ctx, aom.operationStatusResourceID(id, operationID))
 }
 
+// Requeue resets the operation status of the given request to Accepted and queues the request message again. This is used
+// to retry an operation that was moved to the dead-letter queue. The status is restored if the message cannot be queued.
+func (aom *statusManager) Requeue(ctx context.Context, req *ctrl.Request) error {
+	if aom.queue == nil {
+		return errors.New("queue client is unset")
+	}
+
+	if req == nil {
+		return errors.New("async operation request is unset")
+	}
+
+	id, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	opID := aom.operationStatusResourceID(id, req.OperationID)
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+	original := *s
+
+	s.Status = v1.ProvisioningStateAccepted
+	s.EndTime = nil
+	s.Error = nil
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return err
+	}
+
+	if err := aom.queue.Enqueue(ctx, queue.NewMessage(req)); err != nil {
+		obj.Data = &original
+		if restoreErr := storeClient.Save(ctx, obj); restoreErr != nil {
+			return restoreErr
+		}
+		return err
+	}
+
+	metrics.DefaultAsyncOperationMetrics.RecordQueuedAsyncOperation(ctx)
+
+	return aom.updateHistory(ctx, id, req.OperationID, func(r *HistoryRecord) {
+		r.Status = v1.ProvisioningStateAccepted
+		r.EndTime = nil
+		r.Error = nil
+	})
+}
+
+// Cancel marks the operation status as cancel requested so that the worker processing the operation stops it. An operation
+// which has not been picked up by a worker yet is moved to the Canceled state immediately. It returns ErrOperationCompleted
+// if the operation is already in a terminal state.
+func (aom *statusManager) Cancel(ctx context.Context, id resources.ID, operationID uuid.UUID) (*Status, error) {
+	opID := aom.operationStatusResourceID(id, operationID)
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := storeClient.Get(ctx, opID)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return nil, err
+	}
+
+	if s.Status.IsTerminal() {
+		return nil, ErrOperationCompleted
+	}
+
+	now := time.Now().UTC()
+	s.CancelRequested = true
+	s.LastUpdatedTime = now
+
+	// The worker moves the resource to the Canceled state when it receives the request message of a canceled operation.
+	if s.Status == v1.ProvisioningStateAccepted {
+		s.Status = v1.ProvisioningStateCanceled
+		s.EndTime = &now
+		s.Error = &v1.ErrorDetails{
+			Code:    v1.CodeOperationCanceled,
+			Message: "Operation was canceled before it started.",
+		}
+	}
+
+	obj.Data = s
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return nil, err
+	}
+
+	if s.Status.IsTerminal() {
+		err = aom.updateHistory(ctx, id, operationID, func(r *HistoryRecord) {
+			r.Status = s.Status
+			r.EndTime = s.EndTime
+			r.Error = s.Error
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
 func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
 	msg := &ctrl.Request{
@@ -19,17 +19,21 @@ package statusmanager
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,6 +41,7 @@ type asyncOperationsManagerTest struct {
 	manager       StatusManager
 	storeProvider *dataprovider.MockDataStorageProvider
 	storeClient   *store.MockStorageClient
+	historyClient store.StorageClient
 	queue         *queue.MockClient
 }
 
@@ -56,9 +61,24 @@ func setup(tb testing.TB) (asyncOperationsManagerTest, *gomock.Controller) {
 	dp := dataprovider.NewMockDataStorageProvider(ctrl)
 	sc := store.NewMockStorageClient(ctrl)
 	dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(sc, nil)
+	hc := newHistoryClient(tb)
+	dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationhistory").Return(hc, nil).AnyTimes()
+
 	enq := queue.NewMockClient(ctrl)
 	aom := New(dp, enq, "test-location")
-	return asyncOperationsManagerTest{manager: aom, storeProvider: dp, storeClient: sc, queue: enq}, ctrl
+	return asyncOperationsManagerTest{manager: aom, storeProvider: dp, storeClient: sc, historyClient: hc, queue: enq}, ctrl
+}
+
+// newHistoryClient creates the storage client for the history records. A real store is used so that the
+// history records written by the status manager can be verified.
+func newHistoryClient(tb testing.TB) store.StorageClient {
+	db, err := boltstore.OpenDB(filepath.Join(tb.TempDir(), "history.db"))
+	require.NoError(tb, err)
+	tb.Cleanup(func() { _ = db.Close() })
+
+	client := boltstore.NewBoltClient(db)
+	require.NoError(tb, client.Init(context.Background()))
+	return client
 }
 
 var reqCtx = &v1.ARMRequestContext{
@@ -328,3 +348,275 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestRequeueAsyncOperation(t *testing.T) {
+	requeueCases := []struct {
+		Desc       string
+		EnqueueErr error
+	}{
+		{
+			Desc:       "requeue_success",
+			EnqueueErr: nil,
+		},
+		{
+			Desc:       "requeue_enqueue_failure",
+			EnqueueErr: fmt.Errorf(enqueueErr),
+		},
+	}
+
+	for _, tt := range requeueCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			endTime := time.Now().UTC()
+			failed := &Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{
+					ID:      opID.String(),
+					Name:    opID.String(),
+					Status:  v1.ProvisioningStateFailed,
+					EndTime: &endTime,
+					Error:   &v1.ErrorDetails{Code: v1.CodeInternal, Message: "exceeded max retry count"},
+				},
+			}
+
+			aomTest.storeClient.
+				EXPECT().
+				Get(gomock.Any(), "/planes/radius/local/providers/applications.core/locations/test-location/operationstatuses/"+opID.String(), gomock.Any()).
+				Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: failed}, nil)
+
+			aomTest.storeClient.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+					s := obj.Data.(*Status)
+					require.Equal(t, v1.ProvisioningStateAccepted, s.Status)
+					require.Nil(t, s.EndTime)
+					require.Nil(t, s.Error)
+					return nil
+				})
+
+			aomTest.queue.
+				EXPECT().
+				Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tt.EnqueueErr)
+
+			if tt.EnqueueErr != nil {
+				// The original status is restored when the message cannot be queued.
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+						require.Equal(t, v1.ProvisioningStateFailed, obj.Data.(*Status).Status)
+						return nil
+					})
+			}
+
+			err := aomTest.manager.Requeue(context.TODO(), &ctrl.Request{
+				OperationID:   opID,
+				OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+				ResourceID:    ucpEnvResourceID,
+			})
+			if tt.EnqueueErr != nil {
+				require.ErrorContains(t, err, enqueueErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCancelAsyncOperation(t *testing.T) {
+	cancelCases := []struct {
+		Desc            string
+		State           v1.ProvisioningState
+		ExpectedState   v1.ProvisioningState
+		ExpectedErr     error
+		ExpectedEndTime bool
+	}{
+		{
+			Desc:            "cancel_accepted",
+			State:           v1.ProvisioningStateAccepted,
+			ExpectedState:   v1.ProvisioningStateCanceled,
+			ExpectedEndTime: true,
+		},
+		{
+			Desc:          "cancel_updating",
+			State:         v1.ProvisioningStateUpdating,
+			ExpectedState: v1.ProvisioningStateUpdating,
+		},
+		{
+			Desc:        "cancel_completed",
+			State:       v1.ProvisioningStateSucceeded,
+			ExpectedErr: ErrOperationCompleted,
+		},
+	}
+
+	for _, tt := range cancelCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			status := &Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{
+					ID:     opID.String(),
+					Name:   opID.String(),
+					Status: tt.State,
+				},
+			}
+
+			aomTest.storeClient.
+				EXPECT().
+				Get(gomock.Any(), "/planes/radius/local/providers/applications.core/locations/test-location/operationstatuses/"+opID.String(), gomock.Any()).
+				Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: status}, nil)
+
+			if tt.ExpectedErr == nil {
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+						s := obj.Data.(*Status)
+						require.True(t, s.CancelRequested)
+						require.Equal(t, tt.ExpectedState, s.Status)
+						require.Equal(t, tt.ExpectedEndTime, s.EndTime != nil)
+						return nil
+					})
+			}
+
+			rid, err := resources.ParseResource(ucpEnvResourceID)
+			require.NoError(t, err)
+
+			s, err := aomTest.manager.Cancel(context.TODO(), rid, opID)
+			if tt.ExpectedErr != nil {
+				require.ErrorIs(t, err, tt.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.ExpectedState, s.Status)
+		})
+	}
+}
+
+func TestOperationHistory(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	sCtx := *reqCtx
+	sCtx.OperationID = uuid.New()
+	sCtx.ClientPrincipalName = "user@contoso.com"
+	historyID := "/planes/radius/local/providers/applications.core/locations/test-location/operationhistory/" + sCtx.OperationID.String()
+	aomTest.storeProvider.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(aomTest.storeClient, nil).AnyTimes()
+
+	getRecord := func(t *testing.T) *HistoryRecord {
+		obj, err := aomTest.historyClient.Get(context.Background(), historyID)
+		require.NoError(t, err)
+		record := &HistoryRecord{}
+		require.NoError(t, obj.As(record))
+		return record
+	}
+
+	// Queueing the operation creates the history record.
+	aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	err := aomTest.manager.QueueAsyncOperation(context.Background(), &sCtx, QueueOperationOptions{OperationTimeout: operationTimeoutDuration})
+	require.NoError(t, err)
+
+	record := getRecord(t)
+	require.Equal(t, sCtx.ResourceID.String(), record.ResourceID)
+	require.Equal(t, strings.ToLower(sCtx.ResourceID.String()), record.NormalizedResourceID)
+	require.Equal(t, "APPLICATIONS.CORE/ENVIRONMENTS|PUT", record.OperationType)
+	require.Equal(t, v1.ProvisioningStateAccepted, record.Status)
+	require.Equal(t, "user@contoso.com", record.RequestedBy.ClientPrincipalName)
+	require.Equal(t, "client-object-id", record.RequestedBy.ClientObjectID)
+	require.Nil(t, record.EndTime)
+
+	// Completing the operation updates the history record.
+	aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.Object{Data: &Status{}}, nil)
+	aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	endTime := time.Now().UTC()
+	opError := &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed"}
+	err = aomTest.manager.Update(context.Background(), sCtx.ResourceID, sCtx.OperationID, v1.ProvisioningStateFailed, &endTime, opError)
+	require.NoError(t, err)
+
+	record = getRecord(t)
+	require.Equal(t, v1.ProvisioningStateFailed, record.Status)
+	require.NotNil(t, record.EndTime)
+	require.Equal(t, opError, record.Error)
+
+	// Updating an operation without a history record is not an error.
+	aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.Object{Data: &Status{}}, nil)
+	aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	err = aomTest.manager.Update(context.Background(), sCtx.ResourceID, uuid.New(), v1.ProvisioningStateSucceeded, &endTime, nil)
+	require.NoError(t, err)
+}
+
+func TestRecordOperation(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	hc := newHistoryClient(t)
+	dp := dataprovider.NewMockDataStorageProvider(mctrl)
+	dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationhistory").Return(hc, nil)
+	aom := New(dp, nil, "test-location")
+
+	sCtx := *reqCtx
+	sCtx.OperationID = uuid.New()
+	err := aom.RecordOperation(context.Background(), &sCtx, v1.ProvisioningStateSucceeded, nil)
+	require.NoError(t, err)
+
+	obj, err := hc.Get(context.Background(), "/planes/radius/local/providers/applications.core/locations/test-location/operationhistory/"+sCtx.OperationID.String())
+	require.NoError(t, err)
+	record := &HistoryRecord{}
+	require.NoError(t, obj.As(record))
+	require.Equal(t, v1.ProvisioningStateSucceeded, record.Status)
+	require.NotNil(t, record.EndTime)
+}
+
+func TestPruneHistory(t *testing.T) {
+	ctx := context.Background()
+	hc := newHistoryClient(t)
+
+	const historyScope = "/planes/radius/local/providers/applications.core/locations/test-location/operationhistory/"
+	envID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+	appID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app0"
+
+	save := func(resourceID string, startTime time.Time) *HistoryRecord {
+		operationID := uuid.New().String()
+		record := &HistoryRecord{
+			ID:                   historyScope + operationID,
+			OperationID:          operationID,
+			ResourceID:           resourceID,
+			NormalizedResourceID: strings.ToLower(resourceID),
+			StartTime:            startTime,
+		}
+		require.NoError(t, hc.Save(ctx, &store.Object{Metadata: store.Metadata{ID: record.ID}, Data: record}))
+		return record
+	}
+
+	start := time.Now().UTC().Add(-time.Hour)
+	appRecord := save(appID, start)
+	envRecords := []*HistoryRecord{}
+	for i := 0; i <= MaxHistoryRecordsPerResource; i++ {
+		envRecords = append(envRecords, save(envID, start.Add(time.Duration(i)*time.Second)))
+	}
+
+	err := pruneHistory(ctx, hc, envRecords[len(envRecords)-1])
+	require.NoError(t, err)
+
+	// The oldest record of the resource is deleted.
+	_, err = hc.Get(ctx, envRecords[0].ID)
+	require.ErrorIs(t, err, &store.ErrNotFound{ID: envRecords[0].ID})
+
+	result, err := hc.Query(ctx, store.Query{
+		RootScope:    "/planes/radius/local",
+		ResourceType: "applications.core/locations/operationhistory",
+		Filters:      []store.QueryFilter{HistoryResourceFilter(envID)},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Items, MaxHistoryRecordsPerResource)
+
+	// The records of other resources are kept.
+	_, err = hc.Get(ctx, appRecord.ID)
+	require.NoError(t, err)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/trace"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/queue/deadletter"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -59,6 +60,9 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultCancellationCheckInterval is the default interval to check whether the cancellation of the running operation was requested.
+	defaultCancellationCheckInterval = time.Duration(5) * time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -80,6 +84,22 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// CancellationCheckInterval is the interval to check whether the cancellation of the running operation was requested.
+	CancellationCheckInterval time.Duration
+
+	// DeadLetterScope is the resource id of the location whose dead-letter queue receives the messages which can not be
+	// attributed to an async operation, for example /planes/radius/local/providers/Applications.Core/locations/global.
+	DeadLetterScope string
+}
+
+// DeadLetterScope returns the resource id of the location of the provider namespace in the local Radius plane, to be used
+// as Options.DeadLetterScope. The global location is used if location is empty.
+func DeadLetterScope(namespace string, location string) string {
+	if location == "" {
+		location = v1.LocationGlobal
+	}
+	return fmt.Sprintf("/planes/radius/local/providers/%s/locations/%s", namespace, location)
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -116,6 +136,9 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.CancellationCheckInterval == time.Duration(0) {
+		options.CancellationCheckInterval = defaultCancellationCheckInterval
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -148,6 +171,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			op := &ctrl.Request{}
 			if err := json.Unmarshal(msgreq.Data, op); err != nil {
 				logger.Error(err, "failed to unmarshal queue message.")
+				w.deadLetterUnattributed(ctx, msgreq, deadletter.ReasonMalformedMessage, "failed to unmarshal queue message: "+err.Error())
 				return
 			}
 
@@ -165,30 +189,56 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			armReqCtx, err := op.ARMRequestContext()
 			if err != nil {
 				opLogger.Error(err, "failed to get ARM request context.")
+				errMsg := "failed to get ARM request context: " + err.Error()
+				w.failOperationStatus(reqCtx, op, errMsg)
+				w.deadLetterUnattributed(reqCtx, msgreq, deadletter.ReasonInvalidRequest, errMsg)
 				return
 			}
 			reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
 
 			asyncCtrl := w.registry.Get(armReqCtx.OperationType)
 			if asyncCtrl == nil {
-				opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
+				errMsg := "cannot process unknown operation: " + armReqCtx.OperationType.String()
+				opLogger.Error(nil, errMsg)
+				w.deadLetter(reqCtx, msgreq, op, deadletter.ReasonUnknownOperationType, errMsg)
+				w.failOperationStatus(reqCtx, op, errMsg)
 				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
 					opLogger.Error(err, "failed to finish the message")
 				}
 				return
 			}
 
+			// The retry count is checked first so that a message which fails before the operation runs, for example because
+			// its operation status can not be read, is dead-lettered rather than redelivered forever.
 			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
 				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
 				opLogger.Error(nil, errMsg)
+				w.deadLetter(reqCtx, msgreq, op, deadletter.ReasonMaxRetryCountExceeded, errMsg)
 				failed := ctrl.NewFailedResult(v1.ErrorDetails{
 					Code:    v1.CodeInternal,
 					Message: errMsg,
 				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
+				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl)
 				return
 			}
 
+			// The operation was canceled before the worker started processing it.
+			canceled, err := w.isCancelRequested(reqCtx, op, true)
+			if err != nil {
+				opLogger.Error(err, "failed to check the cancellation of the operation.")
+				return
+			}
+			if canceled {
+				opLogger.Info("operation was canceled before it started")
+				w.completeOperation(reqCtx, msgreq, newUserCanceledResult(op), asyncCtrl)
+				return
+			}
+
+			// Record the redelivery of the message so that the dequeue history is kept if the message is dead-lettered later.
+			if msgreq.DequeueCount > 1 {
+				w.recordAttempt(reqCtx, msgreq, op, "")
+			}
+
 			// TODO: Handle the edge cases:
 			// 1. The same message is delivered twice in multiple instances.
 			// 2. provisioningState is not matched between resource and operationStatuses
@@ -241,6 +291,7 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			if err := recover(); err != nil {
 				msg := fmt.Errorf("recovering from panic %v: %s", err, debug.Stack())
 				logger.Error(msg, "recovering from panic")
+				w.recordAttempt(ctx, message, asyncReq, fmt.Sprintf("recovering from panic %v", err))
 
 				// When backend controller has a critical bug such as nil reference, asyncCtrl.Run() is panicking.
 				// If this happens, the message is requeued after message lock time (5 mins).
@@ -262,24 +313,28 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 				result.SetFailed(armErr, false)
 				logger.Error(err, "Operation Failed")
 			}
-			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
+			w.completeOperation(ctx, message, result, asyncCtrl)
 		}
 		trace.SetAsyncResultStatus(result, span)
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	messageExtendTimer := time.NewTimer(w.getMessageExtendDuration(message.NextVisibleAt))
+	defer messageExtendTimer.Stop()
+
+	cancellationTicker := time.NewTicker(w.options.CancellationCheckInterval)
+	defer cancellationTicker.Stop()
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-messageExtendTimer.C:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendTimer.Reset(w.getMessageExtendDuration(message.NextVisibleAt))
 
 		case <-operationTimeoutAfter:
 			logger.Info("Cancelling async operation.")
@@ -288,7 +343,24 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
 			result := ctrl.NewCanceledResult(errMessage)
 			result.Error.Target = asyncReq.ResourceID
-			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
+			w.completeOperation(ctx, message, result, asyncCtrl)
+			return
+
+		case <-cancellationTicker.C:
+			canceled, err := w.isCancelRequested(ctx, asyncReq, false)
+			if err != nil {
+				logger.Error(err, "failed to check the cancellation of the operation.")
+				continue
+			}
+			if !canceled {
+				continue
+			}
+
+			logger.Info("Cancelling async operation as requested.")
+
+			// Cancelling asyncReqCtx stops the controller and the recipe drivers that it calls.
+			opCancel()
+			w.completeOperation(ctx, message, newUserCanceledResult(asyncReq), asyncCtrl)
 			return
 
 		case <-ctx.Done():
@@ -306,6 +378,28 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// isCancelRequested returns true if the cancellation of the operation was requested. A request to cancel an operation
+// which is already in a terminal state is ignored unless includeTerminal is set.
+func (w *AsyncRequestProcessWorker) isCancelRequested(ctx context.Context, req *ctrl.Request, includeTerminal bool) (bool, error) {
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return false, err
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil {
+		return false, err
+	}
+
+	return status.CancelRequested && (includeTerminal || !status.Status.IsTerminal()), nil
+}
+
+func newUserCanceledResult(req *ctrl.Request) ctrl.Result {
+	result := ctrl.NewCanceledResult(fmt.Sprintf("Operation (%s) was canceled by the user.", req.OperationType))
+	result.Error.Target = req.ResourceID
+	return result
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -314,7 +408,7 @@ func extractError(err error) v1.ErrorDetails {
 	}
 }
 
-func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, message *queue.Message, result ctrl.Result, sc store.StorageClient) {
+func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, message *queue.Message, result ctrl.Result, asyncCtrl ctrl.Controller) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	req := &ctrl.Request{}
 	if err := json.Unmarshal(message.Data, req); err != nil {
@@ -322,10 +416,22 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		return
 	}
 
-	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
+	state := result.ProvisioningState()
+	err := w.updateResourceAndOperationStatus(ctx, asyncCtrl.StorageClient(), req, state, result.Error)
 	if err != nil {
 		logger.Error(err, "failed to update resource and/or operation status")
-		return
+		// The message is redelivered to retry the update, unless it exceeded the max retry count and was dead-lettered.
+		if message.DequeueCount <= w.options.MaxOperationRetryCount {
+			return
+		}
+	}
+
+	// Let the controller clean up the state of the resource which would otherwise be left in progress.
+	if handler, ok := asyncCtrl.(ctrl.FailureHandler); ok && !result.Requeue &&
+		(state == v1.ProvisioningStateFailed || state == v1.ProvisioningStateCanceled) {
+		if err := handler.OnFailure(ctx, req, result); err != nil {
+			logger.Error(err, "failed to handle the failure of the operation")
+		}
 	}
 
 	// Finish the message only if Requeue is false. Otherwise, AsyncRequestProcessWorker will requeue the message and process it again.
@@ -333,6 +439,15 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
 			logger.Error(err, "failed to finish the message")
 		}
+		if message.DequeueCount > 1 {
+			w.forgetAttempts(ctx, req)
+		}
+	} else {
+		attemptErr := "operation was requeued"
+		if result.Error != nil {
+			attemptErr = result.Error.Message
+		}
+		w.recordAttempt(ctx, message, req, attemptErr)
 	}
 
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
@@ -366,6 +481,157 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 	return nil
 }
 
+// deadLetterClient returns the dead-letter queue client and the id of the dead-letter entry for the async operation request.
+// Dead-letter entries are stored next to the operation status of the request.
+func (w *AsyncRequestProcessWorker) deadLetterClient(ctx context.Context, req *ctrl.Request) (deadletter.Client, string, error) {
+	if w.registry == nil || w.registry.sp == nil {
+		return nil, "", errors.New("storage provider is unset")
+	}
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	statusID, err := resources.ParseResource(status.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sc, err := w.registry.sp.GetStorageClient(ctx, deadletter.ResourceType(rID.ProviderNamespace()))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return deadletter.New(sc), deadletter.OperationID(statusID), nil
+}
+
+// deadLetter moves the message to the dead-letter queue. Failures are logged because the message must still be completed.
+func (w *AsyncRequestProcessWorker) deadLetter(ctx context.Context, message *queue.Message, req *ctrl.Request, reason deadletter.Reason, lastError string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	dlq, id, err := w.deadLetterClient(ctx, req)
+	if err != nil {
+		// The operation status can not be read, so the message is moved to the dead-letter queue of Options.DeadLetterScope.
+		logger.Error(err, "failed to get dead-letter queue client of the operation")
+		if err := w.moveUnattributed(ctx, message, reason, lastError); err != nil {
+			logger.Error(err, "failed to move the message to dead-letter queue")
+		}
+		return
+	}
+
+	if _, err := dlq.DeadLetter(ctx, id, message, reason, lastError); err != nil {
+		logger.Error(err, "failed to move the message to dead-letter queue")
+		return
+	}
+
+	logger.Info("Moved the message to dead-letter queue.", "deadLetterID", id, "reason", reason)
+}
+
+// deadLetterUnattributed moves a message which can not be attributed to an async operation to the dead-letter queue
+// of Options.DeadLetterScope and finishes the message so that it is not redelivered. The entry is named after the id
+// of the message.
+func (w *AsyncRequestProcessWorker) deadLetterUnattributed(ctx context.Context, message *queue.Message, reason deadletter.Reason, lastError string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if err := w.moveUnattributed(ctx, message, reason, lastError); err != nil {
+		logger.Error(err, "failed to move the message to dead-letter queue")
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+}
+
+func (w *AsyncRequestProcessWorker) moveUnattributed(ctx context.Context, message *queue.Message, reason deadletter.Reason, lastError string) error {
+	if w.options.DeadLetterScope == "" {
+		return errors.New("dead-letter scope is unset")
+	}
+	if w.registry == nil || w.registry.sp == nil {
+		return errors.New("storage provider is unset")
+	}
+
+	scope, err := resources.ParseResource(w.options.DeadLetterScope)
+	if err != nil {
+		return err
+	}
+
+	name := message.ID
+	if name == "" {
+		name = uuid.NewString()
+	}
+	id := scope.Append(resources.TypeSegment{Type: "deadletteredoperations", Name: name}).String()
+
+	sc, err := w.registry.sp.GetStorageClient(ctx, deadletter.ResourceType(scope.ProviderNamespace()))
+	if err != nil {
+		return err
+	}
+
+	if _, err := deadletter.New(sc).DeadLetter(ctx, id, message, reason, lastError); err != nil {
+		return err
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("Moved the message to dead-letter queue.", "deadLetterID", id, "reason", reason)
+	return nil
+}
+
+// recordAttempt records the delivery attempt of the message in the dead-letter queue history.
+func (w *AsyncRequestProcessWorker) recordAttempt(ctx context.Context, message *queue.Message, req *ctrl.Request, attemptErr string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	dlq, id, err := w.deadLetterClient(ctx, req)
+	if err != nil {
+		logger.Error(err, "failed to get dead-letter queue client")
+		return
+	}
+
+	attempt := deadletter.Attempt{
+		DequeueCount: message.DequeueCount,
+		DequeuedAt:   time.Now().UTC(),
+		Error:        attemptErr,
+	}
+	if err := dlq.RecordAttempt(ctx, id, message, attempt); err != nil {
+		logger.Error(err, "failed to record the delivery attempt")
+	}
+}
+
+// forgetAttempts deletes the delivery history of the message once the operation is completed.
+func (w *AsyncRequestProcessWorker) forgetAttempts(ctx context.Context, req *ctrl.Request) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	dlq, id, err := w.deadLetterClient(ctx, req)
+	if err != nil {
+		logger.Error(err, "failed to get dead-letter queue client")
+		return
+	}
+
+	if err := dlq.Forget(ctx, id); err != nil {
+		logger.Error(err, "failed to delete the delivery history")
+	}
+}
+
+// failOperationStatus marks the operation status as failed when the operation cannot be processed by any controller.
+func (w *AsyncRequestProcessWorker) failOperationStatus(ctx context.Context, req *ctrl.Request, errMsg string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return
+	}
+
+	now := time.Now().UTC()
+	err = w.sm.Update(ctx, rID, req.OperationID, v1.ProvisioningStateFailed, &now, &v1.ErrorDetails{Code: v1.CodeInternal, Message: errMsg})
+	if err != nil {
+		logger.Error(err, "failed to update operationstatus", "operationID", req.OperationID.String())
+	}
+}
+
 func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, sc store.StorageClient, resourceID string, operationID uuid.UUID) (bool, error) {
 	rID, err := resources.ParseResource(resourceID)
 	if err != nil {
@@ -18,8 +18,10 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -32,9 +34,11 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/queue/deadletter"
 	"github.com/radius-project/radius/pkg/ucp/queue/inmemory"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
 )
@@ -73,6 +77,17 @@ func (c *testAsyncController) Run(ctx context.Context, request *ctrl.Request) (c
 	return ctrl.Result{}, nil
 }
 
+// testFailureController is a test controller which records the results passed to OnFailure.
+type testFailureController struct {
+	testAsyncController
+	failures chan ctrl.Result
+}
+
+func (c *testFailureController) OnFailure(ctx context.Context, request *ctrl.Request, result ctrl.Result) error {
+	c.failures <- result
+	return nil
+}
+
 type testContext struct {
 	ctx    context.Context
 	mockSC *store.MockStorageClient
@@ -125,6 +140,35 @@ func newTestContext(t *testing.T, lockTime time.Duration) (*testContext, *gomock
 	}, mctrl
 }
 
+// testDeadLetterID returns the id of the dead-letter entry for the operation queued by genTestMessage.
+func testDeadLetterID(operationID uuid.UUID) string {
+	return "/subscriptions/00000000-0000-0000-0000-000000000000/providers/applications.core/locations/global/deadletteredoperations/" + operationID.String()
+}
+
+// expectDeadLetterQueue sets up the status manager and data provider mocks to store dead-letter entries in a bolt store
+// and returns the dead-letter queue client to verify the entries.
+func (c *testContext) expectDeadLetterQueue(t *testing.T) deadletter.Client {
+	db, err := boltstore.OpenDB(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc := boltstore.NewBoltClient(db)
+	require.NoError(t, sc.Init(c.ctx))
+
+	c.mockSP.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/deadletteredoperations").Return(store.StorageClient(sc), nil).AnyTimes()
+	c.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id resources.ID, operationID uuid.UUID) (*manager.Status, error) {
+			return &manager.Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{
+					ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers/applications.core/locations/global/operationstatuses/" + operationID.String(),
+					Name:   operationID.String(),
+					Status: v1.ProvisioningStateAccepted,
+				},
+				LinkedResourceID: id.String(),
+			}, nil
+		}).AnyTimes()
+
+	return deadletter.New(sc)
+}
+
 func genTestMessage(opID uuid.UUID, opTimeout time.Duration) *queue.Message {
 	testMessage := queue.NewMessage(&ctrl.Request{
 		OperationID:   opID,
@@ -148,13 +192,16 @@ func TestStart_UnknownOperation(t *testing.T) {
 	defer mctrl.Finish()
 
 	registry := NewControllerRegistry(tCtx.mockSP)
-	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, nil, tCtx.testQueue, registry)
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
 
 	tCtx.mockSP.EXPECT().
-		GetStorageClient(gomock.Any(), gomock.Any()).
+		GetStorageClient(gomock.Any(), testResourceType).
 		Return(tCtx.mockSC, nil).
 		Times(1)
 
+	dlqClient := tCtx.expectDeadLetterQueue(t)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
 	opts := ctrl.Options{
 		StorageClient: tCtx.mockSC,
 		DataProvider:  tCtx.mockSP,
@@ -190,7 +237,8 @@ func TestStart_UnknownOperation(t *testing.T) {
 	}()
 
 	// Queue async operation.
-	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	operationID := uuid.New()
+	testMessage := genTestMessage(operationID, ctrl.DefaultAsyncOperationTimeout)
 	err = tCtx.testQueue.Enqueue(ctx, testMessage)
 	require.NoError(t, err)
 
@@ -202,6 +250,81 @@ func TestStart_UnknownOperation(t *testing.T) {
 
 	require.Equal(t, 1, testMessage.DequeueCount)
 	require.False(t, called)
+
+	dl, err := dlqClient.Get(context.Background(), testDeadLetterID(operationID))
+	require.NoError(t, err)
+	require.Equal(t, deadletter.ReasonUnknownOperationType, dl.Reason)
+	require.Equal(t, "cannot process unknown operation: APPLICATIONS.CORE/ENVIRONMENTS|PUT", dl.LastError)
+	require.JSONEq(t, string(testMessage.Data), string(dl.Data))
+}
+
+func TestStart_UnattributedMessage(t *testing.T) {
+	invalidRequest := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	req := &ctrl.Request{}
+	require.NoError(t, json.Unmarshal(invalidRequest.Data, req))
+	req.OperationType = "invalid"
+	invalidRequest.Data, _ = json.Marshal(req)
+
+	tests := []struct {
+		name    string
+		message *queue.Message
+		reason  deadletter.Reason
+		err     string
+	}{
+		{
+			name:    "malformed message",
+			message: queue.NewMessage("not a request"),
+			reason:  deadletter.ReasonMalformedMessage,
+			err:     "failed to unmarshal queue message",
+		},
+		{
+			name:    "invalid request",
+			message: invalidRequest,
+			reason:  deadletter.ReasonInvalidRequest,
+			err:     "failed to get ARM request context",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+			defer mctrl.Finish()
+
+			dlqClient := tCtx.expectDeadLetterQueue(t)
+			tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			registry := NewControllerRegistry(tCtx.mockSP)
+			options := Options{
+				DequeueIntervalDuration: defaultTestDequeueInterval,
+				DeadLetterScope:         DeadLetterScope("Applications.Core", ""),
+			}
+			worker := New(options, tCtx.mockSM, tCtx.testQueue, registry)
+
+			ctx, cancel := tCtx.cancellable(0)
+			done := make(chan struct{}, 1)
+			go func() {
+				err := worker.Start(ctx)
+				require.NoError(t, err)
+				close(done)
+			}()
+
+			err := tCtx.testQueue.Enqueue(ctx, tt.message)
+			require.NoError(t, err)
+
+			tCtx.drainQueueOrAssert(t)
+
+			// Cancelling worker loop
+			cancel()
+			<-done
+
+			require.Equal(t, 1, tt.message.DequeueCount)
+
+			dl, err := dlqClient.Get(context.Background(), "/planes/radius/local/providers/Applications.Core/locations/global/deadletteredoperations/"+tt.message.ID)
+			require.NoError(t, err)
+			require.Equal(t, tt.reason, dl.Reason)
+			require.Contains(t, dl.LastError, tt.err)
+		})
+	}
 }
 
 func TestStart_MaxDequeueCount(t *testing.T) {
@@ -215,7 +338,8 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), testResourceType).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
+	dlqClient := tCtx.expectDeadLetterQueue(t)
 
 	expectedDequeueCount := 2
 
@@ -246,7 +370,8 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	require.NoError(t, err)
 
 	// Queue async operation.
-	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	operationID := uuid.New()
+	testMessage := genTestMessage(operationID, ctrl.DefaultAsyncOperationTimeout)
 	err = tCtx.testQueue.Enqueue(ctx, testMessage)
 	require.NoError(t, err)
 	testMessage.DequeueCount = expectedDequeueCount + 1
@@ -265,6 +390,154 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	<-done
 
 	require.Equal(t, expectedDequeueCount+2, testMessage.DequeueCount)
+
+	dl, err := dlqClient.Get(context.Background(), testDeadLetterID(operationID))
+	require.NoError(t, err)
+	require.Equal(t, deadletter.ReasonMaxRetryCountExceeded, dl.Reason)
+	require.Equal(t, expectedDequeueCount+2, dl.DequeueCount)
+	require.Contains(t, dl.LastError, "exceeded max retry count")
+}
+
+func TestStart_MaxDequeueCount_MissingOperationStatus(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, 1*time.Minute)
+	defer mctrl.Finish()
+
+	db, err := boltstore.OpenDB(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	dlqStore := boltstore.NewBoltClient(db)
+	require.NoError(t, dlqStore.Init(tCtx.ctx))
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &store.ErrNotFound{}).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrNotFound{}).AnyTimes()
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), testResourceType).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/deadletteredoperations").Return(store.StorageClient(dlqStore), nil).AnyTimes()
+
+	maxRetryCount := 2
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	options := Options{
+		MaxOperationRetryCount:  maxRetryCount,
+		DequeueIntervalDuration: defaultTestDequeueInterval,
+		DeadLetterScope:         DeadLetterScope("Applications.Core", ""),
+	}
+	worker := New(options, tCtx.mockSM, tCtx.testQueue, registry)
+
+	called := false
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC, DataProvider: tCtx.mockSP}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			called = true
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(0)
+	err = registry.Register(
+		ctx,
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, ctrl.Options{
+			DataProvider: tCtx.mockSP,
+		})
+	require.NoError(t, err)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+	testMessage.DequeueCount = maxRetryCount
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err := worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	// The message is dead-lettered and finished after the max retry count even though the operation status is missing.
+	require.Equal(t, maxRetryCount+1, testMessage.DequeueCount)
+	require.False(t, called)
+
+	dl, err := deadletter.New(dlqStore).Get(context.Background(), "/planes/radius/local/providers/Applications.Core/locations/global/deadletteredoperations/"+testMessage.ID)
+	require.NoError(t, err)
+	require.Equal(t, deadletter.ReasonMaxRetryCountExceeded, dl.Reason)
+}
+
+func TestStart_CanceledOperation(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			require.Equal(t, string(v1.ProvisioningStateCanceled), obj.Data.(map[string]any)["provisioningState"])
+			return nil
+		}).Times(1)
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{Status: v1.ProvisioningStateCanceled},
+		CancelRequested:      true,
+	}, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateCanceled), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), testResourceType).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
+
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	called := false
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC, DataProvider: tCtx.mockSP}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			called = true
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(0)
+	err := registry.Register(
+		ctx,
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, ctrl.Options{
+			DataProvider: tCtx.mockSP,
+		})
+	require.NoError(t, err)
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err = tCtx.testQueue.Enqueue(ctx, testMessage)
+	require.NoError(t, err)
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop
+	cancel()
+	<-done
+
+	require.Equal(t, 1, testMessage.DequeueCount)
+	require.False(t, called)
 }
 
 func TestStart_MaxConcurrency(t *testing.T) {
@@ -468,6 +741,7 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -595,6 +869,102 @@ func TestRunOperation_Timeout(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_Timeout_FailureHandler(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), v1.ProvisioningStateCanceled, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), 10*time.Millisecond)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+	}
+
+	done := make(chan struct{}, 1)
+	testCtrl := &testFailureController{
+		testAsyncController: testAsyncController{
+			BaseController: ctrl.NewBaseAsyncController(opts),
+			fn: func(ctx context.Context) (ctrl.Result, error) {
+				<-ctx.Done()
+				close(done)
+				return ctrl.Result{}, nil
+			},
+		},
+		failures: make(chan ctrl.Result, 1),
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+	<-done
+
+	result := <-testCtrl.failures
+	require.Equal(t, v1.ProvisioningStateCanceled, result.ProvisioningState())
+	require.True(t, strings.HasPrefix(result.Error.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has timed out"))
+}
+
+func TestRunOperation_CancelRequested(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{Status: v1.ProvisioningStateUpdating},
+		CancelRequested:      true,
+	}, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+			require.Equal(t, v1.ProvisioningStateCanceled, state)
+			require.Equal(t, v1.CodeOperationCanceled, opError.Code)
+			require.Equal(t, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) was canceled by the user.", opError.Message)
+			return nil
+		}).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{CancellationCheckInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+	}
+
+	done := make(chan struct{}, 1)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			// The controller observes the cancellation through its context.
+			<-ctx.Done()
+			close(done)
+			return ctrl.Result{}, ctx.Err()
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+	<-done
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
@@ -628,3 +998,53 @@ func TestRunOperation_PanicController(t *testing.T) {
 
 	require.Equal(t, 1, tCtx.internalQ.Len(), "ensure that message is not finished")
 }
+
+func TestRunOperation_RequeueRecordsAttempt(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	dlqClient := tCtx.expectDeadLetterQueue(t)
+
+	operationID := uuid.New()
+	testMessage := genTestMessage(operationID, ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, NewControllerRegistry(tCtx.mockSP))
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			result := ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeInternal, Message: "transient error"})
+			result.Requeue = true
+			return result, nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.Equal(t, 1, tCtx.internalQ.Len(), "ensure that message is not finished")
+
+	// The attempt is tracked but the message is not dead-lettered yet.
+	_, err = dlqClient.Get(context.Background(), testDeadLetterID(operationID))
+	require.ErrorIs(t, err, &store.ErrNotFound{})
+
+	dl, err := dlqClient.DeadLetter(context.Background(), testDeadLetterID(operationID), msg, deadletter.ReasonMaxRetryCountExceeded, "")
+	require.NoError(t, err)
+	require.Equal(t, "transient error", dl.LastError)
+	require.Equal(t, "transient error", dl.History[0].Error)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// AuthorizationHeader is the header carrying the bearer token of a request.
+	AuthorizationHeader = "Authorization"
+
+	bearerPrefix = "bearer "
+)
+
+// Principal represents the authenticated caller of a request.
+type Principal struct {
+	// Name is the name of the user or service account, for example "system:serviceaccount:radius-system:controller".
+	Name string
+
+	// Groups is the list of groups the caller belongs to.
+	Groups []string
+
+	// Authenticator is the name of the authenticator which authenticated the caller.
+	Authenticator string
+}
+
+// Authenticator authenticates the caller of a request.
+type Authenticator interface {
+	// Authenticate returns the principal of the caller of the request. It returns a nil principal and a nil error
+	// if the request doesn't carry the credentials this authenticator handles, and an error if the credentials are invalid.
+	Authenticate(r *http.Request) (*Principal, error)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of the context with the given principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal of the context, or nil if the request was not authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	if !ok {
+		return nil
+	}
+	return principal
+}
+
+var _ Authenticator = (unionAuthenticator)(nil)
+
+// unionAuthenticator authenticates a request with the first authenticator which accepts its credentials.
+type unionAuthenticator []Authenticator
+
+// NewUnionAuthenticator returns an authenticator which tries the given authenticators in order and returns the
+// principal returned by the first one which accepts the credentials of the request. The errors of the authenticators
+// which rejected the credentials are returned if none of them accepts the credentials.
+func NewUnionAuthenticator(authenticators ...Authenticator) Authenticator {
+	return unionAuthenticator(authenticators)
+}
+
+// Authenticate returns the principal returned by the first authenticator which accepts the credentials of the request.
+func (u unionAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	errs := []error{}
+	for _, authenticator := range u {
+		principal, err := authenticator.Authenticate(r)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if principal != nil {
+			return principal, nil
+		}
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// Authenticate is the middleware which authenticates the caller of each request with the given authenticator and
+// adds its principal to the request context. Requests which can't be authenticated are rejected with a 401 response.
+// The paths in anonymousPaths, such as the health probes, are served without authentication.
+func Authenticate(authenticator Authenticator, anonymousPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, path := range anonymousPaths {
+				if strings.EqualFold(strings.TrimSuffix(r.URL.Path, "/"), strings.TrimSuffix(path, "/")) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			logger := ucplog.FromContextOrDiscard(r.Context())
+			principal, err := authenticator.Authenticate(r)
+			if err == nil && principal == nil {
+				err = errors.New("the request doesn't carry any credentials")
+			}
+			if err != nil {
+				logger.Info("Authentication failed", "method", r.Method, "path", r.URL.Path, "reason", err.Error())
+				handleErr(r.Context(), w, r)
+				return
+			}
+
+			logger.V(ucplog.LevelDebug).Info("Authenticated request", "principal", principal.Name, "groups", principal.Groups, "authenticator", principal.Authenticator)
+
+			// The credentials of the caller must not be forwarded to the downstream services.
+			r.Header.Del(AuthorizationHeader)
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// bearerToken returns the bearer token of the request, or an empty string if the request doesn't have one.
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get(AuthorizationHeader)
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return ""
+	}
+
+	return strings.TrimSpace(header[len(bearerPrefix):])
+}
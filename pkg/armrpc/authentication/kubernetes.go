@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// TokenReviewAuthenticatorName is the name of the Kubernetes TokenReview authenticator.
+	TokenReviewAuthenticatorName = "kubernetes-tokenreview"
+
+	// RequestHeaderAuthenticatorName is the name of the Kubernetes front-proxy authenticator.
+	RequestHeaderAuthenticatorName = "kubernetes-requestheader"
+
+	// RemoteUserHeader is the header set by the Kubernetes API server to the name of the caller of a request proxied
+	// to an aggregated API server.
+	RemoteUserHeader = "X-Remote-User"
+
+	// RemoteGroupHeader is the header set by the Kubernetes API server to the groups of the caller of a request proxied
+	// to an aggregated API server.
+	RemoteGroupHeader = "X-Remote-Group"
+
+	// tokenReviewCacheTTL is the duration for which the result of a successful TokenReview is cached.
+	tokenReviewCacheTTL = 1 * time.Minute
+)
+
+var _ Authenticator = (*tokenReviewAuthenticator)(nil)
+
+// tokenReviewAuthenticator authenticates bearer tokens with the Kubernetes TokenReview API.
+type tokenReviewAuthenticator struct {
+	client    kubernetes.Interface
+	audiences []string
+
+	mu    sync.Mutex
+	cache map[string]tokenReviewCacheEntry
+}
+
+type tokenReviewCacheEntry struct {
+	principal *Principal
+	expiry    time.Time
+}
+
+// NewTokenReviewAuthenticator creates an authenticator which validates bearer tokens with the TokenReview API of the
+// Kubernetes cluster. When audiences is not empty the tokens must be issued for one of the audiences.
+func NewTokenReviewAuthenticator(client kubernetes.Interface, audiences []string) Authenticator {
+	return &tokenReviewAuthenticator{
+		client:    client,
+		audiences: audiences,
+		cache:     map[string]tokenReviewCacheEntry{},
+	}
+}
+
+// Authenticate reviews the bearer token of the request and returns the Kubernetes user it belongs to.
+func (a *tokenReviewAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return nil, nil
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(hash[:])
+
+	a.mu.Lock()
+	entry, ok := a.cache[key]
+	a.mu.Unlock()
+	if ok && time.Now().Before(entry.expiry) {
+		return entry.principal, nil
+	}
+
+	review, err := a.client.AuthenticationV1().TokenReviews().Create(r.Context(), &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.audiences,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to review the token: %w", err)
+	}
+
+	if !review.Status.Authenticated {
+		if review.Status.Error != "" {
+			return nil, fmt.Errorf("invalid Kubernetes token: %s", review.Status.Error)
+		}
+		return nil, errors.New("invalid Kubernetes token")
+	}
+
+	principal := &Principal{
+		Name:          review.Status.User.Username,
+		Groups:        review.Status.User.Groups,
+		Authenticator: TokenReviewAuthenticatorName,
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := time.Now()
+	for k, v := range a.cache {
+		if now.After(v.expiry) {
+			delete(a.cache, k)
+		}
+	}
+	a.cache[key] = tokenReviewCacheEntry{principal: principal, expiry: now.Add(tokenReviewCacheTTL)}
+
+	return principal, nil
+}
+
+var _ Authenticator = (*requestHeaderAuthenticator)(nil)
+
+// requestHeaderAuthenticator authenticates the requests proxied by the Kubernetes API server to an aggregated API
+// server, such as UCP, and by UCP to the resource providers. The proxy authenticates the caller and passes its identity
+// in the X-Remote-User and X-Remote-Group headers, which are trusted only when the request presents a client certificate
+// issued by the front-proxy certificate authority.
+type requestHeaderAuthenticator struct {
+	roots        *x509.CertPool
+	allowedNames []string
+}
+
+// NewRequestHeaderAuthenticator creates an authenticator which trusts the identity headers of the requests presenting
+// a client certificate signed by the certificate authority in options.ClientCAFile. The server must request client
+// certificates (tls.RequestClientCert) for the authenticator to see them.
+func NewRequestHeaderAuthenticator(options RequestHeaderOptions) (Authenticator, error) {
+	if options.ClientCAFile == "" {
+		return nil, errors.New("the client CA file of the request header authentication is required")
+	}
+
+	pem, err := os.ReadFile(options.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the client CA file: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("the client CA file %q doesn't contain any certificate", options.ClientCAFile)
+	}
+
+	return &requestHeaderAuthenticator{roots: roots, allowedNames: options.AllowedNames}, nil
+}
+
+// Authenticate verifies the client certificate of the request and returns the principal described by its identity headers.
+func (a *requestHeaderAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	if r.Header.Get(RemoteUserHeader) == "" {
+		return nil, nil
+	}
+
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, fmt.Errorf("the %s header requires a client certificate", RemoteUserHeader)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	cert := r.TLS.PeerCertificates[0]
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:         a.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid front-proxy client certificate: %w", err)
+	}
+
+	if len(a.allowedNames) > 0 && !slices.Contains(a.allowedNames, cert.Subject.CommonName) {
+		return nil, fmt.Errorf("the front-proxy client certificate %q is not allowed", cert.Subject.CommonName)
+	}
+
+	groups := []string{}
+	for _, value := range r.Header.Values(RemoteGroupHeader) {
+		for _, group := range strings.Split(value, ",") {
+			if group = strings.TrimSpace(group); group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	return &Principal{
+		Name:          r.Header.Get(RemoteUserHeader),
+		Groups:        groups,
+		Authenticator: RequestHeaderAuthenticatorName,
+	}, nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func Test_TokenReviewAuthenticator(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	reviews := 0
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		require.Equal(t, []string{"ucp"}, review.Spec.Audiences)
+
+		if review.Spec.Token == "valid" {
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User: authenticationv1.UserInfo{
+					Username: "system:serviceaccount:radius-system:applications-rp",
+					Groups:   []string{"system:serviceaccounts"},
+				},
+			}
+		} else {
+			review.Status = authenticationv1.TokenReviewStatus{Error: "token expired"}
+		}
+		return true, review, nil
+	})
+
+	authenticator := NewTokenReviewAuthenticator(client, []string{"ucp"})
+
+	newRequest := func(token string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/planes", nil)
+		if token != "" {
+			r.Header.Set(AuthorizationHeader, "Bearer "+token)
+		}
+		return r
+	}
+
+	expected := &Principal{
+		Name:          "system:serviceaccount:radius-system:applications-rp",
+		Groups:        []string{"system:serviceaccounts"},
+		Authenticator: TokenReviewAuthenticatorName,
+	}
+
+	principal, err := authenticator.Authenticate(newRequest("valid"))
+	require.NoError(t, err)
+	require.Equal(t, expected, principal)
+
+	// The result of the review is cached.
+	principal, err = authenticator.Authenticate(newRequest("valid"))
+	require.NoError(t, err)
+	require.Equal(t, expected, principal)
+	require.Equal(t, 1, reviews)
+
+	principal, err = authenticator.Authenticate(newRequest("expired"))
+	require.EqualError(t, err, "invalid Kubernetes token: token expired")
+	require.Nil(t, principal)
+
+	principal, err = authenticator.Authenticate(newRequest(""))
+	require.NoError(t, err)
+	require.Nil(t, principal)
+	require.Equal(t, 2, reviews)
+}
+
+func Test_RequestHeaderAuthenticator(t *testing.T) {
+	ca, caKey := newTestCertificate(t, "front-proxy-ca", nil, nil)
+	otherCA, otherCAKey := newTestCertificate(t, "other-ca", nil, nil)
+	client, _ := newTestCertificate(t, "front-proxy-client", ca, caKey)
+	forged, _ := newTestCertificate(t, "front-proxy-client", otherCA, otherCAKey)
+	notAllowed, _ := newTestCertificate(t, "someone-else", ca, caKey)
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}), 0600)
+	require.NoError(t, err)
+
+	authenticator, err := NewRequestHeaderAuthenticator(RequestHeaderOptions{
+		ClientCAFile: caFile,
+		AllowedNames: []string{"front-proxy-client"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		certificate *x509.Certificate
+		user        string
+		expected    *Principal
+		err         string
+	}{
+		{
+			name:        "valid certificate",
+			certificate: client,
+			user:        "alice",
+			expected: &Principal{
+				Name:          "alice",
+				Groups:        []string{"system:authenticated", "developers"},
+				Authenticator: RequestHeaderAuthenticatorName,
+			},
+		},
+		{
+			name:        "no header",
+			certificate: client,
+		},
+		{
+			name: "no certificate",
+			user: "alice",
+			err:  "the X-Remote-User header requires a client certificate",
+		},
+		{
+			name:        "untrusted certificate",
+			certificate: forged,
+			user:        "alice",
+			err:         "invalid front-proxy client certificate: x509: certificate signed by unknown authority",
+		},
+		{
+			name:        "name not allowed",
+			certificate: notAllowed,
+			user:        "alice",
+			err:         "the front-proxy client certificate \"someone-else\" is not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/planes", nil)
+			if tt.certificate != nil {
+				r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{tt.certificate}}
+			}
+			if tt.user != "" {
+				r.Header.Set(RemoteUserHeader, tt.user)
+				r.Header.Add(RemoteGroupHeader, "system:authenticated")
+				r.Header.Add(RemoteGroupHeader, "developers")
+			}
+
+			principal, err := authenticator.Authenticate(r)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, principal)
+		})
+	}
+}
+
+// newTestCertificate creates a certificate signed by parent, or a self-signed CA certificate if parent is nil.
+func newTestCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent = template
+		parentKey = key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
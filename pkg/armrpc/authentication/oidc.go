@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+const (
+	// OIDCAuthenticatorName is the name of the OIDC authenticator.
+	OIDCAuthenticatorName = "oidc"
+
+	// DefaultUsernameClaim is the claim used as the name of the principal when OIDCOptions.UsernameClaim is not set.
+	DefaultUsernameClaim = "sub"
+
+	// DefaultGroupsClaim is the claim used as the groups of the principal when OIDCOptions.GroupsClaim is not set.
+	DefaultGroupsClaim = "groups"
+
+	// discoveryRetryInterval is the minimum interval between two attempts to download the OpenID configuration of the
+	// issuer when the download fails.
+	discoveryRetryInterval = 10 * time.Second
+
+	// clockSkew is the tolerated difference between the clock of the issuer and the local clock when validating the
+	// time claims of the tokens.
+	clockSkew = 5 * time.Minute
+
+	// oidcClientTimeout is the timeout of the requests to the issuer when no HTTP client is provided.
+	oidcClientTimeout = 30 * time.Second
+)
+
+// signingMethods is the list of the algorithms accepted for the signature of the tokens.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+var _ Authenticator = (*oidcAuthenticator)(nil)
+
+// oidcAuthenticator authenticates the bearer tokens issued by an OpenID Connect provider.
+type oidcAuthenticator struct {
+	options OIDCOptions
+
+	// clientCtx carries the HTTP client used by the verifier. It outlives the requests since the verifier downloads the
+	// signing keys of the issuer in the background of the request that needs them.
+	clientCtx context.Context
+
+	mu            sync.Mutex
+	verifier      *oidc.IDTokenVerifier
+	lastDiscovery time.Time
+}
+
+// NewOIDCAuthenticator creates an authenticator which validates the JWT bearer tokens issued by the OpenID Connect
+// provider described by options. The OpenID configuration document of the provider is downloaded with the given HTTP
+// client when the first token is validated, so that the provider doesn't need to be available when the server starts.
+func NewOIDCAuthenticator(options OIDCOptions, client *http.Client) (Authenticator, error) {
+	if options.Issuer == "" {
+		return nil, errors.New("the issuer of the OIDC authentication is required")
+	}
+	if options.Audience == "" {
+		return nil, errors.New("the audience of the OIDC authentication is required")
+	}
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = DefaultUsernameClaim
+	}
+	if options.GroupsClaim == "" {
+		options.GroupsClaim = DefaultGroupsClaim
+	}
+	if client == nil {
+		client = &http.Client{Timeout: oidcClientTimeout}
+	}
+
+	return &oidcAuthenticator{
+		options:   options,
+		clientCtx: oidc.ClientContext(context.Background(), client),
+	}, nil
+}
+
+// Authenticate validates the bearer token of the request and returns the principal described by its claims. The
+// signature, issuer, audience and expiration of the token are validated by the OIDC verifier, the token must have an
+// expiration and must not be used before it was issued.
+func (a *oidcAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return nil, nil
+	}
+
+	verifier, err := a.getVerifier()
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := verifier.Verify(r.Context(), token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OIDC token: %w", err)
+	}
+
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("invalid OIDC token: %w", err)
+	}
+
+	if err := validateTimeClaims(claims, time.Now()); err != nil {
+		return nil, fmt.Errorf("invalid OIDC token: %w", err)
+	}
+
+	name, _ := claims[a.options.UsernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("invalid OIDC token: the claim %q is missing", a.options.UsernameClaim)
+	}
+	if a.options.UsernamePrefix != "" {
+		name = a.options.UsernamePrefix + name
+	}
+
+	groups := []string{}
+	switch value := claims[a.options.GroupsClaim].(type) {
+	case string:
+		groups = append(groups, a.options.GroupsPrefix+value)
+	case []any:
+		for _, group := range value {
+			if s, ok := group.(string); ok {
+				groups = append(groups, a.options.GroupsPrefix+s)
+			}
+		}
+	}
+
+	return &Principal{Name: name, Groups: groups, Authenticator: OIDCAuthenticatorName}, nil
+}
+
+// getVerifier returns the verifier of the tokens of the issuer, downloading the OpenID configuration of the issuer on
+// first use. The lock is not held while the configuration is downloaded, concurrent requests may download it more than
+// once. Failed downloads are rate-limited so that an unavailable issuer isn't flooded.
+func (a *oidcAuthenticator) getVerifier() (*oidc.IDTokenVerifier, error) {
+	a.mu.Lock()
+	verifier, lastDiscovery := a.verifier, a.lastDiscovery
+	if verifier == nil && time.Since(lastDiscovery) >= discoveryRetryInterval {
+		a.lastDiscovery = time.Now()
+	}
+	a.mu.Unlock()
+
+	if verifier != nil {
+		return verifier, nil
+	}
+	if time.Since(lastDiscovery) < discoveryRetryInterval {
+		return nil, fmt.Errorf("the OpenID configuration of %q is not available", a.options.Issuer)
+	}
+
+	provider, err := oidc.NewProvider(a.clientCtx, a.options.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the OpenID configuration of %q: %w", a.options.Issuer, err)
+	}
+
+	verifier = provider.Verifier(&oidc.Config{
+		ClientID:             a.options.Audience,
+		SupportedSigningAlgs: signingMethods,
+	})
+
+	a.mu.Lock()
+	a.verifier = verifier
+	a.mu.Unlock()
+
+	return verifier, nil
+}
+
+// validateTimeClaims validates the time claims of a token that are not enforced by the OIDC verifier: the expiration
+// is required, and the token must not be used before it was issued or before its not-before time.
+func validateTimeClaims(claims map[string]any, now time.Time) error {
+	if _, ok := claims["exp"].(float64); !ok {
+		return errors.New("the token doesn't have an expiration")
+	}
+
+	if iat, ok := claims["iat"]; ok {
+		seconds, ok := iat.(float64)
+		if !ok {
+			return errors.New("the claim \"iat\" is not a valid time")
+		}
+		if now.Add(clockSkew).Before(time.Unix(int64(seconds), 0)) {
+			return errors.New("the token was issued in the future")
+		}
+	}
+
+	if nbf, ok := claims["nbf"]; ok {
+		seconds, ok := nbf.(float64)
+		if !ok {
+			return errors.New("the claim \"nbf\" is not a valid time")
+		}
+		if now.Add(clockSkew).Before(time.Unix(int64(seconds), 0)) {
+			return errors.New("the token is not valid yet")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeyID = "key1"
+
+// newTestIssuer starts an OpenID Connect provider serving the public key of the given private key.
+func newTestIssuer(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":   server.URL,
+			"jwks_uri": server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []any{
+				map[string]any{
+					"kty": "RSA",
+					"kid": testKeyID,
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				},
+			},
+		})
+	})
+
+	return server
+}
+
+func newTestToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func Test_OIDCAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := newTestIssuer(t, key)
+	authenticator, err := NewOIDCAuthenticator(OIDCOptions{
+		Issuer:         issuer.URL,
+		Audience:       "radius",
+		UsernamePrefix: "oidc:",
+		GroupsClaim:    "roles",
+	}, issuer.Client())
+	require.NoError(t, err)
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   issuer.URL,
+			"aud":   []string{"radius", "other"},
+			"sub":   "alice",
+			"roles": []string{"admins", "developers"},
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		token    func() string
+		expected *Principal
+		err      string
+	}{
+		{
+			name:  "valid token",
+			token: func() string { return newTestToken(t, key, testKeyID, validClaims()) },
+			expected: &Principal{
+				Name:          "oidc:alice",
+				Groups:        []string{"admins", "developers"},
+				Authenticator: OIDCAuthenticatorName,
+			},
+		},
+		{
+			name: "expired token",
+			token: func() string {
+				claims := validClaims()
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+				return newTestToken(t, key, testKeyID, claims)
+			},
+			err: "token is expired",
+		},
+		{
+			name: "wrong issuer",
+			token: func() string {
+				claims := validClaims()
+				claims["iss"] = "https://evil.example.com"
+				return newTestToken(t, key, testKeyID, claims)
+			},
+			err: "id token issued by a different provider",
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				claims := validClaims()
+				claims["aud"] = "other"
+				return newTestToken(t, key, testKeyID, claims)
+			},
+			err: "expected audience \"radius\"",
+		},
+		{
+			name:  "wrong signature",
+			token: func() string { return newTestToken(t, otherKey, testKeyID, validClaims()) },
+			err:   "failed to verify signature",
+		},
+		{
+			name: "missing username",
+			token: func() string {
+				claims := validClaims()
+				delete(claims, "sub")
+				return newTestToken(t, key, testKeyID, claims)
+			},
+			err: "invalid OIDC token: the claim \"sub\" is missing",
+		},
+		{
+			name: "missing expiration",
+			token: func() string {
+				claims := validClaims()
+				delete(claims, "exp")
+				return newTestToken(t, key, testKeyID, claims)
+			},
+			err: "invalid OIDC token",
+		},
+		{
+			name: "not valid yet",
+			token: func() string {
+				claims := validClaims()
+				claims["nbf"] = time.Now().Add(30 * time.Minute).Unix()
+				return newTestToken(t, key, testKeyID, claims)
+			},
+			err: "invalid OIDC token",
+		},
+		{
+			name: "issued in the future",
+			token: func() string {
+				claims := validClaims()
+				claims["iat"] = time.Now().Add(30 * time.Minute).Unix()
+				return newTestToken(t, key, testKeyID, claims)
+			},
+			err: "invalid OIDC token: the token was issued in the future",
+		},
+		{
+			name:  "unknown key",
+			token: func() string { return newTestToken(t, key, "key2", validClaims()) },
+			err:   "failed to verify signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/planes", nil)
+			r.Header.Set(AuthorizationHeader, "Bearer "+tt.token())
+
+			principal, err := authenticator.Authenticate(r)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				require.Nil(t, principal)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, principal)
+		})
+	}
+
+	t.Run("no token", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(httptest.NewRequest(http.MethodGet, "/planes", nil))
+		require.NoError(t, err)
+		require.Nil(t, principal)
+	})
+
+}
+
+func Test_OIDCAuthenticator_IssuerUnavailable(t *testing.T) {
+	issuer := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(issuer.Close)
+
+	authenticator, err := NewOIDCAuthenticator(OIDCOptions{Issuer: issuer.URL, Audience: "radius"}, issuer.Client())
+	require.NoError(t, err)
+
+	r := httptest.NewRequest(http.MethodGet, "/planes", nil)
+	r.Header.Set(AuthorizationHeader, "Bearer token")
+
+	_, err = authenticator.Authenticate(r)
+	require.ErrorContains(t, err, "failed to get the OpenID configuration of \""+issuer.URL+"\"")
+
+	// The configuration is not downloaded again until the retry interval has passed.
+	_, err = authenticator.Authenticate(r)
+	require.EqualError(t, err, "the OpenID configuration of \""+issuer.URL+"\" is not available")
+}
+
+func Test_ValidateTimeClaims(t *testing.T) {
+	now := time.Now()
+	exp := float64(now.Add(time.Hour).Unix())
+
+	require.NoError(t, validateTimeClaims(map[string]any{"exp": exp, "iat": float64(now.Unix()), "nbf": float64(now.Unix())}, now))
+	require.EqualError(t, validateTimeClaims(map[string]any{}, now), "the token doesn't have an expiration")
+	require.EqualError(t, validateTimeClaims(map[string]any{"exp": exp, "iat": "now"}, now), "the claim \"iat\" is not a valid time")
+	require.EqualError(t, validateTimeClaims(map[string]any{"exp": exp, "iat": float64(now.Add(time.Hour).Unix())}, now), "the token was issued in the future")
+	require.EqualError(t, validateTimeClaims(map[string]any{"exp": exp, "nbf": float64(now.Add(time.Hour).Unix())}, now), "the token is not valid yet")
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Options represents the authentication configuration of an API server.
+type Options struct {
+	// Enabled requires each request, except the health and version endpoints, to be authenticated.
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// OIDC configures the authentication of JWT bearer tokens issued by an OpenID Connect provider.
+	OIDC *OIDCOptions `yaml:"oidc,omitempty"`
+
+	// Kubernetes configures the authentication of Kubernetes users and service accounts.
+	Kubernetes *KubernetesOptions `yaml:"kubernetes,omitempty"`
+}
+
+// OIDCOptions represents the configuration of the OIDC authentication.
+type OIDCOptions struct {
+	// Issuer is the URL of the OpenID Connect provider, for example "https://login.example.com". It must match
+	// the "iss" claim of the tokens.
+	Issuer string `yaml:"issuer"`
+
+	// Audience is the audience the tokens must be issued for, matched against the "aud" claim of the tokens.
+	Audience string `yaml:"audience"`
+
+	// UsernameClaim is the claim used as the name of the caller. Defaults to "sub".
+	UsernameClaim string `yaml:"usernameClaim,omitempty"`
+
+	// UsernamePrefix is prepended to the name of the caller, for example "oidc:", to avoid clashes with other authenticators.
+	UsernamePrefix string `yaml:"usernamePrefix,omitempty"`
+
+	// GroupsClaim is the claim used as the groups of the caller. Defaults to "groups".
+	GroupsClaim string `yaml:"groupsClaim,omitempty"`
+
+	// GroupsPrefix is prepended to the groups of the caller.
+	GroupsPrefix string `yaml:"groupsPrefix,omitempty"`
+}
+
+// KubernetesOptions represents the configuration of the Kubernetes authentication.
+type KubernetesOptions struct {
+	// TokenReview enables the authentication of bearer tokens, such as service account tokens, with the Kubernetes
+	// TokenReview API.
+	TokenReview bool `yaml:"tokenReview,omitempty"`
+
+	// Audiences is the list of audiences accepted by the TokenReview authentication. The default audience of
+	// the Kubernetes API server is accepted when empty.
+	Audiences []string `yaml:"audiences,omitempty"`
+
+	// RequestHeader enables the authentication of the requests proxied by the Kubernetes API server through the
+	// APIService of an aggregated API server, or by UCP to the resource providers.
+	RequestHeader *RequestHeaderOptions `yaml:"requestHeader,omitempty"`
+}
+
+// RequestHeaderOptions represents the configuration of the authentication of the requests proxied by the Kubernetes API
+// server or by UCP. For the Kubernetes API server, the values come from the extension-apiserver-authentication ConfigMap
+// of the kube-system namespace. For UCP, they describe the proxy client certificate of UCP.
+type RequestHeaderOptions struct {
+	// ClientCAFile is the path to the PEM bundle of the certificate authority which issues the front-proxy
+	// client certificate of the Kubernetes API server (requestheader-client-ca-file).
+	ClientCAFile string `yaml:"clientCAFile"`
+
+	// AllowedNames is the list of the common names allowed for the front-proxy client certificate
+	// (requestheader-allowed-names). Any name is allowed when empty.
+	AllowedNames []string `yaml:"allowedNames,omitempty"`
+}
+
+// RequiresKubernetesClient returns true if the configuration requires a connection to the Kubernetes API server.
+func (o *Options) RequiresKubernetesClient() bool {
+	return o.Enabled && o.Kubernetes != nil && o.Kubernetes.TokenReview
+}
+
+// RequestsClientCertificate returns true if the server must request client certificates for the configured
+// authenticators to work.
+func (o *Options) RequestsClientCertificate() bool {
+	return o.Enabled && o.Kubernetes != nil && o.Kubernetes.RequestHeader != nil
+}
+
+// New creates the authenticator described by options. It returns a nil authenticator if authentication is disabled.
+// k8sConfig is the configuration of the connection to the Kubernetes API server, required by the TokenReview
+// authentication.
+func New(options Options, k8sConfig *rest.Config) (Authenticator, error) {
+	if !options.Enabled {
+		return nil, nil
+	}
+
+	authenticators := []Authenticator{}
+	if options.Kubernetes != nil && options.Kubernetes.RequestHeader != nil {
+		authenticator, err := NewRequestHeaderAuthenticator(*options.Kubernetes.RequestHeader)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, authenticator)
+	}
+
+	if options.OIDC != nil {
+		authenticator, err := NewOIDCAuthenticator(*options.OIDC, nil)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, authenticator)
+	}
+
+	if options.Kubernetes != nil && options.Kubernetes.TokenReview {
+		if k8sConfig == nil {
+			return nil, errors.New("the TokenReview authentication requires a connection to Kubernetes")
+		}
+
+		client, err := kubernetes.NewForConfig(k8sConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the Kubernetes client of the TokenReview authentication: %w", err)
+		}
+		authenticators = append(authenticators, NewTokenReviewAuthenticator(client, options.Kubernetes.Audiences))
+	}
+
+	if len(authenticators) == 0 {
+		return nil, errors.New("authentication is enabled but no authentication method is configured")
+	}
+
+	return NewUnionAuthenticator(authenticators...), nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// credentialsTypeName is the name of the credential resource types of the planes, such as "System.Azure/credentials".
+	credentialsTypeName = "credentials"
+
+	// systemNamespacePrefix is the prefix of the namespaces of the resource types implemented by UCP.
+	systemNamespacePrefix = "system."
+)
+
+// Decision is the result of the authorization of a request.
+type Decision struct {
+	// Allowed is true if the request is allowed.
+	Allowed bool
+
+	// Reason describes the role assignment which allowed the request, or why the request was denied.
+	Reason string
+}
+
+// Authorizer evaluates the role assignments of the callers of requests.
+type Authorizer struct {
+	assignments []RoleAssignment
+}
+
+// NewAuthorizer creates an authorizer evaluating the role assignments of options. It returns a nil authorizer if
+// authorization is disabled, and an error if a role assignment is invalid.
+func NewAuthorizer(options Options) (*Authorizer, error) {
+	if !options.Enabled {
+		return nil, nil
+	}
+
+	assignments := []RoleAssignment{}
+	for i, assignment := range options.RoleAssignments {
+		switch assignment.Role {
+		case RoleReader, RoleContributor, RoleCredentialAdmin:
+		default:
+			return nil, fmt.Errorf("role assignment %d has an invalid role %q, expected one of %q", i, assignment.Role, []Role{RoleReader, RoleContributor, RoleCredentialAdmin})
+		}
+
+		if (assignment.User == "") == (assignment.Group == "") {
+			return nil, fmt.Errorf("role assignment %d must have either a user or a group", i)
+		}
+
+		if !strings.HasPrefix(assignment.Scope, "/") {
+			return nil, fmt.Errorf("role assignment %d has an invalid scope %q, expected \"/\" or a resource ID such as \"/planes/radius/local\"", i, assignment.Scope)
+		}
+		if assignment.Scope != "/" {
+			id, err := resources.ParseScope(assignment.Scope)
+			if err != nil {
+				return nil, fmt.Errorf("role assignment %d has an invalid scope %q: %w", i, assignment.Scope, err)
+			}
+			assignment.Scope = id.String()
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	return &Authorizer{assignments: assignments}, nil
+}
+
+// Authorize decides whether the principal is allowed to send a request with the given HTTP method to the resource or
+// the scope identified by id. An empty id, such as the collection of the planes, is only allowed by the role
+// assignments at the "/" scope.
+func (a *Authorizer) Authorize(principal *authentication.Principal, method string, id resources.ID) Decision {
+	if principal == nil {
+		return Decision{Allowed: false, Reason: "the request is not authenticated"}
+	}
+
+	read := method == http.MethodGet || method == http.MethodHead
+	credential := isCredentialType(id.Type())
+
+	for _, assignment := range a.assignments {
+		if !assignment.appliesTo(principal) || !assignment.inScope(id) || !assignment.allows(read, credential) {
+			continue
+		}
+
+		return Decision{Allowed: true, Reason: fmt.Sprintf("role %q is assigned to %s at scope %q", assignment.Role, assignment.assignee(), assignment.Scope)}
+	}
+
+	action := "write"
+	if read {
+		action = "read"
+	}
+
+	target := id.String()
+	if id.IsEmpty() {
+		target = "/"
+	}
+
+	return Decision{Allowed: false, Reason: fmt.Sprintf("no role assignment of %q allows the %s of %q", principal.Name, action, target)}
+}
+
+// appliesTo returns true if the role is assigned to the principal or one of its groups.
+func (r RoleAssignment) appliesTo(principal *authentication.Principal) bool {
+	if r.User != "" {
+		return r.User == principal.Name
+	}
+
+	return slices.Contains(principal.Groups, r.Group)
+}
+
+// inScope returns true if the resource or scope identified by id is in the scope and resource types of the assignment.
+func (r RoleAssignment) inScope(id resources.ID) bool {
+	if r.Scope != "/" {
+		if id.IsEmpty() {
+			return false
+		}
+
+		target := strings.ToLower(id.String())
+		scope := strings.ToLower(r.Scope)
+		if target != scope && !strings.HasPrefix(target, scope+"/") {
+			return false
+		}
+	}
+
+	if len(r.ResourceTypes) == 0 {
+		return true
+	}
+
+	resourceType := strings.ToLower(id.Type())
+	for _, t := range r.ResourceTypes {
+		t = strings.ToLower(t)
+		if resourceType == t || strings.HasPrefix(resourceType, t+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allows returns true if the role of the assignment allows the read or write of a resource.
+func (r RoleAssignment) allows(read bool, credential bool) bool {
+	switch r.Role {
+	case RoleReader:
+		return read && !credential
+	case RoleContributor:
+		return !credential
+	case RoleCredentialAdmin:
+		return credential
+	default:
+		return false
+	}
+}
+
+func (r RoleAssignment) assignee() string {
+	if r.User != "" {
+		return fmt.Sprintf("user %q", r.User)
+	}
+
+	return fmt.Sprintf("group %q", r.Group)
+}
+
+// isCredentialType returns true if the resource type is a credential of a plane, such as "System.AWS/credentials".
+func isCredentialType(resourceType string) bool {
+	namespace, typeName, ok := strings.Cut(strings.ToLower(resourceType), "/")
+	if !ok {
+		return false
+	}
+
+	typeName, _, _ = strings.Cut(typeName, "/")
+	return strings.HasPrefix(namespace, systemNamespacePrefix) && typeName == credentialsTypeName
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewAuthorizer(t *testing.T) {
+	authorizer, err := NewAuthorizer(Options{})
+	require.NoError(t, err)
+	require.Nil(t, authorizer)
+
+	tests := []struct {
+		name       string
+		assignment RoleAssignment
+		err        string
+	}{
+		{
+			name:       "invalid role",
+			assignment: RoleAssignment{Role: "owner", User: "alice", Scope: "/"},
+			err:        `role assignment 0 has an invalid role "owner", expected one of ["reader" "contributor" "credential-admin"]`,
+		},
+		{
+			name:       "no assignee",
+			assignment: RoleAssignment{Role: RoleReader, Scope: "/"},
+			err:        "role assignment 0 must have either a user or a group",
+		},
+		{
+			name:       "user and group",
+			assignment: RoleAssignment{Role: RoleReader, User: "alice", Group: "developers", Scope: "/"},
+			err:        "role assignment 0 must have either a user or a group",
+		},
+		{
+			name:       "relative scope",
+			assignment: RoleAssignment{Role: RoleReader, User: "alice", Scope: "planes/radius/local"},
+			err:        `role assignment 0 has an invalid scope "planes/radius/local", expected "/" or a resource ID such as "/planes/radius/local"`,
+		},
+		{
+			name:       "resource scope",
+			assignment: RoleAssignment{Role: RoleReader, User: "alice", Scope: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env"},
+			err:        `role assignment 0 has an invalid scope "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env": "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env" is a valid resource id but does not refer to a scope`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAuthorizer(Options{Enabled: true, RoleAssignments: []RoleAssignment{tt.assignment}})
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func Test_Authorizer_Authorize(t *testing.T) {
+	authorizer, err := NewAuthorizer(Options{
+		Enabled: true,
+		RoleAssignments: []RoleAssignment{
+			{Role: RoleContributor, Group: "admins", Scope: "/"},
+			{Role: RoleCredentialAdmin, Group: "admins", Scope: "/"},
+			{Role: RoleReader, User: "alice", Scope: "/planes/radius/local"},
+			{Role: RoleContributor, User: "alice", Scope: "/planes/radius/local/resourceGroups/dev"},
+			{Role: RoleContributor, User: "bob", Scope: "/planes/radius/local/resourceGroups/dev", ResourceTypes: []string{"Applications.Core/applications"}},
+			{Role: RoleCredentialAdmin, User: "carol", Scope: "/planes/aws/aws"},
+		},
+	})
+	require.NoError(t, err)
+
+	admin := &authentication.Principal{Name: "dave", Groups: []string{"admins"}}
+	alice := &authentication.Principal{Name: "alice"}
+	bob := &authentication.Principal{Name: "bob"}
+	carol := &authentication.Principal{Name: "carol"}
+
+	tests := []struct {
+		name      string
+		principal *authentication.Principal
+		method    string
+		id        string
+		allowed   bool
+		reason    string
+	}{
+		{
+			name:      "group assignment at the root scope",
+			principal: admin,
+			method:    http.MethodPut,
+			id:        "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/env",
+			allowed:   true,
+			reason:    `role "contributor" is assigned to group "admins" at scope "/"`,
+		},
+		{
+			name:      "collection of the planes",
+			principal: admin,
+			method:    http.MethodGet,
+			allowed:   true,
+			reason:    `role "contributor" is assigned to group "admins" at scope "/"`,
+		},
+		{
+			name:      "credentials require the credential-admin role",
+			principal: admin,
+			method:    http.MethodPut,
+			id:        "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+			allowed:   true,
+			reason:    `role "credential-admin" is assigned to group "admins" at scope "/"`,
+		},
+		{
+			name:      "reader at the plane scope",
+			principal: alice,
+			method:    http.MethodGet,
+			id:        "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/env",
+			allowed:   true,
+			reason:    `role "reader" is assigned to user "alice" at scope "/planes/radius/local"`,
+		},
+		{
+			name:      "reader can't write",
+			principal: alice,
+			method:    http.MethodDelete,
+			id:        "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/env",
+			reason:    `no role assignment of "alice" allows the write of "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/env"`,
+		},
+		{
+			name:      "contributor at the resource group scope",
+			principal: alice,
+			method:    http.MethodPut,
+			id:        "/planes/radius/local/resourceGroups/Dev/providers/Applications.Core/environments/env",
+			allowed:   true,
+			reason:    `role "contributor" is assigned to user "alice" at scope "/planes/radius/local/resourceGroups/dev"`,
+		},
+		{
+			name:      "the resource group is in its own scope",
+			principal: alice,
+			method:    http.MethodDelete,
+			id:        "/planes/radius/local/resourceGroups/dev",
+			allowed:   true,
+			reason:    `role "contributor" is assigned to user "alice" at scope "/planes/radius/local/resourceGroups/dev"`,
+		},
+		{
+			name:      "scope prefix is matched on segments",
+			principal: alice,
+			method:    http.MethodPut,
+			id:        "/planes/radius/local/resourceGroups/dev2",
+			reason:    `no role assignment of "alice" allows the write of "/planes/radius/local/resourceGroups/dev2"`,
+		},
+		{
+			name:      "reader can't read credentials",
+			principal: alice,
+			method:    http.MethodGet,
+			id:        "/planes/radius/local/providers/System.Radius/credentials/default",
+			reason:    `no role assignment of "alice" allows the read of "/planes/radius/local/providers/System.Radius/credentials/default"`,
+		},
+		{
+			name:      "resource type assignment",
+			principal: bob,
+			method:    http.MethodPost,
+			id:        "/planes/radius/local/resourceGroups/dev/providers/Applications.Core/applications/app",
+			allowed:   true,
+			reason:    `role "contributor" is assigned to user "bob" at scope "/planes/radius/local/resourceGroups/dev"`,
+		},
+		{
+			name:      "other resource types are not allowed",
+			principal: bob,
+			method:    http.MethodGet,
+			id:        "/planes/radius/local/resourceGroups/dev/providers/Applications.Core/environments/env",
+			reason:    `no role assignment of "bob" allows the read of "/planes/radius/local/resourceGroups/dev/providers/Applications.Core/environments/env"`,
+		},
+		{
+			name:      "credential-admin can manage credentials",
+			principal: carol,
+			method:    http.MethodDelete,
+			id:        "/planes/aws/aws/providers/System.AWS/credentials/default",
+			allowed:   true,
+			reason:    `role "credential-admin" is assigned to user "carol" at scope "/planes/aws/aws"`,
+		},
+		{
+			name:      "credential-admin can't manage other resources",
+			principal: carol,
+			method:    http.MethodGet,
+			id:        "/planes/aws/aws/accounts/123/regions/us-west-2/providers/AWS.Kinesis/Stream/stream",
+			reason:    `no role assignment of "carol" allows the read of "/planes/aws/aws/accounts/123/regions/us-west-2/providers/AWS.Kinesis/Stream/stream"`,
+		},
+		{
+			name:      "collection of the planes requires the root scope",
+			principal: alice,
+			method:    http.MethodGet,
+			reason:    `no role assignment of "alice" allows the read of "/"`,
+		},
+		{
+			name:    "not authenticated",
+			method:  http.MethodGet,
+			id:      "/planes/radius/local",
+			allowed: false,
+			reason:  "the request is not authenticated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := resources.ID{}
+			if tt.id != "" {
+				id, err = resources.ParseByMethod(tt.id, tt.method)
+				require.NoError(t, err)
+			}
+
+			decision := authorizer.Authorize(tt.principal, tt.method, id)
+			require.Equal(t, Decision{Allowed: tt.allowed, Reason: tt.reason}, decision)
+		})
+	}
+}
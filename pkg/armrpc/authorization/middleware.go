@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Authorize is the middleware which authorizes each request with the role assignments of the authorizer. It must be
+// registered after the authentication and the ARM request context middlewares. The requests served without
+// authentication, such as the health probes, are not authorized. Every decision is logged.
+func Authorize(authorizer *Authorizer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal := authentication.PrincipalFromContext(r.Context())
+			if principal == nil {
+				// The authentication middleware only lets the anonymous endpoints through without a principal.
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			logger := ucplog.FromContextOrDiscard(r.Context())
+			id := v1.ARMRequestContextFromContext(r.Context()).ResourceID
+			decision := authorizer.Authorize(principal, r.Method, id)
+			logger.Info("Authorization decision",
+				"principal", principal.Name,
+				"groups", principal.Groups,
+				"method", r.Method,
+				"resourceID", id.String(),
+				"allowed", decision.Allowed,
+				"reason", decision.Reason)
+
+			if !decision.Allowed {
+				resp := rest.NewForbiddenResponse(fmt.Sprintf("The client %q is not authorized to perform the request: %s.", principal.Name, decision.Reason))
+				if err := resp.Apply(r.Context(), w, r); err != nil {
+					logger.Error(err, "failed to write the authorization failure")
+				}
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import "errors"
+
+// ErrAuthenticationRequired is returned when authorization is enabled without authentication.
+var ErrAuthenticationRequired = errors.New("authorization requires authentication to be enabled")
+
+// Role is the name of a role which can be assigned to users, service accounts and groups.
+type Role string
+
+const (
+	// RoleReader allows reading the resources of a scope, except credentials.
+	RoleReader Role = "reader"
+
+	// RoleContributor allows reading, creating, updating and deleting the resources of a scope, and invoking their
+	// actions, except credentials.
+	RoleContributor Role = "contributor"
+
+	// RoleCredentialAdmin allows reading, creating, updating and deleting the credentials of a scope.
+	RoleCredentialAdmin Role = "credential-admin"
+)
+
+// Options represents the role-based authorization configuration of an API server.
+type Options struct {
+	// Enabled requires the caller of each request to be assigned a role allowing the request. Authorization requires
+	// authentication to be enabled.
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// RoleAssignments is the list of role assignments.
+	RoleAssignments []RoleAssignment `yaml:"roleAssignments,omitempty"`
+}
+
+// RoleAssignment assigns a role to a user, service account or group at a scope.
+type RoleAssignment struct {
+	// Role is the assigned role: "reader", "contributor" or "credential-admin".
+	Role Role `yaml:"role"`
+
+	// User is the name of the user or service account the role is assigned to, for example
+	// "system:serviceaccount:radius-system:applications-rp". Either User or Group is required.
+	User string `yaml:"user,omitempty"`
+
+	// Group is the name of the group the role is assigned to, for example "system:masters".
+	Group string `yaml:"group,omitempty"`
+
+	// Scope is the scope the role is assigned at: "/" for every plane, a plane such as "/planes/radius/local", or a
+	// resource group such as "/planes/radius/local/resourceGroups/default".
+	Scope string `yaml:"scope"`
+
+	// ResourceTypes limits the assignment to the given resource types, for example "Applications.Core/environments".
+	// The assignment applies to every resource type when empty.
+	ResourceTypes []string `yaml:"resourceTypes,omitempty"`
+}
@@ -75,6 +75,14 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/cancel", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationMethod("CANCEL"),
+		ControllerFactory: defaultoperation.NewCancelOperationStatus,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -83,6 +91,9 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationResult,
 	})
 
+	handlers = append(handlers, server.DeadLetterHandlerOptions(rootRouter, rootScopePath, namespace)...)
+	handlers = append(handlers, server.OperationHistoryHandlerOptions(rootRouter, rootScopePath, namespace))
+
 	return handlers
 }
 
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 const (
@@ -195,6 +196,63 @@ func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource
 	return nil, nil
 }
 
+// RecordSyncOperation records the synchronous operation in the operation history once the controller has completed. The
+// operation is recorded as failed if the controller returned an error or an error response. Failures to record the operation
+// are logged because the operation has already completed. The operation is not recorded if the status manager is not configured.
+func (c *Operation[P, T]) RecordSyncOperation(ctx context.Context, resp rest.Response, err error) {
+	if c.StatusManager() == nil {
+		return
+	}
+
+	state := v1.ProvisioningStateSucceeded
+	opError := syncOperationError(resp, err)
+	if opError != nil {
+		state = v1.ProvisioningStateFailed
+	}
+
+	if err := c.StatusManager().RecordOperation(ctx, v1.ARMRequestContextFromContext(ctx), state, opError); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to record the operation in the operation history")
+	}
+}
+
+// syncOperationError returns the error details of a synchronous operation which returned an error or an error response,
+// or nil if the operation succeeded.
+func syncOperationError(resp rest.Response, err error) *v1.ErrorDetails {
+	if err != nil {
+		var clientErr *v1.ErrClientRP
+		if errors.As(err, &clientErr) {
+			return &v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
+		}
+		return &v1.ErrorDetails{Code: v1.CodeInternal, Message: err.Error()}
+	}
+
+	var body v1.ErrorResponse
+	switch r := resp.(type) {
+	case *rest.BadRequestResponse:
+		body = r.Body
+	case *rest.ValidationErrorResponse:
+		body = r.Body
+	case *rest.NotFoundResponse:
+		body = r.Body
+	case *rest.ConflictResponse:
+		body = r.Body
+	case *rest.InternalServerErrorResponse:
+		body = r.Body
+	case *rest.PreconditionFailedResponse:
+		body = r.Body
+	case *rest.ClientAuthenticationFailed:
+		body = r.Body
+	case *rest.ForbiddenResponse:
+		body = r.Body
+	case *rest.MethodNotAllowedResponse:
+		body = r.Body
+	default:
+		return nil
+	}
+
+	return &body.Error
+}
+
 // ConstructSyncResponse constructs synchronous API response.
 func (c *Operation[P, T]) ConstructSyncResponse(ctx context.Context, method, etag string, resource *T) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*CancelOperationStatus)(nil)
+
+// CancelOperationStatus is the controller implementation to cancel an async operation.
+type CancelOperationStatus struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperationStatus creates a new CancelOperationStatus.
+func NewCancelOperationStatus(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperationStatus{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run requests the cancellation of an asynchronous operation. The operation is canceled immediately if it has not
+// started yet, otherwise the worker processing the operation stops it. The response points to the operation status
+// so that the client can track when the operation reaches the Canceled state. It returns a NotFound error if the operation
+// is not found and a Conflict error if the operation has already completed.
+func (e *CancelOperationStatus) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	os := &manager.Status{}
+	_, err := e.GetResource(ctx, serviceCtx.ResourceID.String(), os)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	operationID, err := uuid.Parse(serviceCtx.ResourceID.Name())
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The operation id %q is invalid.", serviceCtx.ResourceID.Name())), nil
+	}
+
+	resourceID, err := resources.ParseResource(os.LinkedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := e.StatusManager().Cancel(ctx, resourceID, operationID)
+	if errors.Is(err, manager.ErrOperationCompleted) {
+		return rest.NewConflictResponse(fmt.Sprintf("The operation %q has already completed and can not be canceled.", operationID)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewAsyncOperationResponse(status.AsyncOperationStatus, os.Location, http.StatusAccepted, resourceID, operationID, serviceCtx.APIVersion, "", ""), nil
+}
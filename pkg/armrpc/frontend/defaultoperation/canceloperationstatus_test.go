@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCancelOperationID = "00000000-0000-0000-0000-000000000002"
+	testCancelStatusURL   = "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/" + testCancelOperationID
+	testCancelResourceID  = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+)
+
+func TestCancelOperationStatusRun(t *testing.T) {
+	existingStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Name:   testCancelOperationID,
+			Status: v1.ProvisioningStateUpdating,
+		},
+		LinkedResourceID: testCancelResourceID,
+		Location:         v1.LocationGlobal,
+	}
+
+	setup := func(t *testing.T, found bool) (*store.MockStorageClient, *manager.MockStatusManager) {
+		mctrl := gomock.NewController(t)
+		sc := store.NewMockStorageClient(mctrl)
+		sc.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				if !found {
+					return nil, &store.ErrNotFound{ID: id}
+				}
+				return &store.Object{Metadata: store.Metadata{ID: id}, Data: existingStatus}, nil
+			})
+		return sc, manager.NewMockStatusManager(mctrl)
+	}
+
+	run := func(t *testing.T, sc store.StorageClient, sm manager.StatusManager) *httptest.ResponseRecorder {
+		ctl, err := NewCancelOperationStatus(ctrl.Options{StorageClient: sc, StatusManager: sm})
+		require.NoError(t, err)
+
+		ctx, req := newDeadLetterRequest(t, http.MethodPost, testCancelStatusURL+"/cancel?api-version=2023-10-01-preview")
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		return w
+	}
+
+	t.Run("cancel running operation", func(t *testing.T) {
+		sc, sm := setup(t, true)
+		sm.EXPECT().
+			Cancel(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id resources.ID, operationID uuid.UUID) (*manager.Status, error) {
+				require.Equal(t, testCancelResourceID, id.String())
+				require.Equal(t, testCancelOperationID, operationID.String())
+				return &manager.Status{
+					AsyncOperationStatus: v1.AsyncOperationStatus{Name: testCancelOperationID, Status: v1.ProvisioningStateUpdating},
+					CancelRequested:      true,
+				}, nil
+			})
+
+		w := run(t, sc, sm)
+		require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+		require.Equal(t, testCancelStatusURL+"?api-version=2023-10-01-preview", w.Header().Get("Azure-AsyncOperation"))
+	})
+
+	t.Run("cancel completed operation", func(t *testing.T) {
+		sc, sm := setup(t, true)
+		sm.EXPECT().
+			Cancel(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, manager.ErrOperationCompleted)
+
+		w := run(t, sc, sm)
+		require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	})
+
+	t.Run("cancel non-existing operation", func(t *testing.T) {
+		sc, sm := setup(t, false)
+
+		w := run(t, sc, sm)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
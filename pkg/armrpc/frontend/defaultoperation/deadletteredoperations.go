@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/queue/deadletter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var (
+	_ ctrl.Controller = (*ListDeadLetteredOperations)(nil)
+	_ ctrl.Controller = (*GetDeadLetteredOperation)(nil)
+	_ ctrl.Controller = (*DeleteDeadLetteredOperation)(nil)
+	_ ctrl.Controller = (*RequeueDeadLetteredOperation)(nil)
+)
+
+// ListDeadLetteredOperations is the controller implementation to list the dead-lettered async operations.
+type ListDeadLetteredOperations struct {
+	ctrl.BaseController
+}
+
+// NewListDeadLetteredOperations creates a new ListDeadLetteredOperations.
+func NewListDeadLetteredOperations(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListDeadLetteredOperations{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the list of dead-lettered async operations in the plane scope.
+func (e *ListDeadLetteredOperations) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	messages, err := deadletter.New(e.StorageClient()).List(ctx, serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.PaginatedList{Value: []any{}}
+	for _, msg := range messages {
+		op, err := toDeadLetteredOperation(msg)
+		if err != nil {
+			return nil, err
+		}
+		result.Value = append(result.Value, op)
+	}
+
+	return rest.NewOKResponse(result), nil
+}
+
+// GetDeadLetteredOperation is the controller implementation to get a dead-lettered async operation.
+type GetDeadLetteredOperation struct {
+	ctrl.BaseController
+}
+
+// NewGetDeadLetteredOperation creates a new GetDeadLetteredOperation.
+func NewGetDeadLetteredOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &GetDeadLetteredOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the dead-lettered async operation including the original request message and the delivery history,
+// or a NotFound error if the operation is not dead-lettered.
+func (e *GetDeadLetteredOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	msg, err := deadletter.New(e.StorageClient()).Get(ctx, serviceCtx.ResourceID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	op, err := toDeadLetteredOperation(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(op), nil
+}
+
+// DeleteDeadLetteredOperation is the controller implementation to purge a dead-lettered async operation.
+type DeleteDeadLetteredOperation struct {
+	ctrl.BaseController
+}
+
+// NewDeleteDeadLetteredOperation creates a new DeleteDeadLetteredOperation.
+func NewDeleteDeadLetteredOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &DeleteDeadLetteredOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run purges the dead-lettered async operation. It returns NoContent if the operation is not dead-lettered.
+func (e *DeleteDeadLetteredOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	err := deadletter.New(e.StorageClient()).Delete(ctx, serviceCtx.ResourceID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return rest.NewNoContentResponse(), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(nil), nil
+}
+
+// RequeueDeadLetteredOperation is the controller implementation to requeue a dead-lettered async operation.
+type RequeueDeadLetteredOperation struct {
+	ctrl.BaseController
+}
+
+// NewRequeueDeadLetteredOperation creates a new RequeueDeadLetteredOperation.
+func NewRequeueDeadLetteredOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &RequeueDeadLetteredOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run queues the original request message of the dead-lettered async operation again and removes it from the
+// dead-letter queue. The response points to the operation status so that the client can track the retried operation.
+func (e *RequeueDeadLetteredOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	dlq := deadletter.New(e.StorageClient())
+	msg, err := dlq.Get(ctx, serviceCtx.ResourceID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	op, err := toDeadLetteredOperation(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	asyncReq := &asyncctrl.Request{}
+	if err := json.Unmarshal(msg.Data, asyncReq); err != nil {
+		return rest.NewBadRequestResponse("The request message of the dead-lettered operation is invalid: " + err.Error()), nil
+	}
+
+	resourceID, err := resources.ParseResource(asyncReq.ResourceID)
+	if err != nil {
+		return rest.NewBadRequestResponse("The request message of the dead-lettered operation is invalid: " + err.Error()), nil
+	}
+
+	// The entry is deleted before the message is queued again so that the entry recorded by the worker for the requeued
+	// message is never deleted. The entry is restored if the message can not be queued.
+	err = dlq.Delete(ctx, msg.ID)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := e.StatusManager().Requeue(ctx, asyncReq); err != nil {
+		if restoreErr := dlq.Restore(ctx, msg); restoreErr != nil {
+			return nil, errors.Join(err, restoreErr)
+		}
+		return nil, err
+	}
+
+	location := v1.LocationGlobal
+	if segments := serviceCtx.ResourceID.TypeSegments(); len(segments) > 0 {
+		location = segments[0].Name
+	}
+
+	return rest.NewAsyncOperationResponse(op, location, http.StatusAccepted, resourceID, asyncReq.OperationID, asyncReq.APIVersion, "", ""), nil
+}
+
+func toDeadLetteredOperation(msg *deadletter.Message) (*v1.DeadLetteredOperation, error) {
+	id, err := resources.ParseResource(msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &v1.DeadLetteredOperation{
+		ID:   id.String(),
+		Name: id.Name(),
+		Type: id.Type(),
+		Properties: v1.DeadLetteredOperationProperties{
+			Reason:         string(msg.Reason),
+			LastError:      msg.LastError,
+			DequeueCount:   msg.DequeueCount,
+			EnqueuedAt:     msg.EnqueuedAt,
+			DeadLetteredAt: msg.DeadLetteredAt,
+			Payload:        msg.Data,
+		},
+	}
+
+	// The payload is not guaranteed to be an async operation request, for example when the queue message was malformed.
+	asyncReq := &asyncctrl.Request{}
+	if err := json.Unmarshal(msg.Data, asyncReq); err == nil {
+		op.Properties.ResourceID = asyncReq.ResourceID
+		op.Properties.OperationType = asyncReq.OperationType
+	}
+
+	for _, attempt := range msg.History {
+		op.Properties.History = append(op.Properties.History, v1.DeadLetteredOperationAttempt{
+			DequeueCount: attempt.DequeueCount,
+			DequeuedAt:   attempt.DequeuedAt,
+			Error:        attempt.Error,
+		})
+	}
+
+	return op, nil
+}
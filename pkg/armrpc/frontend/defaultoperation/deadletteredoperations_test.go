@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/queue/deadletter"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeadLetterCollectionURL = "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/deadletteredoperations?api-version=2023-10-01-preview"
+	testDeadLetterOperationID   = "00000000-0000-0000-0000-000000000001"
+	testDeadLetterID            = "/planes/radius/local/providers/applications.core/locations/global/deadletteredoperations/" + testDeadLetterOperationID
+	testDeadLetterResourceID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+)
+
+func setupDeadLetterStore(t *testing.T) (store.StorageClient, *queue.Message) {
+	ctx := testcontext.New(t)
+
+	db, err := boltstore.OpenDB(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc := boltstore.NewBoltClient(db)
+	require.NoError(t, sc.Init(ctx))
+
+	msg := queue.NewMessage(&asyncctrl.Request{
+		APIVersion:    "2023-10-01-preview",
+		OperationID:   uuid.MustParse(testDeadLetterOperationID),
+		OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+		ResourceID:    testDeadLetterResourceID,
+	})
+	msg.ID = "message-1"
+	msg.DequeueCount = 4
+	msg.EnqueueAt = time.Now().UTC()
+
+	_, err = deadletter.New(sc).DeadLetter(ctx, testDeadLetterID, msg, deadletter.ReasonMaxRetryCountExceeded, "exceeded max retry count")
+	require.NoError(t, err)
+
+	return sc, msg
+}
+
+func newDeadLetterRequest(t *testing.T, method string, url string) (context.Context, *http.Request) {
+	req, err := rpctest.NewHTTPRequestWithContent(testcontext.New(t), method, url, nil)
+	require.NoError(t, err)
+	return rpctest.NewARMRequestContext(req), req
+}
+
+func TestListDeadLetteredOperations(t *testing.T) {
+	sc, _ := setupDeadLetterStore(t)
+	ctx, req := newDeadLetterRequest(t, http.MethodGet, testDeadLetterCollectionURL)
+
+	ctl, err := NewListDeadLetteredOperations(ctrl.Options{StorageClient: sc})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(ctx, w, req))
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	list := struct {
+		Value []v1.DeadLetteredOperation `json:"value"`
+	}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Len(t, list.Value, 1)
+	require.Equal(t, testDeadLetterOperationID, list.Value[0].Name)
+	require.Equal(t, testDeadLetterResourceID, list.Value[0].Properties.ResourceID)
+	require.Equal(t, "APPLICATIONS.CORE/ENVIRONMENTS|PUT", list.Value[0].Properties.OperationType)
+	require.Equal(t, string(deadletter.ReasonMaxRetryCountExceeded), list.Value[0].Properties.Reason)
+}
+
+func TestGetDeadLetteredOperation(t *testing.T) {
+	sc, msg := setupDeadLetterStore(t)
+
+	t.Run("existing operation", func(t *testing.T) {
+		ctx, req := newDeadLetterRequest(t, http.MethodGet, "http://localhost"+testDeadLetterID+"?api-version=2023-10-01-preview")
+
+		ctl, err := NewGetDeadLetteredOperation(ctrl.Options{StorageClient: sc})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		op := &v1.DeadLetteredOperation{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), op))
+		require.Equal(t, "exceeded max retry count", op.Properties.LastError)
+		require.Equal(t, 4, op.Properties.DequeueCount)
+		require.Len(t, op.Properties.History, 1)
+		require.JSONEq(t, string(msg.Data), string(op.Properties.Payload))
+	})
+
+	t.Run("non-existing operation", func(t *testing.T) {
+		ctx, req := newDeadLetterRequest(t, http.MethodGet, "http://localhost/planes/radius/local/providers/applications.core/locations/global/deadletteredoperations/"+uuid.NewString()+"?api-version=2023-10-01-preview")
+
+		ctl, err := NewGetDeadLetteredOperation(ctrl.Options{StorageClient: sc})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
+
+func TestDeleteDeadLetteredOperation(t *testing.T) {
+	sc, _ := setupDeadLetterStore(t)
+
+	ctl, err := NewDeleteDeadLetteredOperation(ctrl.Options{StorageClient: sc})
+	require.NoError(t, err)
+
+	ctx, req := newDeadLetterRequest(t, http.MethodDelete, "http://localhost"+testDeadLetterID+"?api-version=2023-10-01-preview")
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(ctx, w, req))
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	// Deleting the operation again is a no-op.
+	w = httptest.NewRecorder()
+	resp, err = ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(ctx, w, req))
+	require.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+}
+
+func TestRequeueDeadLetteredOperation(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	sc, _ := setupDeadLetterStore(t)
+	sm := manager.NewMockStatusManager(mctrl)
+	sm.EXPECT().
+		Requeue(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *asyncctrl.Request) error {
+			require.Equal(t, testDeadLetterResourceID, req.ResourceID)
+			require.Equal(t, testDeadLetterOperationID, req.OperationID.String())
+			return nil
+		})
+
+	ctl, err := NewRequeueDeadLetteredOperation(ctrl.Options{StorageClient: sc, StatusManager: sm})
+	require.NoError(t, err)
+
+	ctx, req := newDeadLetterRequest(t, http.MethodPost, "http://localhost"+testDeadLetterID+"/requeue?api-version=2023-10-01-preview")
+	w := httptest.NewRecorder()
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(ctx, w, req))
+	require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+	require.Equal(t, "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses/"+testDeadLetterOperationID+"?api-version=2023-10-01-preview", w.Header().Get("Azure-AsyncOperation"))
+
+	// The operation is removed from the dead-letter queue once it is requeued.
+	_, err = deadletter.New(sc).Get(ctx, testDeadLetterID)
+	require.ErrorIs(t, err, &store.ErrNotFound{})
+}
+
+func TestRequeueDeadLetteredOperation_RequeueFailed(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	sc, _ := setupDeadLetterStore(t)
+	sm := manager.NewMockStatusManager(mctrl)
+	sm.EXPECT().
+		Requeue(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *asyncctrl.Request) error {
+			// The operation is removed from the dead-letter queue before it is requeued.
+			_, err := deadletter.New(sc).Get(ctx, testDeadLetterID)
+			require.ErrorIs(t, err, &store.ErrNotFound{})
+			return errors.New("queue is unavailable")
+		})
+
+	ctl, err := NewRequeueDeadLetteredOperation(ctrl.Options{StorageClient: sc, StatusManager: sm})
+	require.NoError(t, err)
+
+	ctx, req := newDeadLetterRequest(t, http.MethodPost, "http://localhost"+testDeadLetterID+"/requeue?api-version=2023-10-01-preview")
+	_, err = ctl.Run(ctx, httptest.NewRecorder(), req)
+	require.ErrorContains(t, err, "queue is unavailable")
+
+	// The operation is restored in the dead-letter queue when it can not be requeued.
+	dl, err := deadletter.New(sc).Get(ctx, testDeadLetterID)
+	require.NoError(t, err)
+	require.Equal(t, deadletter.ReasonMaxRetryCountExceeded, dl.Reason)
+}
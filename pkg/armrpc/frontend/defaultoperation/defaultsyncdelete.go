@@ -46,7 +46,9 @@ func NewDefaultSyncDelete[P interface {
 // Run executes synchronous deletion operation. It retrieves the resource from the store, runs custom delete filters,
 // and then deletes the resource from the data store. If the resource is not found, a No Content response is returned.
 // If an error occurs during the delete, an error is returned.
-func (e *DefaultSyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+func (e *DefaultSyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (resp rest.Response, err error) {
+	defer func() { e.RecordSyncOperation(ctx, resp, err) }()
+
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
 	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
@@ -23,6 +23,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
@@ -77,6 +78,16 @@ func TestDefaultSyncDelete(t *testing.T) {
 					Times(1)
 			}
 
+			// The operation is recorded in the history whether it succeeded or was rejected.
+			state := v1.ProvisioningStateSucceeded
+			if tt.rejectedByFilter {
+				state = v1.ProvisioningStateFailed
+			}
+			msm.EXPECT().
+				RecordOperation(gomock.Any(), gomock.Any(), state, gomock.Any()).
+				Return(nil).
+				Times(1)
+
 			opts := ctrl.Options{
 				StorageClient: mds,
 				StatusManager: msm,
@@ -43,7 +43,9 @@ func NewDefaultSyncPut[P interface {
 
 // Run executes synchronous create or update operation by validating new resource metadata, ensuring if it is new resource or updated resource,
 // running custom update filters, and upserting resource metadata and returns an resource as a response.
-func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (resp rest.Response, err error) {
+	defer func() { e.RecordSyncOperation(ctx, resp, err) }()
+
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
 	if err != nil {
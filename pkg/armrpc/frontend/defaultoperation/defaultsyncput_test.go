@@ -81,6 +81,14 @@ func TestDefaultSyncPut_Create(t *testing.T) {
 					Times(1)
 			}
 
+			state := v1.ProvisioningStateSucceeded
+			if tt.rErr != nil {
+				state = v1.ProvisioningStateFailed
+			}
+			msm.EXPECT().RecordOperation(gomock.Any(), gomock.Any(), state, gomock.Any()).
+				Return(nil).
+				Times(1)
+
 			opts := ctrl.Options{
 				StorageClient: mds,
 				StatusManager: msm,
@@ -207,6 +215,14 @@ func TestDefaultSyncPut_Update(t *testing.T) {
 					Times(1)
 			}
 
+			state := v1.ProvisioningStateSucceeded
+			if tt.rErr != nil || tt.rCode != http.StatusOK {
+				state = v1.ProvisioningStateFailed
+			}
+			msm.EXPECT().RecordOperation(gomock.Any(), gomock.Any(), state, gomock.Any()).
+				Return(nil).
+				Times(1)
+
 			opts := ctrl.Options{
 				StorageClient: mds,
 				StatusManager: msm,
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// ResourceIDQueryParam is the query parameter to filter the operation history by resource id.
+	ResourceIDQueryParam = "resourceId"
+)
+
+var _ ctrl.Controller = (*ListOperationHistory)(nil)
+
+// ListOperationHistory is the controller implementation to list the operations performed on the resources.
+type ListOperationHistory struct {
+	ctrl.BaseController
+}
+
+// NewListOperationHistory creates a new ListOperationHistory.
+func NewListOperationHistory(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListOperationHistory{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns a page of the operation history in the plane scope. The history is limited to a single resource when the
+// resourceId query parameter is specified. The entries of a page are ordered by the start time of the operations.
+func (e *ListOperationHistory) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceID := req.URL.Query().Get(ResourceIDQueryParam)
+	if resourceID != "" {
+		if _, err := resources.ParseResource(resourceID); err != nil {
+			return rest.NewBadRequestResponse("The resourceId query parameter is not a valid resource id: " + err.Error()), nil
+		}
+	}
+
+	query := store.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: serviceCtx.ResourceID.Type(),
+	}
+	if resourceID != "" {
+		query.Filters = []store.QueryFilter{manager.HistoryResourceFilter(resourceID)}
+	}
+
+	result, err := e.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	records := []*manager.HistoryRecord{}
+	for _, item := range result.Items {
+		record := &manager.HistoryRecord{}
+		if err := item.As(record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].StartTime.Before(records[j].StartTime)
+	})
+
+	list := &v1.PaginatedList{
+		Value:    []any{},
+		NextLink: nextHistoryLink(ctx, req, result.PaginationToken, resourceID),
+	}
+	for _, record := range records {
+		list.Value = append(list.Value, toOperationHistoryEntry(serviceCtx.ResourceID.Type(), record))
+	}
+
+	return rest.NewOKResponse(list), nil
+}
+
+// nextHistoryLink returns the link to the next page of the operation history, which keeps the resource filter of the request.
+func nextHistoryLink(ctx context.Context, req *http.Request, paginationToken string, resourceID string) string {
+	nextLink := ctrl.GetNextLinkURL(ctx, req, paginationToken)
+	if nextLink == "" || resourceID == "" {
+		return nextLink
+	}
+
+	u, err := url.Parse(nextLink)
+	if err != nil {
+		return nextLink
+	}
+
+	qps := u.Query()
+	qps.Set(ResourceIDQueryParam, resourceID)
+	u.RawQuery = qps.Encode()
+	return u.String()
+}
+
+func toOperationHistoryEntry(resourceType string, record *manager.HistoryRecord) *v1.OperationHistoryEntry {
+	return &v1.OperationHistoryEntry{
+		ID:   record.ID,
+		Name: record.OperationID,
+		Type: resourceType,
+		Properties: v1.OperationHistoryEntryProperties{
+			ResourceID:    record.ResourceID,
+			OperationType: record.OperationType,
+			Status:        record.Status,
+			StartTime:     record.StartTime,
+			EndTime:       record.EndTime,
+			Error:         record.Error,
+			RequestedBy:   record.RequestedBy,
+		},
+	}
+}
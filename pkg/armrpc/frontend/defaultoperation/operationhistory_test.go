@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHistoryCollectionURL = "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationhistory?api-version=2023-10-01-preview"
+	testHistoryEnvID         = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+	testHistoryAppID         = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app0"
+)
+
+func setupHistoryStore(t *testing.T) store.StorageClient {
+	ctx := testcontext.New(t)
+
+	db, err := boltstore.OpenDB(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+	sc := boltstore.NewBoltClient(db)
+	require.NoError(t, sc.Init(ctx))
+
+	start := time.Now().UTC().Add(-time.Hour)
+	records := []*manager.HistoryRecord{
+		{
+			OperationID:   "00000000-0000-0000-0000-000000000002",
+			ResourceID:    testHistoryEnvID,
+			OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|DELETE",
+			Status:        v1.ProvisioningStateFailed,
+			StartTime:     start.Add(2 * time.Minute),
+			Error:         &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed to delete"},
+		},
+		{
+			OperationID:   "00000000-0000-0000-0000-000000000001",
+			ResourceID:    testHistoryEnvID,
+			OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+			Status:        v1.ProvisioningStateSucceeded,
+			StartTime:     start,
+			RequestedBy:   v1.OperationRequester{ClientPrincipalName: "alice@contoso.com"},
+		},
+		{
+			OperationID:   "00000000-0000-0000-0000-000000000003",
+			ResourceID:    testHistoryAppID,
+			OperationType: "APPLICATIONS.CORE/APPLICATIONS|PUT",
+			Status:        v1.ProvisioningStateSucceeded,
+			StartTime:     start.Add(time.Minute),
+		},
+	}
+
+	for _, record := range records {
+		record.ID = "/planes/radius/local/providers/applications.core/locations/global/operationhistory/" + record.OperationID
+		record.NormalizedResourceID = strings.ToLower(record.ResourceID)
+		require.NoError(t, sc.Save(ctx, &store.Object{Metadata: store.Metadata{ID: record.ID}, Data: record}))
+	}
+
+	return sc
+}
+
+func TestListOperationHistory(t *testing.T) {
+	sc := setupHistoryStore(t)
+
+	tests := []struct {
+		desc       string
+		resourceID string
+		statusCode int
+		operations []string
+	}{
+		{
+			desc:       "all resources",
+			statusCode: http.StatusOK,
+			operations: []string{
+				"00000000-0000-0000-0000-000000000001",
+				"00000000-0000-0000-0000-000000000003",
+				"00000000-0000-0000-0000-000000000002",
+			},
+		},
+		{
+			desc:       "single resource",
+			resourceID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/ENV0",
+			statusCode: http.StatusOK,
+			operations: []string{
+				"00000000-0000-0000-0000-000000000001",
+				"00000000-0000-0000-0000-000000000002",
+			},
+		},
+		{
+			desc:       "resource without history",
+			resourceID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/ctnr0",
+			statusCode: http.StatusOK,
+			operations: []string{},
+		},
+		{
+			desc:       "invalid resource id",
+			resourceID: "invalid",
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			requestURL := testHistoryCollectionURL
+			if tt.resourceID != "" {
+				requestURL += "&resourceId=" + url.QueryEscape(tt.resourceID)
+			}
+			ctx, req := newDeadLetterRequest(t, http.MethodGet, requestURL)
+
+			ctl, err := NewListOperationHistory(ctrl.Options{StorageClient: sc})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Apply(ctx, w, req))
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.statusCode != http.StatusOK {
+				return
+			}
+
+			list := struct {
+				Value []v1.OperationHistoryEntry `json:"value"`
+			}{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+
+			operations := []string{}
+			for _, entry := range list.Value {
+				operations = append(operations, entry.Name)
+				require.Equal(t, "Applications.Core/locations/operationhistory", entry.Type)
+			}
+			require.Equal(t, tt.operations, operations)
+		})
+	}
+
+	t.Run("entry properties", func(t *testing.T) {
+		ctx, req := newDeadLetterRequest(t, http.MethodGet, testHistoryCollectionURL+"&resourceId="+url.QueryEscape(testHistoryEnvID))
+
+		ctl, err := NewListOperationHistory(ctrl.Options{StorageClient: sc})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+
+		list := struct {
+			Value []v1.OperationHistoryEntry `json:"value"`
+		}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+		require.Len(t, list.Value, 2)
+		require.Equal(t, "alice@contoso.com", list.Value[0].Properties.RequestedBy.ClientPrincipalName)
+		require.Equal(t, v1.ProvisioningStateFailed, list.Value[1].Properties.Status)
+		require.Equal(t, "failed to delete", list.Value[1].Properties.Error.Message)
+	})
+}
+
+func TestListOperationHistory_Pagination(t *testing.T) {
+	ctx := testcontext.New(t)
+	sc := setupHistoryStore(t)
+
+	// Add enough operations on the environment for two pages.
+	start := time.Now().UTC()
+	for i := 0; i < 6; i++ {
+		record := &manager.HistoryRecord{
+			OperationID:          uuid.New().String(),
+			ResourceID:           testHistoryEnvID,
+			NormalizedResourceID: strings.ToLower(testHistoryEnvID),
+			OperationType:        "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+			Status:               v1.ProvisioningStateSucceeded,
+			StartTime:            start.Add(time.Duration(i) * time.Minute),
+		}
+		record.ID = "/planes/radius/local/providers/applications.core/locations/global/operationhistory/" + record.OperationID
+		require.NoError(t, sc.Save(ctx, &store.Object{Metadata: store.Metadata{ID: record.ID}, Data: record}))
+	}
+
+	list := func(t *testing.T, requestURL string) *v1.PaginatedList {
+		ctx, req := newDeadLetterRequest(t, http.MethodGet, requestURL)
+
+		ctl, err := NewListOperationHistory(ctrl.Options{StorageClient: sc})
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(ctx, w, req))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		page := &v1.PaginatedList{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), page))
+		return page
+	}
+
+	page := list(t, testHistoryCollectionURL+"&top=5&resourceId="+url.QueryEscape(testHistoryEnvID))
+	require.Len(t, page.Value, 5)
+	require.NotEmpty(t, page.NextLink)
+
+	nextLink, err := url.Parse(page.NextLink)
+	require.NoError(t, err)
+	require.Equal(t, "5", nextLink.Query().Get("top"))
+	require.NotEmpty(t, nextLink.Query().Get("skipToken"))
+	require.Equal(t, testHistoryEnvID, nextLink.Query().Get(ResourceIDQueryParam))
+
+	page = list(t, page.NextLink)
+	require.Len(t, page.Value, 3)
+	require.Empty(t, page.NextLink)
+}
@@ -26,9 +26,11 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/queue/deadletter"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
@@ -241,6 +243,17 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/cancel",
+		ResourceType:      statusRT,
+		Method:            v1.OperationMethod("CANCEL"),
+		ControllerFactory: defaultoperation.NewCancelOperationStatus,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
@@ -253,9 +266,70 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	for _, h := range DeadLetterHandlerOptions(rootRouter, rootScopePath, providerNamespace) {
+		if err := RegisterHandler(ctx, h, ctrlOpts); err != nil {
+			return err
+		}
+	}
+
+	if err := RegisterHandler(ctx, OperationHistoryHandlerOptions(rootRouter, rootScopePath, providerNamespace), ctrlOpts); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// OperationHistoryHandlerOptions returns the HandlerOptions for listing the history of the operations performed on
+// the resources of the provider namespace.
+func OperationHistoryHandlerOptions(rootRouter chi.Router, rootScopePath string, namespace string) HandlerOptions {
+	return HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationhistory", rootScopePath, namespace),
+		ResourceType:      statusmanager.HistoryResourceType(namespace),
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationHistory,
+	}
+}
+
+// DeadLetterHandlerOptions returns the HandlerOptions for the admin operations to list, get, purge and requeue
+// dead-lettered async operations of the provider namespace.
+func DeadLetterHandlerOptions(rootRouter chi.Router, rootScopePath string, namespace string) []HandlerOptions {
+	deadLetterType := deadletter.ResourceType(namespace)
+	collectionPath := fmt.Sprintf("%s/providers/%s/locations/{location}/deadletteredoperations", rootScopePath, namespace)
+	resourcePath := collectionPath + "/{operationId}"
+
+	return []HandlerOptions{
+		{
+			ParentRouter:      rootRouter,
+			Path:              collectionPath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationList,
+			ControllerFactory: defaultoperation.NewListDeadLetteredOperations,
+		},
+		{
+			ParentRouter:      rootRouter,
+			Path:              resourcePath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationGet,
+			ControllerFactory: defaultoperation.NewGetDeadLetteredOperation,
+		},
+		{
+			ParentRouter:      rootRouter,
+			Path:              resourcePath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationDelete,
+			ControllerFactory: defaultoperation.NewDeleteDeadLetteredOperation,
+		},
+		{
+			ParentRouter:      rootRouter,
+			Path:              resourcePath + "/requeue",
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationMethod("REQUEUE"),
+			ControllerFactory: defaultoperation.NewRequeueDeadLetteredOperation,
+		},
+	}
+}
+
 // HandleError handles unhandled errors from frontend controller and creates internal server error response based on the error type.
 func HandleError(ctx context.Context, w http.ResponseWriter, req *http.Request, err error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -22,6 +22,7 @@ import (
 	"net/http"
 
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/validator"
@@ -45,6 +46,12 @@ type Options struct {
 	EnableArmAuth bool
 	Configure     func(chi.Router) error
 	ArmCertMgr    *authentication.ArmCertManager
+
+	// Authenticator authenticates the callers of the API. Requests are not authenticated when nil.
+	Authenticator authentication.Authenticator
+
+	// Authorizer authorizes the callers of the API. Requests are not authorized when nil.
+	Authorizer *authorization.Authorizer
 }
 
 // New creates a frontend server that can listen on the provided address and serve requests - it creates an HTTP server with a router,
@@ -63,7 +70,13 @@ func New(ctx context.Context, options Options) (*http.Server, error) {
 	if options.EnableArmAuth {
 		r.Use(authentication.ClientCertValidator(options.ArmCertMgr))
 	}
+	if options.Authenticator != nil {
+		r.Use(authentication.Authenticate(options.Authenticator, versionEndpoint, healthzEndpoint))
+	}
 	r.Use(servicecontext.ARMRequestCtx(options.PathBase, options.Location))
+	if options.Authorizer != nil {
+		r.Use(authorization.Authorize(options.Authorizer))
+	}
 
 	r.Get(versionEndpoint, version.ReportVersionHandler)
 	r.Get(healthzEndpoint, version.ReportVersionHandler)
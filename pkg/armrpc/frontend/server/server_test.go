@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// testAuthenticator authenticates the requests carrying the X-Remote-User header.
+type testAuthenticator struct{}
+
+func (testAuthenticator) Authenticate(r *http.Request) (*authentication.Principal, error) {
+	if r.Header.Get(authentication.RemoteUserHeader) == "" {
+		return nil, nil
+	}
+	return &authentication.Principal{Name: r.Header.Get(authentication.RemoteUserHeader), Authenticator: "test"}, nil
+}
+
+func Test_New_Authentication(t *testing.T) {
+	const resourcePath = "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env0"
+
+	server, err := New(testcontext.New(t), Options{
+		Location:      "global",
+		Authenticator: testAuthenticator{},
+		Configure: func(r chi.Router) error {
+			r.Get(resourcePath, func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(authentication.PrincipalFromContext(r.Context()).Name))
+			})
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("unauthenticated request", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, resourcePath, nil))
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("authenticated request", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, resourcePath, nil)
+		r.Header.Set(authentication.RemoteUserHeader, "alice")
+
+		w := httptest.NewRecorder()
+		server.Handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "alice", w.Body.String())
+	})
+
+	t.Run("health probe", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, healthzEndpoint, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -18,11 +18,15 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
+	"path/filepath"
 
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
@@ -50,10 +54,16 @@ type Service struct {
 
 	// KubeClient is the Kubernetes controller runtime client.
 	KubeClient controller_runtime.Client
+
+	// Authenticator authenticates the callers of the API when authentication is enabled.
+	Authenticator authentication.Authenticator
+
+	// Authorizer authorizes the callers of the API when authorization is enabled.
+	Authorizer *authorization.Authorizer
 }
 
-// Init initializes web service - it initializes the StorageProvider, QueueProvider, OperationStatusManager, KubeClient and ARMCertManager
-// with the given context and returns an error if any of the initialization fails.
+// Init initializes web service - it initializes the StorageProvider, QueueProvider, OperationStatusManager, KubeClient, ARMCertManager,
+// Authenticator and Authorizer with the given context and returns an error if any of the initialization fails.
 func (s *Service) Init(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -77,6 +87,24 @@ func (s *Service) Init(ctx context.Context) error {
 		}
 	}
 
+	if s.Options.Config.Server.Authorization.Enabled && !s.Options.Config.Server.Authentication.Enabled {
+		return authorization.ErrAuthenticationRequired
+	}
+
+	if s.Options.Config.Server.Authentication.RequestsClientCertificate() && s.Options.Config.Server.TLSCertDir == "" {
+		return errors.New("the request header authentication requires the server to serve HTTPS, set tlsCertDir")
+	}
+
+	s.Authenticator, err = authentication.New(s.Options.Config.Server.Authentication, s.Options.K8sConfig)
+	if err != nil {
+		return err
+	}
+
+	s.Authorizer, err = authorization.NewAuthorizer(s.Options.Config.Server.Authorization)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -98,8 +126,17 @@ func (s *Service) Start(ctx context.Context, opt Options) error {
 		_ = server.Shutdown(ctx)
 	}()
 
+	if s.Options.Config.Server.Authentication.RequestsClientCertificate() {
+		// The client certificate is verified by the authenticator of the requests proxied by UCP.
+		server.TLSConfig = &tls.Config{ClientAuth: tls.RequestClientCert}
+	}
+
 	logger.Info(fmt.Sprintf("listening on: '%s'...", address))
-	err = server.ListenAndServe()
+	if tlsCertDir := s.Options.Config.Server.TLSCertDir; tlsCertDir != "" {
+		err = server.ListenAndServeTLS(filepath.Join(tlsCertDir, "tls.crt"), filepath.Join(tlsCertDir, "tls.key"))
+	} else {
+		err = server.ListenAndServe()
+	}
 	if err == http.ErrServerClosed {
 		// We expect this, safe to ignore.
 		logger.Info("Server stopped...")
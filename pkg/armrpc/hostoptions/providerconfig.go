@@ -17,6 +17,8 @@ limitations under the License.
 package hostoptions
 
 import (
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
@@ -59,6 +61,14 @@ type ServerOptions struct {
 	ArmMetadataEndpoint string `yaml:"armMetadataEndpoint,omitempty"`
 	// EnableAuth when set the arm client authetication will be performed
 	EnableArmAuth bool `yaml:"enableArmAuth,omitempty"`
+	// Authentication configures the authentication of the callers of the API. The requests proxied by UCP are
+	// authenticated with the request header authentication, trusting the proxy client certificate of UCP.
+	Authentication authentication.Options `yaml:"authentication,omitempty"`
+	// Authorization configures the role-based authorization of the callers of the API.
+	Authorization authorization.Options `yaml:"authorization,omitempty"`
+	// TLSCertDir is the directory of the tls.crt and tls.key files of the serving certificate. The server serves HTTPS
+	// when set, which the request header authentication requires to receive the client certificates.
+	TLSCertDir string `yaml:"tlsCertDir,omitempty"`
 }
 
 // WorkerServerOptions includes the worker server options.
@@ -83,4 +93,14 @@ type BicepOptions struct {
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+
+	// Version is the version of Terraform to install, for example: "1.6.4". A pinned default version is installed if empty.
+	Version string `yaml:"version,omitempty"`
+
+	// ExecPath is the path to a pre-provisioned Terraform binary, used instead of downloading Terraform in air-gapped environments.
+	ExecPath string `yaml:"execPath,omitempty"`
+
+	// MirrorURL is the URL of a mirror of https://releases.hashicorp.com, or the path to a local directory with the same layout,
+	// used to download Terraform in air-gapped environments. The mirror must provide the configured or default version.
+	MirrorURL string `yaml:"mirrorURL,omitempty"`
 }
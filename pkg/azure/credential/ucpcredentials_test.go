@@ -21,6 +21,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/stretchr/testify/require"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
@@ -97,4 +98,32 @@ func TestRefreshCredentials(t *testing.T) {
 		require.False(t, c.isExpired())
 		require.Equal(t, old, c.tokenCred)
 	})
+
+	t.Run("workload identity credential", func(t *testing.T) {
+		t.Setenv(sdk_cred.AzureFederatedTokenFileEnvVar, "/var/run/secrets/azure/tokens/azure-identity-token")
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AzureCredential{
+				Kind:     "WorkloadIdentity",
+				ClientID: "fakeid",
+				TenantID: "fakeid",
+			},
+		}
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
+		require.NoError(t, err)
+
+		err = c.refreshCredentials(context.TODO())
+		require.NoError(t, err)
+		require.False(t, c.isExpired())
+		require.IsType(t, &azidentity.WorkloadIdentityCredential{}, c.tokenCred)
+	})
+
+	t.Run("service principal credential without secret", func(t *testing.T) {
+		p := newMockProvider()
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
+		require.NoError(t, err)
+		p.fakeCredential.ClientSecret = ""
+
+		err = c.refreshCredentials(context.TODO())
+		require.Error(t, err)
+	})
 }
@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	ucp_v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -118,6 +119,10 @@ type ExposeOptions struct {
 	Port        int
 	RemotePort  int
 	Replica     string
+
+	// KubeContext is the kube context of the cluster of the Kubernetes plane the container is deployed to. The name of
+	// the plane is used if empty.
+	KubeContext string
 }
 
 type LogsOptions struct {
@@ -126,6 +131,10 @@ type LogsOptions struct {
 	Follow      bool
 	Container   string
 	Replica     string
+
+	// KubeContext is the kube context of the cluster of the Kubernetes plane the container is deployed to. The name of
+	// the plane is used if empty.
+	KubeContext string
 }
 
 type LogStream struct {
@@ -144,6 +153,15 @@ type ApplicationsManagementClient interface {
 	ListAllResourcesByEnvironment(ctx context.Context, environmentName string) ([]generated.GenericResource, error)
 	ShowResource(ctx context.Context, resourceType string, resourceName string) (generated.GenericResource, error)
 	DeleteResource(ctx context.Context, resourceType string, resourceName string) (bool, error)
+
+	// DetectResourceDrift checks a recipe-provisioned resource for drift, optionally re-applying its recipe, and returns
+	// the resource with its updated drift status.
+	DetectResourceDrift(ctx context.Context, resourceType string, resourceName string, reconcile bool) (generated.GenericResource, error)
+
+	// PlanResource previews the changes the recipe of the given resource definition would make to the infrastructure,
+	// without deploying it.
+	PlanResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource) (generated.RecipePlan, error)
+
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 	ShowApplication(ctx context.Context, applicationName string) (corerp.ApplicationResource, error)
 
@@ -170,6 +188,37 @@ type ApplicationsManagementClient interface {
 
 	// ShowRecipe shows recipe details including list of all parameters for a given recipe registered to an environment
 	ShowRecipe(ctx context.Context, environmentName string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
+
+	// ExportRecipeState exports the Terraform state of the recipe deployed for a resource of the environment.
+	ExportRecipeState(ctx context.Context, environmentName string, body corerp.RecipeStateExport) (corerp.RecipeStateResponse, error)
+
+	// UnlockRecipeState releases the lock on the Terraform state of the recipe deployed for a resource of the environment.
+	UnlockRecipeState(ctx context.Context, environmentName string, body corerp.RecipeStateUnlock) (corerp.RecipeStateResponse, error)
+
+	// StartRecipeUpgrade starts re-deploying the recipes of the resources of the environment which were deployed with an
+	// older version of their recipe. The progress of the upgrade is reported in the status of the environment.
+	StartRecipeUpgrade(ctx context.Context, environmentName string, body corerp.RecipeUpgrade) error
+
+	// ListDeadLetteredOperations lists the async operations of the resource provider namespace in the dead-letter queue.
+	ListDeadLetteredOperations(ctx context.Context, namespace string) ([]v1.DeadLetteredOperation, error)
+
+	// GetDeadLetteredOperation gets the dead-lettered async operation.
+	GetDeadLetteredOperation(ctx context.Context, namespace string, operationID string) (v1.DeadLetteredOperation, error)
+
+	// RequeueDeadLetteredOperation queues the dead-lettered async operation again.
+	RequeueDeadLetteredOperation(ctx context.Context, namespace string, operationID string) error
+
+	// PurgeDeadLetteredOperation removes the async operation from the dead-letter queue.
+	PurgeDeadLetteredOperation(ctx context.Context, namespace string, operationID string) (bool, error)
+
+	// CancelOperation requests the cancellation of the async operation.
+	CancelOperation(ctx context.Context, namespace string, operationID string) (v1.AsyncOperationStatus, error)
+
+	// ListOperationHistory lists the PUT and DELETE operations performed on the resource.
+	ListOperationHistory(ctx context.Context, resourceType string, resourceName string) ([]v1.OperationHistoryEntry, error)
+
+	// ListSecretStoreSecrets lists the decoded secret values of the secret store with the given resource ID.
+	ListSecretStoreSecrets(ctx context.Context, secretStoreID string) (map[string]string, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
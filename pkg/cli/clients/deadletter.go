@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// ListDeadLetteredOperations lists the async operations of the resource provider namespace in the dead-letter queue.
+func (amc *UCPApplicationsManagementClient) ListDeadLetteredOperations(ctx context.Context, namespace string) ([]v1.DeadLetteredOperation, error) {
+	result := struct {
+		Value    []v1.DeadLetteredOperation `json:"value"`
+		NextLink string                     `json:"nextLink,omitempty"`
+	}{}
+
+	resp, err := amc.sendDeadLetterRequest(ctx, http.MethodGet, namespace, "", "", http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return []v1.DeadLetteredOperation{}, nil
+	}
+
+	return result.Value, nil
+}
+
+// GetDeadLetteredOperation gets the dead-lettered async operation including its original payload and delivery history.
+func (amc *UCPApplicationsManagementClient) GetDeadLetteredOperation(ctx context.Context, namespace string, operationID string) (v1.DeadLetteredOperation, error) {
+	resp, err := amc.sendDeadLetterRequest(ctx, http.MethodGet, namespace, operationID, "", http.StatusOK)
+	if err != nil {
+		return v1.DeadLetteredOperation{}, err
+	}
+
+	result := v1.DeadLetteredOperation{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return v1.DeadLetteredOperation{}, err
+	}
+
+	return result, nil
+}
+
+// RequeueDeadLetteredOperation queues the dead-lettered async operation again and removes it from the dead-letter queue.
+func (amc *UCPApplicationsManagementClient) RequeueDeadLetteredOperation(ctx context.Context, namespace string, operationID string) error {
+	_, err := amc.sendDeadLetterRequest(ctx, http.MethodPost, namespace, operationID, "requeue", http.StatusAccepted)
+	return err
+}
+
+// PurgeDeadLetteredOperation removes the async operation from the dead-letter queue. It returns false if the operation
+// was not in the dead-letter queue.
+func (amc *UCPApplicationsManagementClient) PurgeDeadLetteredOperation(ctx context.Context, namespace string, operationID string) (bool, error) {
+	resp, err := amc.sendDeadLetterRequest(ctx, http.MethodDelete, namespace, operationID, "", http.StatusOK, http.StatusNoContent)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode != http.StatusNoContent, nil
+}
+
+// sendDeadLetterRequest sends the request to the dead-letter queue endpoint of the resource provider namespace.
+func (amc *UCPApplicationsManagementClient) sendDeadLetterRequest(ctx context.Context, method string, namespace string, operationID string, action string, statusCodes ...int) (*http.Response, error) {
+	segments := []string{"deadletteredoperations"}
+	if operationID != "" {
+		segments = append(segments, url.PathEscape(operationID))
+	}
+	if action != "" {
+		segments = append(segments, action)
+	}
+
+	return amc.sendLocationRequest(ctx, method, namespace, segments, nil, statusCodes...)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeadLetterPath = "/planes/radius/local/providers/Applications.Core/locations/global/deadletteredoperations"
+
+func newLocationTestClient(t *testing.T, handler http.HandlerFunc) *UCPApplicationsManagementClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	return &UCPApplicationsManagementClient{
+		RootScope:     "planes/radius/local/resourceGroups/test-group",
+		ClientOptions: sdk.NewClientOptions(connection),
+	}
+}
+
+func Test_ListDeadLetteredOperations(t *testing.T) {
+	client := newLocationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, testDeadLetterPath, r.URL.Path)
+		require.Equal(t, operationsAPIVersion, r.URL.Query().Get("api-version"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"value": []v1.DeadLetteredOperation{{Name: "op1"}},
+		})
+	})
+
+	operations, err := client.ListDeadLetteredOperations(testcontext.New(t), "Applications.Core")
+	require.NoError(t, err)
+	require.Len(t, operations, 1)
+	require.Equal(t, "op1", operations[0].Name)
+}
+
+func Test_GetDeadLetteredOperation_NotFound(t *testing.T) {
+	client := newLocationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, testDeadLetterPath+"/op1", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetDeadLetteredOperation(testcontext.New(t), "Applications.Core", "op1")
+	require.True(t, clientv2.Is404Error(err))
+}
+
+func Test_RequeueDeadLetteredOperation(t *testing.T) {
+	client := newLocationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, testDeadLetterPath+"/op1/requeue", r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := client.RequeueDeadLetteredOperation(testcontext.New(t), "Applications.Core", "op1")
+	require.NoError(t, err)
+}
+
+func Test_PurgeDeadLetteredOperation(t *testing.T) {
+	status := http.StatusOK
+	client := newLocationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, testDeadLetterPath+"/op1", r.URL.Path)
+		w.WriteHeader(status)
+	})
+
+	purged, err := client.PurgeDeadLetteredOperation(testcontext.New(t), "Applications.Core", "op1")
+	require.NoError(t, err)
+	require.True(t, purged)
+
+	status = http.StatusNoContent
+	purged, err = client.PurgeDeadLetteredOperation(testcontext.New(t), "Applications.Core", "op1")
+	require.NoError(t, err)
+	require.False(t, purged)
+}
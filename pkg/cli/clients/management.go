@@ -18,6 +18,8 @@ package clients
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -210,6 +212,58 @@ func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context,
 	return respFromCtx.StatusCode != 204, nil
 }
 
+// DetectResourceDrift creates a new client, sends a drift detection (or reconciliation when reconcile is true) request
+// for the resource, polls until the request is completed and returns the resource with its updated drift status.
+func (amc *UCPApplicationsManagementClient) DetectResourceDrift(ctx context.Context, resourceType string, resourceName string, reconcile bool) (generated.GenericResource, error) {
+	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	if reconcile {
+		poller, err := client.BeginReconcileDrift(ctx, resourceName, nil)
+		if err != nil {
+			return generated.GenericResource{}, err
+		}
+
+		if _, err = poller.PollUntilDone(ctx, nil); err != nil {
+			return generated.GenericResource{}, err
+		}
+	} else {
+		poller, err := client.BeginDetectDrift(ctx, resourceName, nil)
+		if err != nil {
+			return generated.GenericResource{}, err
+		}
+
+		if _, err = poller.PollUntilDone(ctx, nil); err != nil {
+			return generated.GenericResource{}, err
+		}
+	}
+
+	getResponse, err := client.Get(ctx, resourceName, &generated.GenericResourcesClientGetOptions{})
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return getResponse.GenericResource, nil
+}
+
+// PlanResource sends the resource definition to the plan action of the resource and returns the resources its recipe
+// would create, update or delete, or an error if one occurs.
+func (amc *UCPApplicationsManagementClient) PlanResource(ctx context.Context, resourceType string, resourceName string, resource generated.GenericResource) (generated.RecipePlan, error) {
+	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return generated.RecipePlan{}, err
+	}
+
+	response, err := client.Plan(ctx, resourceName, resource, &generated.GenericResourcesClientPlanOptions{})
+	if err != nil {
+		return generated.RecipePlan{}, err
+	}
+
+	return response.RecipePlan, nil
+}
+
 // ListApplications() retrieves a list of ApplicationResource objects from the Azure API
 // and returns them in a slice, or an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListApplications(ctx context.Context) ([]corerpv20231001.ApplicationResource, error) {
@@ -634,3 +688,86 @@ func (amc *UCPApplicationsManagementClient) ShowRecipe(ctx context.Context, envi
 
 	return corerpv20231001.RecipeGetMetadataResponse(resp.RecipeGetMetadataResponse), nil
 }
+
+// ExportRecipeState creates a new EnvironmentsClient, exports the Terraform state of the recipe deployed for the
+// resource from the environment, and returns the state along with the kind of the backend storing it.
+func (amc *UCPApplicationsManagementClient) ExportRecipeState(ctx context.Context, environmentName string, body corerpv20231001.RecipeStateExport) (corerpv20231001.RecipeStateResponse, error) {
+	client, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return corerpv20231001.RecipeStateResponse{}, err
+	}
+
+	resp, err := client.ExportRecipeState(ctx, environmentName, body, &corerpv20231001.EnvironmentsClientExportRecipeStateOptions{})
+	if err != nil {
+		return corerpv20231001.RecipeStateResponse{}, err
+	}
+
+	return resp.RecipeStateResponse, nil
+}
+
+// UnlockRecipeState creates a new EnvironmentsClient and releases the lock on the Terraform state of the recipe
+// deployed for the resource, returning the kind of the backend storing the state.
+func (amc *UCPApplicationsManagementClient) UnlockRecipeState(ctx context.Context, environmentName string, body corerpv20231001.RecipeStateUnlock) (corerpv20231001.RecipeStateResponse, error) {
+	client, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return corerpv20231001.RecipeStateResponse{}, err
+	}
+
+	resp, err := client.UnlockRecipeState(ctx, environmentName, body, &corerpv20231001.EnvironmentsClientUnlockRecipeStateOptions{})
+	if err != nil {
+		return corerpv20231001.RecipeStateResponse{}, err
+	}
+
+	return resp.RecipeStateResponse, nil
+}
+
+// StartRecipeUpgrade creates a new EnvironmentsClient and starts the async operation upgrading the recipes of the
+// resources of the environment. It returns once the operation is accepted, without waiting for it to complete.
+func (amc *UCPApplicationsManagementClient) StartRecipeUpgrade(ctx context.Context, environmentName string, body corerpv20231001.RecipeUpgrade) error {
+	client, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.BeginUpgradeRecipes(ctx, environmentName, body, &corerpv20231001.EnvironmentsClientBeginUpgradeRecipesOptions{})
+	return err
+}
+
+// ListSecretStoreSecrets creates a new SecretStoresClient, lists the secrets of the secret store with the given resource
+// ID, and returns the secret values keyed by name. Base64-encoded values are decoded.
+func (amc *UCPApplicationsManagementClient) ListSecretStoreSecrets(ctx context.Context, secretStoreID string) (map[string]string, error) {
+	id, err := resources.ParseResource(secretStoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := corerpv20231001.NewSecretStoresClient(id.RootScope(), &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListSecrets(ctx, id.Name(), map[string]any{}, &corerpv20231001.SecretStoresClientListSecretsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := map[string]string{}
+	for key, secret := range resp.Data {
+		if secret == nil || secret.Value == nil {
+			continue
+		}
+
+		value := *secret.Value
+		if secret.Encoding != nil && *secret.Encoding == corerpv20231001.SecretValueEncodingBase64 {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("secret %s is not a valid base64-encoded value: %w", key, err)
+			}
+			value = string(decoded)
+		}
+
+		secrets[key] = value
+	}
+
+	return secrets, nil
+}
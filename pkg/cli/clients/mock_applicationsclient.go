@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20231001preview0 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -37,6 +38,21 @@ func (m *MockApplicationsManagementClient) EXPECT() *MockApplicationsManagementC
 	return m.recorder
 }
 
+// CancelOperation mocks base method.
+func (m *MockApplicationsManagementClient) CancelOperation(arg0 context.Context, arg1, arg2 string) (v1.AsyncOperationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v1.AsyncOperationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOperation indicates an expected call of CancelOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) CancelOperation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CancelOperation), arg0, arg1, arg2)
+}
+
 // CreateApplicationIfNotFound mocks base method.
 func (m *MockApplicationsManagementClient) CreateApplicationIfNotFound(arg0 context.Context, arg1 string, arg2 v20231001preview.ApplicationResource) error {
 	m.ctrl.T.Helper()
@@ -153,6 +169,51 @@ func (mr *MockApplicationsManagementClientMockRecorder) DeleteUCPGroup(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteUCPGroup), arg0, arg1, arg2, arg3)
 }
 
+// DetectResourceDrift mocks base method.
+func (m *MockApplicationsManagementClient) DetectResourceDrift(arg0 context.Context, arg1, arg2 string, arg3 bool) (generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectResourceDrift", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectResourceDrift indicates an expected call of DetectResourceDrift.
+func (mr *MockApplicationsManagementClientMockRecorder) DetectResourceDrift(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectResourceDrift", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DetectResourceDrift), arg0, arg1, arg2, arg3)
+}
+
+// ExportRecipeState mocks base method.
+func (m *MockApplicationsManagementClient) ExportRecipeState(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeStateExport) (v20231001preview.RecipeStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportRecipeState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.RecipeStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportRecipeState indicates an expected call of ExportRecipeState.
+func (mr *MockApplicationsManagementClientMockRecorder) ExportRecipeState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRecipeState", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ExportRecipeState), arg0, arg1, arg2)
+}
+
+// GetDeadLetteredOperation mocks base method.
+func (m *MockApplicationsManagementClient) GetDeadLetteredOperation(arg0 context.Context, arg1, arg2 string) (v1.DeadLetteredOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetteredOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v1.DeadLetteredOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetteredOperation indicates an expected call of GetDeadLetteredOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) GetDeadLetteredOperation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetteredOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetDeadLetteredOperation), arg0, arg1, arg2)
+}
+
 // GetEnvDetails mocks base method.
 func (m *MockApplicationsManagementClient) GetEnvDetails(arg0 context.Context, arg1 string) (v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +319,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListApplications(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListApplications), arg0)
 }
 
+// ListDeadLetteredOperations mocks base method.
+func (m *MockApplicationsManagementClient) ListDeadLetteredOperations(arg0 context.Context, arg1 string) ([]v1.DeadLetteredOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetteredOperations", arg0, arg1)
+	ret0, _ := ret[0].([]v1.DeadLetteredOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetteredOperations indicates an expected call of ListDeadLetteredOperations.
+func (mr *MockApplicationsManagementClientMockRecorder) ListDeadLetteredOperations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetteredOperations", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListDeadLetteredOperations), arg0, arg1)
+}
+
 // ListEnvironmentsAll mocks base method.
 func (m *MockApplicationsManagementClient) ListEnvironmentsAll(arg0 context.Context) ([]v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +364,36 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListEnvironmentsInResour
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentsInResourceGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListEnvironmentsInResourceGroup), arg0)
 }
 
+// ListOperationHistory mocks base method.
+func (m *MockApplicationsManagementClient) ListOperationHistory(arg0 context.Context, arg1, arg2 string) ([]v1.OperationHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOperationHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]v1.OperationHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperationHistory indicates an expected call of ListOperationHistory.
+func (mr *MockApplicationsManagementClientMockRecorder) ListOperationHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperationHistory", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListOperationHistory), arg0, arg1, arg2)
+}
+
+// ListSecretStoreSecrets mocks base method.
+func (m *MockApplicationsManagementClient) ListSecretStoreSecrets(arg0 context.Context, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretStoreSecrets", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretStoreSecrets indicates an expected call of ListSecretStoreSecrets.
+func (mr *MockApplicationsManagementClientMockRecorder) ListSecretStoreSecrets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretStoreSecrets", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListSecretStoreSecrets), arg0, arg1)
+}
+
 // ListUCPGroup mocks base method.
 func (m *MockApplicationsManagementClient) ListUCPGroup(arg0 context.Context, arg1, arg2 string) ([]v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +409,50 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListUCPGroup(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPGroup), arg0, arg1, arg2)
 }
 
+// PlanResource mocks base method.
+func (m *MockApplicationsManagementClient) PlanResource(arg0 context.Context, arg1, arg2 string, arg3 generated.GenericResource) (generated.RecipePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(generated.RecipePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanResource indicates an expected call of PlanResource.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanResource), arg0, arg1, arg2, arg3)
+}
+
+// PurgeDeadLetteredOperation mocks base method.
+func (m *MockApplicationsManagementClient) PurgeDeadLetteredOperation(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetteredOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetteredOperation indicates an expected call of PurgeDeadLetteredOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) PurgeDeadLetteredOperation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetteredOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PurgeDeadLetteredOperation), arg0, arg1, arg2)
+}
+
+// RequeueDeadLetteredOperation mocks base method.
+func (m *MockApplicationsManagementClient) RequeueDeadLetteredOperation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLetteredOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueDeadLetteredOperation indicates an expected call of RequeueDeadLetteredOperation.
+func (mr *MockApplicationsManagementClientMockRecorder) RequeueDeadLetteredOperation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetteredOperation", reflect.TypeOf((*MockApplicationsManagementClient)(nil).RequeueDeadLetteredOperation), arg0, arg1, arg2)
+}
+
 // ShowApplication mocks base method.
 func (m *MockApplicationsManagementClient) ShowApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
@@ -362,3 +512,32 @@ func (mr *MockApplicationsManagementClientMockRecorder) ShowUCPGroup(arg0, arg1,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowUCPGroup), arg0, arg1, arg2, arg3)
 }
+
+// StartRecipeUpgrade mocks base method.
+func (m *MockApplicationsManagementClient) StartRecipeUpgrade(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeUpgrade) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRecipeUpgrade", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartRecipeUpgrade indicates an expected call of StartRecipeUpgrade.
+func (mr *MockApplicationsManagementClientMockRecorder) StartRecipeUpgrade(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRecipeUpgrade", reflect.TypeOf((*MockApplicationsManagementClient)(nil).StartRecipeUpgrade), arg0, arg1, arg2)
+}
+
+// UnlockRecipeState mocks base method.
+func (m *MockApplicationsManagementClient) UnlockRecipeState(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeStateUnlock) (v20231001preview.RecipeStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockRecipeState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.RecipeStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockRecipeState indicates an expected call of UnlockRecipeState.
+func (mr *MockApplicationsManagementClientMockRecorder) UnlockRecipeState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockRecipeState", reflect.TypeOf((*MockApplicationsManagementClient)(nil).UnlockRecipeState), arg0, arg1, arg2)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// operationsAPIVersion is the api-version used for the async operation endpoints of the resource providers.
+	operationsAPIVersion = "2023-10-01-preview"
+)
+
+// CancelOperation requests the cancellation of the async operation and returns the status of the operation.
+func (amc *UCPApplicationsManagementClient) CancelOperation(ctx context.Context, namespace string, operationID string) (v1.AsyncOperationStatus, error) {
+	resp, err := amc.sendLocationRequest(ctx, http.MethodPost, namespace, []string{"operationStatuses", url.PathEscape(operationID), "cancel"}, nil, http.StatusAccepted)
+	if err != nil {
+		return v1.AsyncOperationStatus{}, err
+	}
+
+	result := v1.AsyncOperationStatus{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return v1.AsyncOperationStatus{}, err
+	}
+
+	return result, nil
+}
+
+// ListOperationHistory lists the PUT and DELETE operations performed on the resource, ordered by their start time.
+func (amc *UCPApplicationsManagementClient) ListOperationHistory(ctx context.Context, resourceType string, resourceName string) ([]v1.OperationHistoryEntry, error) {
+	id, err := resources.ParseResource("/" + amc.RootScope + "/providers/" + resourceType + "/" + resourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []v1.OperationHistoryEntry{}
+	query := url.Values{"resourceId": []string{id.String()}}
+	for {
+		resp, err := amc.sendLocationRequest(ctx, http.MethodGet, id.ProviderNamespace(), []string{"operationHistory"}, query, http.StatusOK)
+		if err != nil {
+			return nil, err
+		}
+
+		result := struct {
+			Value    []v1.OperationHistoryEntry `json:"value"`
+			NextLink string                     `json:"nextLink"`
+		}{}
+		if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+			return nil, err
+		}
+		entries = append(entries, result.Value...)
+
+		if result.NextLink == "" {
+			break
+		}
+
+		// The next link carries the paging parameters of the next page.
+		nextLink, err := url.Parse(result.NextLink)
+		if err != nil {
+			return nil, err
+		}
+		if nextLink.Query().Get("skipToken") == "" {
+			break
+		}
+		query.Set("skipToken", nextLink.Query().Get("skipToken"))
+		if top := nextLink.Query().Get("top"); top != "" {
+			query.Set("top", top)
+		}
+	}
+
+	// The pages are returned in the order of the store, so the entries are ordered once all the pages are read.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Properties.StartTime.Before(entries[j].Properties.StartTime)
+	})
+
+	return entries, nil
+}
+
+// sendLocationRequest sends the request to the endpoint of the resource provider namespace in the global location.
+// The async operations are scoped to the plane, so any resource group in the configured scope is ignored.
+func (amc *UCPApplicationsManagementClient) sendLocationRequest(ctx context.Context, method string, namespace string, segments []string, query url.Values, statusCodes ...int) (*http.Response, error) {
+	scope, err := resources.ParseScope("/" + amc.RootScope)
+	if err != nil {
+		return nil, err
+	}
+
+	options := amc.ClientOptions
+	if options == nil {
+		options = &arm.ClientOptions{}
+	}
+
+	host := cloud.AzurePublic.Services[cloud.ResourceManager].Endpoint
+	if c, ok := options.Cloud.Services[cloud.ResourceManager]; ok {
+		host = c.Endpoint
+	}
+
+	pipeline, err := armruntime.NewPipeline(clientv2.ModuleName, clientv2.ModuleVersion, &aztoken.AnonymousCredential{}, runtime.PipelineOptions{}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	segments = append([]string{scope.PlaneScope(), "providers", namespace, "locations", v1.LocationGlobal}, segments...)
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(host, strings.Join(segments, "/")))
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for k, v := range query {
+		values[k] = v
+	}
+	values.Set("api-version", operationsAPIVersion)
+	req.Raw().URL.RawQuery = values.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	return sendRequest(pipeline, req, statusCodes...)
+}
+
+func sendRequest(pipeline runtime.Pipeline, req *policy.Request, statusCodes ...int) (*http.Response, error) {
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !runtime.HasStatusCode(resp, statusCodes...) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const testOperationStatusPath = "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses"
+
+func Test_CancelOperation(t *testing.T) {
+	client := newLocationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, testOperationStatusPath+"/op1/cancel", r.URL.Path)
+		require.Equal(t, operationsAPIVersion, r.URL.Query().Get("api-version"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(v1.AsyncOperationStatus{Name: "op1", Status: v1.ProvisioningStateUpdating})
+	})
+
+	status, err := client.CancelOperation(testcontext.New(t), "Applications.Core", "op1")
+	require.NoError(t, err)
+	require.Equal(t, "op1", status.Name)
+	require.Equal(t, v1.ProvisioningStateUpdating, status.Status)
+}
+
+func Test_CancelOperation_Conflict(t *testing.T) {
+	client := newLocationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	_, err := client.CancelOperation(testcontext.New(t), "Applications.Core", "op1")
+	require.Error(t, err)
+	require.False(t, clientv2.Is404Error(err))
+}
+
+func Test_ListOperationHistory(t *testing.T) {
+	start := time.Now().UTC()
+	client := newLocationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/planes/radius/local/providers/Applications.Core/locations/global/operationHistory", r.URL.Path)
+		require.Equal(t, operationsAPIVersion, r.URL.Query().Get("api-version"))
+		require.Equal(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env0", r.URL.Query().Get("resourceId"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("skipToken") == "" {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"value": []v1.OperationHistoryEntry{
+					{Name: "op2", Properties: v1.OperationHistoryEntryProperties{OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|DELETE", Status: v1.ProvisioningStateFailed, StartTime: start.Add(time.Minute)}},
+				},
+				"nextLink": "http://localhost/planes/radius/local/providers/Applications.Core/locations/global/operationHistory?api-version=2023-10-01-preview&skipToken=page2&top=1",
+			})
+			return
+		}
+
+		require.Equal(t, "page2", r.URL.Query().Get("skipToken"))
+		require.Equal(t, "1", r.URL.Query().Get("top"))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"value": []v1.OperationHistoryEntry{
+				{Name: "op1", Properties: v1.OperationHistoryEntryProperties{OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT", Status: v1.ProvisioningStateSucceeded, StartTime: start}},
+			},
+		})
+	})
+
+	entries, err := client.ListOperationHistory(testcontext.New(t), "Applications.Core/environments", "env0")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "op1", entries[0].Name)
+	require.Equal(t, v1.ProvisioningStateSucceeded, entries[0].Properties.Status)
+	require.Equal(t, "op2", entries[1].Name)
+}
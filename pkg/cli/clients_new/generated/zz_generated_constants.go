@@ -33,3 +33,21 @@ func PossibleCreatedByTypeValues() []CreatedByType {
 	}
 }
 
+// PlanAction - The action the deployment of a recipe would take on a resource.
+type PlanAction string
+
+const (
+	PlanActionCreate PlanAction = "Create"
+	PlanActionDelete PlanAction = "Delete"
+	PlanActionUpdate PlanAction = "Update"
+)
+
+// PossiblePlanActionValues returns the possible values for the PlanAction const type.
+func PossiblePlanActionValues() []PlanAction {
+	return []PlanAction{	
+		PlanActionCreate,
+		PlanActionDelete,
+		PlanActionUpdate,
+	}
+}
+
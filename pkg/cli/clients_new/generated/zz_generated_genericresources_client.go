@@ -174,6 +174,64 @@ func (client *GenericResourcesClient) deleteCreateRequest(ctx context.Context, r
 	return req, nil
 }
 
+// BeginDetectDrift - Detects drift between a recipe-provisioned resource and its deployed infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+// Generated from API version 2023-10-01-preview
+// resourceName - The name of the generic resource
+// options - GenericResourcesClientBeginDetectDriftOptions contains the optional parameters for the GenericResourcesClient.BeginDetectDrift
+// method.
+func (client *GenericResourcesClient) BeginDetectDrift(ctx context.Context, resourceName string, options *GenericResourcesClientBeginDetectDriftOptions) (*runtime.Poller[GenericResourcesClientDetectDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.detectDrift(ctx, resourceName, options)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewPoller(resp, client.pl, &runtime.NewPollerOptions[GenericResourcesClientDetectDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+	} else {
+		return runtime.NewPollerFromResumeToken[GenericResourcesClientDetectDriftResponse](options.ResumeToken, client.pl, nil)
+	}
+}
+
+// DetectDrift - Detects drift between a recipe-provisioned resource and its deployed infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+// Generated from API version 2023-10-01-preview
+func (client *GenericResourcesClient) detectDrift(ctx context.Context, resourceName string, options *GenericResourcesClientBeginDetectDriftOptions) (*http.Response, error) {
+	req, err := client.detectDriftCreateRequest(ctx, resourceName, options)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.pl.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusAccepted, http.StatusNoContent) {
+		return nil, runtime.NewResponseError(resp)
+	}
+	 return resp, nil
+}
+
+// detectDriftCreateRequest creates the DetectDrift request.
+func (client *GenericResourcesClient) detectDriftCreateRequest(ctx context.Context, resourceName string, options *GenericResourcesClientBeginDetectDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}/detectDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.host, urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
 // Get - Retrieves information about a generic resource with the name given of the type given within the root scope
 // If the operation fails it returns an *azcore.ResponseError type.
 // Generated from API version 2023-10-01-preview
@@ -330,3 +388,111 @@ func (client *GenericResourcesClient) listSecretsHandleResponse(resp *http.Respo
 	return result, nil
 }
 
+// Plan - Previews the changes the recipe of a resource would make to the infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+// Generated from API version 2023-10-01-preview
+// resourceName - The name of the generic resource
+// genericResourceParameters - generic resource plan parameters
+// options - GenericResourcesClientPlanOptions contains the optional parameters for the GenericResourcesClient.Plan method.
+func (client *GenericResourcesClient) Plan(ctx context.Context, resourceName string, genericResourceParameters GenericResource, options *GenericResourcesClientPlanOptions) (GenericResourcesClientPlanResponse, error) {
+	req, err := client.planCreateRequest(ctx, resourceName, genericResourceParameters, options)
+	if err != nil {
+		return GenericResourcesClientPlanResponse{}, err
+	}
+	resp, err := client.pl.Do(req)
+	if err != nil {
+		return GenericResourcesClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return GenericResourcesClientPlanResponse{}, runtime.NewResponseError(resp)
+	}
+	return client.planHandleResponse(resp)
+}
+
+// planCreateRequest creates the Plan request.
+func (client *GenericResourcesClient) planCreateRequest(ctx context.Context, resourceName string, genericResourceParameters GenericResource, options *GenericResourcesClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.host, urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, runtime.MarshalAsJSON(req, genericResourceParameters)
+}
+
+// planHandleResponse handles the Plan response.
+func (client *GenericResourcesClient) planHandleResponse(resp *http.Response) (GenericResourcesClientPlanResponse, error) {
+	result := GenericResourcesClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlan); err != nil {
+		return GenericResourcesClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginReconcileDrift - Detects drift for a recipe-provisioned resource and re-applies the recipe if drift is found
+// If the operation fails it returns an *azcore.ResponseError type.
+// Generated from API version 2023-10-01-preview
+// resourceName - The name of the generic resource
+// options - GenericResourcesClientBeginReconcileDriftOptions contains the optional parameters for the GenericResourcesClient.BeginReconcileDrift
+// method.
+func (client *GenericResourcesClient) BeginReconcileDrift(ctx context.Context, resourceName string, options *GenericResourcesClientBeginReconcileDriftOptions) (*runtime.Poller[GenericResourcesClientReconcileDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.reconcileDrift(ctx, resourceName, options)
+		if err != nil {
+			return nil, err
+		}
+		return runtime.NewPoller(resp, client.pl, &runtime.NewPollerOptions[GenericResourcesClientReconcileDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+	} else {
+		return runtime.NewPollerFromResumeToken[GenericResourcesClientReconcileDriftResponse](options.ResumeToken, client.pl, nil)
+	}
+}
+
+// ReconcileDrift - Detects drift for a recipe-provisioned resource and re-applies the recipe if drift is found
+// If the operation fails it returns an *azcore.ResponseError type.
+// Generated from API version 2023-10-01-preview
+func (client *GenericResourcesClient) reconcileDrift(ctx context.Context, resourceName string, options *GenericResourcesClientBeginReconcileDriftOptions) (*http.Response, error) {
+	req, err := client.reconcileDriftCreateRequest(ctx, resourceName, options)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.pl.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusAccepted, http.StatusNoContent) {
+		return nil, runtime.NewResponseError(resp)
+	}
+	 return resp, nil
+}
+
+// reconcileDriftCreateRequest creates the ReconcileDrift request.
+func (client *GenericResourcesClient) reconcileDriftCreateRequest(ctx context.Context, resourceName string, options *GenericResourcesClientBeginReconcileDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}/reconcileDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.host, urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
@@ -81,6 +81,20 @@ type GenericResourcesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// GenericResourcesClientBeginDetectDriftOptions contains the optional parameters for the GenericResourcesClient.BeginDetectDrift
+// method.
+type GenericResourcesClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// GenericResourcesClientBeginReconcileDriftOptions contains the optional parameters for the GenericResourcesClient.BeginReconcileDrift
+// method.
+type GenericResourcesClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // GenericResourcesClientGetOptions contains the optional parameters for the GenericResourcesClient.Get method.
 type GenericResourcesClientGetOptions struct {
 	// placeholder for future optional parameters
@@ -97,6 +111,11 @@ type GenericResourcesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// GenericResourcesClientPlanOptions contains the optional parameters for the GenericResourcesClient.Plan method.
+type GenericResourcesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // GenericResourcesList - Object that includes an array of GenericResources and a possible link for next set
 type GenericResourcesList struct {
 	// The link used to fetch the next page of resource list.
@@ -106,6 +125,21 @@ type GenericResourcesList struct {
 	Value []*GenericResource `json:"value,omitempty"`
 }
 
+// PlannedResource - A resource the deployment of a recipe would create, update or delete.
+type PlannedResource struct {
+	// REQUIRED; The action the deployment of the recipe would take on the resource.
+	Action *PlanAction `json:"action,omitempty"`
+
+	// REQUIRED; The UCP resource ID of the resource, or the recipe address of the resource when it has no resource ID.
+	ID *string `json:"id,omitempty"`
+}
+
+// RecipePlan - The preview of the changes a deployment of the recipe of a resource would make to the infrastructure.
+type RecipePlan struct {
+	// REQUIRED; The resources the deployment of the recipe would create, update or delete.
+	Resources []*PlannedResource `json:"resources,omitempty"`
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -18,6 +18,11 @@ type GenericResourcesClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// GenericResourcesClientDetectDriftResponse contains the response from method GenericResourcesClient.DetectDrift.
+type GenericResourcesClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // GenericResourcesClientGetResponse contains the response from method GenericResourcesClient.Get.
 type GenericResourcesClientGetResponse struct {
 	GenericResource
@@ -34,3 +39,13 @@ type GenericResourcesClientListSecretsResponse struct {
 	Value map[string]*string
 }
 
+// GenericResourcesClientPlanResponse contains the response from method GenericResourcesClient.Plan.
+type GenericResourcesClientPlanResponse struct {
+	RecipePlan
+}
+
+// GenericResourcesClientReconcileDriftResponse contains the response from method GenericResourcesClient.ReconcileDrift.
+type GenericResourcesClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
@@ -24,11 +24,17 @@ import (
 	"strings"
 
 	credentials "github.com/oras-project/oras-credentials-go"
+	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 
 	"github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go"
@@ -67,11 +73,15 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Long: `Publish a Bicep file to an OCI registry.
 This command compiles and publishes a local Bicep file to a remote Open Container Initiative (OCI) registry, such as Azure Container Registry, Docker Hub, or GitHub Container Registry, to later be used as a Bicep registry or for Radius Recipes.
 Before publishing, it is expected the user runs docker login (or similar command) and has the proper permission to push to the target OCI registry.
+Alternatively, the registry credentials configured for Bicep Recipes in an environment can be used by specifying the environment with --environment.
 For more information on Bicep modules visit https://learn.microsoft.com/azure/azure-resource-manager/bicep/modules
 		`,
 		Example: `
 # Publish a Bicep file to a container registry
 rad bicep publish --file ./redis-test.bicep --target br:ghcr.io/myregistry/redis-test:v1
+
+# Publish a Bicep file to a container registry using the registry credentials of the 'prod' environment
+rad bicep publish --file ./redis-test.bicep --target br:ghcr.io/myregistry/redis-test:v1 --environment prod
 		`,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -81,6 +91,8 @@ rad bicep publish --file ./redis-test.bicep --target br:ghcr.io/myregistry/redis
 	_ = cmd.MarkFlagRequired("file")
 	cmd.Flags().String("target", "", "remote OCI registry path, in the format 'br:HOST/PATH:TAG'.")
 	_ = cmd.MarkFlagRequired("target")
+	cmd.Flags().StringP("environment", "e", "", "The environment whose registry credentials are used to publish. If not specified, the local Docker credentials are used.")
+	commonflags.AddWorkspaceFlag(cmd)
 
 	return cmd, runner
 }
@@ -92,11 +104,14 @@ type Runner struct {
 	ConnectionFactory connections.Factory
 	Output            output.Interface
 
-	File          string
-	Target        string
-	Destination   *destination
-	Template      map[string]any
-	TemplateBytes []byte
+	File               string
+	Target             string
+	EnvironmentName    string
+	Workspace          *workspaces.Workspace
+	Destination        *destination
+	RegistryCredential *auth.Credential
+	Template           map[string]any
+	TemplateBytes      []byte
 }
 
 // NewRunner creates a new instance of the `rad bicep publish` runner.
@@ -131,6 +146,21 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 	r.Target = strings.TrimPrefix(target, "br:")
 
+	environmentName, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return err
+	}
+
+	// The workspace is only needed to read the registry credentials of the environment.
+	if environmentName != "" {
+		workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+		if err != nil {
+			return err
+		}
+		r.Workspace = workspace
+		r.EnvironmentName = environmentName
+	}
+
 	return nil
 }
 
@@ -158,6 +188,18 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 	r.Destination = dest
 
+	if r.EnvironmentName != "" {
+		client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+		if err != nil {
+			return err
+		}
+
+		r.RegistryCredential, err = getEnvironmentRegistryCredential(ctx, client, r.EnvironmentName, r.Destination.host)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = r.publish(ctx)
 	if err != nil {
 		return clierrors.MessageWithCause(err, "Failed to publish Bicep file %q to %q.", r.File, r.Target)
@@ -227,15 +269,26 @@ func (r *Runner) prepareSource(ctx context.Context) (*memory.Store, error) {
 }
 
 func (r *Runner) prepareDestination(ctx context.Context) (*remote.Repository, error) {
-	// Create a new credential store from Docker to get local credentials
-	ds, err := credentials.NewStoreFromDocker(credentials.StoreOptions{
-		AllowPlaintextPut: true,
-	})
+	dst, err := remote.NewRepository(r.Destination.host + "/" + r.Destination.repo)
 	if err != nil {
 		return nil, err
 	}
 
-	dst, err := remote.NewRepository(r.Destination.host + "/" + r.Destination.repo)
+	// Use the registry credentials of the environment if specified.
+	if r.RegistryCredential != nil {
+		dst.Client = &auth.Client{
+			Client:     retry.DefaultClient,
+			Cache:      auth.NewCache(),
+			Credential: auth.StaticCredential(r.Destination.host, *r.RegistryCredential),
+		}
+
+		return dst, nil
+	}
+
+	// Create a new credential store from Docker to get local credentials
+	ds, err := credentials.NewStoreFromDocker(credentials.StoreOptions{
+		AllowPlaintextPut: true,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -249,6 +302,39 @@ func (r *Runner) prepareDestination(ctx context.Context) (*remote.Repository, er
 	return dst, nil
 }
 
+// getEnvironmentRegistryCredential returns the credential configured for Bicep Recipes in the environment for the
+// registry host. The credential is read from the secret store referenced by the environment.
+func getEnvironmentRegistryCredential(ctx context.Context, client clients.ApplicationsManagementClient, environmentName string, host string) (*auth.Credential, error) {
+	env, err := client.GetEnvDetails(ctx, environmentName)
+	if clients.Is404Error(err) {
+		return nil, clierrors.Message("The environment %q does not exist. Run `rad env create` first.", environmentName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var registryAuth *corerp.RegistryAuthentication
+	if env.Properties != nil && env.Properties.RecipeConfig != nil && env.Properties.RecipeConfig.Bicep != nil {
+		registryAuth = env.Properties.RecipeConfig.Bicep.Authentication[host]
+	}
+	if registryAuth == nil {
+		return nil, clierrors.Message("The environment %q does not have registry credentials for %q.", environmentName, host)
+	}
+
+	// The environment only references the secret store, the credentials are read with the listSecrets action of the
+	// secret store which requires permission to read its secrets.
+	secrets, err := client.ListSecretStoreSecrets(ctx, to.String(registryAuth.Secret))
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to read the registry credentials for %q from secret store %q.", host, to.String(registryAuth.Secret))
+	}
+
+	username, password, token := secrets["username"], secrets["password"], secrets["token"]
+	if token != "" {
+		return &auth.Credential{AccessToken: token}, nil
+	}
+
+	return &auth.Credential{Username: username, Password: password}, nil
+}
+
 // extractDestination extracts the host, repo, and tag from the target
 func (r *Runner) extractDestination() (*destination, error) {
 	ref, err := registry.ParseReference(r.Target)
@@ -22,16 +22,22 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/content/memory"
 	"oras.land/oras-go/v2/registry"
 	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
 )
 
 func TestRunner_extractDestination(t *testing.T) {
@@ -241,6 +247,96 @@ func TestRunner_prepareDestination(t *testing.T) {
 	}
 }
 
+func TestRunner_prepareDestination_EnvironmentCredential(t *testing.T) {
+	r := &Runner{
+		Destination: &destination{
+			host: "myregistry.azurecr.io",
+			repo: "repo",
+			tag:  "tag",
+		},
+		RegistryCredential: &auth.Credential{Username: "admin", Password: "password"},
+	}
+
+	got, err := r.prepareDestination(context.Background())
+	require.NoError(t, err)
+
+	client, ok := got.Client.(*auth.Client)
+	require.True(t, ok)
+
+	cred, err := client.Credential(context.Background(), "myregistry.azurecr.io")
+	require.NoError(t, err)
+	require.Equal(t, auth.Credential{Username: "admin", Password: "password"}, cred)
+
+	cred, err = client.Credential(context.Background(), "ghcr.io")
+	require.NoError(t, err)
+	require.Equal(t, auth.EmptyCredential, cred)
+}
+
+func Test_getEnvironmentRegistryCredential(t *testing.T) {
+	basicSecretStoreID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/basic-creds"
+	tokenSecretStoreID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/secretStores/token-creds"
+	env := corerp.EnvironmentResource{
+		Properties: &corerp.EnvironmentProperties{
+			RecipeConfig: &corerp.RecipeConfigProperties{
+				Bicep: &corerp.BicepConfigProperties{
+					Authentication: map[string]*corerp.RegistryAuthentication{
+						"basic.azurecr.io": {Secret: to.Ptr(basicSecretStoreID)},
+						"ghcr.io":          {Secret: to.Ptr(tokenSecretStoreID)},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		host     string
+		expected *auth.Credential
+		err      error
+	}{
+		{
+			name:     "basic",
+			host:     "basic.azurecr.io",
+			expected: &auth.Credential{Username: "admin", Password: "password"},
+		},
+		{
+			name:     "token",
+			host:     "ghcr.io",
+			expected: &auth.Credential{AccessToken: "token"},
+		},
+		{
+			name: "no credentials for host",
+			host: "docker.io",
+			err:  clierrors.Message("The environment %q does not have registry credentials for %q.", "prod", "docker.io"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := clients.NewMockApplicationsManagementClient(ctrl)
+			client.EXPECT().GetEnvDetails(gomock.Any(), "prod").Return(env, nil).Times(1)
+			client.EXPECT().
+				ListSecretStoreSecrets(gomock.Any(), basicSecretStoreID).
+				Return(map[string]string{"username": "admin", "password": "password"}, nil).
+				AnyTimes()
+			client.EXPECT().
+				ListSecretStoreSecrets(gomock.Any(), tokenSecretStoreID).
+				Return(map[string]string{"token": "token"}, nil).
+				AnyTimes()
+
+			cred, err := getEnvironmentRegistryCredential(context.Background(), client, "prod", tt.host)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, cred)
+		})
+	}
+}
+
 func TestRunner_Validate(t *testing.T) {
 	tests := []radcli.ValidateInput{
 		{
@@ -275,6 +371,27 @@ func TestRunner_Validate(t *testing.T) {
 				ConfigFilePath: "",
 			},
 		},
+		{
+			Name: "With file, target and environment flags",
+			Input: []string{
+				"--file",
+				"redis.recipe.bicep",
+				"--target",
+				"br:ghcr.io/test-registry/test/repo:tag",
+				"--environment",
+				"prod",
+			},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "prod", r.EnvironmentName)
+				require.NotNil(t, r.Workspace)
+			},
+		},
 		{
 			Name: "With file and target w/o `br` flags",
 			Input: []string{
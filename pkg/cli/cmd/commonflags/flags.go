@@ -37,6 +37,12 @@ const (
 	ClearEnvAzureFlag = "clear-azure"
 	// ClearEnvAWSFlag tells the command to clear aws scope on the environment it is configured.
 	ClearEnvAWSFlag = "clear-aws"
+	// ResourceProviderFlag provides the resource provider namespace.
+	ResourceProviderFlag = "provider"
+	// DefaultResourceProvider is the default resource provider namespace.
+	DefaultResourceProvider = "Applications.Core"
+	// RecipeCatalogFlag provides the location of the index of a recipe catalog.
+	RecipeCatalogFlag = "catalog"
 )
 
 // AddOutputFlag adds a flag to the given command that allows the user to specify the output format of the command's output.
@@ -85,6 +91,13 @@ func AddResourceTypeFlag(cmd *cobra.Command) {
 	cmd.Flags().String("resource-type", "", "Specify the type of the portable resource this recipe can be consumed by")
 }
 
+// AddRecipeCatalogFlag adds a required flag to the given command that allows the user to specify the location of the
+// index of a recipe catalog, either an OCI artifact reference or a git repository prefixed with 'git::'.
+func AddRecipeCatalogFlag(cmd *cobra.Command) {
+	cmd.Flags().String(RecipeCatalogFlag, "", "Specify the location of the recipe catalog index, either an OCI artifact reference or a git repository prefixed with 'git::'")
+	_ = cmd.MarkFlagRequired(RecipeCatalogFlag)
+}
+
 // AddAzureScopeFlags adds flags to a command to specify an Azure subscription and resource group, and marks them as
 // required together, as well as mutually exclusive with a flag to clear environment variables.
 func AddAzureScopeFlags(cmd *cobra.Command) {
@@ -130,3 +143,8 @@ func AddAWSAccountFlag(cmd *cobra.Command) {
 func AddKubeContextFlagVar(cmd *cobra.Command, ref *string) {
 	cmd.Flags().StringVar(ref, "kubecontext", "", "The Kubernetes context to use, will use the default if unset")
 }
+
+// AddResourceProviderFlag adds a flag to the given command that allows the user to specify a resource provider namespace.
+func AddResourceProviderFlag(cmd *cobra.Command) {
+	cmd.Flags().String(ResourceProviderFlag, DefaultResourceProvider, "The resource provider namespace")
+}
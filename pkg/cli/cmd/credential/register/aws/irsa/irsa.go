@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package irsa
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for registering AWS cloud provider credentials with IAM roles for service
+// accounts (IRSA), and returns a Runner to execute the command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "irsa",
+		Short: "Register (Add or update) AWS cloud provider credential with IRSA for a Radius installation.",
+		Long: `Register (Add or update) AWS cloud provider credential with IAM roles for service accounts (IRSA) for a Radius installation.
+
+This command is intended for scripting or advanced use-cases. See 'rad init' for a user-friendly way
+to configure these settings.
+
+Radius will assume the provided IAM role for all interactions with AWS. No access key is stored: Radius exchanges
+the web identity token projected into its pods by the EKS pod identity webhook for temporary credentials of the
+role with AssumeRoleWithWebIdentity.
+
+The trust policy of the role must allow the OIDC provider of the cluster to assume the role for the Radius
+service accounts.
+` + common.LongDescriptionBlurb,
+		Example: `
+# Register (Add or update) cloud provider credential for AWS with IRSA authentication
+rad credential register aws irsa --iam-role <roleARN>
+`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	cmd.Flags().String("iam-role", "", "The ARN of the AWS IAM role assumed with IRSA, for example 'arn:aws:iam::<account id>:role/<role name>'.")
+	_ = cmd.MarkFlagRequired("iam-role")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad credential register aws irsa` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	IAMRoleARN  string
+	KubeContext string
+}
+
+// NewRunner creates a new instance of the `rad credential register aws irsa` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate checks if the required workspace, output format and IAM role ARN are present and valid, and if not,
+// returns an error.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.IAMRoleARN, err = cmd.Flags().GetString("iam-role")
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(r.IAMRoleARN, "arn:") || !strings.Contains(r.IAMRoleARN, ":role/") {
+		return clierrors.Message("IAM role %q is not a valid role ARN, expected 'arn:aws:iam::<account id>:role/<role name>'.", r.IAMRoleARN)
+	}
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("A Kubernetes connection is required.")
+	}
+	r.KubeContext = kubeContext
+	return nil
+}
+
+// Run registers an AWS IRSA credential with the given context and workspace, and returns an error if unsuccessful.
+func (r *Runner) Run(ctx context.Context) error {
+	r.Output.LogInfo("Registering credential for %q cloud provider in Radius installation %q...", "aws", r.Workspace.FmtConnection())
+	client, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	credential := ucp.AwsCredentialResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Type:     to.Ptr(cli_credential.AWSCredential),
+		Properties: &ucp.AwsIRSACredentialProperties{
+			Storage: &ucp.CredentialStorageProperties{
+				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+			},
+			RoleARN: &r.IAMRoleARN,
+			Kind:    to.Ptr(ucp.AWSCredentialKindIRSA),
+		},
+	}
+
+	err = client.PutAWS(ctx, credential)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Successfully registered credential for %q cloud provider. Tokens may take up to 30 seconds to refresh.", "aws")
+
+	return nil
+}
@@ -19,7 +19,9 @@ package register
 import (
 	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
 	credential_register_aws "github.com/radius-project/radius/pkg/cli/cmd/credential/register/aws"
+	credential_register_aws_irsa "github.com/radius-project/radius/pkg/cli/cmd/credential/register/aws/irsa"
 	credential_register_azure "github.com/radius-project/radius/pkg/cli/cmd/credential/register/azure"
+	credential_register_azure_wi "github.com/radius-project/radius/pkg/cli/cmd/credential/register/azure/wi"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/spf13/cobra"
 )
@@ -37,16 +39,26 @@ func NewCommand(factory framework.Factory) *cobra.Command {
 		Example: `
 # Register (Add or update) cloud provider credential for Azure with service principal authentication
 rad credential register azure --client-id <client id> --client-secret <client secret> --tenant-id <tenant id> 	
+# Register (Add or update) cloud provider credential for Azure with workload identity authentication
+rad credential register azure wi --client-id <client id> --tenant-id <tenant id>
 # Register (Add or Update) cloud provider credential for AWS with IAM authentication
 rad credential register aws --access-key-id <access-key-id> --secret-access-key <secret-access-key>	
+# Register (Add or Update) cloud provider credential for AWS with IAM roles for service accounts (IRSA)
+rad credential register aws irsa --iam-role <roleARN>
 `,
 	}
 
 	azure, _ := credential_register_azure.NewCommand(factory)
 	cmd.AddCommand(azure)
 
+	wi, _ := credential_register_azure_wi.NewCommand(factory)
+	azure.AddCommand(wi)
+
 	aws, _ := credential_register_aws.NewCommand(factory)
 	cmd.AddCommand(aws)
 
+	irsa, _ := credential_register_aws_irsa.NewCommand(factory)
+	aws.AddCommand(irsa)
+
 	return cmd
 }
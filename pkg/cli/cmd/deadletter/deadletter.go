@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	deadletter_list "github.com/radius-project/radius/pkg/cli/cmd/deadletter/list"
+	deadletter_purge "github.com/radius-project/radius/pkg/cli/cmd/deadletter/purge"
+	deadletter_requeue "github.com/radius-project/radius/pkg/cli/cmd/deadletter/requeue"
+	deadletter_show "github.com/radius-project/radius/pkg/cli/cmd/deadletter/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing the dead-letter queue, with subcommands for listing, showing,
+// requeuing, and purging dead-lettered operations.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "deadletter",
+		Short: "Manage dead-lettered operations",
+		Long: `Manage dead-lettered operations
+
+Asynchronous operations are moved to the dead-letter queue when they exceed the maximum retry count or when no controller is registered for the operation type.
+The dead-letter queue keeps the original request, the last error, and the delivery history of each operation so that it can be inspected, requeued, or purged.
+`,
+		Example: `
+# List dead-lettered operations of Applications.Core
+rad deadletter list
+
+# Show the details of a dead-lettered operation
+rad deadletter show 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11
+
+# Requeue a dead-lettered operation
+rad deadletter requeue 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11
+
+# Purge all dead-lettered operations of Applications.Datastores
+rad deadletter purge --all --provider Applications.Datastores
+`,
+	}
+
+	list, _ := deadletter_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := deadletter_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	requeue, _ := deadletter_requeue.NewCommand(factory)
+	cmd.AddCommand(requeue)
+
+	purge, _ := deadletter_purge.NewCommand(factory)
+	cmd.AddCommand(purge)
+
+	return cmd
+}
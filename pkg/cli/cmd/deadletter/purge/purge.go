@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad deadletter purge` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "purge [operationId]",
+		Short: "Purge dead-lettered operations",
+		Long: `Purge dead-lettered operations
+
+Purged operations are removed from the dead-letter queue and cannot be requeued. Use --all to purge every dead-lettered operation of the resource provider.`,
+		Example: `
+# Purge a dead-lettered operation
+rad deadletter purge 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11
+
+# Purge all dead-lettered operations of Applications.Core without prompting for confirmation
+rad deadletter purge --all --yes`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceProviderFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().Bool("all", false, "Purge all dead-lettered operations of the resource provider")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad deadletter purge` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	Namespace         string
+	OperationID       string
+	All               bool
+	Confirmation      bool
+}
+
+// NewRunner creates a new instance of the `rad deadletter purge` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad deadletter purge` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := cmd.Flags().GetString(commonflags.ResourceProviderFlag)
+	if err != nil {
+		return err
+	}
+
+	all, err := cmd.Flags().GetBool("all")
+	if err != nil {
+		return err
+	}
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	if all && len(args) > 0 {
+		return clierrors.Message("The operation id cannot be specified together with --all.")
+	} else if !all && len(args) == 0 {
+		return clierrors.Message("Specify the operation id to purge, or use --all to purge all dead-lettered operations.")
+	}
+
+	r.Workspace = workspace
+	r.Namespace = namespace
+	r.All = all
+	r.Confirmation = yes
+	if len(args) > 0 {
+		r.OperationID = args[0]
+	}
+
+	return nil
+}
+
+// Run runs the `rad deadletter purge` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if !r.Confirmation {
+		message := fmt.Sprintf("Are you sure you want to purge the dead-lettered operation '%v'?", r.OperationID)
+		if r.All {
+			message = fmt.Sprintf("Are you sure you want to purge all dead-lettered operations of '%v'?", r.Namespace)
+		}
+
+		confirmed, err := prompt.YesOrNoPrompt(message, prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			r.Output.LogInfo("dead-lettered operations NOT purged")
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	operationIDs := []string{r.OperationID}
+	if r.All {
+		operations, err := client.ListDeadLetteredOperations(ctx, r.Namespace)
+		if err != nil {
+			return err
+		}
+
+		operationIDs = []string{}
+		for _, operation := range operations {
+			operationIDs = append(operationIDs, operation.Name)
+		}
+	}
+
+	for _, operationID := range operationIDs {
+		purged, err := client.PurgeDeadLetteredOperation(ctx, r.Namespace, operationID)
+		if err != nil {
+			return err
+		}
+
+		if purged {
+			r.Output.LogInfo("Operation %q purged", operationID)
+		} else {
+			r.Output.LogInfo("Operation %q does not exist or has already been purged", operationID)
+		}
+	}
+
+	return nil
+}
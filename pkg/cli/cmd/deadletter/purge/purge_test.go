@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const testOperationID = "00000000-0000-0000-0000-000000000001"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Purge Command with operation id",
+			Input:         []string{testOperationID, "--yes"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, testOperationID, runner.(*Runner).OperationID)
+				require.True(t, runner.(*Runner).Confirmation)
+				require.False(t, runner.(*Runner).All)
+			},
+		},
+		{
+			Name:          "Purge Command with --all",
+			Input:         []string{"--all"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).All)
+			},
+		},
+		{
+			Name:          "Purge Command without operation id",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Purge Command with operation id and --all",
+			Input:         []string{testOperationID, "--all"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Purge operation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PurgeDeadLetteredOperation(gomock.Any(), "Applications.Core", testOperationID).
+			Return(false, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Namespace:         "Applications.Core",
+			OperationID:       testOperationID,
+			Confirmation:      true,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Operation %q does not exist or has already been purged",
+				Params: []any{testOperationID},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Purge all operations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, "Are you sure you want to purge all dead-lettered operations of 'Applications.Core'?").
+			Return(prompt.ConfirmYes, nil).
+			Times(1)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListDeadLetteredOperations(gomock.Any(), "Applications.Core").
+			Return([]v1.DeadLetteredOperation{{Name: "op1"}, {Name: "op2"}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			PurgeDeadLetteredOperation(gomock.Any(), "Applications.Core", "op1").
+			Return(true, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			PurgeDeadLetteredOperation(gomock.Any(), "Applications.Core", "op2").
+			Return(true, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			InputPrompter:     prompter,
+			Workspace:         &workspaces.Workspace{},
+			Namespace:         "Applications.Core",
+			All:               true,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "Operation %q purged", Params: []any{"op1"}},
+			output.LogOutput{Format: "Operation %q purged", Params: []any{"op2"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Purge not confirmed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, "Are you sure you want to purge the dead-lettered operation '"+testOperationID+"'?").
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			InputPrompter: prompter,
+			Workspace:     &workspaces.Workspace{},
+			Namespace:     "Applications.Core",
+			OperationID:   testOperationID,
+			Output:        outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "dead-lettered operations NOT purged"},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
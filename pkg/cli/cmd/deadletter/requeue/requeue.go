@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requeue
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad deadletter requeue` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "requeue operationId",
+		Short: "Requeue a dead-lettered operation",
+		Long: `Requeue a dead-lettered operation
+
+The original request message is queued again and the operation is removed from the dead-letter queue. The operation status is reset to Accepted so that the progress of the operation can be tracked again.`,
+		Example: `
+# Requeue a dead-lettered operation of Applications.Core
+rad deadletter requeue 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceProviderFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad deadletter requeue` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Namespace         string
+	OperationID       string
+}
+
+// NewRunner creates a new instance of the `rad deadletter requeue` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad deadletter requeue` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := cmd.Flags().GetString(commonflags.ResourceProviderFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+	r.Namespace = namespace
+	r.OperationID = args[0]
+
+	return nil
+}
+
+// Run runs the `rad deadletter requeue` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.RequeueDeadLetteredOperation(ctx, r.Namespace, r.OperationID)
+	if clientv2.Is404Error(err) {
+		return clierrors.Message("The operation %q was not found in the dead-letter queue of %q.", r.OperationID, r.Namespace)
+	} else if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Operation %q requeued", r.OperationID)
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad deadletter show` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "show operationId",
+		Short: "Show the details of a dead-lettered operation",
+		Long: `Show the details of a dead-lettered operation
+
+The output includes the last error and the delivery history of the operation. Use the JSON output format to inspect the original request message.`,
+		Example: `
+# Show the details of a dead-lettered operation
+rad deadletter show 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11
+
+# Show the original request message of a dead-lettered operation
+rad deadletter show 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddResourceProviderFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad deadletter show` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Namespace         string
+	OperationID       string
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad deadletter show` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad deadletter show` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := cmd.Flags().GetString(commonflags.ResourceProviderFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+	r.Format = format
+	r.Namespace = namespace
+	r.OperationID = args[0]
+
+	return nil
+}
+
+// Run runs the `rad deadletter show` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	operation, err := client.GetDeadLetteredOperation(ctx, r.Namespace, r.OperationID)
+	if clientv2.Is404Error(err) {
+		return clierrors.Message("The operation %q was not found in the dead-letter queue of %q.", r.OperationID, r.Namespace)
+	} else if err != nil {
+		return err
+	}
+
+	err = r.Output.WriteFormatted(r.Format, operation, objectformats.GetDeadLetteredOperationTableFormat())
+	if err != nil {
+		return err
+	}
+
+	// The table format cannot show nested values, so print the last error and the delivery history separately.
+	if r.Format == output.FormatTable {
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Last error: %s", operation.Properties.LastError)
+		for _, attempt := range operation.Properties.History {
+			r.Output.LogInfo("  attempt %d at %s: %s", attempt.DequeueCount, attempt.DequeuedAt.Format(time.RFC3339), attempt.Error)
+		}
+	}
+
+	return nil
+}
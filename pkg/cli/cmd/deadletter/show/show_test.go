@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const testOperationID = "00000000-0000-0000-0000-000000000001"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Show Command with operation id",
+			Input:         []string{testOperationID},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, testOperationID, runner.(*Runner).OperationID)
+				require.Equal(t, "Applications.Core", runner.(*Runner).Namespace)
+			},
+		},
+		{
+			Name:          "Show Command without operation id",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		dequeuedAt := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+		operation := v1.DeadLetteredOperation{
+			Name: testOperationID,
+			Properties: v1.DeadLetteredOperationProperties{
+				Reason:       "MaxRetryCountExceeded",
+				LastError:    "exceeded max retry count",
+				DequeueCount: 2,
+				History: []v1.DeadLetteredOperationAttempt{
+					{DequeueCount: 1, DequeuedAt: dequeuedAt, Error: "timeout"},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetDeadLetteredOperation(gomock.Any(), "Applications.Core", testOperationID).
+			Return(operation, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Namespace:         "Applications.Core",
+			OperationID:       testOperationID,
+			Format:            "table",
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     operation,
+				Options: objectformats.GetDeadLetteredOperationTableFormat(),
+			},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Last error: %s", Params: []any{"exceeded max retry count"}},
+			output.LogOutput{Format: "  attempt %d at %s: %s", Params: []any{1, "2023-10-01T00:00:00Z", "timeout"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetDeadLetteredOperation(gomock.Any(), "Applications.Core", testOperationID).
+			Return(v1.DeadLetteredOperation{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Namespace:         "Applications.Core",
+			OperationID:       testOperationID,
+			Format:            "table",
+			Output:            &output.MockOutput{},
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The operation %q was not found in the dead-letter queue of %q.", testOperationID, "Applications.Core"), err)
+	})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -98,6 +99,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# preview the infrastructure changes of the recipes of the template without deploying it
+rad deploy myapp.bicep --what-if
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -108,6 +112,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("what-if", false, "Preview the infrastructure changes the recipes of the portable resources in the template would make, without deploying the template")
 
 	return cmd, runner
 }
@@ -126,6 +131,7 @@ type Runner struct {
 	Parameters      map[string]map[string]any
 	Workspace       *workspaces.Workspace
 	Providers       *clients.Providers
+	WhatIf          bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -232,6 +238,14 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// The validation is shared with `rad run`, which does not define the --what-if flag.
+	if cmd.Flags().Lookup("what-if") != nil {
+		r.WhatIf, err = cmd.Flags().GetBool("what-if")
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -246,6 +260,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.WhatIf {
+		return r.runWhatIf(ctx, template)
+	}
+
 	// Create application if specified. This supports the case where the application resource
 	// is not specified in Bicep. Creating the application automatically helps us "bootstrap" in a new environment.
 	if r.ApplicationName != "" {
@@ -303,3 +321,35 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// runWhatIf previews the infrastructure changes the recipes of the portable resources of the template would make,
+// without deploying the template or creating the application.
+func (r *Runner) runWhatIf(ctx context.Context, template map[string]any) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Previewing the changes of template '%v' in environment '%v' from workspace '%v'...", r.FilePath, r.EnvironmentName, r.Workspace.Name)
+
+	result, err := deploy.WhatIf(ctx, client, deploy.WhatIfOptions{
+		Template:   template,
+		Parameters: r.Parameters,
+		Scope:      r.Workspace.Scope,
+		Providers:  r.Providers,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, skipped := range result.Skipped {
+		r.Output.LogInfo("Skipping resource %q of type %q: %s", skipped.Resource, skipped.Type, skipped.Reason)
+	}
+
+	if len(result.Changes) == 0 {
+		r.Output.LogInfo("No infrastructure changes.")
+		return nil
+	}
+
+	return r.Output.WriteFormatted(output.FormatTable, result.Changes, objectformats.GetWhatIfTableFormat())
+}
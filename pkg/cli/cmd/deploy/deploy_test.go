@@ -24,10 +24,12 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/config"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -191,6 +193,21 @@ func Test_Validate(t *testing.T) {
 				Config:         radcli.LoadEmptyConfig(t),
 			},
 		},
+		{
+			Name:          "rad deploy - valid with what-if",
+			Input:         []string{"app.bicep", "--what-if"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvDetails(gomock.Any(), radcli.TestEnvironmentName).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
 		{
 			Name:          "rad deploy - missing env and app succeeds",
 			Input:         []string{"app.bicep", "--group", "new-group"},
@@ -491,4 +508,99 @@ func Test_Run(t *testing.T) {
 		// is always empty.
 		require.Empty(t, outputSink.Writes)
 	})
+
+	t.Run("What-if deployment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		template := map[string]any{
+			"resources": map[string]any{
+				"redis": map[string]any{
+					"import": "radius",
+					"type":   "Applications.Datastores/redisCaches@2023-10-01-preview",
+					"properties": map[string]any{
+						"name": "redis",
+						"properties": map[string]any{
+							"environment": "[parameters('environment')]",
+						},
+					},
+				},
+			},
+		}
+
+		bicep := bicep.NewMockInterface(ctrl)
+		bicep.EXPECT().
+			PrepareTemplate("app.bicep").
+			Return(template, nil).
+			Times(1)
+
+		plan := generated.RecipePlan{
+			Resources: []*generated.PlannedResource{
+				{
+					ID:     to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"),
+					Action: to.Ptr(generated.PlanActionCreate),
+				},
+			},
+		}
+
+		appManagmentMock := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagmentMock.EXPECT().
+			PlanResource(gomock.Any(), "Applications.Datastores/redisCaches", "redis", generated.GenericResource{
+				Properties: map[string]any{"environment": "test-environment-id"},
+			}).
+			Return(plan, nil).
+			Times(1)
+
+		// The template must not be deployed and the application must not be created.
+		deployMock := deploy.NewMockInterface(ctrl)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			Bicep:             bicep,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagmentMock},
+			Deploy:            deployMock,
+			Output:            outputSink,
+			FilePath:          "app.bicep",
+			ApplicationName:   "test-application",
+			EnvironmentName:   radcli.TestEnvironmentName,
+			Parameters:        map[string]map[string]any{"environment": {"value": "test-environment-id"}},
+			Workspace:         workspace,
+			Providers: &clients.Providers{
+				Radius: &clients.RadiusProvider{},
+			},
+			WhatIf: true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Previewing the changes of template '%v' in environment '%v' from workspace '%v'...",
+				Params: []any{"app.bicep", radcli.TestEnvironmentName, "kind-kind"},
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []deploy.WhatIfChange{
+					{
+						Resource: "redis",
+						Type:     "Applications.Datastores/redisCaches",
+						Action:   "Create",
+						ID:       "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+					},
+				},
+				Options: objectformats.GetWhatIfTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad deployment cancel` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "cancel operationId",
+		Short: "Cancel a deployment",
+		Long: `Cancel a deployment
+
+The asynchronous operation of the deployment is canceled. An operation which has not started yet is canceled immediately. An operation which is running, for example a recipe deployment, is stopped by the resource provider and moved to the Canceled state shortly after.
+Operations which have already completed can not be canceled.`,
+		Example: `
+# Cancel a deployment of Applications.Core
+rad deployment cancel 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11
+
+# Cancel a deployment of Applications.Datastores
+rad deployment cancel 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11 --provider Applications.Datastores`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceProviderFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad deployment cancel` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Namespace         string
+	OperationID       string
+}
+
+// NewRunner creates a new instance of the `rad deployment cancel` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad deployment cancel` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := cmd.Flags().GetString(commonflags.ResourceProviderFlag)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+	r.Namespace = namespace
+	r.OperationID = args[0]
+
+	return nil
+}
+
+// Run runs the `rad deployment cancel` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	status, err := client.CancelOperation(ctx, r.Namespace, r.OperationID)
+	if clientv2.Is404Error(err) {
+		return clierrors.Message("The operation %q of %q was not found.", r.OperationID, r.Namespace)
+	} else if respErr, ok := clientv2.ExtractResponseError(err); ok && respErr.StatusCode == http.StatusConflict {
+		return clierrors.Message("The operation %q has already completed and can not be canceled.", r.OperationID)
+	} else if err != nil {
+		return err
+	}
+
+	if status.Status == v1.ProvisioningStateCanceled {
+		r.Output.LogInfo("Operation %q canceled", r.OperationID)
+	} else {
+		r.Output.LogInfo("Cancellation of operation %q requested", r.OperationID)
+	}
+
+	return nil
+}
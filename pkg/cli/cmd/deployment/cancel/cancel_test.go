@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cancel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const testOperationID = "00000000-0000-0000-0000-000000000001"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Cancel Command with operation id",
+			Input:         []string{testOperationID},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, testOperationID, runner.(*Runner).OperationID)
+				require.Equal(t, "Applications.Core", runner.(*Runner).Namespace)
+			},
+		},
+		{
+			Name:          "Cancel Command with provider",
+			Input:         []string{testOperationID, "--provider", "Applications.Datastores"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "Applications.Datastores", runner.(*Runner).Namespace)
+			},
+		},
+		{
+			Name:          "Cancel Command without operation id",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newRunner := func(client clients.ApplicationsManagementClient, outputSink *output.MockOutput) *Runner {
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Workspace:         &workspaces.Workspace{},
+			Namespace:         "Applications.Core",
+			OperationID:       testOperationID,
+			Output:            outputSink,
+		}
+	}
+
+	t.Run("Cancel running operation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CancelOperation(gomock.Any(), "Applications.Core", testOperationID).
+			Return(v1.AsyncOperationStatus{Status: v1.ProvisioningStateUpdating}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Cancellation of operation %q requested",
+				Params: []any{testOperationID},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Cancel queued operation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CancelOperation(gomock.Any(), "Applications.Core", testOperationID).
+			Return(v1.AsyncOperationStatus{Status: v1.ProvisioningStateCanceled}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		err := newRunner(appManagementClient, outputSink).Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Operation %q canceled",
+				Params: []any{testOperationID},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Operation not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CancelOperation(gomock.Any(), "Applications.Core", testOperationID).
+			Return(v1.AsyncOperationStatus{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}).
+			Times(1)
+
+		err := newRunner(appManagementClient, &output.MockOutput{}).Run(context.Background())
+		require.Equal(t, clierrors.Message("The operation %q of %q was not found.", testOperationID, "Applications.Core"), err)
+	})
+
+	t.Run("Operation completed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CancelOperation(gomock.Any(), "Applications.Core", testOperationID).
+			Return(v1.AsyncOperationStatus{}, &azcore.ResponseError{StatusCode: http.StatusConflict}).
+			Times(1)
+
+		err := newRunner(appManagementClient, &output.MockOutput{}).Run(context.Background())
+		require.Equal(t, clierrors.Message("The operation %q has already completed and can not be canceled.", testOperationID), err)
+	})
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	deployment_cancel "github.com/radius-project/radius/pkg/cli/cmd/deployment/cancel"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing the deployments of Radius resources, with a subcommand for
+// canceling a deployment that is in progress.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "deployment",
+		Short: "Manage deployments",
+		Long: `Manage deployments
+
+Radius resources are deployed by asynchronous operations. Each operation is identified by the operation id of the Azure-AsyncOperation header returned when the deployment was requested.
+`,
+		Example: `
+# Cancel a deployment of Applications.Core
+rad deployment cancel 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11
+`,
+	}
+
+	cancel, _ := deployment_cancel.NewCommand(factory)
+	cmd.AddCommand(cancel)
+
+	return cmd
+}
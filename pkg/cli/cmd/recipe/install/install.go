@@ -0,0 +1,263 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipecatalog"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new Cobra command and a Runner object to install a recipe of a recipe catalog to an environment.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "install [catalog-recipe-name]",
+		Short: "Install a recipe of a recipe catalog to an environment.",
+		Long: `Install a recipe of a recipe catalog to an environment.
+The recipe is registered to the environment with the template of the requested version, the newest version by default.
+Parameters are validated against the parameter schema published in the catalog, and against the parameters declared by
+the recipe template once the recipe is registered. The recipe is unregistered if the parameters do not match the template.
+
+You can specify parameters using the '--parameters' flag ('-p' for short). Parameters can be passed as:
+
+- A file containing a single value in JSON format
+- A key-value-pair passed in the command line`,
+		Example: `
+# Install the newest version of a recipe of a catalog published to an OCI registry
+rad recipe install redis-azure --catalog ghcr.io/myorg/recipes/catalog:latest
+
+# Install a version of a recipe of a catalog stored in a git repository, under another recipe name and with parameters
+rad recipe install redis-azure --catalog git::https://github.com/myorg/recipes.git --version 1.0.0 --recipe-name default -p sku=Standard -e prod`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddRecipeCatalogFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().String("version", "", "specify the version of the recipe to install, defaults to the newest version published in the catalog.")
+	cmd.Flags().String("recipe-name", "", "specify the name of the recipe in the environment, defaults to the name of the recipe in the catalog.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe install` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	NewSource         func(location string) (recipecatalog.Source, error)
+	Catalog           string
+	CatalogRecipeName string
+	Version           string
+	RecipeName        string
+	Parameters        map[string]map[string]any
+}
+
+// NewRunner creates a new instance of the `rad recipe install` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		NewSource:         recipecatalog.NewSource,
+	}
+}
+
+// Validate runs validation for the `rad recipe install` command.
+//
+
+// Validate validates the command line args, sets the workspace, environment, catalog, recipe names, version and
+// parameters, and returns an error if any of these fail.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	catalog, err := cmd.Flags().GetString(commonflags.RecipeCatalogFlag)
+	if err != nil {
+		return err
+	}
+	r.Catalog = catalog
+
+	catalogRecipeName, err := cli.RequireRecipeNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.CatalogRecipeName = catalogRecipeName
+
+	r.Version, err = cmd.Flags().GetString("version")
+	if err != nil {
+		return err
+	}
+
+	r.RecipeName, err = cmd.Flags().GetString("recipe-name")
+	if err != nil {
+		return err
+	}
+	if r.RecipeName == "" {
+		r.RecipeName = catalogRecipeName
+	}
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run reads the recipe from the catalog, validates the parameters against the parameter schema of the catalog, registers
+// the recipe to the environment and validates the parameters against the parameters declared by the recipe template. The
+// previous registration of the recipe is restored if the parameters do not match the template.
+func (r *Runner) Run(ctx context.Context) error {
+	source, err := r.NewSource(r.Catalog)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Invalid recipe catalog %q.", r.Catalog)
+	}
+
+	index, err := source.ReadIndex(ctx)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to read the recipe catalog %q.", r.Catalog)
+	}
+
+	entry := index.Find(r.CatalogRecipeName)
+	if entry == nil {
+		return clierrors.Message("The recipe %q was not found in the recipe catalog %q. Use `rad recipe search` to list the recipes of the catalog.", r.CatalogRecipeName, r.Catalog)
+	}
+
+	version, err := entry.FindVersion(r.Version)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to install the recipe %q.", entry.Name)
+	}
+
+	parameters, err := recipecatalog.ValidateParameters(entry, bicep.ConvertToMapStringInterface(r.Parameters))
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Invalid parameters for the recipe %q.", entry.Name)
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	envResource, err := client.GetEnvDetails(ctx, r.Workspace.Environment)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q does not exist. Run `rad env create` first.", r.Workspace.Environment)
+	} else if err != nil {
+		return err
+	}
+
+	envRecipes := envResource.Properties.Recipes
+	if envRecipes == nil {
+		envRecipes = map[string]map[string]corerp.RecipePropertiesClassification{}
+	}
+	if envRecipes[entry.ResourceType] == nil {
+		envRecipes[entry.ResourceType] = map[string]corerp.RecipePropertiesClassification{}
+	}
+	previous, registered := envRecipes[entry.ResourceType][r.RecipeName]
+
+	var properties corerp.RecipePropertiesClassification
+	switch entry.TemplateKind {
+	case recipes.TemplateKindTerraform:
+		properties = &corerp.TerraformRecipeProperties{
+			TemplateKind:    to.Ptr(entry.TemplateKind),
+			TemplatePath:    to.Ptr(version.TemplatePath),
+			TemplateVersion: to.Ptr(version.Version),
+			Parameters:      parameters,
+		}
+	case recipes.TemplateKindBicep:
+		properties = &corerp.BicepRecipeProperties{
+			TemplateKind: to.Ptr(entry.TemplateKind),
+			TemplatePath: to.Ptr(version.TemplatePath),
+			Parameters:   parameters,
+		}
+	case recipes.TemplateKindHelm:
+		properties = &corerp.HelmRecipeProperties{
+			TemplateKind:    to.Ptr(entry.TemplateKind),
+			TemplatePath:    to.Ptr(version.TemplatePath),
+			TemplateVersion: to.Ptr(version.Version),
+			Parameters:      parameters,
+		}
+	}
+	envRecipes[entry.ResourceType][r.RecipeName] = properties
+	envResource.Properties.Recipes = envRecipes
+
+	r.Output.LogInfo("Installing version %q of recipe %q to environment %q...", version.Version, entry.Name, r.Workspace.Environment)
+	err = client.CreateEnvironment(ctx, r.Workspace.Environment, v1.LocationGlobal, envResource.Properties)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to register the recipe %q to the environment %q.", r.RecipeName, r.Workspace.Environment)
+	}
+
+	metadata, err := client.ShowRecipe(ctx, r.Workspace.Environment, corerp.RecipeGetMetadata{Name: &r.RecipeName, ResourceType: &entry.ResourceType})
+	if err == nil {
+		err = recipecatalog.ValidateTemplateParameters(parameters, metadata.Parameters)
+	}
+	if err != nil {
+		// Restore the previous registration of the recipe so that a recipe with invalid parameters is never left registered.
+		if registered {
+			envRecipes[entry.ResourceType][r.RecipeName] = previous
+		} else {
+			delete(envRecipes[entry.ResourceType], r.RecipeName)
+			if len(envRecipes[entry.ResourceType]) == 0 {
+				delete(envRecipes, entry.ResourceType)
+			}
+		}
+
+		if restoreErr := client.CreateEnvironment(ctx, r.Workspace.Environment, v1.LocationGlobal, envResource.Properties); restoreErr != nil {
+			return clierrors.MessageWithCause(restoreErr, "Failed to validate the recipe %q and to restore the previous recipes of the environment %q: %s", r.RecipeName, r.Workspace.Environment, err.Error())
+		}
+
+		return clierrors.MessageWithCause(err, "Failed to validate the parameters of the recipe %q against its template. The recipe was not installed.", r.RecipeName)
+	}
+
+	r.Output.LogInfo("Successfully installed recipe %q to environment %q as %q", entry.Name, r.Workspace.Environment, r.RecipeName)
+	return nil
+}
@@ -0,0 +1,384 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipecatalog"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCatalog      = "ghcr.io/myorg/recipes/catalog:latest"
+	testResourceType = "Applications.Datastores/redisCaches"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Install Command with defaults",
+			Input:         []string{"redis-azure", "--catalog", testCatalog},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, testCatalog, r.Catalog)
+				require.Equal(t, "redis-azure", r.CatalogRecipeName)
+				require.Equal(t, "redis-azure", r.RecipeName)
+				require.Empty(t, r.Version)
+			},
+		},
+		{
+			Name:          "Install Command with version, recipe name and parameters",
+			Input:         []string{"redis-azure", "--catalog", testCatalog, "--version", "1.0.0", "--recipe-name", "default", "-p", "sku=Basic"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "1.0.0", r.Version)
+				require.Equal(t, "default", r.RecipeName)
+				require.Equal(t, map[string]map[string]any{"sku": {"value": "Basic"}}, r.Parameters)
+			},
+		},
+		{
+			Name:          "Install Command without catalog",
+			Input:         []string{"redis-azure"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Install Command without recipe name",
+			Input:         []string{"--catalog", testCatalog},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Install Command with fallback workspace",
+			Input:         []string{"redis-azure", "--catalog", testCatalog},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func testIndex() *recipecatalog.Index {
+	return &recipecatalog.Index{
+		Recipes: []recipecatalog.Entry{
+			{
+				Name:         "redis-azure",
+				ResourceType: testResourceType,
+				TemplateKind: "bicep",
+				Parameters: map[string]recipecatalog.ParameterSchema{
+					"sku":      {Type: recipecatalog.ParameterTypeString},
+					"capacity": {Type: recipecatalog.ParameterTypeInt},
+				},
+				Versions: []recipecatalog.Version{
+					{Version: "1.1.0", TemplatePath: "ghcr.io/myorg/recipes/azure/rediscaches:1.1.0"},
+					{Version: "1.0.0", TemplatePath: "ghcr.io/myorg/recipes/azure/rediscaches:1.0.0"},
+				},
+			},
+			{
+				Name:         "redis-kubernetes",
+				ResourceType: testResourceType,
+				TemplateKind: "terraform",
+				Versions:     []recipecatalog.Version{{Version: "2.0.0", TemplatePath: "myorg/redis/kubernetes"}},
+			},
+		},
+	}
+}
+
+func testSource(t *testing.T) func(string) (recipecatalog.Source, error) {
+	ctrl := gomock.NewController(t)
+	source := recipecatalog.NewMockSource(ctrl)
+	source.EXPECT().
+		ReadIndex(gomock.Any()).
+		Return(testIndex(), nil).
+		Times(1)
+
+	return func(location string) (recipecatalog.Source, error) {
+		require.Equal(t, testCatalog, location)
+		return source, nil
+	}
+}
+
+func testEnvironment() corerp.EnvironmentResource {
+	return corerp.EnvironmentResource{
+		ID: to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+		Properties: &corerp.EnvironmentProperties{
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				testResourceType: {
+					"default": &corerp.BicepRecipeProperties{
+						TemplateKind: to.Ptr("bicep"),
+						TemplatePath: to.Ptr("ghcr.io/myorg/recipes/azure/rediscaches:0.9.0"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Install bicep recipe", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(testEnvironment(), nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateEnvironment(gomock.Any(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, location string, properties *corerp.EnvironmentProperties) error {
+				require.Equal(t, &corerp.BicepRecipeProperties{
+					TemplateKind: to.Ptr("bicep"),
+					TemplatePath: to.Ptr("ghcr.io/myorg/recipes/azure/rediscaches:1.0.0"),
+					Parameters:   map[string]any{"sku": "Basic", "capacity": 2},
+				}, properties.Recipes[testResourceType]["default"])
+				return nil
+			}).
+			Times(1)
+		appManagementClient.EXPECT().
+			ShowRecipe(gomock.Any(), "kind-kind", corerp.RecipeGetMetadata{Name: to.Ptr("default"), ResourceType: to.Ptr(testResourceType)}).
+			Return(corerp.RecipeGetMetadataResponse{
+				Parameters: map[string]any{
+					"sku":      map[string]any{"type": "string"},
+					"capacity": map[string]any{"type": "int", "defaultValue": 1},
+				},
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			NewSource:         testSource(t),
+			Catalog:           testCatalog,
+			CatalogRecipeName: "redis-azure",
+			Version:           "1.0.0",
+			RecipeName:        "default",
+			Parameters: map[string]map[string]any{
+				"sku":      {"value": "Basic"},
+				"capacity": {"value": "2"},
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Installing version %q of recipe %q to environment %q...",
+				Params: []any{"1.0.0", "redis-azure", "kind-kind"},
+			},
+			output.LogOutput{
+				Format: "Successfully installed recipe %q to environment %q as %q",
+				Params: []any{"redis-azure", "kind-kind", "default"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Install terraform recipe", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(corerp.EnvironmentResource{Properties: &corerp.EnvironmentProperties{}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateEnvironment(gomock.Any(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, name string, location string, properties *corerp.EnvironmentProperties) error {
+				require.Equal(t, &corerp.TerraformRecipeProperties{
+					TemplateKind:    to.Ptr("terraform"),
+					TemplatePath:    to.Ptr("myorg/redis/kubernetes"),
+					TemplateVersion: to.Ptr("2.0.0"),
+					Parameters:      map[string]any{},
+				}, properties.Recipes[testResourceType]["redis-kubernetes"])
+				return nil
+			}).
+			Times(1)
+		appManagementClient.EXPECT().
+			ShowRecipe(gomock.Any(), "kind-kind", gomock.Any()).
+			Return(corerp.RecipeGetMetadataResponse{Parameters: map[string]any{}}, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			NewSource:         testSource(t),
+			Catalog:           testCatalog,
+			CatalogRecipeName: "redis-kubernetes",
+			RecipeName:        "redis-kubernetes",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Parameters do not match the template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(testEnvironment(), nil).
+			Times(1)
+		gomock.InOrder(
+			appManagementClient.EXPECT().
+				CreateEnvironment(gomock.Any(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+				Return(nil),
+			appManagementClient.EXPECT().
+				ShowRecipe(gomock.Any(), "kind-kind", gomock.Any()).
+				Return(corerp.RecipeGetMetadataResponse{Parameters: map[string]any{"sku": map[string]any{"type": "string"}}}, nil),
+			appManagementClient.EXPECT().
+				CreateEnvironment(gomock.Any(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, location string, properties *corerp.EnvironmentProperties) error {
+					// The previous registration of the recipe is restored.
+					require.Equal(t, testEnvironment().Properties.Recipes, properties.Recipes)
+					return nil
+				}),
+		)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			NewSource:         testSource(t),
+			Catalog:           testCatalog,
+			CatalogRecipeName: "redis-azure",
+			RecipeName:        "default",
+			Parameters:        map[string]map[string]any{"capacity": {"value": "2"}},
+		}
+
+		err := runner.Run(context.Background())
+		require.ErrorContains(t, err, `Failed to validate the parameters of the recipe "default" against its template. The recipe was not installed.`)
+	})
+
+	t.Run("New recipe is removed when the parameters do not match the template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(corerp.EnvironmentResource{Properties: &corerp.EnvironmentProperties{}}, nil).
+			Times(1)
+		gomock.InOrder(
+			appManagementClient.EXPECT().
+				CreateEnvironment(gomock.Any(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+				Return(nil),
+			appManagementClient.EXPECT().
+				ShowRecipe(gomock.Any(), "kind-kind", gomock.Any()).
+				Return(corerp.RecipeGetMetadataResponse{Parameters: map[string]any{}}, nil),
+			appManagementClient.EXPECT().
+				CreateEnvironment(gomock.Any(), "kind-kind", v1.LocationGlobal, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, location string, properties *corerp.EnvironmentProperties) error {
+					require.Empty(t, properties.Recipes)
+					return nil
+				}),
+		)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			NewSource:         testSource(t),
+			Catalog:           testCatalog,
+			CatalogRecipeName: "redis-azure",
+			RecipeName:        "redis-azure",
+			Parameters:        map[string]map[string]any{"sku": {"value": "Basic"}},
+		}
+
+		err := runner.Run(context.Background())
+		require.ErrorContains(t, err, `parameter "sku" is not declared by the recipe template`)
+	})
+
+	t.Run("Parameters do not match the catalog", func(t *testing.T) {
+		runner := &Runner{
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			NewSource:         testSource(t),
+			Catalog:           testCatalog,
+			CatalogRecipeName: "redis-azure",
+			RecipeName:        "redis-azure",
+			Parameters:        map[string]map[string]any{"tls": {"value": "true"}},
+		}
+
+		err := runner.Run(context.Background())
+		require.ErrorContains(t, err, `Invalid parameters for the recipe "redis-azure".`)
+	})
+
+	t.Run("Recipe not found in the catalog", func(t *testing.T) {
+		runner := &Runner{
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			NewSource:         testSource(t),
+			Catalog:           testCatalog,
+			CatalogRecipeName: "sql",
+			RecipeName:        "sql",
+		}
+
+		err := runner.Run(context.Background())
+		require.ErrorContains(t, err, `The recipe "sql" was not found in the recipe catalog`)
+	})
+
+	t.Run("Version not found in the catalog", func(t *testing.T) {
+		runner := &Runner{
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			NewSource:         testSource(t),
+			Catalog:           testCatalog,
+			CatalogRecipeName: "redis-azure",
+			Version:           "3.0.0",
+			RecipeName:        "redis-azure",
+		}
+
+		err := runner.Run(context.Background())
+		require.ErrorContains(t, err, `Failed to install the recipe "redis-azure".`)
+	})
+}
@@ -97,6 +97,13 @@ func Test_Run(t *testing.T) {
 							TemplateVersion: to.Ptr("1.1.0"),
 						},
 					},
+					ds_ctrl.RedisCachesResourceType: {
+						"redis-helm": &v20231001preview.HelmRecipeProperties{
+							TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+							TemplatePath:    to.Ptr("oci://ghcr.io/myorg/charts/redis"),
+							TemplateVersion: to.Ptr("1.2.0"),
+						},
+					},
 				},
 			},
 		}
@@ -114,6 +121,13 @@ func Test_Run(t *testing.T) {
 				TemplatePath:    "Azure/cosmosdb/azurerm",
 				TemplateVersion: "1.1.0",
 			},
+			{
+				Name:            "redis-helm",
+				ResourceType:    ds_ctrl.RedisCachesResourceType,
+				TemplateKind:    recipes.TemplateKindHelm,
+				TemplatePath:    "oci://ghcr.io/myorg/charts/redis",
+				TemplateVersion: "1.2.0",
+			},
 		}
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
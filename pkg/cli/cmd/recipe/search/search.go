@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipecatalog"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new Cobra command and a Runner object to search the recipes published in a recipe catalog.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "search [query]",
+		Short: "Search the recipes of a recipe catalog.",
+		Long: `Search the recipes of a recipe catalog.
+The catalog index is read from an OCI registry, or from a git repository when the location is prefixed with 'git::'.
+Recipes whose name, resource type or description contains the query are listed. All the recipes are listed if no query is specified.`,
+		Example: `
+# List the recipes of a catalog published to an OCI registry
+rad recipe search --catalog ghcr.io/myorg/recipes/catalog:latest
+
+# Search the recipes for Redis caches of a catalog stored in a git repository
+rad recipe search redis --catalog git::https://github.com/myorg/recipes.git//catalog/index.yaml?ref=main --resource-type Applications.Datastores/redisCaches`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddRecipeCatalogFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe search` command.
+type Runner struct {
+	ConfigHolder *framework.ConfigHolder
+	Output       output.Interface
+	NewSource    func(location string) (recipecatalog.Source, error)
+	Catalog      string
+	Query        string
+	ResourceType string
+	Format       string
+}
+
+// NewRunner creates a new instance of the `rad recipe search` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+		NewSource:    recipecatalog.NewSource,
+	}
+}
+
+// Validate runs validation for the `rad recipe search` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	catalog, err := cmd.Flags().GetString(commonflags.RecipeCatalogFlag)
+	if err != nil {
+		return err
+	}
+	r.Catalog = catalog
+
+	if len(args) > 0 {
+		r.Query = args[0]
+	}
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe search` command.
+//
+
+// Run reads the index of the recipe catalog and writes the recipes matching the query and resource type to the output in
+// the specified format. It returns an error if the catalog index can not be read.
+func (r *Runner) Run(ctx context.Context) error {
+	source, err := r.NewSource(r.Catalog)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Invalid recipe catalog %q.", r.Catalog)
+	}
+
+	index, err := source.ReadIndex(ctx)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to read the recipe catalog %q.", r.Catalog)
+	}
+
+	entries := index.Search(r.Query, r.ResourceType)
+	if len(entries) == 0 && r.Format == output.FormatTable {
+		r.Output.LogInfo("No recipes matching %q were found in the recipe catalog %q.", r.Query, r.Catalog)
+		return nil
+	}
+
+	return r.Output.WriteFormatted(r.Format, entries, objectformats.GetRecipeCatalogTableFormat())
+}
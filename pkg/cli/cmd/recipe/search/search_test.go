@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipecatalog"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Search Command with catalog",
+			Input:         []string{"--catalog", "ghcr.io/myorg/recipes/catalog:latest"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "ghcr.io/myorg/recipes/catalog:latest", r.Catalog)
+				require.Empty(t, r.Query)
+				require.Equal(t, "table", r.Format)
+			},
+		},
+		{
+			Name:          "Search Command with query and resource type",
+			Input:         []string{"redis", "--catalog", "git::https://github.com/myorg/recipes.git", "--resource-type", "Applications.Datastores/redisCaches"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "redis", r.Query)
+				require.Equal(t, "Applications.Datastores/redisCaches", r.ResourceType)
+			},
+		},
+		{
+			Name:          "Search Command without catalog",
+			Input:         []string{"redis"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Search Command with too many args",
+			Input:         []string{"redis", "mongo", "--catalog", "ghcr.io/myorg/recipes/catalog:latest"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	index := &recipecatalog.Index{
+		Recipes: []recipecatalog.Entry{
+			{
+				Name:         "redis-kubernetes",
+				ResourceType: "Applications.Datastores/redisCaches",
+				TemplateKind: "terraform",
+				Versions:     []recipecatalog.Version{{Version: "1.0.0", TemplatePath: "myorg/redis/kubernetes"}},
+			},
+			{
+				Name:         "mongo-azure",
+				ResourceType: "Applications.Datastores/mongoDatabases",
+				TemplateKind: "bicep",
+				Versions:     []recipecatalog.Version{{Version: "1.0.0", TemplatePath: "ghcr.io/myorg/recipes/azure/mongodatabases:1.0.0"}},
+			},
+		},
+	}
+
+	newSource := func(t *testing.T, index *recipecatalog.Index, err error) func(string) (recipecatalog.Source, error) {
+		ctrl := gomock.NewController(t)
+		source := recipecatalog.NewMockSource(ctrl)
+		source.EXPECT().
+			ReadIndex(gomock.Any()).
+			Return(index, err).
+			Times(1)
+
+		return func(location string) (recipecatalog.Source, error) {
+			require.Equal(t, "ghcr.io/myorg/recipes/catalog:latest", location)
+			return source, nil
+		}
+	}
+
+	t.Run("Search recipes", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:    outputSink,
+			NewSource: newSource(t, index, nil),
+			Catalog:   "ghcr.io/myorg/recipes/catalog:latest",
+			Query:     "redis",
+			Format:    "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     []recipecatalog.Entry{index.Recipes[0]},
+				Options: objectformats.GetRecipeCatalogTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("No matching recipes", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:    outputSink,
+			NewSource: newSource(t, index, nil),
+			Catalog:   "ghcr.io/myorg/recipes/catalog:latest",
+			Query:     "sql",
+			Format:    "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "No recipes matching %q were found in the recipe catalog %q.",
+				Params: []any{"sql", "ghcr.io/myorg/recipes/catalog:latest"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Catalog can not be read", func(t *testing.T) {
+		readErr := errors.New("unauthorized")
+		runner := &Runner{
+			Output:    &output.MockOutput{},
+			NewSource: newSource(t, nil, readErr),
+			Catalog:   "ghcr.io/myorg/recipes/catalog:latest",
+			Format:    "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.MessageWithCause(readErr, "Failed to read the recipe catalog %q.", "ghcr.io/myorg/recipes/catalog:latest"), err)
+	})
+}
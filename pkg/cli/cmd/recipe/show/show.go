@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/spf13/cobra"
 )
 
@@ -37,7 +38,7 @@ import (
 //
 
 // NewCommand creates a new cobra command that can be used to show recipe details, such as the name, resource type,
-// parameters, parameter details and template path, with the option to customize the output format.
+// parameters, parameter details, template path and expected outputs, with the option to customize the output format.
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
 
@@ -46,7 +47,7 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Short: "Show recipe details",
 		Long: `Show recipe details
 
-The recipe show command outputs details about a recipe. This includes the name, resource type, parameters, parameter details and template path.
+The recipe show command outputs details about a recipe. This includes the name, resource type, parameters, parameter details, template path and the outputs the recipe is expected to return.
 	
 By default, the command is scoped to the resource group and environment defined in your rad.yaml workspace file. You can optionally override these values through the environment and group flags.
 	
@@ -144,8 +145,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 // Run runs the `rad recipe show` command.
 //
 
-// Run retrieves the recipe details and parameters from the Applications Management service and prints them in the
-// specified format. It returns an error if one occurs.
+// Run retrieves the recipe details and parameters from the Applications Management service and prints them, along with
+// the outputs expected from recipes of the resource type, in the specified format. It returns an error if one occurs.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
 	if err != nil {
@@ -216,6 +217,24 @@ func (r *Runner) Run(ctx context.Context) error {
 		r.Output.LogInfo("No parameters available")
 	}
 
+	r.Output.LogInfo("")
+
+	// The outputs expected from the recipe depend only on the resource type, and are validated by the recipe engine
+	// after the recipe is executed.
+	recipeOutputs := recipes.GetOutputContract(r.ResourceType)
+	if recipeOutputs == nil {
+		recipeOutputs = []recipes.OutputDefinition{}
+	}
+
+	err = r.Output.WriteFormatted(r.Format, recipeOutputs, objectformats.GetRecipeOutputsTableFormat())
+	if err != nil {
+		return err
+	}
+
+	if len(recipeOutputs) == 0 {
+		r.Output.LogInfo("No expected outputs declared for resource type %s", r.ResourceType)
+	}
+
 	return nil
 }
 
@@ -165,6 +165,14 @@ func Test_Run(t *testing.T) {
 				Obj:     recipeParams,
 				Options: objectformats.GetRecipeParamsTableFormat(),
 			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     recipes.GetOutputContract(datastorerp.MongoDatabasesResourceType),
+				Options: objectformats.GetRecipeOutputsTableFormat(),
+			},
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
@@ -242,6 +250,14 @@ func Test_Run(t *testing.T) {
 				Obj:     recipeParams,
 				Options: objectformats.GetRecipeParamsTableFormat(),
 			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     recipes.GetOutputContract(datastorerp.MongoDatabasesResourceType),
+				Options: objectformats.GetRecipeOutputsTableFormat(),
+			},
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
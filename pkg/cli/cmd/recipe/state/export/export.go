@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputFileFlag = "output-file"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe state export` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "export [resourceType] [resourceName]",
+		Short: "Export the Terraform state of a recipe",
+		Long: `Export the Terraform state of a recipe
+
+Exports the Terraform state of the recipe deployed for a resource from the Terraform backend configured for the environment. The state is written to the console in JSON format, or to the file specified with the output-file flag.`,
+		Example: `
+# Export the Terraform state of the recipe deployed for a resource in the default environment
+rad recipe state export redisCaches redis
+
+# Export the Terraform state of the recipe deployed for a resource to a file
+rad recipe state export redisCaches redis --output-file redis.tfstate
+
+# Export the Terraform state of the recipe deployed for a resource in a specified environment and group
+rad recipe state export redisCaches redis --environment dev --group dev`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	cmd.Flags().String(outputFileFlag, "", "The file to write the Terraform state to")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe state export` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceID        string
+	OutputFile        string
+}
+
+// NewRunner creates a new instance of the `rad recipe state export` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe state export` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+
+	id, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return err
+	}
+	r.ResourceID = id.Append(resources.TypeSegment{Type: resourceType, Name: resourceName}).String()
+
+	r.OutputFile, err = cmd.Flags().GetString(outputFileFlag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe state export` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ExportRecipeState(ctx, r.Workspace.Environment, v20231001preview.RecipeStateExport{ResourceID: &r.ResourceID})
+	if err != nil {
+		return err
+	}
+
+	if r.OutputFile == "" {
+		return r.Output.WriteFormatted(output.FormatJson, resp.State, output.FormatterOptions{})
+	}
+
+	b, err := json.MarshalIndent(resp.State, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(r.OutputFile, b, 0600); err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Terraform state of resource %q exported from the %s backend to %s", r.ResourceID, to.String(resp.Backend), r.OutputFile)
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Datastores/redisCaches/redis"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Export Command with resource type and name",
+			Input:         []string{"redisCaches", "redis"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, testResourceID, runner.(*Runner).ResourceID)
+				require.Equal(t, "", runner.(*Runner).OutputFile)
+			},
+		},
+		{
+			Name:          "Export Command with output file",
+			Input:         []string{"redisCaches", "redis", "--output-file", "redis.tfstate"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "redis.tfstate", runner.(*Runner).OutputFile)
+			},
+		},
+		{
+			Name:          "Export Command with invalid resource type",
+			Input:         []string{"invalidType", "redis"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Export Command without resource name",
+			Input:         []string{"redisCaches"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	state := map[string]any{"version": float64(4), "serial": float64(1)}
+	setup := func(t *testing.T) *clients.MockApplicationsManagementClient {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ExportRecipeState(gomock.Any(), "test-environment", v20231001preview.RecipeStateExport{ResourceID: to.Ptr(testResourceID)}).
+			Return(v20231001preview.RecipeStateResponse{Backend: to.Ptr("kubernetes"), State: state}, nil).
+			Times(1)
+		return appManagementClient
+	}
+
+	t.Run("Export state to the console", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: setup(t)},
+			Workspace:         &workspaces.Workspace{Environment: "test-environment"},
+			ResourceID:        testResourceID,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  output.FormatJson,
+				Obj:     state,
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Export state to a file", func(t *testing.T) {
+		outputFile := filepath.Join(t.TempDir(), "redis.tfstate")
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: setup(t)},
+			Workspace:         &workspaces.Workspace{Environment: "test-environment"},
+			ResourceID:        testResourceID,
+			OutputFile:        outputFile,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		b, err := os.ReadFile(outputFile)
+		require.NoError(t, err)
+		actual := map[string]any{}
+		require.NoError(t, json.Unmarshal(b, &actual))
+		require.Equal(t, state, actual)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Terraform state of resource %q exported from the %s backend to %s",
+				Params: []any{testResourceID, "kubernetes", outputFile},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
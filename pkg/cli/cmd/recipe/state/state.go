@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	state_export "github.com/radius-project/radius/pkg/cli/cmd/recipe/state/export"
+	state_unlock "github.com/radius-project/radius/pkg/cli/cmd/recipe/state/unlock"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing the Terraform state of recipes, with subcommands for exporting
+// the state and releasing the lock on the state.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Manage the Terraform state of recipes",
+		Long: `Manage the Terraform state of recipes
+
+The state of the Terraform recipe deployed for a resource is stored in the Terraform backend configured for the environment of the resource.
+The state can be exported for inspection, and a lock left behind by an interrupted deployment can be released.
+`,
+		Example: `
+# Export the Terraform state of the recipe deployed for a resource
+rad recipe state export redisCaches redis
+
+# Release the lock on the Terraform state of the recipe deployed for a resource
+rad recipe state unlock redisCaches redis --lock-id 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11
+`,
+	}
+
+	export, _ := state_export.NewCommand(factory)
+	cmd.AddCommand(export)
+
+	unlock, _ := state_unlock.NewCommand(factory)
+	cmd.AddCommand(unlock)
+
+	return cmd
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unlock
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	lockIDFlag = "lock-id"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe state unlock` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "unlock [resourceType] [resourceName]",
+		Short: "Release the lock on the Terraform state of a recipe",
+		Long: `Release the lock on the Terraform state of a recipe
+
+Releases the lock left on the Terraform state of the recipe deployed for a resource, for example by an interrupted deployment.
+The ID of the lock is required unless the state is stored in the kubernetes or local backend. The ID of the lock is reported by Terraform in the error of the deployment that failed to acquire the lock.`,
+		Example: `
+# Release the lock on the Terraform state stored in the default kubernetes backend
+rad recipe state unlock redisCaches redis
+
+# Release the lock on the Terraform state stored in an s3 backend
+rad recipe state unlock redisCaches redis --lock-id 2f1c4e4e-6a3b-4b7a-9c6e-0e1c9d1f3a11`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	cmd.Flags().String(lockIDFlag, "", "The ID of the lock to release")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe state unlock` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceID        string
+	LockID            string
+}
+
+// NewRunner creates a new instance of the `rad recipe state unlock` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe state unlock` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+
+	id, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return err
+	}
+	r.ResourceID = id.Append(resources.TypeSegment{Type: resourceType, Name: resourceName}).String()
+
+	r.LockID, err = cmd.Flags().GetString(lockIDFlag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe state unlock` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	body := v20231001preview.RecipeStateUnlock{ResourceID: &r.ResourceID}
+	if r.LockID != "" {
+		body.LockID = &r.LockID
+	}
+
+	resp, err := client.UnlockRecipeState(ctx, r.Workspace.Environment, body)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Lock on the Terraform state of resource %q released in the %s backend", r.ResourceID, to.String(resp.Backend))
+	return nil
+}
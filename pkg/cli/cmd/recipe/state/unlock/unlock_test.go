@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unlock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Datastores/redisCaches/redis"
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Unlock Command without lock ID",
+			Input:         []string{"redisCaches", "redis"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, testResourceID, runner.(*Runner).ResourceID)
+				require.Equal(t, "", runner.(*Runner).LockID)
+			},
+		},
+		{
+			Name:          "Unlock Command with lock ID",
+			Input:         []string{"redisCaches", "redis", "--lock-id", "lock-id"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "lock-id", runner.(*Runner).LockID)
+			},
+		},
+		{
+			Name:          "Unlock Command with invalid resource type",
+			Input:         []string{"invalidType", "redis"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	testcases := []struct {
+		name   string
+		lockID string
+		body   v20231001preview.RecipeStateUnlock
+	}{
+		{
+			name: "Unlock without lock ID",
+			body: v20231001preview.RecipeStateUnlock{ResourceID: to.Ptr(testResourceID)},
+		},
+		{
+			name:   "Unlock with lock ID",
+			lockID: "lock-id",
+			body:   v20231001preview.RecipeStateUnlock{ResourceID: to.Ptr(testResourceID), LockID: to.Ptr("lock-id")},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				UnlockRecipeState(gomock.Any(), "test-environment", tc.body).
+				Return(v20231001preview.RecipeStateResponse{Backend: to.Ptr("s3")}, nil).
+				Times(1)
+
+			outputSink := &output.MockOutput{}
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				Workspace:         &workspaces.Workspace{Environment: "test-environment"},
+				ResourceID:        testResourceID,
+				LockID:            tc.lockID,
+				Output:            outputSink,
+			}
+
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+
+			expected := []any{
+				output.LogOutput{
+					Format: "Lock on the Terraform state of resource %q released in the %s backend",
+					Params: []any{testResourceID, "s3"},
+				},
+			}
+			require.Equal(t, expected, outputSink.Writes)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	batchSizeFlag = "batch-size"
+
+	// defaultPollInterval is the interval at which the progress of the upgrade is polled.
+	defaultPollInterval = 5 * time.Second
+)
+
+// NewCommand creates an instance of the command and runner for the `rad recipe upgrade` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the recipes of the resources of an environment",
+		Long: `Upgrade the recipes of the resources of an environment
+
+Re-deploys the recipes of all the resources of the environment which were deployed with a different recipe template or version than the one currently registered in the environment.
+Resources are upgraded in batches. The upgrade is halted after the first batch in which a recipe fails to deploy, and the resources which failed are reported.`,
+		Example: `
+# Upgrade the recipes of the resources of the current environment
+rad recipe upgrade
+
+# Upgrade the recipes of the resources of a specific environment, 10 resources at a time
+rad recipe upgrade --environment my-env --batch-size 10`,
+		Args: cobra.NoArgs,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	cmd.Flags().Int32(batchSizeFlag, 0, "The number of resources to upgrade at a time. Defaults to 5")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe upgrade` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	BatchSize         int32
+
+	// PollInterval is the interval at which the progress of the upgrade is polled.
+	PollInterval time.Duration
+}
+
+// NewRunner creates a new instance of the `rad recipe upgrade` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		PollInterval:      defaultPollInterval,
+	}
+}
+
+// Validate runs validation for the `rad recipe upgrade` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	r.BatchSize, err = cmd.Flags().GetInt32(batchSizeFlag)
+	if err != nil {
+		return err
+	}
+	if r.BatchSize < 0 {
+		return clierrors.Message("The batch size must be greater than 0, got %d.", r.BatchSize)
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe upgrade` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	body := v20231001preview.RecipeUpgrade{}
+	if r.BatchSize > 0 {
+		body.BatchSize = &r.BatchSize
+	}
+
+	err = client.StartRecipeUpgrade(ctx, r.Workspace.Environment, body)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Upgrading the recipes of the resources of environment %q...", r.Workspace.Environment)
+
+	reported := int32(-1)
+	for {
+		env, err := client.GetEnvDetails(ctx, r.Workspace.Environment)
+		if err != nil {
+			return err
+		}
+
+		status := &v20231001preview.RecipeUpgradeStatus{}
+		if env.Properties != nil && env.Properties.RecipeUpgrade != nil {
+			status = env.Properties.RecipeUpgrade
+		}
+
+		// The total is unknown until the resources to upgrade are found.
+		if status.Total != nil && to.Int32(status.Upgraded) != reported {
+			reported = to.Int32(status.Upgraded)
+			r.Output.LogInfo("Upgraded %d of %d resource(s)", reported, *status.Total)
+		}
+
+		if status.State != nil && *status.State == v20231001preview.RecipeUpgradeStateSucceeded {
+			r.Output.LogInfo("Recipe upgrade of environment %q completed", r.Workspace.Environment)
+			return nil
+		}
+
+		if status.State != nil && *status.State == v20231001preview.RecipeUpgradeStateFailed {
+			if len(status.FailedResources) > 0 {
+				r.Output.LogInfo("The recipes of the following resources failed to upgrade:")
+				for _, id := range status.FailedResources {
+					r.Output.LogInfo("  %s", to.String(id))
+				}
+			}
+			return clierrors.Message("Recipe upgrade of environment %q failed. %s", r.Workspace.Environment, to.String(status.Message))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Upgrade Command with default batch size",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, int32(0), runner.(*Runner).BatchSize)
+			},
+		},
+		{
+			Name:          "Upgrade Command with batch size",
+			Input:         []string{"--batch-size", "10"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, int32(10), runner.(*Runner).BatchSize)
+			},
+		},
+		{
+			Name:          "Upgrade Command with negative batch size",
+			Input:         []string{"--batch-size", "-1"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Upgrade Command with too many args",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func testEnvironment(status *v20231001preview.RecipeUpgradeStatus) v20231001preview.EnvironmentResource {
+	return v20231001preview.EnvironmentResource{
+		Properties: &v20231001preview.EnvironmentProperties{RecipeUpgrade: status},
+	}
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Upgrade succeeds", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			StartRecipeUpgrade(gomock.Any(), "test-environment", v20231001preview.RecipeUpgrade{BatchSize: to.Ptr(int32(2))}).
+			Return(nil).
+			Times(1)
+		gomock.InOrder(
+			appManagementClient.EXPECT().
+				GetEnvDetails(gomock.Any(), "test-environment").
+				Return(testEnvironment(&v20231001preview.RecipeUpgradeStatus{State: to.Ptr(v20231001preview.RecipeUpgradeStateInProgress)}), nil),
+			appManagementClient.EXPECT().
+				GetEnvDetails(gomock.Any(), "test-environment").
+				Return(testEnvironment(&v20231001preview.RecipeUpgradeStatus{
+					State:    to.Ptr(v20231001preview.RecipeUpgradeStateInProgress),
+					Total:    to.Ptr(int32(3)),
+					Upgraded: to.Ptr(int32(2)),
+				}), nil),
+			appManagementClient.EXPECT().
+				GetEnvDetails(gomock.Any(), "test-environment").
+				Return(testEnvironment(&v20231001preview.RecipeUpgradeStatus{
+					State:    to.Ptr(v20231001preview.RecipeUpgradeStateSucceeded),
+					Total:    to.Ptr(int32(3)),
+					Upgraded: to.Ptr(int32(3)),
+				}), nil),
+		)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Environment: "test-environment"},
+			BatchSize:         2,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Upgrading the recipes of the resources of environment %q...",
+				Params: []any{"test-environment"},
+			},
+			output.LogOutput{
+				Format: "Upgraded %d of %d resource(s)",
+				Params: []any{int32(2), int32(3)},
+			},
+			output.LogOutput{
+				Format: "Upgraded %d of %d resource(s)",
+				Params: []any{int32(3), int32(3)},
+			},
+			output.LogOutput{
+				Format: "Recipe upgrade of environment %q completed",
+				Params: []any{"test-environment"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Upgrade fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			StartRecipeUpgrade(gomock.Any(), "test-environment", v20231001preview.RecipeUpgrade{}).
+			Return(nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "test-environment").
+			Return(testEnvironment(&v20231001preview.RecipeUpgradeStatus{
+				State:           to.Ptr(v20231001preview.RecipeUpgradeStateFailed),
+				Total:           to.Ptr(int32(3)),
+				Upgraded:        to.Ptr(int32(1)),
+				FailedResources: to.SliceOfPtrs("redis"),
+				Message:         to.Ptr("The recipe upgrade was halted."),
+			}), nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Environment: "test-environment"},
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("Recipe upgrade of environment %q failed. %s", "test-environment", "The recipe upgrade was halted."), err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Upgrading the recipes of the resources of environment %q...",
+				Params: []any{"test-environment"},
+			},
+			output.LogOutput{
+				Format: "Upgraded %d of %d resource(s)",
+				Params: []any{int32(1), int32(3)},
+			},
+			output.LogOutput{
+				Format: "The recipes of the following resources failed to upgrade:",
+			},
+			output.LogOutput{
+				Format: "  %s",
+				Params: []any{"redis"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
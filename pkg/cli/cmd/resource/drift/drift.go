@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource drift` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "drift [resourceType] [resourceName]",
+		Short: "Detect drift of a recipe-provisioned Radius resource",
+		Long: `Detect drift of a recipe-provisioned Radius resource.
+
+Drift detection compares the infrastructure deployed by the recipe of the resource with the recipe. Terraform recipes are
+compared using a Terraform plan, Bicep recipes by checking the output resources of the recipe still exist. The result is
+recorded in the status of the resource.
+
+Use --reconcile to re-apply the recipe when drift is detected.`,
+		Example: `
+	# detect drift of a redis cache
+	rad resource drift redisCaches cache
+
+	# detect drift of a redis cache and re-apply its recipe if it drifted
+	rad resource drift redisCaches cache --reconcile
+
+	# detect drift of a redis cache in a specified resource group
+	rad resource drift redisCaches cache -g my-group
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().Bool("reconcile", false, "Re-apply the recipe of the resource if drift is detected")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource drift` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ResourceType      string
+	ResourceName      string
+	Reconcile         bool
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad resource drift` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource drift` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+	r.ResourceName = resourceName
+
+	reconcile, err := cmd.Flags().GetBool("reconcile")
+	if err != nil {
+		return err
+	}
+	r.Reconcile = reconcile
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource drift` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Detecting drift of resource %q of type %q...", r.ResourceName, r.ResourceType)
+
+	resource, err := client.DetectResourceDrift(ctx, r.ResourceType, r.ResourceName, r.Reconcile)
+	if err != nil {
+		return err
+	}
+
+	err = r.Output.WriteFormatted(r.Format, resource, objectformats.GetResourceDriftTableFormat())
+	if err != nil {
+		return err
+	}
+
+	// The drifted resources are part of the resource for the other formats.
+	drifted := driftedResources(resource)
+	if r.Format != output.FormatTable || len(drifted) == 0 {
+		return nil
+	}
+
+	r.Output.LogInfo("")
+	return r.Output.WriteFormatted(r.Format, drifted, objectformats.GetDriftedResourceTableFormat())
+}
+
+// driftedResources returns the drifted resources recorded in the drift status of the resource.
+func driftedResources(resource generated.GenericResource) []any {
+	status, ok := resource.Properties["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	drift, ok := status["recipeDrift"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	drifted, ok := drift["driftedResources"].([]any)
+	if !ok {
+		return nil
+	}
+
+	return drifted
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Drift Command",
+			Input:         []string{"redisCaches", "foo"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Datastores/redisCaches", r.ResourceType)
+				require.Equal(t, "foo", r.ResourceName)
+				require.False(t, r.Reconcile)
+			},
+		},
+		{
+			Name:          "Drift Command with reconcile",
+			Input:         []string{"redisCaches", "foo", "--reconcile"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).Reconcile)
+			},
+		},
+		{
+			Name:          "Drift Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Drift Command with insufficient args",
+			Input:         []string{"redisCaches"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("In sync", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource("redisCaches", "foo")
+		resource.Properties = map[string]any{
+			"status": map[string]any{
+				"recipeDrift": map[string]any{
+					"state": "InSync",
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DetectResourceDrift(gomock.Any(), "redisCaches", "foo", false).
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "redisCaches",
+			ResourceName:      "foo",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Detecting drift of resource %q of type %q...",
+				Params: []any{"foo", "redisCaches"},
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resource,
+				Options: objectformats.GetResourceDriftTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Drifted and reconciled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		drifted := []any{
+			map[string]any{"id": "azurerm_redis_cache.redis", "action": "Update"},
+		}
+		resource := radcli.CreateResource("redisCaches", "foo")
+		resource.Properties = map[string]any{
+			"status": map[string]any{
+				"recipeDrift": map[string]any{
+					"state":            "Reconciled",
+					"driftedResources": drifted,
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DetectResourceDrift(gomock.Any(), "redisCaches", "foo", true).
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "redisCaches",
+			ResourceName:      "foo",
+			Reconcile:         true,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Detecting drift of resource %q of type %q...",
+				Params: []any{"foo", "redisCaches"},
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resource,
+				Options: objectformats.GetResourceDriftTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     drifted,
+				Options: objectformats.GetDriftedResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DetectResourceDrift(gomock.Any(), "redisCaches", "foo", false).
+			Return(radcli.CreateResource("redisCaches", "foo"), errors.New("resource is not provisioned by a recipe")).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "redisCaches",
+			ResourceName:      "foo",
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.EqualError(t, err, "resource is not provisioned by a recipe")
+	})
+}
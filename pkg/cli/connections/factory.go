@@ -160,6 +160,7 @@ func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces
 			ContainerClient:   *cntrClient,
 			EnvironmentClient: *envClient,
 			GatewayClient:     *gwClient,
+			NewClusterClient:  kubernetes.NewClientset,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported connection type: %+v", connection)
@@ -44,6 +44,12 @@ const (
 type AWSCredentialProperties struct {
 	// AccessKeyID is the access key ID for the AWS credential.
 	AccessKeyID *string
+
+	// Kind is the kind of the AWS credential.
+	Kind *string
+
+	// RoleARN is the ARN of the IAM role assumed with IRSA.
+	RoleARN *string
 }
 
 // AWSCredentialManagementClient is used to interface with cloud provider configuration and credentials.
@@ -84,20 +90,28 @@ func (cpm *AWSCredentialManagementClient) Get(ctx context.Context, credentialNam
 	if err != nil {
 		return ProviderCredentialConfiguration{}, err
 	}
-	awsAccessKeyCredentials, ok := resp.AwsCredentialResource.Properties.(*ucp.AwsAccessKeyCredentialProperties)
-	if !ok {
-		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AWSCredential)
-	}
-
 	providerCredentialConfiguration := ProviderCredentialConfiguration{
 		CloudProviderStatus: CloudProviderStatus{
 			Name:    AWSCredential,
 			Enabled: true,
 		},
-		AWSCredentials: &AWSCredentialProperties{
-			AccessKeyID: awsAccessKeyCredentials.AccessKeyID,
-		},
 	}
+
+	switch p := resp.AwsCredentialResource.Properties.(type) {
+	case *ucp.AwsAccessKeyCredentialProperties:
+		providerCredentialConfiguration.AWSCredentials = &AWSCredentialProperties{
+			AccessKeyID: p.AccessKeyID,
+			Kind:        (*string)(p.Kind),
+		}
+	case *ucp.AwsIRSACredentialProperties:
+		providerCredentialConfiguration.AWSCredentials = &AWSCredentialProperties{
+			Kind:    (*string)(p.Kind),
+			RoleARN: p.RoleARN,
+		}
+	default:
+		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AWSCredential)
+	}
+
 	return providerCredentialConfiguration, nil
 }
 
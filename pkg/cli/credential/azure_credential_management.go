@@ -71,13 +71,13 @@ type ProviderCredentialConfiguration struct {
 }
 
 type AzureCredentialProperties struct {
-	// clientId for ServicePrincipal
+	// clientId for ServicePrincipal or WorkloadIdentity
 	ClientID *string
 
 	// The credential kind
 	Kind *string
 
-	// tenantId for ServicePrincipal
+	// tenantId for ServicePrincipal or WorkloadIdentity
 	TenantID *string
 }
 
@@ -124,21 +124,28 @@ func (cpm *AzureCredentialManagementClient) Get(ctx context.Context, credentialN
 		return ProviderCredentialConfiguration{}, err
 	}
 
-	azureServicePrincipal, ok := resp.AzureCredentialResource.Properties.(*ucp.AzureServicePrincipalProperties)
-	if !ok {
-		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AzureCredential)
-	}
-
 	providerCredentialConfiguration := ProviderCredentialConfiguration{
 		CloudProviderStatus: CloudProviderStatus{
 			Name:    AzureCredential,
 			Enabled: true,
 		},
-		AzureCredentials: &AzureCredentialProperties{
-			ClientID: azureServicePrincipal.ClientID,
-			Kind:     (*string)(azureServicePrincipal.Kind),
-			TenantID: azureServicePrincipal.TenantID,
-		},
+	}
+
+	switch p := resp.AzureCredentialResource.Properties.(type) {
+	case *ucp.AzureServicePrincipalProperties:
+		providerCredentialConfiguration.AzureCredentials = &AzureCredentialProperties{
+			ClientID: p.ClientID,
+			Kind:     (*string)(p.Kind),
+			TenantID: p.TenantID,
+		}
+	case *ucp.AzureWorkloadIdentityProperties:
+		providerCredentialConfiguration.AzureCredentials = &AzureCredentialProperties{
+			ClientID: p.ClientID,
+			Kind:     (*string)(p.Kind),
+			TenantID: p.TenantID,
+		}
+	default:
+		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AzureCredential)
 	}
 
 	return providerCredentialConfiguration, nil
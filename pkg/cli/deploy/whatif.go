@@ -0,0 +1,311 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/rp/portableresources"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+var (
+	// parametersExpression matches the ARM expression referencing a template parameter, eg: parameters('environment').
+	parametersExpression = regexp.MustCompile(`^parameters\('([^']+)'\)$`)
+
+	// referenceIDExpression matches the ARM expression referencing the ID of a resource of the template by its symbolic
+	// name, eg: reference('app').id.
+	referenceIDExpression = regexp.MustCompile(`^reference\('([^']+)'\)\.id$`)
+)
+
+// WhatIfOptions contains options to be used with WhatIf.
+type WhatIfOptions struct {
+	// Parameters should contain the parameters to set for the deployment.
+	Parameters clients.DeploymentParameters
+
+	// Template should contain a parsed ARM-JSON template.
+	Template map[string]any
+
+	// Scope is the resource group scope the template would be deployed to.
+	Scope string
+
+	// Providers are cloud and radius providers configured on the env for deployment
+	Providers *clients.Providers
+}
+
+// WhatIfChange is a change the recipe of a portable resource of a template would make to the infrastructure.
+type WhatIfChange struct {
+	// Resource is the name of the portable resource.
+	Resource string
+
+	// Type is the type of the portable resource.
+	Type string
+
+	// Action is the action the recipe would take on the infrastructure resource.
+	Action string
+
+	// ID is the ID of the infrastructure resource.
+	ID string
+}
+
+// WhatIfSkippedResource is a portable resource of a template whose changes could not be previewed.
+type WhatIfSkippedResource struct {
+	// Resource is the symbolic name of the portable resource in the template.
+	Resource string
+
+	// Type is the type of the portable resource.
+	Type string
+
+	// Reason is the reason the changes of the resource could not be previewed.
+	Reason string
+}
+
+// WhatIfResult is the preview of the changes the recipes of the portable resources of a template would make to the
+// infrastructure.
+type WhatIfResult struct {
+	// Changes are the changes the recipes would make to the infrastructure.
+	Changes []WhatIfChange
+
+	// Skipped are the portable resources whose changes could not be previewed.
+	Skipped []WhatIfSkippedResource
+}
+
+// WhatIf previews the changes the recipes of the portable resources of a template would make to the infrastructure,
+// without deploying the template. The definitions of the portable resources are evaluated from the template and sent
+// to the plan action of each resource. Resources whose definition depends on values only known during the deployment
+// are reported as skipped.
+func WhatIf(ctx context.Context, client clients.ApplicationsManagementClient, options WhatIfOptions) (WhatIfResult, error) {
+	result := WhatIfResult{
+		Changes: []WhatIfChange{},
+		Skipped: []WhatIfSkippedResource{},
+	}
+
+	err := bicep.InjectEnvironmentParam(options.Template, options.Parameters, options.Providers.Radius.EnvironmentID)
+	if err != nil {
+		return WhatIfResult{}, err
+	}
+
+	err = bicep.InjectApplicationParam(options.Template, options.Parameters, options.Providers.Radius.ApplicationID)
+	if err != nil {
+		return WhatIfResult{}, err
+	}
+
+	evaluator := &templateEvaluator{
+		template:   options.Template,
+		parameters: options.Parameters,
+		scope:      options.Scope,
+	}
+
+	resources, _ := options.Template["resources"].(map[string]any)
+	names := []string{}
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		resource, ok := resources[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		resourceType := evaluator.resourceType(resource)
+		if !portableresources.IsValidPortableResourceType(resourceType) {
+			continue
+		}
+
+		resourceName, body, err := evaluator.resourceBody(resource)
+		if err != nil {
+			result.Skipped = append(result.Skipped, WhatIfSkippedResource{Resource: name, Type: resourceType, Reason: err.Error()})
+			continue
+		}
+
+		plan, err := client.PlanResource(ctx, resourceType, resourceName, body)
+		if err != nil {
+			return WhatIfResult{}, fmt.Errorf("failed to preview the changes of resource %q: %w", resourceName, err)
+		}
+
+		for _, planned := range plan.Resources {
+			change := WhatIfChange{
+				Resource: resourceName,
+				Type:     resourceType,
+				ID:       to.String(planned.ID),
+			}
+			if planned.Action != nil {
+				change.Action = string(*planned.Action)
+			}
+			result.Changes = append(result.Changes, change)
+		}
+	}
+
+	return result, nil
+}
+
+// templateEvaluator evaluates the subset of ARM template expressions needed to compute the definition of the Radius
+// resources of a template before it is deployed.
+type templateEvaluator struct {
+	template   map[string]any
+	parameters clients.DeploymentParameters
+	scope      string
+}
+
+// resourceType returns the type of a Radius resource of the template without its API version, or an empty string when
+// the resource is not a Radius resource.
+func (e *templateEvaluator) resourceType(resource map[string]any) string {
+	if _, ok := resource["import"]; !ok {
+		return ""
+	}
+
+	resourceType, _ := resource["type"].(string)
+	resourceType, _, _ = strings.Cut(resourceType, "@")
+	return resourceType
+}
+
+// resourceBody evaluates the definition of a Radius resource of the template and returns its name and body.
+func (e *templateEvaluator) resourceBody(resource map[string]any) (string, generated.GenericResource, error) {
+	evaluated, err := e.evaluate(resource["properties"])
+	if err != nil {
+		return "", generated.GenericResource{}, err
+	}
+
+	definition, ok := evaluated.(map[string]any)
+	if !ok {
+		return "", generated.GenericResource{}, fmt.Errorf("the resource has no properties")
+	}
+
+	name, ok := definition["name"].(string)
+	if !ok || name == "" {
+		return "", generated.GenericResource{}, fmt.Errorf("the resource has no name")
+	}
+
+	body := generated.GenericResource{}
+	if location, ok := definition["location"].(string); ok {
+		body.Location = to.Ptr(location)
+	}
+	if properties, ok := definition["properties"].(map[string]any); ok {
+		body.Properties = properties
+	}
+
+	return name, body, nil
+}
+
+// evaluate evaluates the expressions of a value of the template.
+func (e *templateEvaluator) evaluate(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return e.evaluateString(v)
+
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			evaluated, err := e.evaluate(item)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = evaluated
+		}
+		return result, nil
+
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			evaluated, err := e.evaluate(item)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, evaluated)
+		}
+		return result, nil
+
+	default:
+		return value, nil
+	}
+}
+
+// evaluateString evaluates a string of the template, which is an expression when enclosed in brackets.
+func (e *templateEvaluator) evaluateString(value string) (any, error) {
+	// Strings starting with two brackets are escaped literals.
+	if strings.HasPrefix(value, "[[") {
+		return value[1:], nil
+	}
+
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return value, nil
+	}
+
+	expression := strings.TrimSpace(value[1 : len(value)-1])
+	if match := parametersExpression.FindStringSubmatch(expression); match != nil {
+		return e.parameter(match[1])
+	}
+
+	if match := referenceIDExpression.FindStringSubmatch(expression); match != nil {
+		return e.resourceID(match[1])
+	}
+
+	return nil, fmt.Errorf("the expression %q can only be evaluated during the deployment", value)
+}
+
+// parameter returns the value of a parameter of the template, or its default value when it is not set.
+func (e *templateEvaluator) parameter(name string) (any, error) {
+	if parameter, ok := e.parameters[name]; ok {
+		if value, ok := parameter["value"]; ok {
+			return value, nil
+		}
+	}
+
+	parameters, _ := e.template["parameters"].(map[string]any)
+	definition, _ := parameters[name].(map[string]any)
+	if value, ok := definition["defaultValue"]; ok {
+		return e.evaluate(value)
+	}
+
+	return nil, fmt.Errorf("the parameter %q has no value", name)
+}
+
+// resourceID returns the ID of a Radius resource of the template from its symbolic name.
+func (e *templateEvaluator) resourceID(symbolicName string) (string, error) {
+	resources, _ := e.template["resources"].(map[string]any)
+	resource, ok := resources[symbolicName].(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("the resource %q does not exist in the template", symbolicName)
+	}
+
+	resourceType := e.resourceType(resource)
+	if resourceType == "" {
+		return "", fmt.Errorf("the ID of the resource %q can only be evaluated during the deployment", symbolicName)
+	}
+
+	properties, _ := resource["properties"].(map[string]any)
+	name, err := e.evaluate(properties["name"])
+	if err != nil {
+		return "", err
+	}
+
+	resourceName, ok := name.(string)
+	if !ok || resourceName == "" {
+		return "", fmt.Errorf("the resource %q has no name", symbolicName)
+	}
+
+	return e.scope + "/providers/" + resourceType + "/" + resourceName, nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	testScope         = "/planes/radius/local/resourceGroups/test-group"
+	testEnvironmentID = testScope + "/providers/Applications.Core/environments/test-env"
+)
+
+func readTemplate(t *testing.T) map[string]any {
+	b, err := os.ReadFile("./testdata/whatif-template.json")
+	require.NoError(t, err)
+
+	template := map[string]any{}
+	err = json.Unmarshal(b, &template)
+	require.NoError(t, err)
+	return template
+}
+
+func Test_WhatIf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+
+	expectedBody := generated.GenericResource{
+		Location: to.Ptr("global"),
+		Properties: map[string]any{
+			"environment": testEnvironmentID,
+			"application": testScope + "/providers/Applications.Core/applications/myapp",
+			"recipe": map[string]any{
+				"name": "[default]",
+				"parameters": map[string]any{
+					"sku": "Premium",
+				},
+			},
+		},
+	}
+
+	client.EXPECT().
+		PlanResource(gomock.Any(), "Applications.Datastores/redisCaches", "myredis", expectedBody).
+		Return(generated.RecipePlan{
+			Resources: []*generated.PlannedResource{
+				{ID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"), Action: to.Ptr(generated.PlanActionCreate)},
+				{ID: to.Ptr("/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"), Action: to.Ptr(generated.PlanActionUpdate)},
+			},
+		}, nil).
+		Times(1)
+
+	result, err := WhatIf(context.Background(), client, WhatIfOptions{
+		Template:   readTemplate(t),
+		Parameters: clients.DeploymentParameters{"sku": {"value": "Premium"}},
+		Scope:      testScope,
+		Providers: &clients.Providers{
+			Radius: &clients.RadiusProvider{EnvironmentID: testEnvironmentID},
+		},
+	})
+	require.NoError(t, err)
+
+	expected := WhatIfResult{
+		Changes: []WhatIfChange{
+			{
+				Resource: "myredis",
+				Type:     "Applications.Datastores/redisCaches",
+				Action:   "Create",
+				ID:       "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+			},
+			{
+				Resource: "myredis",
+				Type:     "Applications.Datastores/redisCaches",
+				Action:   "Update",
+				ID:       "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+			},
+		},
+		Skipped: []WhatIfSkippedResource{
+			{
+				Resource: "mongo",
+				Type:     "Applications.Datastores/mongoDatabases",
+				Reason:   "the expression \"[reference('app').properties.status.compute.namespace]\" can only be evaluated during the deployment",
+			},
+		},
+	}
+	require.Equal(t, expected, result)
+}
+
+func Test_WhatIf_PlanError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+
+	client.EXPECT().
+		PlanResource(gomock.Any(), "Applications.Datastores/redisCaches", "myredis", gomock.Any()).
+		Return(generated.RecipePlan{}, errors.New("recipe not found")).
+		Times(1)
+
+	_, err := WhatIf(context.Background(), client, WhatIfOptions{
+		Template:   readTemplate(t),
+		Parameters: clients.DeploymentParameters{},
+		Scope:      testScope,
+		Providers: &clients.Providers{
+			Radius: &clients.RadiusProvider{EnvironmentID: testEnvironmentID},
+		},
+	})
+	require.EqualError(t, err, "failed to preview the changes of resource \"myredis\": recipe not found")
+}
+
+func Test_TemplateEvaluator(t *testing.T) {
+	evaluator := &templateEvaluator{
+		template:   readTemplate(t),
+		parameters: clients.DeploymentParameters{"environment": {"value": testEnvironmentID}},
+		scope:      testScope,
+	}
+
+	cases := []struct {
+		desc     string
+		value    any
+		expected any
+		err      string
+	}{
+		{"literal", "value", "value", ""},
+		{"escaped literal", "[[value]", "[value]", ""},
+		{"parameter", "[parameters('environment')]", testEnvironmentID, ""},
+		{"parameter default value", "[parameters('location')]", "global", ""},
+		{"missing parameter", "[parameters('missing')]", nil, "the parameter \"missing\" has no value"},
+		{"resource id", "[reference('app').id]", testScope + "/providers/Applications.Core/applications/myapp", ""},
+		{"missing resource", "[reference('missing').id]", nil, "the resource \"missing\" does not exist in the template"},
+		{"unsupported expression", "[format('{0}', 'a')]", nil, "the expression \"[format('{0}', 'a')]\" can only be evaluated during the deployment"},
+		{"nested values", map[string]any{"a": []any{"[parameters('location')]", float64(1)}}, map[string]any{"a": []any{"global", float64(1)}}, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actual, err := evaluator.evaluate(tc.value)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
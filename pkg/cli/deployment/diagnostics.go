@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 
 	"io"
 	"net/http"
@@ -49,6 +50,10 @@ type ARMDiagnosticsClient struct {
 	ContainerClient   generated.GenericResourcesClient
 	EnvironmentClient generated.GenericResourcesClient
 	GatewayClient     generated.GenericResourcesClient
+
+	// NewClusterClient creates the clients of a cluster registered as a Kubernetes plane using the given kube context of
+	// the local kubeconfig. It is used for the containers of environments targeting a registered cluster.
+	NewClusterClient func(kubeContext string) (*k8s.Clientset, *rest.Config, error)
 }
 
 var _ clients.DiagnosticsClient = (*ARMDiagnosticsClient)(nil)
@@ -81,16 +86,21 @@ func (dc *ARMDiagnosticsClient) GetPublicEndpoint(ctx context.Context, options c
 // Expose function finds a running replica of the container, prints the replica name, sets up a signal notification,
 // creates channels for errors, readiness and stopping, and runs a portforwarding process.
 func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error) {
-	namespace, err := dc.findNamespaceOfContainer(ctx, options.Resource)
+	namespace, planeName, err := dc.findComputeOfContainer(ctx, options.Resource)
+	if err != nil {
+		return
+	}
+
+	k8sClient, restConfig, err := dc.getClusterClient(planeName, options.KubeContext)
 	if err != nil {
 		return
 	}
 
 	var replica *corev1.Pod
 	if options.Replica != "" {
-		replica, err = getSpecificReplica(ctx, dc.K8sTypedClient, namespace, options.Resource, options.Replica)
+		replica, err = getSpecificReplica(ctx, k8sClient, namespace, options.Resource, options.Replica)
 	} else {
-		replica, err = getRunningReplica(ctx, dc.K8sTypedClient, namespace, options.Application, options.Resource)
+		replica, err = getRunningReplica(ctx, k8sClient, namespace, options.Application, options.Resource)
 	}
 
 	if err != nil {
@@ -106,7 +116,7 @@ func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.Expo
 	ready := make(chan struct{})
 	stop = make(chan struct{}, 1)
 	go func() {
-		err := runPortforward(dc.RestConfig, dc.K8sTypedClient, replica, ready, stop, options.Port, options.RemotePort)
+		err := runPortforward(restConfig, k8sClient, replica, ready, stop, options.Port, options.RemotePort)
 		failed <- err
 	}()
 
@@ -116,27 +126,32 @@ func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.Expo
 // Logs() retrieves the running replicas of the container, and creates log streams for the replicas. If an error occurs,
 // it will close all the created streams before returning the error.
 func (dc *ARMDiagnosticsClient) Logs(ctx context.Context, options clients.LogsOptions) ([]clients.LogStream, error) {
-	namespace, err := dc.findNamespaceOfContainer(ctx, options.Resource)
+	namespace, planeName, err := dc.findComputeOfContainer(ctx, options.Resource)
 	if err != nil {
 		return nil, nil
 	}
 
+	k8sClient, restConfig, err := dc.getClusterClient(planeName, options.KubeContext)
+	if err != nil {
+		return nil, err
+	}
+
 	var replicas []corev1.Pod
 
 	if options.Replica != "" {
-		replica, err := getSpecificReplica(ctx, dc.K8sTypedClient, namespace, options.Resource, options.Replica)
+		replica, err := getSpecificReplica(ctx, k8sClient, namespace, options.Resource, options.Replica)
 		if err != nil {
 			return nil, err
 		}
 		replicas = append(replicas, *replica)
 	} else {
-		replicas, err = getRunningReplicas(ctx, dc.K8sTypedClient, namespace, options.Application, options.Resource)
+		replicas, err = getRunningReplicas(ctx, k8sClient, namespace, options.Application, options.Resource)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	streams, err := createLogStreams(ctx, options, dc, replicas)
+	streams, err := createLogStreams(ctx, options, restConfig, k8sClient, replicas)
 	if err != nil {
 		// If there was an error, try to close all streams that were created
 		// ignore errors from stream close
@@ -149,68 +164,135 @@ func (dc *ARMDiagnosticsClient) Logs(ctx context.Context, options clients.LogsOp
 	return streams, err
 }
 
-func (dc *ARMDiagnosticsClient) findNamespaceOfContainer(ctx context.Context, resourceName string) (string, error) {
+// findComputeOfContainer returns the Kubernetes namespace of the application of the container and the name of the
+// Kubernetes plane of the cluster targeted by the environment of the application.
+func (dc *ARMDiagnosticsClient) findComputeOfContainer(ctx context.Context, resourceName string) (string, string, error) {
 	containerResponse, err := dc.ContainerClient.Get(ctx, resourceName, nil)
 	if err != nil {
-		return "", fmt.Errorf("could not find container %q:%w", resourceName, err)
+		return "", "", fmt.Errorf("could not find container %q:%w", resourceName, err)
 	}
 
 	obj, ok := containerResponse.Properties["application"]
 	if !ok {
-		return "", fmt.Errorf("could not find namespace for container %q", resourceName)
+		return "", "", fmt.Errorf("could not find namespace for container %q", resourceName)
 	}
 
 	application, ok := obj.(string)
 	if !ok {
-		return "", fmt.Errorf("could not find namespace for container %q", resourceName)
+		return "", "", fmt.Errorf("could not find namespace for container %q", resourceName)
 	}
 
 	id, err := resources.ParseResource(application)
 	if err != nil {
-		return "", fmt.Errorf("could not namespace for container %q:%w", resourceName, err)
+		return "", "", fmt.Errorf("could not namespace for container %q:%w", resourceName, err)
 	}
 
 	applicationResponse, err := dc.ApplicationClient.Get(ctx, id.Name(), nil)
 	if err != nil {
-		return "", fmt.Errorf("could not namespace for container %q:%w", resourceName, err)
+		return "", "", fmt.Errorf("could not namespace for container %q:%w", resourceName, err)
 	}
 
 	obj, ok = applicationResponse.Properties["status"]
 	if !ok {
-		return "", fmt.Errorf("could not find namespace for container %q", resourceName)
+		return "", "", fmt.Errorf("could not find namespace for container %q", resourceName)
 	}
 
 	status, ok := obj.(map[string]any)
 	if !ok {
-		return "", fmt.Errorf("could not find namespace for container %q", resourceName)
+		return "", "", fmt.Errorf("could not find namespace for container %q", resourceName)
 	}
 
 	obj, ok = status["compute"]
 	if !ok {
-		return "", fmt.Errorf("could not find namespace for container %q", resourceName)
+		return "", "", fmt.Errorf("could not find namespace for container %q", resourceName)
 	}
 
 	compute, ok := obj.(map[string]any)
 	if !ok {
-		return "", fmt.Errorf("could not find namespace for container %q", resourceName)
+		return "", "", fmt.Errorf("could not find namespace for container %q", resourceName)
 	}
 
 	kind, ok := compute["kind"].(string)
 	if !ok || !strings.EqualFold(kind, "kubernetes") {
-		return "", fmt.Errorf("could not find namespace for container %q", resourceName)
+		return "", "", fmt.Errorf("could not find namespace for container %q", resourceName)
 	}
 
 	namespace, ok := compute["namespace"].(string)
-	if ok {
-		return namespace, nil
+	if !ok {
+		return "", "", fmt.Errorf("could not find namespace for container %q", resourceName)
+	}
+
+	planeName, err := dc.findPlaneOfEnvironment(ctx, applicationResponse.Properties)
+	if err != nil {
+		return "", "", fmt.Errorf("could not find cluster for container %q:%w", resourceName, err)
+	}
+
+	return namespace, planeName, nil
+}
+
+// findPlaneOfEnvironment returns the name of the Kubernetes plane of the cluster targeted by the environment of the
+// application with the given properties. The local plane is returned unless the Kubernetes compute of the environment
+// references a Kubernetes plane.
+func (dc *ARMDiagnosticsClient) findPlaneOfEnvironment(ctx context.Context, applicationProperties map[string]any) (string, error) {
+	environment, ok := applicationProperties["environment"].(string)
+	if !ok {
+		return resources_kubernetes.PlaneNameLocal, nil
+	}
+
+	id, err := resources.ParseResource(environment)
+	if err != nil {
+		return "", err
+	}
+
+	environmentResponse, err := dc.EnvironmentClient.Get(ctx, id.Name(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	compute, ok := environmentResponse.Properties["compute"].(map[string]any)
+	if !ok {
+		return resources_kubernetes.PlaneNameLocal, nil
+	}
+
+	resourceID, ok := compute["resourceId"].(string)
+	if !ok {
+		return resources_kubernetes.PlaneNameLocal, nil
+	}
+
+	planeName, err := resources_kubernetes.ParsePlaneID(resourceID)
+	if err != nil {
+		return resources_kubernetes.PlaneNameLocal, nil
+	}
+
+	return planeName, nil
+}
+
+// getClusterClient returns the clients of the cluster of the given Kubernetes plane, using kubeContext or the kube
+// context named after the plane if kubeContext is empty. The clients of the workspace are used for the local plane.
+func (dc *ARMDiagnosticsClient) getClusterClient(planeName string, kubeContext string) (*k8s.Clientset, *rest.Config, error) {
+	if planeName == "" || strings.EqualFold(planeName, resources_kubernetes.PlaneNameLocal) {
+		return dc.K8sTypedClient, dc.RestConfig, nil
+	}
+
+	if dc.NewClusterClient == nil {
+		return nil, nil, fmt.Errorf("could not connect to the cluster of the Kubernetes plane %q", planeName)
+	}
+
+	if kubeContext == "" {
+		kubeContext = planeName
+	}
+
+	k8sClient, restConfig, err := dc.NewClusterClient(kubeContext)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not connect to the cluster of the Kubernetes plane %q with the kube context %q, use --kubecontext to specify the kube context of the cluster: %w", planeName, kubeContext, err)
 	}
 
-	return "", fmt.Errorf("could not find namespace for container %q", resourceName)
+	return k8sClient, restConfig, nil
 }
 
 // Note: If an error is returned, any streams that were created before the error will also be returned.
 // Caller is responsible for closing streams even when there is an error.
-func createLogStreams(ctx context.Context, options clients.LogsOptions, dc *ARMDiagnosticsClient, replicas []corev1.Pod) ([]clients.LogStream, error) {
+func createLogStreams(ctx context.Context, options clients.LogsOptions, restConfig *rest.Config, k8sClient *k8s.Clientset, replicas []corev1.Pod) ([]clients.LogStream, error) {
 	container := options.Container
 	follow := options.Follow
 
@@ -224,7 +306,7 @@ func createLogStreams(ctx context.Context, options clients.LogsOptions, dc *ARMD
 			}
 		}
 
-		stream, err := streamLogs(ctx, dc.RestConfig, dc.K8sTypedClient, &replica, container, follow)
+		stream, err := streamLogs(ctx, restConfig, k8sClient, &replica, container, follow)
 		if err != nil {
 			return streams, fmt.Errorf("failed to open log stream to %s: %w", options.Resource, err)
 		}
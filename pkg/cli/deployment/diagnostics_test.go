@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func Test_GetClusterClient(t *testing.T) {
+	localClient := &k8s.Clientset{}
+	remoteClient := &k8s.Clientset{}
+
+	kubeContexts := []string{}
+	dc := &ARMDiagnosticsClient{
+		K8sTypedClient: localClient,
+		RestConfig:     &rest.Config{Host: "https://local"},
+		NewClusterClient: func(kubeContext string) (*k8s.Clientset, *rest.Config, error) {
+			kubeContexts = append(kubeContexts, kubeContext)
+			if kubeContext == "missing" {
+				return nil, nil, errors.New("context \"missing\" does not exist")
+			}
+			return remoteClient, &rest.Config{Host: "https://" + kubeContext}, nil
+		},
+	}
+
+	t.Run("local plane", func(t *testing.T) {
+		client, config, err := dc.getClusterClient("local", "prod-cluster")
+		require.NoError(t, err)
+		require.Same(t, localClient, client)
+		require.Equal(t, "https://local", config.Host)
+	})
+
+	t.Run("kube context named after the plane", func(t *testing.T) {
+		client, config, err := dc.getClusterClient("prod", "")
+		require.NoError(t, err)
+		require.Same(t, remoteClient, client)
+		require.Equal(t, "https://prod", config.Host)
+	})
+
+	t.Run("kube context specified", func(t *testing.T) {
+		_, config, err := dc.getClusterClient("prod", "prod-cluster")
+		require.NoError(t, err)
+		require.Equal(t, "https://prod-cluster", config.Host)
+	})
+
+	t.Run("missing kube context", func(t *testing.T) {
+		_, _, err := dc.getClusterClient("prod", "missing")
+		require.EqualError(t, err, "could not connect to the cluster of the Kubernetes plane \"prod\" with the kube context \"missing\", use --kubecontext to specify the kube context of the cluster: context \"missing\" does not exist")
+	})
+
+	require.Equal(t, []string{"prod", "prod-cluster", "missing"}, kubeContexts)
+}
@@ -169,6 +169,10 @@ func GetCloudProviderTableFormat(credentialType string) output.FormatterOptions
 					Heading:  "REGISTERED",
 					JSONPath: "{ .Enabled }",
 				},
+				{
+					Heading:  "KIND",
+					JSONPath: "{ .AzureCredentials.Kind }",
+				},
 				{
 					Heading:  "CLIENTID",
 					JSONPath: "{ .AzureCredentials.ClientID }",
@@ -190,10 +194,18 @@ func GetCloudProviderTableFormat(credentialType string) output.FormatterOptions
 					Heading:  "REGISTERED",
 					JSONPath: "{ .Enabled }",
 				},
+				{
+					Heading:  "KIND",
+					JSONPath: "{ .AWSCredentials.Kind }",
+				},
 				{
 					Heading:  "ACCESSKEYID",
 					JSONPath: "{ .AWSCredentials.AccessKeyID }",
 				},
+				{
+					Heading:  "ROLEARN",
+					JSONPath: "{ .AWSCredentials.RoleARN }",
+				},
 			},
 		}
 	}
@@ -229,6 +241,35 @@ func GetEnvironmentRecipesTableFormat() output.FormatterOptions {
 	}
 }
 
+// GetRecipeCatalogTableFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the
+// table of the recipes published in a recipe catalog.
+func GetRecipeCatalogTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "TEMPLATE KIND",
+				JSONPath: "{ .TemplateKind }",
+			},
+			{
+				Heading:  "LATEST VERSION",
+				JSONPath: "{ .Versions[0].Version }",
+			},
+			{
+				Heading:  "DESCRIPTION",
+				JSONPath: "{ .Description }",
+			},
+		},
+	}
+}
+
 type OutputEnvObject struct {
 	EnvName     string
 	ComputeKind string
@@ -292,3 +333,157 @@ func GetRecipeParamsTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetRecipeOutputsTableFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the
+// table of the outputs expected from a recipe.
+func GetRecipeOutputsTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "OUTPUT NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "REQUIRED",
+				JSONPath: "{ .Required }",
+			},
+			{
+				Heading:  "SECRET",
+				JSONPath: "{ .Secret }",
+			},
+			{
+				Heading:  "DESCRIPTION",
+				JSONPath: "{ .Description }",
+			},
+		},
+	}
+}
+
+// GetDeadLetteredOperationTableFormat returns the fields to output from a dead-lettered operation object.
+func GetDeadLetteredOperationTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Properties.OperationType }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Properties.Reason }",
+			},
+			{
+				Heading:  "DEQUEUE COUNT",
+				JSONPath: "{ .Properties.DequeueCount }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Properties.ResourceID }",
+			},
+		},
+	}
+}
+
+// GetOperationHistoryTableFormat returns the fields to output from an operation history entry.
+func GetOperationHistoryTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Properties.OperationType }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .Properties.Status }",
+			},
+			{
+				Heading:  "START",
+				JSONPath: "{ .Properties.StartTime }",
+			},
+			{
+				Heading:  "END",
+				JSONPath: "{ .Properties.EndTime }",
+			},
+			{
+				Heading:  "REQUESTED BY",
+				JSONPath: "{ .Properties.RequestedBy.ClientPrincipalName }",
+			},
+		},
+	}
+}
+
+// GetResourceDriftTableFormat returns the fields to output from a resource with a recipe drift status.
+func GetResourceDriftTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "DRIFT",
+				JSONPath: "{ .Properties.status.recipeDrift.state }",
+			},
+			{
+				Heading:  "LAST CHECKED",
+				JSONPath: "{ .Properties.status.recipeDrift.lastCheckedTime }",
+			},
+		},
+	}
+}
+
+// GetDriftedResourceTableFormat returns the fields to output from a drifted resource of a recipe drift status.
+func GetDriftedResourceTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "DRIFTED RESOURCE",
+				JSONPath: "{ .id }",
+			},
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .action }",
+			},
+		},
+	}
+}
+
+// GetWhatIfTableFormat returns the fields to output from a change the recipe of a portable resource would make to the
+// infrastructure.
+func GetWhatIfTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+		},
+	}
+}
@@ -20,7 +20,10 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipecatalog"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
@@ -54,3 +57,76 @@ func Test_EnvTableFormat(t *testing.T) {
 	expected := "NAME               COMPUTE     RECIPES   PROVIDERS\ntest_env_resource  kubernetes  3         2\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_ResourceDriftTableFormat(t *testing.T) {
+	obj := generated.GenericResource{
+		Name: to.Ptr("redis"),
+		Type: to.Ptr("Applications.Datastores/redisCaches"),
+		Properties: map[string]any{
+			"status": map[string]any{
+				"recipeDrift": map[string]any{
+					"state":           "Drifted",
+					"lastCheckedTime": "2023-10-01T00:00:00Z",
+					"driftedResources": []any{
+						map[string]any{"id": "azurerm_redis_cache.redis", "action": "Update"},
+					},
+				},
+			},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetResourceDriftTableFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE  TYPE                                 DRIFT     LAST CHECKED\nredis     Applications.Datastores/redisCaches  Drifted   2023-10-01T00:00:00Z\n"
+	require.Equal(t, expected, buffer.String())
+
+	buffer = &bytes.Buffer{}
+	drifted := obj.Properties["status"].(map[string]any)["recipeDrift"].(map[string]any)["driftedResources"]
+	err = output.Write(output.FormatTable, drifted, buffer, GetDriftedResourceTableFormat())
+	require.NoError(t, err)
+
+	expected = "DRIFTED RESOURCE           ACTION\nazurerm_redis_cache.redis  Update\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_WhatIfTableFormat(t *testing.T) {
+	obj := []deploy.WhatIfChange{
+		{
+			Resource: "redis",
+			Type:     "Applications.Datastores/redisCaches",
+			Action:   "Create",
+			ID:       "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetWhatIfTableFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE  TYPE                                 ACTION    ID\nredis     Applications.Datastores/redisCaches  Create    /planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_RecipeCatalogTableFormat(t *testing.T) {
+	obj := []recipecatalog.Entry{
+		{
+			Name:         "redis-azure",
+			ResourceType: "Applications.Datastores/redisCaches",
+			TemplateKind: "bicep",
+			Description:  "Azure Cache for Redis",
+			Versions: []recipecatalog.Version{
+				{Version: "1.1.0", TemplatePath: "ghcr.io/myorg/recipes/azure/rediscaches:1.1.0"},
+				{Version: "1.0.0", TemplatePath: "ghcr.io/myorg/recipes/azure/rediscaches:1.0.0"},
+			},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetRecipeCatalogTableFormat())
+	require.NoError(t, err)
+
+	expected := "NAME         TYPE                                 TEMPLATE KIND  LATEST VERSION  DESCRIPTION\nredis-azure  Applications.Datastores/redisCaches  bicep          1.1.0           Azure Cache for Redis\n"
+	require.Equal(t, expected, buffer.String())
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipecatalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+)
+
+// ValidateParameters validates the parameters provided to install a recipe against the parameter schema of the catalog
+// entry and returns the parameters converted to the types of the schema. Values passed as strings on the command line
+// are converted to the int, bool, object or array type of the parameter. It returns an error if a parameter is not
+// declared by the recipe, has the wrong type or a value that is not allowed, or if a required parameter is missing.
+func ValidateParameters(entry *Entry, parameters map[string]any) (map[string]any, error) {
+	result := map[string]any{}
+	for _, name := range sortedKeys(parameters) {
+		schema, ok := entry.Parameters[name]
+		if !ok {
+			return nil, fmt.Errorf("recipe %q does not have a parameter named %q, available parameters are: %s", entry.Name, name, strings.Join(sortedKeys(entry.Parameters), ", "))
+		}
+
+		value, err := convertParameter(schema.Type, parameters[name])
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q of recipe %q: %w", name, entry.Name, err)
+		}
+
+		if len(schema.AllowedValues) > 0 && !isAllowed(value, schema.AllowedValues) {
+			return nil, fmt.Errorf("parameter %q of recipe %q has value %v which is not one of the allowed values %v", name, entry.Name, value, schema.AllowedValues)
+		}
+
+		result[name] = value
+	}
+
+	for _, name := range sortedKeys(entry.Parameters) {
+		schema := entry.Parameters[name]
+		if _, ok := result[name]; !ok && schema.Required && schema.DefaultValue == nil {
+			return nil, fmt.Errorf("parameter %q of recipe %q is required", name, entry.Name)
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateTemplateParameters validates the parameters of a recipe against the parameters declared by the recipe template,
+// as returned by the recipe metadata API. It returns an error if a parameter is not declared by the template, has a type
+// which does not match the type declared by the template, or is the recipe context parameter which is set by Radius.
+func ValidateTemplateParameters(parameters map[string]any, templateParameters map[string]any) error {
+	for _, name := range sortedKeys(parameters) {
+		if name == recipecontext.RecipeContextParamKey {
+			return fmt.Errorf("parameter %q is set by Radius and can not be provided", name)
+		}
+
+		details, ok := templateParameters[name]
+		if !ok {
+			return fmt.Errorf("parameter %q is not declared by the recipe template", name)
+		}
+
+		templateType := ""
+		if detailsMap, ok := details.(map[string]any); ok {
+			templateType, _ = detailsMap["type"].(string)
+		}
+
+		expected := templateParameterType(templateType)
+		if expected == "" {
+			continue
+		}
+
+		if err := checkType(expected, parameters[name]); err != nil {
+			return fmt.Errorf("parameter %q does not match the type %q declared by the recipe template: %w", name, templateType, err)
+		}
+	}
+
+	return nil
+}
+
+// convertParameter converts a value passed as a string to the type of the parameter, and checks the type of the value.
+func convertParameter(parameterType string, value any) (any, error) {
+	if s, ok := value.(string); ok {
+		switch parameterType {
+		case ParameterTypeInt:
+			i, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, fmt.Errorf("expected an integer, got %q", s)
+			}
+			return i, nil
+		case ParameterTypeBool:
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, fmt.Errorf("expected a boolean, got %q", s)
+			}
+			return b, nil
+		case ParameterTypeObject, ParameterTypeArray:
+			var v any
+			if err := json.Unmarshal([]byte(s), &v); err != nil {
+				return nil, fmt.Errorf("expected a JSON %s, got %q", parameterType, s)
+			}
+			value = v
+		}
+	}
+
+	if err := checkType(parameterType, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// checkType returns an error if the value is not of the given parameter type.
+func checkType(parameterType string, value any) error {
+	ok := false
+	switch parameterType {
+	case ParameterTypeString:
+		_, ok = value.(string)
+	case ParameterTypeInt:
+		switch v := value.(type) {
+		case int, int32, int64:
+			ok = true
+		case float64:
+			ok = v == math.Trunc(v)
+		}
+	case ParameterTypeBool:
+		_, ok = value.(bool)
+	case ParameterTypeObject:
+		_, ok = value.(map[string]any)
+	case ParameterTypeArray:
+		_, ok = value.([]any)
+	default:
+		ok = true
+	}
+
+	if !ok {
+		return fmt.Errorf("expected a value of type %s, got %v", parameterType, value)
+	}
+	return nil
+}
+
+// templateParameterType returns the catalog parameter type matching the type of a Bicep or Terraform template parameter,
+// or an empty string if the template type is unknown.
+func templateParameterType(templateType string) string {
+	templateType = strings.ToLower(strings.TrimSpace(templateType))
+	switch {
+	case templateType == "string" || templateType == "securestring":
+		return ParameterTypeString
+	case templateType == "int":
+		return ParameterTypeInt
+	case templateType == "bool":
+		return ParameterTypeBool
+	case templateType == "object" || templateType == "secureobject" ||
+		strings.HasPrefix(templateType, "map(") || strings.HasPrefix(templateType, "object("):
+		return ParameterTypeObject
+	case templateType == "array" ||
+		strings.HasPrefix(templateType, "list(") || strings.HasPrefix(templateType, "set(") || strings.HasPrefix(templateType, "tuple("):
+		return ParameterTypeArray
+	default:
+		return ""
+	}
+}
+
+func isAllowed(value any, allowed []any) bool {
+	for _, a := range allowed {
+		if fmt.Sprint(a) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
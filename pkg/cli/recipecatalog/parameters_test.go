@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipecatalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testEntry() *Entry {
+	return &Entry{
+		Name: "redis",
+		Parameters: map[string]ParameterSchema{
+			"sku":      {Type: ParameterTypeString, Required: true, AllowedValues: []any{"Basic", "Standard"}},
+			"capacity": {Type: ParameterTypeInt, Required: true, DefaultValue: 1},
+			"tls":      {Type: ParameterTypeBool},
+			"tags":     {Type: ParameterTypeObject},
+			"zones":    {Type: ParameterTypeArray},
+		},
+	}
+}
+
+func Test_ValidateParameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters map[string]any
+		expected   map[string]any
+		err        string
+	}{
+		{
+			name: "converts command line values",
+			parameters: map[string]any{
+				"sku":      "Basic",
+				"capacity": "2",
+				"tls":      "true",
+				"tags":     `{"team":"data"}`,
+				"zones":    `["1","2"]`,
+			},
+			expected: map[string]any{
+				"sku":      "Basic",
+				"capacity": 2,
+				"tls":      true,
+				"tags":     map[string]any{"team": "data"},
+				"zones":    []any{"1", "2"},
+			},
+		},
+		{
+			name:       "accepts JSON numbers",
+			parameters: map[string]any{"sku": "Standard", "capacity": float64(3)},
+			expected:   map[string]any{"sku": "Standard", "capacity": float64(3)},
+		},
+		{
+			name:       "unknown parameter",
+			parameters: map[string]any{"sku": "Basic", "size": "large"},
+			err:        `recipe "redis" does not have a parameter named "size", available parameters are: capacity, sku, tags, tls, zones`,
+		},
+		{
+			name:       "invalid integer",
+			parameters: map[string]any{"sku": "Basic", "capacity": "two"},
+			err:        `parameter "capacity" of recipe "redis": expected an integer, got "two"`,
+		},
+		{
+			name:       "wrong type",
+			parameters: map[string]any{"sku": "Basic", "capacity": 1.5},
+			err:        `parameter "capacity" of recipe "redis": expected a value of type int, got 1.5`,
+		},
+		{
+			name:       "value not allowed",
+			parameters: map[string]any{"sku": "Premium"},
+			err:        `parameter "sku" of recipe "redis" has value Premium which is not one of the allowed values [Basic Standard]`,
+		},
+		{
+			name:       "missing required parameter",
+			parameters: map[string]any{"tls": "false"},
+			err:        `parameter "sku" of recipe "redis" is required`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ValidateParameters(testEntry(), tt.parameters)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_ValidateTemplateParameters(t *testing.T) {
+	templateParameters := map[string]any{
+		"context":  map[string]any{"type": "object"},
+		"sku":      map[string]any{"type": "string"},
+		"capacity": map[string]any{"type": "int", "defaultValue": 1},
+		"zones":    map[string]any{"type": "list(string)", "required": false},
+		"size":     map[string]any{"type": "number"},
+	}
+
+	tests := []struct {
+		name       string
+		parameters map[string]any
+		err        string
+	}{
+		{
+			name:       "valid",
+			parameters: map[string]any{"sku": "Basic", "capacity": 2, "zones": []any{"1"}, "size": 1.5},
+		},
+		{
+			name:       "not declared by the template",
+			parameters: map[string]any{"tls": true},
+			err:        `parameter "tls" is not declared by the recipe template`,
+		},
+		{
+			name:       "type mismatch",
+			parameters: map[string]any{"zones": "1"},
+			err:        `parameter "zones" does not match the type "list(string)" declared by the recipe template: expected a value of type array, got 1`,
+		},
+		{
+			name:       "context parameter",
+			parameters: map[string]any{"context": map[string]any{}},
+			err:        `parameter "context" is set by Radius and can not be provided`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTemplateParameters(tt.parameters, templateParameters)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipecatalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	credentials "github.com/oras-project/oras-credentials-go"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+)
+
+const (
+	// GitSourcePrefix is the prefix of the location of a catalog index stored in a git repository.
+	GitSourcePrefix = "git::"
+
+	// DefaultIndexFile is the path of the catalog index in a git repository when the location does not specify one.
+	DefaultIndexFile = "index.yaml"
+)
+
+//go:generate mockgen -destination=./mock_source.go -package=recipecatalog -self_package github.com/radius-project/radius/pkg/cli/recipecatalog github.com/radius-project/radius/pkg/cli/recipecatalog Source
+
+// Source reads the index of a recipe catalog.
+type Source interface {
+	// ReadIndex reads and parses the index of the catalog.
+	ReadIndex(ctx context.Context) (*Index, error)
+}
+
+// NewSource creates the source of the catalog index stored at the given location. The location is either the reference
+// of an OCI artifact, such as 'ghcr.io/myorg/recipes/catalog:latest', whose first layer is the index, or a git repository
+// prefixed with 'git::', such as 'git::https://github.com/myorg/recipes.git//catalog/index.yaml?ref=main'. The path of the
+// index in the repository defaults to 'index.yaml' and the ref to the default branch of the repository.
+func NewSource(location string) (Source, error) {
+	if location == "" {
+		return nil, fmt.Errorf("the location of the recipe catalog must be specified")
+	}
+
+	if strings.HasPrefix(location, GitSourcePrefix) {
+		return newGitSource(strings.TrimPrefix(location, GitSourcePrefix))
+	}
+
+	return &ociSource{reference: location}, nil
+}
+
+// ociSource reads the catalog index from the first layer of an OCI artifact.
+type ociSource struct {
+	reference string
+}
+
+// ReadIndex reads the catalog index from the OCI registry, using the local Docker credentials to authenticate.
+func (s *ociSource) ReadIndex(ctx context.Context) (*Index, error) {
+	repo, err := remote.NewRepository(s.reference)
+	if err != nil {
+		return nil, fmt.Errorf("invalid catalog reference %q: %w", s.reference, err)
+	}
+
+	ds, err := credentials.NewStoreFromDocker(credentials.StoreOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	repo.Client = &auth.Client{
+		Client:     retry.DefaultClient,
+		Cache:      auth.DefaultCache,
+		Credential: ds.Get,
+	}
+
+	return readOCIIndex(ctx, repo, repo.Reference.Reference)
+}
+
+// readOCIIndex reads the catalog index from the first layer of the manifest tagged with reference in the target.
+func readOCIIndex(ctx context.Context, target oras.ReadOnlyTarget, reference string) (*Index, error) {
+	_, manifestBytes, err := oras.FetchBytes(ctx, target, reference, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the catalog manifest %q: %w", reference, err)
+	}
+
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse the catalog manifest %q: %w", reference, err)
+	}
+
+	if len(manifest.Layers) == 0 {
+		return nil, fmt.Errorf("the catalog manifest %q has no layers", reference)
+	}
+
+	b, err := content.FetchAll(ctx, target, manifest.Layers[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the catalog index %q: %w", reference, err)
+	}
+
+	return ParseIndex(b)
+}
+
+// CloneFunc clones the ref of a git repository to dir. An empty ref clones the default branch.
+type CloneFunc func(ctx context.Context, url string, ref string, dir string) error
+
+// gitSource reads the catalog index from a file of a git repository.
+type gitSource struct {
+	url   string
+	ref   string
+	path  string
+	clone CloneFunc
+}
+
+func newGitSource(location string) (*gitSource, error) {
+	s := &gitSource{path: DefaultIndexFile, clone: gitClone}
+
+	if i := strings.LastIndex(location, "?ref="); i >= 0 {
+		s.ref = location[i+len("?ref="):]
+		location = location[:i]
+	}
+
+	// The path of the index is separated from the URL of the repository by '//', which is also part of the scheme.
+	start := 0
+	if i := strings.Index(location, "://"); i >= 0 {
+		start = i + len("://")
+	}
+	if i := strings.Index(location[start:], "//"); i >= 0 {
+		s.path = path.Clean(location[start+i+len("//"):])
+		location = location[:start+i]
+	}
+	s.url = location
+
+	if s.url == "" {
+		return nil, fmt.Errorf("the git repository of the recipe catalog must be specified")
+	}
+	if s.path == "." || strings.HasPrefix(s.path, "../") || path.IsAbs(s.path) {
+		return nil, fmt.Errorf("invalid path %q of the catalog index in the git repository", s.path)
+	}
+
+	return s, nil
+}
+
+// ReadIndex clones the git repository to a temporary directory and reads the catalog index from it.
+func (s *gitSource) ReadIndex(ctx context.Context) (*Index, error) {
+	dir, err := os.MkdirTemp("", "rad-recipe-catalog-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := s.clone(ctx, s.url, s.ref, dir); err != nil {
+		return nil, fmt.Errorf("failed to clone the catalog repository %q: %w", s.url, err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(s.path)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the catalog index %q from the repository %q: %w", s.path, s.url, err)
+	}
+
+	return ParseIndex(b)
+}
+
+// gitClone makes a shallow clone of the git repository using the git executable.
+func gitClone(ctx context.Context, url string, ref string, dir string) error {
+	args := []string{"clone", "--depth", "1"}
+	if ref != "" {
+		args = append(args, "--branch", ref)
+	}
+	args = append(args, "--", url, dir)
+
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
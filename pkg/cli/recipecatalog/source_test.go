@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipecatalog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+func Test_NewSource(t *testing.T) {
+	tests := []struct {
+		location string
+		expected Source
+		err      string
+	}{
+		{
+			location: "ghcr.io/myorg/recipes/catalog:latest",
+			expected: &ociSource{reference: "ghcr.io/myorg/recipes/catalog:latest"},
+		},
+		{
+			location: "git::https://github.com/myorg/recipes.git",
+			expected: &gitSource{url: "https://github.com/myorg/recipes.git", path: "index.yaml"},
+		},
+		{
+			location: "git::https://github.com/myorg/recipes.git//catalog/index.yaml?ref=v1",
+			expected: &gitSource{url: "https://github.com/myorg/recipes.git", path: "catalog/index.yaml", ref: "v1"},
+		},
+		{
+			location: "git::git@github.com:myorg/recipes.git?ref=main",
+			expected: &gitSource{url: "git@github.com:myorg/recipes.git", path: "index.yaml", ref: "main"},
+		},
+		{
+			location: "",
+			err:      "the location of the recipe catalog must be specified",
+		},
+		{
+			location: "git::",
+			err:      "the git repository of the recipe catalog must be specified",
+		},
+		{
+			location: "git::https://github.com/myorg/recipes.git//../index.yaml",
+			err:      `invalid path "../index.yaml" of the catalog index in the git repository`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			source, err := NewSource(tt.location)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			if git, ok := source.(*gitSource); ok {
+				require.NotNil(t, git.clone)
+				git.clone = nil
+			}
+			require.Equal(t, tt.expected, source)
+		})
+	}
+}
+
+func Test_GitSource_ReadIndex(t *testing.T) {
+	source, err := newGitSource("https://github.com/myorg/recipes.git//catalog/index.yaml?ref=v1")
+	require.NoError(t, err)
+
+	source.clone = func(ctx context.Context, url string, ref string, dir string) error {
+		require.Equal(t, "https://github.com/myorg/recipes.git", url)
+		require.Equal(t, "v1", ref)
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "catalog"), 0755))
+		return os.WriteFile(filepath.Join(dir, "catalog", "index.yaml"), []byte(testIndex), 0644)
+	}
+
+	index, err := source.ReadIndex(context.Background())
+	require.NoError(t, err)
+	require.Len(t, index.Recipes, 3)
+}
+
+func Test_GitSource_ReadIndex_Errors(t *testing.T) {
+	source, err := newGitSource("https://github.com/myorg/recipes.git")
+	require.NoError(t, err)
+
+	source.clone = func(ctx context.Context, url string, ref string, dir string) error {
+		return errors.New("repository not found")
+	}
+	_, err = source.ReadIndex(context.Background())
+	require.EqualError(t, err, `failed to clone the catalog repository "https://github.com/myorg/recipes.git": repository not found`)
+
+	source.clone = func(ctx context.Context, url string, ref string, dir string) error {
+		return nil
+	}
+	_, err = source.ReadIndex(context.Background())
+	require.ErrorContains(t, err, `failed to read the catalog index "index.yaml" from the repository "https://github.com/myorg/recipes.git"`)
+}
+
+func Test_ReadOCIIndex(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+
+	push := func(mediaType string, b []byte) ocispec.Descriptor {
+		desc := content.NewDescriptorFromBytes(mediaType, b)
+		require.NoError(t, store.Push(ctx, desc, bytes.NewReader(b)))
+		return desc
+	}
+
+	layer := push("application/vnd.radius.recipe-catalog.v1+yaml", []byte(testIndex))
+	manifest, err := oras.Pack(ctx, store, "application/vnd.radius.recipe-catalog", []ocispec.Descriptor{layer}, oras.PackOptions{PackImageManifest: true})
+	require.NoError(t, err)
+	require.NoError(t, store.Tag(ctx, manifest, "latest"))
+
+	index, err := readOCIIndex(ctx, store, "latest")
+	require.NoError(t, err)
+	require.Len(t, index.Recipes, 3)
+
+	_, err = readOCIIndex(ctx, store, "missing")
+	require.ErrorContains(t, err, `failed to fetch the catalog manifest "missing"`)
+}
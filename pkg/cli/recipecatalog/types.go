@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipecatalog
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ParameterTypeString is the type of a string parameter.
+	ParameterTypeString = "string"
+	// ParameterTypeInt is the type of an integer parameter.
+	ParameterTypeInt = "int"
+	// ParameterTypeBool is the type of a boolean parameter.
+	ParameterTypeBool = "bool"
+	// ParameterTypeObject is the type of an object parameter.
+	ParameterTypeObject = "object"
+	// ParameterTypeArray is the type of an array parameter.
+	ParameterTypeArray = "array"
+)
+
+// Index is the index of a recipe catalog. It lists the recipes published in the catalog.
+type Index struct {
+	// Recipes are the recipes published in the catalog.
+	Recipes []Entry `json:"recipes" yaml:"recipes"`
+}
+
+// Entry is a recipe published in a recipe catalog.
+type Entry struct {
+	// Name is the name of the recipe in the catalog.
+	Name string `json:"name" yaml:"name"`
+	// ResourceType is the type of the portable resource the recipe can be consumed by.
+	ResourceType string `json:"resourceType" yaml:"resourceType"`
+	// TemplateKind is the kind of the template of the recipe, either bicep, terraform or helm.
+	TemplateKind string `json:"templateKind" yaml:"templateKind"`
+	// Description is the description of the recipe.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Parameters is the schema of the parameters accepted by the recipe, keyed by parameter name.
+	Parameters map[string]ParameterSchema `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	// Versions are the published versions of the recipe, newest first.
+	Versions []Version `json:"versions" yaml:"versions"`
+}
+
+// ParameterSchema is the schema of a recipe parameter.
+type ParameterSchema struct {
+	// Type is the type of the parameter: string, int, bool, object or array.
+	Type string `json:"type" yaml:"type"`
+	// Description is the description of the parameter.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Required is true if a value must be provided for the parameter when the recipe is installed.
+	Required bool `json:"required,omitempty" yaml:"required,omitempty"`
+	// DefaultValue is the value used by the recipe when the parameter is not provided.
+	DefaultValue any `json:"defaultValue,omitempty" yaml:"defaultValue,omitempty"`
+	// AllowedValues restricts the values of the parameter, if not empty.
+	AllowedValues []any `json:"allowedValues,omitempty" yaml:"allowedValues,omitempty"`
+}
+
+// Version is a published version of a recipe.
+type Version struct {
+	// Version is the version of the recipe. It is used as the template version of Terraform and Helm recipes.
+	Version string `json:"version" yaml:"version"`
+	// TemplatePath is the path of the template of this version of the recipe.
+	TemplatePath string `json:"templatePath" yaml:"templatePath"`
+}
+
+// ParseIndex parses a catalog index in YAML or JSON format and validates its entries. It returns an error if the index
+// can not be parsed, or if an entry is missing a required field, has an unsupported template kind or parameter type, or
+// is published more than once under the same name.
+func ParseIndex(b []byte) (*Index, error) {
+	index := &Index{}
+	if err := yaml.Unmarshal(b, index); err != nil {
+		return nil, fmt.Errorf("failed to parse the catalog index: %w", err)
+	}
+
+	names := map[string]bool{}
+	for _, entry := range index.Recipes {
+		if err := entry.validate(); err != nil {
+			return nil, err
+		}
+
+		key := strings.ToLower(entry.Name)
+		if names[key] {
+			return nil, fmt.Errorf("recipe %q is published more than once in the catalog index", entry.Name)
+		}
+		names[key] = true
+	}
+
+	return index, nil
+}
+
+// Search returns the entries of the index whose name, resource type or description contains the query, ignoring case.
+// If resourceType is not empty only the entries for that resource type are returned. An empty query matches every entry.
+// The entries are sorted by resource type and name.
+func (i *Index) Search(query string, resourceType string) []Entry {
+	query = strings.ToLower(query)
+	results := []Entry{}
+	for _, entry := range i.Recipes {
+		if resourceType != "" && !strings.EqualFold(entry.ResourceType, resourceType) {
+			continue
+		}
+
+		if query == "" ||
+			strings.Contains(strings.ToLower(entry.Name), query) ||
+			strings.Contains(strings.ToLower(entry.ResourceType), query) ||
+			strings.Contains(strings.ToLower(entry.Description), query) {
+			results = append(results, entry)
+		}
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		if !strings.EqualFold(results[a].ResourceType, results[b].ResourceType) {
+			return strings.ToLower(results[a].ResourceType) < strings.ToLower(results[b].ResourceType)
+		}
+		return strings.ToLower(results[a].Name) < strings.ToLower(results[b].Name)
+	})
+	return results
+}
+
+// Find returns the entry of the index with the given name, ignoring case, or nil if the catalog has no such recipe.
+func (i *Index) Find(name string) *Entry {
+	for idx := range i.Recipes {
+		if strings.EqualFold(i.Recipes[idx].Name, name) {
+			return &i.Recipes[idx]
+		}
+	}
+
+	return nil
+}
+
+// LatestVersion returns the newest published version of the recipe.
+func (e *Entry) LatestVersion() Version {
+	return e.Versions[0]
+}
+
+// FindVersion returns the published version of the recipe with the given version. An empty version returns the newest version.
+func (e *Entry) FindVersion(version string) (Version, error) {
+	if version == "" {
+		return e.LatestVersion(), nil
+	}
+
+	for _, v := range e.Versions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+
+	return Version{}, fmt.Errorf("version %q of recipe %q is not published in the catalog, available versions are: %s", version, e.Name, strings.Join(e.VersionNames(), ", "))
+}
+
+// VersionNames returns the names of the published versions of the recipe, newest first.
+func (e *Entry) VersionNames() []string {
+	names := make([]string, len(e.Versions))
+	for i, v := range e.Versions {
+		names[i] = v.Version
+	}
+	return names
+}
+
+func (e *Entry) validate() error {
+	if e.Name == "" {
+		return fmt.Errorf("a recipe in the catalog index has no name")
+	}
+	if e.ResourceType == "" {
+		return fmt.Errorf("recipe %q in the catalog index has no resource type", e.Name)
+	}
+	if !slices.Contains(recipes.SupportedTemplateKind, e.TemplateKind) {
+		return fmt.Errorf("recipe %q in the catalog index has unsupported template kind %q, expected one of %q", e.Name, e.TemplateKind, recipes.SupportedTemplateKind)
+	}
+	if len(e.Versions) == 0 {
+		return fmt.Errorf("recipe %q in the catalog index has no versions", e.Name)
+	}
+	for _, v := range e.Versions {
+		if v.Version == "" || v.TemplatePath == "" {
+			return fmt.Errorf("recipe %q in the catalog index has a version without a version or template path", e.Name)
+		}
+	}
+	for name, parameter := range e.Parameters {
+		switch parameter.Type {
+		case ParameterTypeString, ParameterTypeInt, ParameterTypeBool, ParameterTypeObject, ParameterTypeArray:
+		default:
+			return fmt.Errorf("parameter %q of recipe %q in the catalog index has unsupported type %q", name, e.Name, parameter.Type)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipecatalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testIndex = `
+recipes:
+  - name: redis-azure
+    resourceType: Applications.Datastores/redisCaches
+    templateKind: bicep
+    description: Azure Cache for Redis
+    parameters:
+      sku:
+        type: string
+        allowedValues: [Basic, Standard, Premium]
+      capacity:
+        type: int
+        defaultValue: 1
+    versions:
+      - version: 1.1.0
+        templatePath: ghcr.io/myorg/recipes/azure/rediscaches:1.1.0
+      - version: 1.0.0
+        templatePath: ghcr.io/myorg/recipes/azure/rediscaches:1.0.0
+  - name: redis-kubernetes
+    resourceType: Applications.Datastores/redisCaches
+    templateKind: terraform
+    versions:
+      - version: 2.0.0
+        templatePath: myorg/redis/kubernetes
+  - name: mongo-azure
+    resourceType: Applications.Datastores/mongoDatabases
+    templateKind: bicep
+    description: Azure Cosmos DB for MongoDB
+    versions:
+      - version: 1.0.0
+        templatePath: ghcr.io/myorg/recipes/azure/mongodatabases:1.0.0
+`
+
+func Test_ParseIndex(t *testing.T) {
+	index, err := ParseIndex([]byte(testIndex))
+	require.NoError(t, err)
+	require.Len(t, index.Recipes, 3)
+
+	redis := index.Recipes[0]
+	require.Equal(t, "redis-azure", redis.Name)
+	require.Equal(t, ParameterSchema{Type: ParameterTypeInt, DefaultValue: 1}, redis.Parameters["capacity"])
+	require.Equal(t, []any{"Basic", "Standard", "Premium"}, redis.Parameters["sku"].AllowedValues)
+	require.Equal(t, []string{"1.1.0", "1.0.0"}, redis.VersionNames())
+}
+
+func Test_ParseIndex_JSON(t *testing.T) {
+	index, err := ParseIndex([]byte(`{"recipes":[{"name":"redis","resourceType":"Applications.Datastores/redisCaches","templateKind":"terraform","versions":[{"version":"1.0.0","templatePath":"myorg/redis/kubernetes"}]}]}`))
+	require.NoError(t, err)
+	require.Equal(t, "redis", index.Recipes[0].Name)
+}
+
+func Test_ParseIndex_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		index    string
+		expected string
+	}{
+		{
+			name:     "invalid yaml",
+			index:    "recipes: [",
+			expected: "failed to parse the catalog index",
+		},
+		{
+			name:     "missing name",
+			index:    "recipes:\n  - resourceType: Applications.Datastores/redisCaches",
+			expected: "a recipe in the catalog index has no name",
+		},
+		{
+			name:     "missing resource type",
+			index:    "recipes:\n  - name: redis",
+			expected: `recipe "redis" in the catalog index has no resource type`,
+		},
+		{
+			name:     "unsupported template kind",
+			index:    "recipes:\n  - name: redis\n    resourceType: Applications.Datastores/redisCaches\n    templateKind: pulumi",
+			expected: `recipe "redis" in the catalog index has unsupported template kind "pulumi"`,
+		},
+		{
+			name:     "no versions",
+			index:    "recipes:\n  - name: redis\n    resourceType: Applications.Datastores/redisCaches\n    templateKind: bicep",
+			expected: `recipe "redis" in the catalog index has no versions`,
+		},
+		{
+			name:     "version without template path",
+			index:    "recipes:\n  - name: redis\n    resourceType: Applications.Datastores/redisCaches\n    templateKind: bicep\n    versions:\n      - version: 1.0.0",
+			expected: `recipe "redis" in the catalog index has a version without a version or template path`,
+		},
+		{
+			name:     "unsupported parameter type",
+			index:    "recipes:\n  - name: redis\n    resourceType: Applications.Datastores/redisCaches\n    templateKind: bicep\n    parameters:\n      size:\n        type: number\n    versions:\n      - version: 1.0.0\n        templatePath: redis:1.0.0",
+			expected: `parameter "size" of recipe "redis" in the catalog index has unsupported type "number"`,
+		},
+		{
+			name:     "duplicate recipe",
+			index:    "recipes:\n  - name: redis\n    resourceType: Applications.Datastores/redisCaches\n    templateKind: bicep\n    versions:\n      - version: 1.0.0\n        templatePath: redis:1.0.0\n  - name: Redis\n    resourceType: Applications.Datastores/redisCaches\n    templateKind: bicep\n    versions:\n      - version: 1.0.0\n        templatePath: redis:1.0.0",
+			expected: `recipe "Redis" is published more than once in the catalog index`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseIndex([]byte(tt.index))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
+
+func Test_Index_Search(t *testing.T) {
+	index, err := ParseIndex([]byte(testIndex))
+	require.NoError(t, err)
+
+	names := func(entries []Entry) []string {
+		result := []string{}
+		for _, e := range entries {
+			result = append(result, e.Name)
+		}
+		return result
+	}
+
+	require.Equal(t, []string{"mongo-azure", "redis-azure", "redis-kubernetes"}, names(index.Search("", "")))
+	require.Equal(t, []string{"redis-azure", "redis-kubernetes"}, names(index.Search("REDIS", "")))
+	require.Equal(t, []string{"mongo-azure"}, names(index.Search("cosmos", "")))
+	require.Equal(t, []string{"mongo-azure", "redis-azure"}, names(index.Search("azure", "")))
+	require.Equal(t, []string{"redis-azure"}, names(index.Search("azure", "applications.datastores/rediscaches")))
+	require.Empty(t, index.Search("sql", ""))
+}
+
+func Test_Index_Find(t *testing.T) {
+	index, err := ParseIndex([]byte(testIndex))
+	require.NoError(t, err)
+
+	entry := index.Find("Redis-Azure")
+	require.NotNil(t, entry)
+	require.Equal(t, "redis-azure", entry.Name)
+
+	require.Nil(t, index.Find("sql"))
+}
+
+func Test_Entry_FindVersion(t *testing.T) {
+	index, err := ParseIndex([]byte(testIndex))
+	require.NoError(t, err)
+	entry := index.Find("redis-azure")
+
+	version, err := entry.FindVersion("")
+	require.NoError(t, err)
+	require.Equal(t, Version{Version: "1.1.0", TemplatePath: "ghcr.io/myorg/recipes/azure/rediscaches:1.1.0"}, version)
+
+	version, err = entry.FindVersion("1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "ghcr.io/myorg/recipes/azure/rediscaches:1.0.0", version.TemplatePath)
+
+	_, err = entry.FindVersion("2.0.0")
+	require.EqualError(t, err, `version "2.0.0" of recipe "redis-azure" is not published in the catalog, available versions are: 1.1.0, 1.0.0`)
+}
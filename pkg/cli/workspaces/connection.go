@@ -19,6 +19,7 @@ package workspaces
 import (
 	"fmt"
 	"net/url"
+	"os"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
@@ -28,6 +29,19 @@ import (
 
 const KindKubernetes string = "kubernetes"
 
+const (
+	// AuthenticationKindKubernetes authenticates with the bearer token of the Kubernetes context of the workspace.
+	AuthenticationKindKubernetes string = "kubernetes"
+
+	// AuthenticationKindOIDC authenticates with the access tokens issued by an OpenID Connect provider to a client
+	// application with the client credentials flow.
+	AuthenticationKindOIDC string = "oidc"
+
+	// OIDCClientSecretEnvVar is the environment variable storing the secret of the client application used by
+	// the OIDC authentication.
+	OIDCClientSecretEnvVar string = "RADIUS_OIDC_CLIENT_SECRET"
+)
+
 // MakeFallbackWorkspace creates an un-named workspace that will use the current KubeContext.
 // This is is used in fallback cases where the user has no config.
 //
@@ -157,6 +171,27 @@ type KubernetesConnectionConfig struct {
 
 	// Overrides describes local overrides for testing purposes. This field is optional.
 	Overrides KubernetesConnectionOverrides `json:"overrides,omitempty" mapstructure:"overrides" yaml:"overrides,omitempty"`
+
+	// Authentication describes how to authenticate with a control plane which requires authentication when connecting
+	// to UCP directly with the UCP override. The requests sent through the Kubernetes API server are authenticated by
+	// Kubernetes. This field is optional.
+	Authentication *AuthenticationConfig `json:"authentication,omitempty" mapstructure:"authentication" yaml:"authentication,omitempty"`
+}
+
+// AuthenticationConfig describes how to authenticate with a control plane which requires authentication.
+type AuthenticationConfig struct {
+	// Kind specifies the kind of authentication: 'kubernetes' or 'oidc'.
+	Kind string `json:"kind" mapstructure:"kind" yaml:"kind"`
+
+	// Issuer is the URL of the OpenID Connect provider. Required for the 'oidc' kind.
+	Issuer string `json:"issuer,omitempty" mapstructure:"issuer" yaml:"issuer,omitempty"`
+
+	// ClientID is the ID of the client application. Required for the 'oidc' kind. The secret of the client
+	// application is read from the RADIUS_OIDC_CLIENT_SECRET environment variable.
+	ClientID string `json:"clientId,omitempty" mapstructure:"clientId" yaml:"clientId,omitempty"`
+
+	// Scopes is the list of the scopes requested for the access tokens of the 'oidc' kind. This field is optional.
+	Scopes []string `json:"scopes,omitempty" mapstructure:"scopes" yaml:"scopes,omitempty"`
 }
 
 type KubernetesConnectionOverrides struct {
@@ -178,8 +213,9 @@ func (c *KubernetesConnectionConfig) GetKind() string {
 	return KindKubernetes
 }
 
-// Connect() checks if a URL is provided in the Overrides field, and if so, creates a direct connection to the URL. If no URL
-// is provided, it creates a connection to Kubernetes using the provided context. If an error occurs, an error is returned.
+// Connect() checks if a URL is provided in the Overrides field, and if so, creates a direct connection to the URL, authenticated
+// with the credentials described by the Authentication field if set. If no URL is provided, it creates a connection to Kubernetes
+// using the provided context. If an error occurs, an error is returned.
 func (c *KubernetesConnectionConfig) Connect() (sdk.Connection, error) {
 	if c.Overrides.UCP != "" {
 		strURL := strings.TrimSuffix(c.Overrides.UCP, "/")
@@ -188,7 +224,16 @@ func (c *KubernetesConnectionConfig) Connect() (sdk.Connection, error) {
 		if err != nil {
 			return nil, err
 		}
-		return sdk.NewDirectConnection(strURL)
+
+		if c.Authentication == nil {
+			return sdk.NewDirectConnection(strURL)
+		}
+
+		credentials, err := c.credentials()
+		if err != nil {
+			return nil, err
+		}
+		return sdk.NewDirectConnectionWithCredentials(strURL, credentials)
 	}
 
 	config, err := kubernetes.NewCLIClientConfig(c.Context)
@@ -198,3 +243,29 @@ func (c *KubernetesConnectionConfig) Connect() (sdk.Connection, error) {
 
 	return sdk.NewKubernetesConnectionFromConfig(config)
 }
+
+// credentials returns the credentials described by the Authentication field.
+func (c *KubernetesConnectionConfig) credentials() (sdk.Credentials, error) {
+	switch c.Authentication.Kind {
+	case AuthenticationKindKubernetes:
+		config, err := kubernetes.NewCLIClientConfig(c.Context)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.NewKubernetesCredentials(config), nil
+
+	case AuthenticationKindOIDC:
+		if c.Authentication.Issuer == "" || c.Authentication.ClientID == "" {
+			return nil, fmt.Errorf("workspace fields '$.connection.authentication.issuer' and '$.connection.authentication.clientId' are required for the '%s' authentication", AuthenticationKindOIDC)
+		}
+
+		secret := os.Getenv(OIDCClientSecretEnvVar)
+		if secret == "" {
+			return nil, fmt.Errorf("the environment variable %s must be set to the secret of the client application '%s'", OIDCClientSecretEnvVar, c.Authentication.ClientID)
+		}
+		return sdk.NewOIDCClientCredentials(c.Authentication.Issuer, c.Authentication.ClientID, secret, c.Authentication.Scopes), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported authentication kind '%s'", c.Authentication.Kind)
+	}
+}
@@ -43,3 +43,48 @@ func Test_IsSameKubernetesContext(t *testing.T) {
 	require.Equal(t, isSame, false)
 
 }
+
+func Test_KubernetesConnectionConfig_Connect_Authentication(t *testing.T) {
+	t.Run("oidc without client secret", func(t *testing.T) {
+		t.Setenv(OIDCClientSecretEnvVar, "")
+		config := &KubernetesConnectionConfig{
+			Overrides:      KubernetesConnectionOverrides{UCP: "http://localhost:9000"},
+			Authentication: &AuthenticationConfig{Kind: AuthenticationKindOIDC, Issuer: "https://login.example.com", ClientID: "rad"},
+		}
+
+		_, err := config.Connect()
+		require.ErrorContains(t, err, OIDCClientSecretEnvVar)
+	})
+
+	t.Run("oidc without issuer", func(t *testing.T) {
+		config := &KubernetesConnectionConfig{
+			Overrides:      KubernetesConnectionOverrides{UCP: "http://localhost:9000"},
+			Authentication: &AuthenticationConfig{Kind: AuthenticationKindOIDC, ClientID: "rad"},
+		}
+
+		_, err := config.Connect()
+		require.ErrorContains(t, err, "$.connection.authentication.issuer")
+	})
+
+	t.Run("oidc", func(t *testing.T) {
+		t.Setenv(OIDCClientSecretEnvVar, "secret")
+		config := &KubernetesConnectionConfig{
+			Overrides:      KubernetesConnectionOverrides{UCP: "http://localhost:9000/"},
+			Authentication: &AuthenticationConfig{Kind: AuthenticationKindOIDC, Issuer: "https://login.example.com", ClientID: "rad"},
+		}
+
+		connection, err := config.Connect()
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost:9000/apis/api.ucp.dev/v1alpha3", connection.Endpoint())
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		config := &KubernetesConnectionConfig{
+			Overrides:      KubernetesConnectionOverrides{UCP: "http://localhost:9000"},
+			Authentication: &AuthenticationConfig{Kind: "basic"},
+		}
+
+		_, err := config.Connect()
+		require.ErrorContains(t, err, "unsupported authentication kind 'basic'")
+	})
+}
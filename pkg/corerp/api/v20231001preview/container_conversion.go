@@ -124,7 +124,7 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			Container: datamodel.Container{
 				Image:           to.String(src.Properties.Container.Image),
 				ImagePullPolicy: toImagePullPolicyDataModel(src.Properties.Container.ImagePullPolicy),
-				Env:             to.StringMap(src.Properties.Container.Env),
+				Env:             toEnvironmentVariableDataModel(src.Properties.Container.Env),
 				LivenessProbe:   livenessProbe,
 				Ports:           ports,
 				ReadinessProbe:  readinessProbe,
@@ -132,7 +132,10 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 				Command:         stringSlice(src.Properties.Container.Command),
 				Args:            stringSlice(src.Properties.Container.Args),
 				WorkingDir:      to.String(src.Properties.Container.WorkingDir),
+				Resources:       toContainerResourcesDataModel(src.Properties.Container.Resources),
 			},
+			InitContainers:       toAdditionalContainersDataModel(src.Properties.InitContainers),
+			Sidecars:             toAdditionalContainersDataModel(src.Properties.Sidecars),
 			Extensions:           extensions,
 			Runtimes:             toRuntimePropertiesDataModel(src.Properties.Runtimes),
 			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
@@ -251,7 +254,7 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		Container: &Container{
 			Image:           to.Ptr(c.Properties.Container.Image),
 			ImagePullPolicy: fromImagePullPolicyDataModel(c.Properties.Container.ImagePullPolicy),
-			Env:             *to.StringMapPtr(c.Properties.Container.Env),
+			Env:             fromEnvironmentVariableDataModel(c.Properties.Container.Env),
 			LivenessProbe:   livenessProbe,
 			Ports:           ports,
 			ReadinessProbe:  readinessProbe,
@@ -259,7 +262,10 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 			Command:         to.SliceOfPtrs(c.Properties.Container.Command...),
 			Args:            to.SliceOfPtrs(c.Properties.Container.Args...),
 			WorkingDir:      to.Ptr(c.Properties.Container.WorkingDir),
+			Resources:       fromContainerResourcesDataModel(c.Properties.Container.Resources),
 		},
+		InitContainers:       fromAdditionalContainersDataModel(c.Properties.InitContainers),
+		Sidecars:             fromAdditionalContainersDataModel(c.Properties.Sidecars),
 		Extensions:           extensions,
 		Identity:             identity,
 		Runtimes:             fromRuntimePropertiesDataModel(c.Properties.Runtimes),
@@ -634,6 +640,16 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Replicas: c.Replicas,
 			},
 		}
+	case *AutoScalingExtension:
+		return datamodel.Extension{
+			Kind: datamodel.AutoScaling,
+			AutoScaling: &datamodel.AutoScalingExtension{
+				MinReplicas:             c.MinReplicas,
+				MaxReplicas:             to.Int32(c.MaxReplicas),
+				TargetCPUUtilization:    c.TargetCPUUtilization,
+				TargetMemoryUtilization: c.TargetMemoryUtilization,
+			},
+		}
 	case *DaprSidecarExtension:
 		return datamodel.Extension{
 			Kind: datamodel.DaprSidecar,
@@ -665,6 +681,14 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 			Kind:     to.Ptr(string(e.Kind)),
 			Replicas: e.ManualScaling.Replicas,
 		}
+	case datamodel.AutoScaling:
+		return &AutoScalingExtension{
+			Kind:                    to.Ptr(string(e.Kind)),
+			MinReplicas:             e.AutoScaling.MinReplicas,
+			MaxReplicas:             to.Ptr(e.AutoScaling.MaxReplicas),
+			TargetCPUUtilization:    e.AutoScaling.TargetCPUUtilization,
+			TargetMemoryUtilization: e.AutoScaling.TargetMemoryUtilization,
+		}
 	case datamodel.DaprSidecar:
 		return &DaprSidecarExtension{
 			Kind:     to.Ptr(string(e.Kind)),
@@ -685,6 +709,227 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 	return nil
 }
 
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentVariable. An environment variable is
+// either an EnvironmentVariable object or a plain string, which is read as the literal value of the variable.
+func (e *EnvironmentVariable) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*e = EnvironmentVariable{Value: &value}
+		return nil
+	}
+
+	return e.unmarshalObjectJSON(data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type EnvironmentVariableUpdate. An environment variable
+// is either an EnvironmentVariableUpdate object or a plain string, which is read as the literal value of the variable.
+func (e *EnvironmentVariableUpdate) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*e = EnvironmentVariableUpdate{Value: &value}
+		return nil
+	}
+
+	return e.unmarshalObjectJSON(data)
+}
+
+func toEnvironmentVariableDataModel(e map[string]*EnvironmentVariable) map[string]datamodel.EnvironmentVariable {
+	if e == nil {
+		return nil
+	}
+
+	m := map[string]datamodel.EnvironmentVariable{}
+	for key, val := range e {
+		if val == nil {
+			continue
+		}
+
+		env := datamodel.EnvironmentVariable{
+			Value: val.Value,
+		}
+		if val.ValueFrom != nil && val.ValueFrom.SecretRef != nil {
+			env.ValueFrom = &datamodel.EnvironmentVariableReference{
+				SecretRef: &datamodel.EnvironmentVariableSecretReference{
+					Source: to.String(val.ValueFrom.SecretRef.Source),
+					Key:    to.String(val.ValueFrom.SecretRef.Key),
+				},
+			}
+		}
+		m[key] = env
+	}
+
+	return m
+}
+
+func fromEnvironmentVariableDataModel(e map[string]datamodel.EnvironmentVariable) map[string]*EnvironmentVariable {
+	m := map[string]*EnvironmentVariable{}
+	for key, val := range e {
+		env := &EnvironmentVariable{
+			Value: val.Value,
+		}
+		if val.ValueFrom != nil && val.ValueFrom.SecretRef != nil {
+			env.ValueFrom = &EnvironmentVariableReference{
+				SecretRef: &SecretReference{
+					Source: to.Ptr(val.ValueFrom.SecretRef.Source),
+					Key:    to.Ptr(val.ValueFrom.SecretRef.Key),
+				},
+			}
+		}
+		m[key] = env
+	}
+
+	return m
+}
+
+func toContainerResourcesDataModel(r *ContainerResources) *datamodel.ResourceRequirements {
+	if r == nil {
+		return nil
+	}
+
+	return &datamodel.ResourceRequirements{
+		Requests: toComputeResourcesDataModel(r.Requests),
+		Limits:   toComputeResourcesDataModel(r.Limits),
+	}
+}
+
+func fromContainerResourcesDataModel(r *datamodel.ResourceRequirements) *ContainerResources {
+	if r == nil {
+		return nil
+	}
+
+	return &ContainerResources{
+		Requests: fromComputeResourcesDataModel(r.Requests),
+		Limits:   fromComputeResourcesDataModel(r.Limits),
+	}
+}
+
+func toComputeResourcesDataModel(c *ComputeResources) *datamodel.ComputeResources {
+	if c == nil {
+		return nil
+	}
+
+	return &datamodel.ComputeResources{
+		CPU:    to.String(c.CPU),
+		Memory: to.String(c.Memory),
+	}
+}
+
+func fromComputeResourcesDataModel(c *datamodel.ComputeResources) *ComputeResources {
+	if c == nil {
+		return nil
+	}
+
+	return &ComputeResources{
+		CPU:    toStringPtr(c.CPU),
+		Memory: toStringPtr(c.Memory),
+	}
+}
+
+func toAdditionalContainersDataModel(containers []*AdditionalContainer) []datamodel.AdditionalContainer {
+	if containers == nil {
+		return nil
+	}
+
+	converted := []datamodel.AdditionalContainer{}
+	for _, c := range containers {
+		if c == nil {
+			continue
+		}
+
+		var ports map[string]datamodel.AdditionalContainerPort
+		if c.Ports != nil {
+			ports = map[string]datamodel.AdditionalContainerPort{}
+			for key, val := range c.Ports {
+				if val == nil {
+					continue
+				}
+
+				ports[key] = datamodel.AdditionalContainerPort{
+					ContainerPort: to.Int32(val.ContainerPort),
+					Protocol:      toPortProtocolDataModel(val.Protocol),
+				}
+			}
+		}
+
+		var volumeMounts []datamodel.VolumeMount
+		for _, vm := range c.VolumeMounts {
+			if vm == nil {
+				continue
+			}
+
+			volumeMounts = append(volumeMounts, datamodel.VolumeMount{
+				Volume:    to.String(vm.Volume),
+				MountPath: to.String(vm.MountPath),
+				ReadOnly:  to.Bool(vm.ReadOnly),
+			})
+		}
+
+		converted = append(converted, datamodel.AdditionalContainer{
+			Name:            to.String(c.Name),
+			Image:           to.String(c.Image),
+			ImagePullPolicy: toImagePullPolicyDataModel(c.ImagePullPolicy),
+			Env:             toEnvironmentVariableDataModel(c.Env),
+			Ports:           ports,
+			VolumeMounts:    volumeMounts,
+			Command:         stringSlice(c.Command),
+			Args:            stringSlice(c.Args),
+			WorkingDir:      to.String(c.WorkingDir),
+			Resources:       toContainerResourcesDataModel(c.Resources),
+		})
+	}
+
+	return converted
+}
+
+func fromAdditionalContainersDataModel(containers []datamodel.AdditionalContainer) []*AdditionalContainer {
+	if containers == nil {
+		return nil
+	}
+
+	converted := []*AdditionalContainer{}
+	for _, c := range containers {
+		var ports map[string]*AdditionalContainerPort
+		if c.Ports != nil {
+			ports = map[string]*AdditionalContainerPort{}
+			for key, val := range c.Ports {
+				ports[key] = &AdditionalContainerPort{
+					ContainerPort: to.Ptr(val.ContainerPort),
+					Protocol:      fromPortProtocolDataModel(val.Protocol),
+				}
+			}
+		}
+
+		var env map[string]*EnvironmentVariable
+		if c.Env != nil {
+			env = fromEnvironmentVariableDataModel(c.Env)
+		}
+
+		var volumeMounts []*VolumeMount
+		for _, vm := range c.VolumeMounts {
+			volumeMounts = append(volumeMounts, &VolumeMount{
+				Volume:    to.Ptr(vm.Volume),
+				MountPath: to.Ptr(vm.MountPath),
+				ReadOnly:  to.Ptr(vm.ReadOnly),
+			})
+		}
+
+		converted = append(converted, &AdditionalContainer{
+			Name:            to.Ptr(c.Name),
+			Image:           to.Ptr(c.Image),
+			ImagePullPolicy: fromImagePullPolicyDataModel(c.ImagePullPolicy),
+			Env:             env,
+			Ports:           ports,
+			VolumeMounts:    volumeMounts,
+			Command:         to.SliceOfPtrs(c.Command...),
+			Args:            to.SliceOfPtrs(c.Args...),
+			WorkingDir:      toStringPtr(c.WorkingDir),
+			Resources:       fromContainerResourcesDataModel(c.Resources),
+		})
+	}
+
+	return converted
+}
+
 func toHealthProbeBase(h HealthProbeProperties) datamodel.HealthProbeBase {
 	return datamodel.HealthProbeBase{
 		FailureThreshold:    h.FailureThreshold,
@@ -207,6 +207,155 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 
 }
 
+func TestContainerConvertResourcesAndAutoScaling(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-autoscaling.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, &datamodel.ResourceRequirements{
+		Requests: &datamodel.ComputeResources{CPU: "250m", Memory: "128Mi"},
+		Limits:   &datamodel.ComputeResources{CPU: "1", Memory: "512Mi"},
+	}, ct.Properties.Container.Resources)
+	require.Equal(t, []datamodel.Extension{
+		{
+			Kind: datamodel.AutoScaling,
+			AutoScaling: &datamodel.AutoScalingExtension{
+				MinReplicas:             to.Ptr[int32](2),
+				MaxReplicas:             10,
+				TargetCPUUtilization:    to.Ptr[int32](70),
+				TargetMemoryUtilization: to.Ptr[int32](80),
+			},
+		},
+	}, ct.Properties.Extensions)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Equal(t, r.Properties.Container.Resources, versioned.Properties.Container.Resources)
+	require.Equal(t, r.Properties.Extensions, versioned.Properties.Extensions)
+}
+
+func TestContainerConvertEnvironmentVariables(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-env.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, map[string]datamodel.EnvironmentVariable{
+		"LOG_LEVEL": {Value: to.Ptr("debug")},
+		"DB_PASSWORD": {
+			ValueFrom: &datamodel.EnvironmentVariableReference{
+				SecretRef: &datamodel.EnvironmentVariableSecretReference{
+					Source: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/dbsecrets",
+					Key:    "password",
+				},
+			},
+		},
+	}, ct.Properties.Container.Env)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Equal(t, r.Properties.Container.Env, versioned.Properties.Container.Env)
+}
+
+func TestContainerConvertAdditionalContainers(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-additionalcontainers.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, []datamodel.AdditionalContainer{
+		{
+			Name:    "migrate",
+			Image:   "ghcr.io/radius-project/migrations:latest",
+			Command: []string{"/bin/migrate"},
+			Args:    []string{"up"},
+			Env: map[string]datamodel.EnvironmentVariable{
+				"MIGRATIONS_DIR": {Value: to.Ptr("/migrations")},
+			},
+		},
+	}, ct.Properties.InitContainers)
+	require.Equal(t, []datamodel.AdditionalContainer{
+		{
+			Name:            "log-shipper",
+			Image:           "fluent/fluent-bit:2.2",
+			ImagePullPolicy: "IfNotPresent",
+			WorkingDir:      "/fluent-bit",
+			Ports: map[string]datamodel.AdditionalContainerPort{
+				"metrics": {ContainerPort: 2020, Protocol: datamodel.ProtocolTCP},
+			},
+			VolumeMounts: []datamodel.VolumeMount{
+				{Volume: "logs", MountPath: "/logs", ReadOnly: true},
+			},
+			Resources: &datamodel.ResourceRequirements{
+				Limits: &datamodel.ComputeResources{CPU: "100m", Memory: "64Mi"},
+			},
+		},
+	}, ct.Properties.Sidecars)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Len(t, versioned.Properties.InitContainers, 1)
+	require.Equal(t, "migrate", to.String(versioned.Properties.InitContainers[0].Name))
+	require.Len(t, versioned.Properties.Sidecars, 1)
+	require.Equal(t, r.Properties.Sidecars[0].Ports, versioned.Properties.Sidecars[0].Ports)
+	require.Equal(t, r.Properties.Sidecars[0].VolumeMounts, versioned.Properties.Sidecars[0].VolumeMounts)
+	require.Equal(t, r.Properties.Sidecars[0].Resources, versioned.Properties.Sidecars[0].Resources)
+
+	roundTripped, err := versioned.ConvertTo()
+	require.NoError(t, err)
+	require.Equal(t, ct.Properties.InitContainers, roundTripped.(*datamodel.ContainerResource).Properties.InitContainers)
+	require.Equal(t, ct.Properties.Sidecars, roundTripped.(*datamodel.ContainerResource).Properties.Sidecars)
+}
+
+func TestContainerDataModelLegacyEnvironmentVariables(t *testing.T) {
+	// Container resources stored before environment variables supported secret references hold plain strings.
+	container := &datamodel.Container{}
+	err := json.Unmarshal([]byte(`{"image":"nginx","env":{"LOG_LEVEL":"debug","EMPTY":""}}`), container)
+	require.NoError(t, err)
+	require.Equal(t, map[string]datamodel.EnvironmentVariable{
+		"LOG_LEVEL": {Value: to.Ptr("debug")},
+		"EMPTY":     {Value: to.Ptr("")},
+	}, container.Env)
+}
+
+func TestContainerStringEnvironmentVariables(t *testing.T) {
+	// Environment variables with a plain string value are read as literal values.
+	container := &Container{}
+	err := json.Unmarshal([]byte(`{"image":"nginx","env":{"LOG_LEVEL":"debug","EMPTY":"","MODE":{"value":"test"}}}`), container)
+	require.NoError(t, err)
+	require.Equal(t, map[string]*EnvironmentVariable{
+		"LOG_LEVEL": {Value: to.Ptr("debug")},
+		"EMPTY":     {Value: to.Ptr("")},
+		"MODE":      {Value: to.Ptr("test")},
+	}, container.Env)
+
+	update := &ContainerUpdate{}
+	err = json.Unmarshal([]byte(`{"env":{"LOG_LEVEL":"debug"}}`), update)
+	require.NoError(t, err)
+	require.Equal(t, map[string]*EnvironmentVariableUpdate{
+		"LOG_LEVEL": {Value: to.Ptr("debug")},
+	}, update.Env)
+
+	err = json.Unmarshal([]byte(`{"image":"nginx","env":{"LOG_LEVEL":1}}`), container)
+	require.Error(t, err)
+}
+
 func TestContainerConvertVersionedToDataModelEmptyProtocol(t *testing.T) {
 	// arrange
 	rawPayload := testutil.ReadFixture("containerresourcenegativetest.json")
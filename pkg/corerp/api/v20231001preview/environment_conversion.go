@@ -18,16 +18,20 @@ package v20231001preview
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	types "github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 
 	rp_util "github.com/radius-project/radius/pkg/rp/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 )
 
 const (
@@ -90,6 +94,12 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Recipes = envRecipes
 	}
 
+	recipeConfig, err := toRecipeConfigDataModel(src.Properties.RecipeConfig)
+	if err != nil {
+		return &datamodel.Environment{}, err
+	}
+	converted.Properties.RecipeConfig = recipeConfig
+
 	if src.Properties.Providers != nil {
 		if src.Properties.Providers.Azure != nil {
 			converted.Properties.Providers.Azure = datamodel.ProvidersAzure{
@@ -101,6 +111,11 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 				Scope: to.String(src.Properties.Providers.Aws.Scope),
 			}
 		}
+		if src.Properties.Providers.Gcp != nil {
+			converted.Properties.Providers.GCP = datamodel.ProvidersGCP{
+				Scope: to.String(src.Properties.Providers.Gcp.Scope),
+			}
+		}
 	}
 
 	if src.Properties.Simulated != nil && *src.Properties.Simulated {
@@ -151,6 +166,9 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Recipes = recipes
 	}
 
+	dst.Properties.RecipeConfig = fromRecipeConfigDataModel(env.Properties.RecipeConfig)
+	dst.Properties.RecipeUpgrade = fromRecipeUpgradeStatusDataModel(env.Properties.RecipeUpgrade)
+
 	if env.Properties.Providers != (datamodel.Providers{}) {
 		dst.Properties.Providers = &Providers{}
 		if env.Properties.Providers.Azure != (datamodel.ProvidersAzure{}) {
@@ -163,6 +181,11 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 				Scope: to.Ptr(env.Properties.Providers.AWS.Scope),
 			}
 		}
+		if env.Properties.Providers.GCP != (datamodel.ProvidersGCP{}) {
+			dst.Properties.Providers.Gcp = &ProvidersGcp{
+				Scope: to.Ptr(env.Properties.Providers.GCP.Scope),
+			}
+		}
 	}
 
 	if env.Properties.Simulated {
@@ -192,6 +215,14 @@ func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.En
 			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"}
 		}
 
+		// A resource ID in the Kubernetes plane references a cluster registered with UCP.
+		resourceID := to.String(v.ResourceID)
+		if strings.HasPrefix(strings.ToLower(resourceID), "/planes/"+resources_kubernetes.PlaneTypeKubernetes+"/") {
+			if _, err := resources_kubernetes.ParsePlaneID(resourceID); err != nil {
+				return nil, &v1.ErrModelConversion{PropertyName: "$.properties.compute.resourceId", ValidValue: "a Kubernetes plane ID such as /planes/kubernetes/{planeName}"}
+			}
+		}
+
 		var identity *rpv1.IdentitySettings
 		if v.Identity != nil {
 			identity = &rpv1.IdentitySettings{
@@ -204,7 +235,7 @@ func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.En
 		return &rpv1.EnvironmentCompute{
 			Kind: k,
 			KubernetesCompute: rpv1.KubernetesComputeProperties{
-				ResourceID: to.String(v.ResourceID),
+				ResourceID: resourceID,
 				Namespace:  to.String(v.Namespace),
 			},
 			Identity: identity,
@@ -274,6 +305,13 @@ func fromEnvExtensionClassificationDataModel(e datamodel.Extension) ExtensionCla
 			Annotations: *to.StringMapPtr(ann),
 			Labels:      *to.StringMapPtr(lbl),
 		}
+	case datamodel.GatewayAPI:
+		if e.GatewayAPI != nil {
+			return &GatewayAPIExtension{
+				Kind:             to.Ptr(string(e.Kind)),
+				GatewayClassName: to.Ptr(e.GatewayAPI.GatewayClassName),
+			}
+		}
 	}
 
 	return nil
@@ -290,6 +328,13 @@ func toEnvExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Labels:      to.StringMap(c.Labels),
 			},
 		}
+	case *GatewayAPIExtension:
+		return datamodel.Extension{
+			Kind: datamodel.GatewayAPI,
+			GatewayAPI: &datamodel.GatewayAPIExtension{
+				GatewayClassName: to.String(c.GatewayClassName),
+			},
+		}
 	}
 
 	return datamodel.Extension{}
@@ -316,6 +361,13 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			TemplatePath: to.String(c.TemplatePath),
 			Parameters:   c.Parameters,
 		}, nil
+	case *HelmRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    to.String(c.TemplatePath),
+			Parameters:      c.Parameters,
+		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
 }
@@ -335,6 +387,298 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			TemplatePath: to.Ptr(e.TemplatePath),
 			Parameters:   e.Parameters,
 		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+		}
+	}
+	return nil
+}
+
+func toRecipeConfigDataModel(config *RecipeConfigProperties) (datamodel.RecipeConfigProperties, error) {
+	if config == nil {
+		return datamodel.RecipeConfigProperties{}, nil
+	}
+
+	terraform, err := toTerraformConfigDataModel(config.Terraform)
+	if err != nil {
+		return datamodel.RecipeConfigProperties{}, err
+	}
+
+	bicep, err := toBicepConfigDataModel(config.Bicep)
+	if err != nil {
+		return datamodel.RecipeConfigProperties{}, err
+	}
+
+	return datamodel.RecipeConfigProperties{
+		Terraform: terraform,
+		Bicep:     bicep,
+	}, nil
+}
+
+func toBicepConfigDataModel(config *BicepConfigProperties) (datamodel.BicepConfigProperties, error) {
+	if config == nil || config.Authentication == nil {
+		return datamodel.BicepConfigProperties{}, nil
+	}
+
+	authentication := map[string]datamodel.RegistryAuthentication{}
+	for _, registry := range sortedKeys(config.Authentication) {
+		auth := config.Authentication[registry]
+		if auth == nil {
+			continue
+		}
+
+		secret := to.String(auth.Secret)
+		id, err := resources.ParseResource(secret)
+		if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+			return datamodel.BicepConfigProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secret %q for registry %q must be the resource ID of an %s resource", secret, registry, datamodel.SecretStoreResourceType))
+		}
+
+		authentication[registry] = datamodel.RegistryAuthentication{Secret: secret}
+	}
+
+	return datamodel.BicepConfigProperties{
+		Authentication: authentication,
+	}, nil
+}
+
+func toTerraformConfigDataModel(config *TerraformConfigProperties) (datamodel.TerraformConfigProperties, error) {
+	if config == nil {
+		return datamodel.TerraformConfigProperties{}, nil
+	}
+
+	converted := datamodel.TerraformConfigProperties{}
+	if config.Authentication != nil {
+		var err error
+		if converted.Authentication.Git, err = toModuleSourceAuthenticationDataModel(config.Authentication.Git); err != nil {
+			return datamodel.TerraformConfigProperties{}, err
+		}
+		if converted.Authentication.HTTP, err = toModuleSourceAuthenticationDataModel(config.Authentication.HTTP); err != nil {
+			return datamodel.TerraformConfigProperties{}, err
+		}
+		if converted.Authentication.Registry, err = toModuleSourceAuthenticationDataModel(config.Authentication.Registry); err != nil {
+			return datamodel.TerraformConfigProperties{}, err
+		}
+	}
+
+	if config.Providers != nil {
+		for _, provider := range sortedKeys(config.Providers) {
+			if err := validateTerraformProviderConfigs(provider, config.Providers[provider]); err != nil {
+				return datamodel.TerraformConfigProperties{}, err
+			}
+		}
+		converted.Providers = config.Providers
+	}
+
+	if config.Env != nil {
+		converted.Env = map[string]string{}
+		for name, value := range config.Env {
+			converted.Env[name] = to.String(value)
+		}
+	}
+
+	if config.Backend != nil {
+		backend, err := toTerraformBackendDataModel(config.Backend)
+		if err != nil {
+			return datamodel.TerraformConfigProperties{}, err
+		}
+		converted.Backend = backend
+	}
+
+	return converted, nil
+}
+
+// toTerraformBackendDataModel converts the Terraform backend configuration, ensuring that the backend is supported
+// and that a referenced secret store is a valid Applications.Core/secretStores resource ID.
+func toTerraformBackendDataModel(config *TerraformBackendConfig) (datamodel.TerraformBackendConfig, error) {
+	kind := to.String(config.Kind)
+	switch kind {
+	case backends.BackendKubernetes, backends.BackendS3, backends.BackendAzureRM, backends.BackendHTTP, backends.BackendLocal:
+	default:
+		return datamodel.TerraformBackendConfig{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("terraform backend kind %q is not supported. Supported kinds are: %s", kind,
+			strings.Join([]string{backends.BackendKubernetes, backends.BackendS3, backends.BackendAzureRM, backends.BackendHTTP, backends.BackendLocal}, ", ")))
+	}
+
+	secret := to.String(config.Secret)
+	if secret != "" {
+		id, err := resources.ParseResource(secret)
+		if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+			return datamodel.TerraformBackendConfig{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secret %q for the terraform backend must be the resource ID of an %s resource", secret, datamodel.SecretStoreResourceType))
+		}
+	}
+
+	return datamodel.TerraformBackendConfig{
+		Kind:   kind,
+		Config: config.Config,
+		Secret: secret,
+	}, nil
+}
+
+func toModuleSourceAuthenticationDataModel(config map[string]*ModuleSourceAuthentication) (map[string]datamodel.ModuleSourceAuthentication, error) {
+	if config == nil {
+		return nil, nil
 	}
+
+	authentication := map[string]datamodel.ModuleSourceAuthentication{}
+	for _, host := range sortedKeys(config) {
+		auth := config[host]
+		if auth == nil {
+			continue
+		}
+
+		secret := to.String(auth.Secret)
+		id, err := resources.ParseResource(secret)
+		if err != nil || !strings.EqualFold(id.Type(), datamodel.SecretStoreResourceType) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("secret %q for module source %q must be the resource ID of an %s resource", secret, host, datamodel.SecretStoreResourceType))
+		}
+
+		authentication[host] = datamodel.ModuleSourceAuthentication{Secret: secret}
+	}
+
+	return authentication, nil
+}
+
+// validateTerraformProviderConfigs ensures that a provider has at most one default configuration and that the
+// alternate configurations of the provider have unique aliases.
+func validateTerraformProviderConfigs(provider string, configs []map[string]any) error {
+	defaults := 0
+	aliases := map[string]bool{}
+	for _, config := range configs {
+		alias, ok := config["alias"]
+		if !ok {
+			defaults++
+			continue
+		}
+
+		name, ok := alias.(string)
+		if !ok || name == "" {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("alias of provider %q must be a non-empty string", provider))
+		}
+		if aliases[name] {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("alias %q of provider %q must be unique", name, provider))
+		}
+		aliases[name] = true
+	}
+
+	if defaults > 1 {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("provider %q must have at most one configuration without an alias", provider))
+	}
+
 	return nil
 }
+
+// sortedKeys returns the keys of the map in sorted order so that validation errors are deterministic.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func fromRecipeConfigDataModel(config datamodel.RecipeConfigProperties) *RecipeConfigProperties {
+	terraform := fromTerraformConfigDataModel(config.Terraform)
+	bicep := fromBicepConfigDataModel(config.Bicep)
+	if terraform == nil && bicep == nil {
+		return nil
+	}
+
+	return &RecipeConfigProperties{
+		Terraform: terraform,
+		Bicep:     bicep,
+	}
+}
+
+func fromBicepConfigDataModel(config datamodel.BicepConfigProperties) *BicepConfigProperties {
+	if config.Authentication == nil {
+		return nil
+	}
+
+	authentication := map[string]*RegistryAuthentication{}
+	for registry, auth := range config.Authentication {
+		authentication[registry] = &RegistryAuthentication{
+			Secret: to.Ptr(auth.Secret),
+		}
+	}
+
+	return &BicepConfigProperties{
+		Authentication: authentication,
+	}
+}
+
+func fromTerraformConfigDataModel(config datamodel.TerraformConfigProperties) *TerraformConfigProperties {
+	auth := config.Authentication
+	if auth.Git == nil && auth.HTTP == nil && auth.Registry == nil && config.Providers == nil && config.Env == nil && config.Backend.Kind == "" {
+		return nil
+	}
+
+	converted := &TerraformConfigProperties{
+		Providers: config.Providers,
+	}
+
+	if auth.Git != nil || auth.HTTP != nil || auth.Registry != nil {
+		converted.Authentication = &TerraformAuthenticationConfig{
+			Git:      fromModuleSourceAuthenticationDataModel(auth.Git),
+			HTTP:     fromModuleSourceAuthenticationDataModel(auth.HTTP),
+			Registry: fromModuleSourceAuthenticationDataModel(auth.Registry),
+		}
+	}
+
+	if config.Env != nil {
+		converted.Env = map[string]*string{}
+		for name, value := range config.Env {
+			converted.Env[name] = to.Ptr(value)
+		}
+	}
+
+	if config.Backend.Kind != "" {
+		converted.Backend = &TerraformBackendConfig{
+			Kind:   to.Ptr(config.Backend.Kind),
+			Config: config.Backend.Config,
+			Secret: toStringPtr(config.Backend.Secret),
+		}
+	}
+
+	return converted
+}
+
+func fromModuleSourceAuthenticationDataModel(config map[string]datamodel.ModuleSourceAuthentication) map[string]*ModuleSourceAuthentication {
+	if config == nil {
+		return nil
+	}
+
+	authentication := map[string]*ModuleSourceAuthentication{}
+	for host, auth := range config {
+		authentication[host] = &ModuleSourceAuthentication{
+			Secret: to.Ptr(auth.Secret),
+		}
+	}
+
+	return authentication
+}
+
+func fromRecipeUpgradeStatusDataModel(status *datamodel.RecipeUpgradeStatus) *RecipeUpgradeStatus {
+	if status == nil {
+		return nil
+	}
+
+	converted := &RecipeUpgradeStatus{
+		State:           to.Ptr(RecipeUpgradeState(status.State)),
+		BatchSize:       to.Ptr(int32(status.BatchSize)),
+		Total:           to.Ptr(int32(status.Total)),
+		Upgraded:        to.Ptr(int32(status.Upgraded)),
+		LastUpdatedTime: to.Ptr(status.LastUpdatedTime),
+	}
+	if len(status.FailedResources) > 0 {
+		converted.FailedResources = to.SliceOfPtrs(status.FailedResources...)
+	}
+	if status.Message != "" {
+		converted.Message = to.Ptr(status.Message)
+	}
+
+	return converted
+}
@@ -116,6 +116,9 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 						AWS: datamodel.ProvidersAWS{
 							Scope: "/planes/aws/aws/accounts/140313373712/regions/us-west-2",
 						},
+						GCP: datamodel.ProvidersGCP{
+							Scope: "/planes/gcp/gcp/projects/test-project/regions/us-central1",
+						},
 					},
 					Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
 						ds_ctrl.MongoDatabasesResourceType: {
@@ -141,6 +144,11 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								TemplateKind: recipes.TemplateKindBicep,
 								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/rediscaches",
 							},
+							"helm-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindHelm,
+								TemplatePath:    "oci://ghcr.io/sampleregistry/radius/charts/redis",
+								TemplateVersion: "1.2.0",
+							},
 						},
 						dapr_ctrl.DaprStateStoresResourceType: {
 							"statestore-recipe": datamodel.EnvironmentRecipeProperties{
@@ -268,10 +276,42 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-remote-cluster.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/planes/kubernetes/cluster1",
+							Namespace:  "default",
+						},
+					},
+				},
+			},
+			err: nil,
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
 		},
+		{
+			filename: "environmentresource-invalid-cluster.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.resourceId", ValidValue: "a Kubernetes plane ID such as /planes/kubernetes/{planeName}"},
+		},
 		{
 			filename: "environmentresource-invalid-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
@@ -282,16 +322,20 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\""},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
 			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidLocalModulePathFmt, "../not-allowed/")},
 		},
+		{
+			filename: "environmentresource-invalid-registryauth.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "secret \"/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/containers/registry-creds\" for registry \"ghcr.io\" must be the resource ID of an Applications.Core/secretStores resource"},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -365,6 +409,7 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 				require.Equal(t, 1, len(versioned.Properties.Extensions))
 				recipeDetails := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"]
 				if tt.filename == "environmentresourcedatamodel.json" {
+					require.Equal(t, "/planes/gcp/gcp/projects/test-project/regions/us-central1", string(*versioned.Properties.Providers.Gcp.Scope))
 					require.Equal(t, "Azure/cosmosdb/azurerm", string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplatePath))
 					require.Equal(t, recipes.TemplateKindTerraform, string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplateKind))
 
@@ -522,3 +567,190 @@ func getTestKubernetesEmptyMetadataExtensions(t *testing.T) []datamodel.Extensio
 
 	return extensions
 }
+
+func TestConvertGatewayAPIExtension(t *testing.T) {
+	rawPayload := testutil.ReadFixture("environmentresource-with-gatewayapi.json")
+	r := &EnvironmentResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.Environment)
+	expected := []datamodel.Extension{
+		{
+			Kind:       datamodel.GatewayAPI,
+			GatewayAPI: &datamodel.GatewayAPIExtension{GatewayClassName: "istio"},
+		},
+	}
+	require.Equal(t, expected, ct.Properties.Extensions)
+
+	versioned := &EnvironmentResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Len(t, versioned.Properties.Extensions, 1)
+
+	ext, ok := versioned.Properties.Extensions[0].(*GatewayAPIExtension)
+	require.True(t, ok)
+	require.Equal(t, "gatewayApi", *ext.Kind)
+	require.Equal(t, "istio", *ext.GatewayClassName)
+}
+
+func TestConvertRecipeConfig(t *testing.T) {
+	rawPayload := testutil.ReadFixture("environmentresource-with-recipeconfig.json")
+	r := &EnvironmentResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.Environment)
+	expected := datamodel.RecipeConfigProperties{
+		Terraform: datamodel.TerraformConfigProperties{
+			Authentication: datamodel.TerraformAuthenticationConfig{
+				Git: map[string]datamodel.ModuleSourceAuthentication{
+					"github.com": {Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/github"},
+				},
+				HTTP: map[string]datamodel.ModuleSourceAuthentication{
+					"artifacts.example.com": {Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/artifacts"},
+				},
+				Registry: map[string]datamodel.ModuleSourceAuthentication{
+					"app.terraform.io": {Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/tfc"},
+				},
+			},
+			Providers: map[string][]map[string]any{
+				"postgresql": {
+					{"host": "postgres.example.com", "port": float64(5432)},
+				},
+				"helm": {
+					{"alias": "east", "kubernetes": map[string]any{"config_context": "east"}},
+					{"alias": "west", "kubernetes": map[string]any{"config_context": "west"}},
+				},
+			},
+			Env: map[string]string{
+				"TF_REGISTRY_CLIENT_TIMEOUT": "30",
+			},
+			Backend: datamodel.TerraformBackendConfig{
+				Kind:   "s3",
+				Config: map[string]any{"bucket": "radius-tfstate", "region": "us-west-2"},
+				Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/tfstate",
+			},
+		},
+		Bicep: datamodel.BicepConfigProperties{
+			Authentication: map[string]datamodel.RegistryAuthentication{
+				"private.example.com": {Secret: "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/secretStores/registry-creds"},
+			},
+		},
+	}
+	require.Equal(t, expected, ct.Properties.RecipeConfig)
+
+	versioned := &EnvironmentResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+	require.Equal(t, r.Properties.RecipeConfig, versioned.Properties.RecipeConfig)
+}
+
+func TestToTerraformConfigDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *TerraformConfigProperties
+		err    string
+	}{
+		{
+			name: "invalid secret store",
+			config: &TerraformConfigProperties{
+				Authentication: &TerraformAuthenticationConfig{
+					Git: map[string]*ModuleSourceAuthentication{
+						"github.com": {Secret: to.Ptr("/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env")},
+					},
+				},
+			},
+			err: "secret \"/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env\" for module source \"github.com\" must be the resource ID of an Applications.Core/secretStores resource",
+		},
+		{
+			name: "multiple default provider configurations",
+			config: &TerraformConfigProperties{
+				Providers: map[string][]map[string]any{
+					"postgresql": {{"host": "a"}, {"host": "b"}},
+				},
+			},
+			err: "provider \"postgresql\" must have at most one configuration without an alias",
+		},
+		{
+			name: "duplicate alias",
+			config: &TerraformConfigProperties{
+				Providers: map[string][]map[string]any{
+					"helm": {{"alias": "east"}, {"alias": "east"}},
+				},
+			},
+			err: "alias \"east\" of provider \"helm\" must be unique",
+		},
+		{
+			name: "invalid alias",
+			config: &TerraformConfigProperties{
+				Providers: map[string][]map[string]any{
+					"helm": {{"alias": 1}},
+				},
+			},
+			err: "alias of provider \"helm\" must be a non-empty string",
+		},
+		{
+			name: "unsupported backend",
+			config: &TerraformConfigProperties{
+				Backend: &TerraformBackendConfig{Kind: to.Ptr("gcs")},
+			},
+			err: "terraform backend kind \"gcs\" is not supported. Supported kinds are: kubernetes, s3, azurerm, http, local",
+		},
+		{
+			name: "invalid backend secret store",
+			config: &TerraformConfigProperties{
+				Backend: &TerraformBackendConfig{
+					Kind:   to.Ptr("s3"),
+					Secret: to.Ptr("/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env"),
+				},
+			},
+			err: "secret \"/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env\" for the terraform backend must be the resource ID of an Applications.Core/secretStores resource",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toTerraformConfigDataModel(tt.config)
+			require.Equal(t, v1.NewClientErrInvalidRequest(tt.err), err)
+		})
+	}
+}
+
+func TestToBicepConfigDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *BicepConfigProperties
+		err    string
+	}{
+		{
+			name: "missing secret",
+			config: &BicepConfigProperties{
+				Authentication: map[string]*RegistryAuthentication{"ghcr.io": {}},
+			},
+			err: "secret \"\" for registry \"ghcr.io\" must be the resource ID of an Applications.Core/secretStores resource",
+		},
+		{
+			name: "secret is not a secret store",
+			config: &BicepConfigProperties{
+				Authentication: map[string]*RegistryAuthentication{
+					"ghcr.io": {Secret: to.Ptr("/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/creds")},
+				},
+			},
+			err: "secret \"/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/creds\" for registry \"ghcr.io\" must be the resource ID of an Applications.Core/secretStores resource",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toBicepConfigDataModel(tt.config)
+			require.Equal(t, v1.NewClientErrInvalidRequest(tt.err), err)
+		})
+	}
+}
@@ -78,6 +78,8 @@ func (dst *ExtenderResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &ExtenderProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResourcesDataModel(extender.Properties.Status.OutputResources),
+			RecipeDrift:     toRecipeDrift(extender.Properties.Status.RecipeDrift),
+			Recipe:          toRecipeStatusDataModel(extender.Properties.Status.Recipe),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(extender.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(extender.Properties.Environment),
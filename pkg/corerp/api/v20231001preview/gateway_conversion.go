@@ -46,9 +46,15 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 	if src.Properties.Routes != nil {
 		for _, r := range src.Properties.Routes {
 			s := datamodel.GatewayRoute{
-				Destination:   to.String(r.Destination),
-				Path:          to.String(r.Path),
-				ReplacePrefix: to.String(r.ReplacePrefix),
+				Destination:     to.String(r.Destination),
+				Destinations:    toGatewayRouteDestinationsDataModel(r.Destinations),
+				Path:            to.String(r.Path),
+				ReplacePrefix:   to.String(r.ReplacePrefix),
+				Match:           toGatewayRouteMatchDataModel(r.Match),
+				RequestHeaders:  toGatewayRouteHeaderModifierDataModel(r.RequestHeaders),
+				ResponseHeaders: toGatewayRouteHeaderModifierDataModel(r.ResponseHeaders),
+				Timeout:         to.String(r.Timeout),
+				RetryPolicy:     toGatewayRouteRetryPolicyDataModel(r.RetryPolicy),
 			}
 			routes = append(routes, s)
 		}
@@ -110,9 +116,15 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 	if g.Properties.Routes != nil {
 		for _, r := range g.Properties.Routes {
 			s := &GatewayRoute{
-				Destination:   to.Ptr(r.Destination),
-				Path:          to.Ptr(r.Path),
-				ReplacePrefix: to.Ptr(r.ReplacePrefix),
+				Destination:     to.Ptr(r.Destination),
+				Destinations:    fromGatewayRouteDestinationsDataModel(r.Destinations),
+				Path:            to.Ptr(r.Path),
+				ReplacePrefix:   to.Ptr(r.ReplacePrefix),
+				Match:           fromGatewayRouteMatchDataModel(r.Match),
+				RequestHeaders:  fromGatewayRouteHeaderModifierDataModel(r.RequestHeaders),
+				ResponseHeaders: fromGatewayRouteHeaderModifierDataModel(r.ResponseHeaders),
+				Timeout:         toStringPtr(r.Timeout),
+				RetryPolicy:     fromGatewayRouteRetryPolicyDataModel(r.RetryPolicy),
 			}
 			routes = append(routes, s)
 		}
@@ -175,3 +187,106 @@ func fromTLSMinVersionDataModel(tlsMinVersion datamodel.MinimumTLSProtocolVersio
 
 	return &t
 }
+
+func toGatewayRouteDestinationsDataModel(destinations []*GatewayRouteDestination) []datamodel.GatewayRouteDestination {
+	if destinations == nil {
+		return nil
+	}
+
+	converted := []datamodel.GatewayRouteDestination{}
+	for _, d := range destinations {
+		if d == nil {
+			continue
+		}
+		converted = append(converted, datamodel.GatewayRouteDestination{
+			Destination: to.String(d.Destination),
+			Weight:      to.Int32(d.Weight),
+		})
+	}
+
+	return converted
+}
+
+func fromGatewayRouteDestinationsDataModel(destinations []datamodel.GatewayRouteDestination) []*GatewayRouteDestination {
+	if destinations == nil {
+		return nil
+	}
+
+	converted := []*GatewayRouteDestination{}
+	for _, d := range destinations {
+		converted = append(converted, &GatewayRouteDestination{
+			Destination: to.Ptr(d.Destination),
+			Weight:      to.Ptr(d.Weight),
+		})
+	}
+
+	return converted
+}
+
+func toGatewayRouteMatchDataModel(match *GatewayRouteMatch) *datamodel.GatewayRouteMatch {
+	if match == nil {
+		return nil
+	}
+
+	return &datamodel.GatewayRouteMatch{
+		Method:          to.String(match.Method),
+		Headers:         to.StringMap(match.Headers),
+		QueryParameters: to.StringMap(match.QueryParameters),
+	}
+}
+
+func fromGatewayRouteMatchDataModel(match *datamodel.GatewayRouteMatch) *GatewayRouteMatch {
+	if match == nil {
+		return nil
+	}
+
+	return &GatewayRouteMatch{
+		Method:          toStringPtr(match.Method),
+		Headers:         stringPtrMap(match.Headers),
+		QueryParameters: stringPtrMap(match.QueryParameters),
+	}
+}
+
+func toGatewayRouteHeaderModifierDataModel(modifier *GatewayRouteHeaderModifier) *datamodel.GatewayRouteHeaderModifier {
+	if modifier == nil {
+		return nil
+	}
+
+	return &datamodel.GatewayRouteHeaderModifier{
+		Set:    to.StringMap(modifier.Set),
+		Remove: stringSlice(modifier.Remove),
+	}
+}
+
+func fromGatewayRouteHeaderModifierDataModel(modifier *datamodel.GatewayRouteHeaderModifier) *GatewayRouteHeaderModifier {
+	if modifier == nil {
+		return nil
+	}
+
+	return &GatewayRouteHeaderModifier{
+		Set:    stringPtrMap(modifier.Set),
+		Remove: stringPtrSlice(modifier.Remove),
+	}
+}
+
+func toGatewayRouteRetryPolicyDataModel(policy *GatewayRouteRetryPolicy) *datamodel.GatewayRouteRetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &datamodel.GatewayRouteRetryPolicy{
+		Attempts:      to.Int32(policy.Attempts),
+		PerTryTimeout: to.String(policy.PerTryTimeout),
+	}
+}
+
+func fromGatewayRouteRetryPolicyDataModel(policy *datamodel.GatewayRouteRetryPolicy) *GatewayRouteRetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &GatewayRouteRetryPolicy{
+		Attempts:      to.Ptr(policy.Attempts),
+		PerTryTimeout: toStringPtr(policy.PerTryTimeout),
+	}
+}
@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
 
@@ -250,6 +251,101 @@ func TestGatewayTLSTerminationConvertDataModelToVersioned_NoMinProtocolVersion(t
 	require.Equal(t, TLSMinVersionTls12, *versioned.Properties.TLS.MinimumProtocolVersion)
 }
 
+func TestGatewayTrafficPolicyConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-trafficpolicy.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	expected := datamodel.GatewayRoute{
+		Path: "/api",
+		Destinations: []datamodel.GatewayRouteDestination{
+			{
+				Destination: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/httpRoutes/stable",
+				Weight:      90,
+			},
+			{
+				Destination: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/httpRoutes/canary",
+				Weight:      10,
+			},
+		},
+		Match: &datamodel.GatewayRouteMatch{
+			Method:          "GET",
+			Headers:         map[string]string{"x-version": "v2"},
+			QueryParameters: map[string]string{"debug": "true"},
+		},
+		RequestHeaders: &datamodel.GatewayRouteHeaderModifier{
+			Set:    map[string]string{"x-forwarded-by": "radius"},
+			Remove: []string{"x-internal"},
+		},
+		ResponseHeaders: &datamodel.GatewayRouteHeaderModifier{
+			Set:    map[string]string{},
+			Remove: []string{"server"},
+		},
+		Timeout: "30s",
+		RetryPolicy: &datamodel.GatewayRouteRetryPolicy{
+			Attempts:      3,
+			PerTryTimeout: "5s",
+		},
+	}
+	require.Equal(t, []datamodel.GatewayRoute{expected}, gw.Properties.Routes)
+}
+
+func TestGatewayTrafficPolicyConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-trafficpolicy.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	expected := &GatewayRoute{
+		Destination:   to.Ptr(""),
+		Path:          to.Ptr("/api"),
+		ReplacePrefix: to.Ptr(""),
+		Destinations: []*GatewayRouteDestination{
+			{
+				Destination: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/httpRoutes/stable"),
+				Weight:      to.Ptr[int32](90),
+			},
+			{
+				Destination: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/httpRoutes/canary"),
+				Weight:      to.Ptr[int32](10),
+			},
+		},
+		Match: &GatewayRouteMatch{
+			Method:          to.Ptr("GET"),
+			Headers:         map[string]*string{"x-version": to.Ptr("v2")},
+			QueryParameters: map[string]*string{"debug": to.Ptr("true")},
+		},
+		RequestHeaders: &GatewayRouteHeaderModifier{
+			Set:    map[string]*string{"x-forwarded-by": to.Ptr("radius")},
+			Remove: []*string{to.Ptr("x-internal")},
+		},
+		ResponseHeaders: &GatewayRouteHeaderModifier{
+			Remove: []*string{to.Ptr("server")},
+		},
+		Timeout: to.Ptr("30s"),
+		RetryPolicy: &GatewayRouteRetryPolicy{
+			Attempts:      to.Ptr[int32](3),
+			PerTryTimeout: to.Ptr("5s"),
+		},
+	}
+	require.Equal(t, []*GatewayRoute{expected}, versioned.Properties.Routes)
+}
+
 func TestGatewayConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ConvertTo converts from the versioned exportRecipeState request to version-agnostic datamodel.
+func (src *RecipeStateExport) ConvertTo() (v1.DataModelInterface, error) {
+	resourceID, err := toRecipeStateResourceID(src.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &datamodel.RecipeState{
+		ResourceID: resourceID,
+	}, nil
+}
+
+// ConvertTo converts from the versioned unlockRecipeState request to version-agnostic datamodel.
+func (src *RecipeStateUnlock) ConvertTo() (v1.DataModelInterface, error) {
+	resourceID, err := toRecipeStateResourceID(src.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &datamodel.RecipeState{
+		ResourceID: resourceID,
+		LockID:     to.String(src.LockID),
+	}, nil
+}
+
+// ConvertTo returns an error as it does not support converting the Recipe State response to a version-agnostic object.
+func (src *RecipeStateResponse) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting Recipe State to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Recipe State response.
+func (dst *RecipeStateResponse) ConvertFrom(src v1.DataModelInterface) error {
+	state, ok := src.(*datamodel.RecipeStateResponse)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+	dst.Backend = to.Ptr(state.Backend)
+	dst.State = state.State
+	return nil
+}
+
+func toRecipeStateResourceID(id *string) (string, error) {
+	resourceID := to.String(id)
+	if _, err := resources.ParseResource(resourceID); err != nil {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("resourceId %q must be a valid resource ID", resourceID))
+	}
+	return resourceID, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecipeStateResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+
+func TestRecipeStateExportConvertVersionedToDataModel(t *testing.T) {
+	t.Run("valid resource ID", func(t *testing.T) {
+		r := &RecipeStateExport{ResourceID: to.Ptr(testRecipeStateResourceID)}
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		require.Equal(t, &datamodel.RecipeState{ResourceID: testRecipeStateResourceID}, dm)
+	})
+
+	t.Run("invalid resource ID", func(t *testing.T) {
+		r := &RecipeStateExport{ResourceID: to.Ptr("redis")}
+		_, err := r.ConvertTo()
+		require.Equal(t, v1.NewClientErrInvalidRequest("resourceId \"redis\" must be a valid resource ID"), err)
+	})
+}
+
+func TestRecipeStateUnlockConvertVersionedToDataModel(t *testing.T) {
+	t.Run("valid resource ID", func(t *testing.T) {
+		r := &RecipeStateUnlock{ResourceID: to.Ptr(testRecipeStateResourceID), LockID: to.Ptr("lock-id")}
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		require.Equal(t, &datamodel.RecipeState{ResourceID: testRecipeStateResourceID, LockID: "lock-id"}, dm)
+	})
+
+	t.Run("missing resource ID", func(t *testing.T) {
+		r := &RecipeStateUnlock{}
+		_, err := r.ConvertTo()
+		require.Equal(t, v1.NewClientErrInvalidRequest("resourceId \"\" must be a valid resource ID"), err)
+	})
+}
+
+func TestRecipeStateResponseConversion(t *testing.T) {
+	t.Run("convert to data model", func(t *testing.T) {
+		r := &RecipeStateResponse{}
+		_, err := r.ConvertTo()
+		require.ErrorContains(t, err, "converting Recipe State to a version-agnostic object is not supported")
+	})
+
+	t.Run("convert from data model", func(t *testing.T) {
+		versioned := &RecipeStateResponse{}
+		err := versioned.ConvertFrom(&datamodel.RecipeStateResponse{
+			Backend: "s3",
+			State:   map[string]any{"version": float64(4)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &RecipeStateResponse{
+			Backend: to.Ptr("s3"),
+			State:   map[string]any{"version": float64(4)},
+		}, versioned)
+	})
+
+	t.Run("invalid data model", func(t *testing.T) {
+		versioned := &RecipeStateResponse{}
+		err := versioned.ConvertFrom(&datamodel.Recipe{})
+		require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+	})
+}
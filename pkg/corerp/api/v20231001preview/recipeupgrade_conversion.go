@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+// ConvertTo converts from the versioned upgradeRecipes request to version-agnostic datamodel.
+func (src *RecipeUpgrade) ConvertTo() (v1.DataModelInterface, error) {
+	converted := &datamodel.RecipeUpgrade{
+		BatchSize: datamodel.DefaultRecipeUpgradeBatchSize,
+	}
+
+	if src.BatchSize != nil {
+		if *src.BatchSize < 1 {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("batchSize must be greater than 0, got %d", *src.BatchSize))
+		}
+		converted.BatchSize = int(*src.BatchSize)
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipeUpgradeConvertVersionedToDataModel(t *testing.T) {
+	t.Run("default batch size", func(t *testing.T) {
+		r := &RecipeUpgrade{}
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		require.Equal(t, &datamodel.RecipeUpgrade{BatchSize: datamodel.DefaultRecipeUpgradeBatchSize}, dm)
+	})
+
+	t.Run("batch size", func(t *testing.T) {
+		r := &RecipeUpgrade{BatchSize: to.Ptr(int32(10))}
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		require.Equal(t, &datamodel.RecipeUpgrade{BatchSize: 10}, dm)
+	})
+
+	t.Run("invalid batch size", func(t *testing.T) {
+		r := &RecipeUpgrade{BatchSize: to.Ptr(int32(0))}
+		_, err := r.ConvertTo()
+		require.Equal(t, v1.NewClientErrInvalidRequest("batchSize must be greater than 0, got 0"), err)
+	})
+}
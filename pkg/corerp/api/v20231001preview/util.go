@@ -113,6 +113,20 @@ func stringSlice(s []*string) []string {
 	return r
 }
 
+func stringPtrSlice(s []string) []*string {
+	if s == nil {
+		return nil
+	}
+	return to.SliceOfPtrs(s...)
+}
+
+func stringPtrMap(m map[string]string) map[string]*string {
+	if m == nil {
+		return nil
+	}
+	return *to.StringMapPtr(m)
+}
+
 func isValidTemplateKind(templateKind string) bool {
 	return slices.Contains(recipes.SupportedTemplateKind, templateKind)
 }
@@ -136,3 +150,38 @@ func toOutputResourcesDataModel(outputResources []rpv1.OutputResource) []*Output
 	}
 	return outResources
 }
+
+func toRecipeDrift(drift *rpv1.RecipeDriftStatus) *RecipeDriftStatus {
+	if drift == nil {
+		return nil
+	}
+
+	var driftedResources []*DriftedResource
+	for _, dr := range drift.DriftedResources {
+		driftedResources = append(driftedResources, &DriftedResource{
+			ID:     to.Ptr(dr.ID),
+			Action: to.Ptr(DriftAction(dr.Action)),
+		})
+	}
+
+	return &RecipeDriftStatus{
+		State:            to.Ptr(RecipeDriftState(drift.State)),
+		LastCheckedTime:  to.Ptr(drift.LastCheckedTime),
+		DriftedResources: driftedResources,
+	}
+}
+
+func toRecipeStatusDataModel(recipe *rpv1.RecipeStatus) *RecipeStatus {
+	if recipe == nil {
+		return nil
+	}
+
+	return &RecipeStatus{
+		TemplateKind:    to.Ptr(recipe.TemplateKind),
+		TemplatePath:    to.Ptr(recipe.TemplatePath),
+		TemplateVersion: to.Ptr(recipe.TemplateVersion),
+		ResolvedVersion: to.Ptr(recipe.ResolvedVersion),
+		TemplateDigest:  to.Ptr(recipe.TemplateDigest),
+		Parameters:      recipe.Parameters,
+	}
+}
@@ -143,6 +143,63 @@ func (client *EnvironmentsClient) deleteCreateRequest(ctx context.Context, envir
 	return req, nil
 }
 
+// ExportRecipeState - Exports the Terraform state of the recipe deployed for a resource of the environment.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientExportRecipeStateOptions contains the optional parameters for the EnvironmentsClient.ExportRecipeState
+//     method.
+func (client *EnvironmentsClient) ExportRecipeState(ctx context.Context, environmentName string, body RecipeStateExport, options *EnvironmentsClientExportRecipeStateOptions) (EnvironmentsClientExportRecipeStateResponse, error) {
+	var err error
+	req, err := client.exportRecipeStateCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return EnvironmentsClientExportRecipeStateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientExportRecipeStateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientExportRecipeStateResponse{}, err
+	}
+	resp, err := client.exportRecipeStateHandleResponse(httpResp)
+	return resp, err
+}
+
+// exportRecipeStateCreateRequest creates the ExportRecipeState request.
+func (client *EnvironmentsClient) exportRecipeStateCreateRequest(ctx context.Context, environmentName string, body RecipeStateExport, options *EnvironmentsClientExportRecipeStateOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/exportRecipeState"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// exportRecipeStateHandleResponse handles the ExportRecipeState response.
+func (client *EnvironmentsClient) exportRecipeStateHandleResponse(resp *http.Response) (EnvironmentsClientExportRecipeStateResponse, error) {
+	result := EnvironmentsClientExportRecipeStateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipeStateResponse); err != nil {
+		return EnvironmentsClientExportRecipeStateResponse{}, err
+	}
+	return result, nil
+}
+
 // Get - Get a EnvironmentResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -309,6 +366,63 @@ func (client *EnvironmentsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// UnlockRecipeState - Releases the lock on the Terraform state of the recipe deployed for a resource of the environment.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientUnlockRecipeStateOptions contains the optional parameters for the EnvironmentsClient.UnlockRecipeState
+//     method.
+func (client *EnvironmentsClient) UnlockRecipeState(ctx context.Context, environmentName string, body RecipeStateUnlock, options *EnvironmentsClientUnlockRecipeStateOptions) (EnvironmentsClientUnlockRecipeStateResponse, error) {
+	var err error
+	req, err := client.unlockRecipeStateCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return EnvironmentsClientUnlockRecipeStateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientUnlockRecipeStateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientUnlockRecipeStateResponse{}, err
+	}
+	resp, err := client.unlockRecipeStateHandleResponse(httpResp)
+	return resp, err
+}
+
+// unlockRecipeStateCreateRequest creates the UnlockRecipeState request.
+func (client *EnvironmentsClient) unlockRecipeStateCreateRequest(ctx context.Context, environmentName string, body RecipeStateUnlock, options *EnvironmentsClientUnlockRecipeStateOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/unlockRecipeState"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// unlockRecipeStateHandleResponse handles the UnlockRecipeState response.
+func (client *EnvironmentsClient) unlockRecipeStateHandleResponse(resp *http.Response) (EnvironmentsClientUnlockRecipeStateResponse, error) {
+	result := EnvironmentsClientUnlockRecipeStateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipeStateResponse); err != nil {
+		return EnvironmentsClientUnlockRecipeStateResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a EnvironmentResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -365,3 +479,71 @@ func (client *EnvironmentsClient) updateHandleResponse(resp *http.Response) (Env
 	return result, nil
 }
 
+// BeginUpgradeRecipes - Re-runs the recipes of the resources of the environment which were deployed by an older version of
+// their recipe.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientBeginUpgradeRecipesOptions contains the optional parameters for the EnvironmentsClient.BeginUpgradeRecipes
+//     method.
+func (client *EnvironmentsClient) BeginUpgradeRecipes(ctx context.Context, environmentName string, body RecipeUpgrade, options *EnvironmentsClientBeginUpgradeRecipesOptions) (*runtime.Poller[EnvironmentsClientUpgradeRecipesResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.upgradeRecipes(ctx, environmentName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[EnvironmentsClientUpgradeRecipesResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[EnvironmentsClientUpgradeRecipesResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// UpgradeRecipes - Re-runs the recipes of the resources of the environment which were deployed by an older version of
+// their recipe.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *EnvironmentsClient) upgradeRecipes(ctx context.Context, environmentName string, body RecipeUpgrade, options *EnvironmentsClientBeginUpgradeRecipesOptions) (*http.Response, error) {
+	var err error
+	req, err := client.upgradeRecipesCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// upgradeRecipesCreateRequest creates the UpgradeRecipes request.
+func (client *EnvironmentsClient) upgradeRecipesCreateRequest(ctx context.Context, environmentName string, body RecipeUpgrade, options *EnvironmentsClientBeginUpgradeRecipesOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/upgradeRecipes"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
@@ -171,6 +171,72 @@ func (client *ExtendersClient) deleteCreateRequest(ctx context.Context, extender
 	return req, nil
 }
 
+// BeginDetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified Extender resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - extenderName - The name of the ExtenderResource portable resource
+//   - body - The content of the action request
+//   - options - ExtendersClientBeginDetectDriftOptions contains the optional parameters for the ExtendersClient.BeginDetectDrift
+//     method.
+func (client *ExtendersClient) BeginDetectDrift(ctx context.Context, extenderName string, body map[string]any, options *ExtendersClientBeginDetectDriftOptions) (*runtime.Poller[ExtendersClientDetectDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.detectDrift(ctx, extenderName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[ExtendersClientDetectDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[ExtendersClientDetectDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// DetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified Extender resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *ExtendersClient) detectDrift(ctx context.Context, extenderName string, body map[string]any, options *ExtendersClientBeginDetectDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.detectDriftCreateRequest(ctx, extenderName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// detectDriftCreateRequest creates the DetectDrift request.
+func (client *ExtendersClient) detectDriftCreateRequest(ctx context.Context, extenderName string, body map[string]any, options *ExtendersClientBeginDetectDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/extenders/{extenderName}/detectDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if extenderName == "" {
+		return nil, errors.New("parameter extenderName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{extenderName}", url.PathEscape(extenderName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // Get - Get a ExtenderResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -336,6 +402,131 @@ func (client *ExtendersClient) listSecretsHandleResponse(resp *http.Response) (E
 	return result, nil
 }
 
+// Plan - Previews the changes a deployment of the recipe of the specified Extender resource would make to the
+// infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - extenderName - The name of the ExtenderResource portable resource
+//   - body - The content of the action request
+//   - options - ExtendersClientPlanOptions contains the optional parameters for the ExtendersClient.Plan method.
+func (client *ExtendersClient) Plan(ctx context.Context, extenderName string, body ExtenderResource, options *ExtendersClientPlanOptions) (ExtendersClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, extenderName, body, options)
+	if err != nil {
+		return ExtendersClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ExtendersClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ExtendersClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *ExtendersClient) planCreateRequest(ctx context.Context, extenderName string, body ExtenderResource, options *ExtendersClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/extenders/{extenderName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if extenderName == "" {
+		return nil, errors.New("parameter extenderName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{extenderName}", url.PathEscape(extenderName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *ExtendersClient) planHandleResponse(resp *http.Response) (ExtendersClientPlanResponse, error) {
+	result := ExtendersClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlan); err != nil {
+		return ExtendersClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified Extender resource
+// and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - extenderName - The name of the ExtenderResource portable resource
+//   - body - The content of the action request
+//   - options - ExtendersClientBeginReconcileDriftOptions contains the optional parameters for the ExtendersClient.BeginReconcileDrift
+//     method.
+func (client *ExtendersClient) BeginReconcileDrift(ctx context.Context, extenderName string, body map[string]any, options *ExtendersClientBeginReconcileDriftOptions) (*runtime.Poller[ExtendersClientReconcileDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.reconcileDrift(ctx, extenderName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[ExtendersClientReconcileDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[ExtendersClientReconcileDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// ReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified Extender resource and
+// re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *ExtendersClient) reconcileDrift(ctx context.Context, extenderName string, body map[string]any, options *ExtendersClientBeginReconcileDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.reconcileDriftCreateRequest(ctx, extenderName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// reconcileDriftCreateRequest creates the ReconcileDrift request.
+func (client *ExtendersClient) reconcileDriftCreateRequest(ctx context.Context, extenderName string, body map[string]any, options *ExtendersClientBeginReconcileDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/extenders/{extenderName}/reconcileDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if extenderName == "" {
+		return nil, errors.New("parameter extenderName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{extenderName}", url.PathEscape(extenderName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // BeginUpdate - Update a ExtenderResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -9,6 +9,92 @@ package v20231001preview
 
 import "time"
 
+// AdditionalContainer - Definition of an init container or a sidecar container that runs in the same pod as the
+// container.
+type AdditionalContainer struct {
+	// REQUIRED; The registry and image to download and run in your container
+	Image *string
+
+	// REQUIRED; The name of the container. Must be unique within the container resource.
+	Name *string
+
+	// Arguments to the entrypoint. Overrides the container image's CMD
+	Args []*string
+
+	// Entrypoint array. Overrides the container image's ENTRYPOINT
+	Command []*string
+
+	// environment
+	Env map[string]*EnvironmentVariable
+
+	// The pull policy for the container image
+	ImagePullPolicy *ImagePullPolicy
+
+	// container ports
+	Ports map[string]*AdditionalContainerPort
+
+	// Compute resource requests and limits of the container
+	Resources *ContainerResources
+
+	// Volumes of the container resource to mount into the container
+	VolumeMounts []*VolumeMount
+
+	// Working directory for the container
+	WorkingDir *string
+}
+
+// AdditionalContainerPort - Specifies a listening port of an init container or a sidecar container
+type AdditionalContainerPort struct {
+	// REQUIRED; The listening port number
+	ContainerPort *int32
+
+	// Protocol in use by the port
+	Protocol *PortProtocol
+}
+
+// AdditionalContainerPortUpdate - Specifies a listening port of an init container or a sidecar container
+type AdditionalContainerPortUpdate struct {
+	// The listening port number
+	ContainerPort *int32
+
+	// Protocol in use by the port
+	Protocol *PortProtocol
+}
+
+// AdditionalContainerUpdate - Definition of an init container or a sidecar container that runs in the same pod as the
+// container.
+type AdditionalContainerUpdate struct {
+	// Arguments to the entrypoint. Overrides the container image's CMD
+	Args []*string
+
+	// Entrypoint array. Overrides the container image's ENTRYPOINT
+	Command []*string
+
+	// environment
+	Env map[string]*EnvironmentVariableUpdate
+
+	// The registry and image to download and run in your container
+	Image *string
+
+	// The pull policy for the container image
+	ImagePullPolicy *ImagePullPolicy
+
+	// The name of the container. Must be unique within the container resource.
+	Name *string
+
+	// container ports
+	Ports map[string]*AdditionalContainerPortUpdate
+
+	// Compute resource requests and limits of the container
+	Resources *ContainerResources
+
+	// Volumes of the container resource to mount into the container
+	VolumeMounts []*VolumeMountUpdate
+
+	// Working directory for the container
+	WorkingDir *string
+}
+
 // ApplicationGraphConnection - Describes the connection between two resources.
 type ApplicationGraphConnection struct {
 	// REQUIRED; The direction of the connection. 'Outbound' indicates this connection specifies the ID of the destination and
@@ -123,6 +209,9 @@ type ApplicationResourceUpdateProperties struct {
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigPropertiesUpdate
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesUpdateClassification
 
@@ -130,6 +219,32 @@ type ApplicationResourceUpdateProperties struct {
 	Simulated *bool
 }
 
+// AutoScalingExtension - AutoScaling Extension. Scales the container horizontally between minReplicas and maxReplicas based
+// on the CPU and memory utilization.
+type AutoScalingExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; Maximum replica count.
+	MaxReplicas *int32
+
+	// Minimum replica count. Defaults to 1.
+	MinReplicas *int32
+
+	// Target average CPU utilization, as a percentage of the CPU requests of the container. The container and its sidecars must request CPU.
+	TargetCPUUtilization *int32
+
+	// Target average memory utilization, as a percentage of the memory requests of the container. The container and its sidecars must request memory.
+	TargetMemoryUtilization *int32
+}
+
+// GetExtension implements the ExtensionClassification interface for type AutoScalingExtension.
+func (a *AutoScalingExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: a.Kind,
+	}
+}
+
 // AzureKeyVaultVolumeProperties - Represents Azure Key Vault Volume properties
 type AzureKeyVaultVolumeProperties struct {
 	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
@@ -171,6 +286,20 @@ func (a *AzureKeyVaultVolumeProperties) GetVolumeProperties() *VolumeProperties
 	}
 }
 
+// BicepConfigProperties - Configuration for Bicep Recipes. Controls how Bicep templates are fetched from registries.
+type BicepConfigProperties struct {
+	// Authentication information used to access private OCI registries, keyed by registry host. For example:
+	// 'myregistry.azurecr.io'.
+	Authentication map[string]*RegistryAuthentication
+}
+
+// BicepConfigPropertiesUpdate - Configuration for Bicep Recipes. Controls how Bicep templates are fetched from registries.
+type BicepConfigPropertiesUpdate struct {
+	// Authentication information used to access private OCI registries, keyed by registry host. For example:
+	// 'myregistry.azurecr.io'.
+	Authentication map[string]*RegistryAuthenticationUpdate
+}
+
 // BicepRecipeProperties - Represents Bicep recipe properties.
 type BicepRecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
@@ -234,6 +363,15 @@ type CertificateObjectProperties struct {
 	Version *string
 }
 
+// ComputeResources - Amounts of compute resources.
+type ComputeResources struct {
+	// The CPU, in Kubernetes quantity format. Ex - 500m.
+	CPU *string
+
+	// The memory, in Kubernetes quantity format. Ex - 256Mi.
+	Memory *string
+}
+
 // ConnectionProperties - Connection Properties
 type ConnectionProperties struct {
 	// REQUIRED; The source of the connection
@@ -270,7 +408,7 @@ type Container struct {
 	Command []*string
 
 	// environment
-	Env map[string]*string
+	Env map[string]*EnvironmentVariable
 
 	// The pull policy for the container image
 	ImagePullPolicy *ImagePullPolicy
@@ -284,6 +422,9 @@ type Container struct {
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// Compute resource requests and limits of the container
+	Resources *ContainerResources
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -349,6 +490,9 @@ type ContainerProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettings
 
+	// Containers that run to completion, in order, before the container is started. Ex - database migrations.
+	InitContainers []*AdditionalContainer
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -358,6 +502,9 @@ type ContainerProperties struct {
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
 
+	// Containers that run alongside the container in the same pod. Ex - log shippers.
+	Sidecars []*AdditionalContainer
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 
@@ -427,6 +574,9 @@ type ContainerResourceUpdateProperties struct {
 	// Configuration for supported external identity providers
 	Identity *IdentitySettingsUpdate
 
+	// Containers that run to completion, in order, before the container is started. Ex - database migrations.
+	InitContainers []*AdditionalContainerUpdate
+
 	// Specifies how the underlying container resource is provisioned and managed.
 	ResourceProvisioning *ContainerResourceProvisioning
 
@@ -435,6 +585,18 @@ type ContainerResourceUpdateProperties struct {
 
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
+
+	// Containers that run alongside the container in the same pod. Ex - log shippers.
+	Sidecars []*AdditionalContainerUpdate
+}
+
+// ContainerResources - Compute resource requirements of a container.
+type ContainerResources struct {
+	// The maximum compute resources the container can use.
+	Limits *ComputeResources
+
+	// The compute resources reserved for the container.
+	Requests *ComputeResources
 }
 
 // ContainerUpdate - Definition of a container
@@ -446,7 +608,7 @@ type ContainerUpdate struct {
 	Command []*string
 
 	// environment
-	Env map[string]*string
+	Env map[string]*EnvironmentVariableUpdate
 
 	// The registry and image to download and run in your container
 	Image *string
@@ -463,6 +625,9 @@ type ContainerUpdate struct {
 	// readiness probe properties
 	ReadinessProbe HealthProbePropertiesClassification
 
+	// Compute resource requests and limits of the container
+	Resources *ContainerResources
+
 	// container volumes
 	Volumes map[string]VolumeClassification
 
@@ -495,6 +660,15 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// DriftedResource - A resource which has drifted from the recipe.
+type DriftedResource struct {
+	// REQUIRED; The action required to reconcile the resource with the recipe.
+	Action *DriftAction
+
+	// REQUIRED; The UCP resource ID of the drifted resource, or the recipe address of the resource when it has no resource ID.
+	ID *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -536,6 +710,9 @@ type EnvironmentProperties struct {
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigProperties
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesClassification
 
@@ -544,6 +721,9 @@ type EnvironmentProperties struct {
 
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
+
+	// READ-ONLY; The status of the last upgrade of the recipes of the resources of the environment.
+	RecipeUpgrade *RecipeUpgradeStatus
 }
 
 // EnvironmentResource - The environment resource
@@ -599,6 +779,9 @@ type EnvironmentResourceUpdateProperties struct {
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigPropertiesUpdate
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesUpdateClassification
 
@@ -606,6 +789,36 @@ type EnvironmentResourceUpdateProperties struct {
 	Simulated *bool
 }
 
+// EnvironmentVariable - Environment variables type
+type EnvironmentVariable struct {
+	// The value of the environment variable
+	Value *string
+
+	// The reference to the variable
+	ValueFrom *EnvironmentVariableReference
+}
+
+// EnvironmentVariableReference - The reference to the variable
+type EnvironmentVariableReference struct {
+	// REQUIRED; The secret reference
+	SecretRef *SecretReference
+}
+
+// EnvironmentVariableReferenceUpdate - The reference to the variable
+type EnvironmentVariableReferenceUpdate struct {
+	// The secret reference
+	SecretRef *SecretReferenceUpdate
+}
+
+// EnvironmentVariableUpdate - Environment variables type
+type EnvironmentVariableUpdate struct {
+	// The value of the environment variable
+	Value *string
+
+	// The reference to the variable
+	ValueFrom *EnvironmentVariableReferenceUpdate
+}
+
 // EphemeralVolume - Specifies an ephemeral volume for a container
 type EphemeralVolume struct {
 	// REQUIRED; Discriminator property for Volume.
@@ -788,6 +1001,23 @@ type Extension struct {
 // GetExtension implements the ExtensionClassification interface for type Extension.
 func (e *Extension) GetExtension() *Extension { return e }
 
+// GatewayAPIExtension - Kubernetes Gateway API extension of an environment resource. Gateways in the environment are rendered
+// as Gateway API objects instead of Contour HTTPProxy objects.
+type GatewayAPIExtension struct {
+	// REQUIRED; The name of the GatewayClass used by the Gateway objects rendered for the gateways in the environment.
+	GatewayClassName *string
+
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+}
+
+// GetExtension implements the ExtensionClassification interface for type GatewayAPIExtension.
+func (g *GatewayAPIExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: g.Kind,
+	}
+}
+
 // GatewayHostname - Declare hostname information for the Gateway. Leaving the hostname empty auto-assigns one: mygateway.myapp.PUBLICHOSTNAMEORIP.nip.io.
 type GatewayHostname struct {
 	// Specify a fully-qualified domain name: myapp.mydomain.com. Mutually exclusive with 'prefix' and will take priority if both
@@ -897,12 +1127,70 @@ type GatewayRoute struct {
 	// The HttpRoute to route to. Ex - myserviceroute.id.
 	Destination *string
 
+	// Split the traffic between multiple HttpRoutes by weight. Cannot be combined with destination. The weights must add up
+// to 100.
+	Destinations []*GatewayRouteDestination
+
+	// Additional conditions the incoming request must match, on top of the path.
+	Match *GatewayRouteMatch
+
 	// The path to match the incoming request path on. Ex - /myservice.
 	Path *string
 
 	// Optionally update the prefix when sending the request to the service. Ex - replacePrefix: '/' and path: '/myservice' will
 // transform '/myservice/myroute' to '/myroute'
 	ReplacePrefix *string
+
+	// Modify the headers of the request before it is sent to the destination.
+	RequestHeaders *GatewayRouteHeaderModifier
+
+	// Modify the headers of the response before it is sent to the client.
+	ResponseHeaders *GatewayRouteHeaderModifier
+
+	// The retry policy for requests that fail.
+	RetryPolicy *GatewayRouteRetryPolicy
+
+	// The timeout for the whole request, as a duration. Ex - 30s.
+	Timeout *string
+}
+
+// GatewayRouteDestination - Weighted destination of a gateway route.
+type GatewayRouteDestination struct {
+	// REQUIRED; The HttpRoute to route to. Ex - myserviceroute.id.
+	Destination *string
+
+	// REQUIRED; The percentage of the traffic sent to the destination, from 0 to 100.
+	Weight *int32
+}
+
+// GatewayRouteHeaderModifier - Header modifications for a gateway route.
+type GatewayRouteHeaderModifier struct {
+	// The names of the headers to remove.
+	Remove []*string
+
+	// The headers to set, overwriting any existing value.
+	Set map[string]*string
+}
+
+// GatewayRouteMatch - Conditions the incoming request must match for a gateway route.
+type GatewayRouteMatch struct {
+	// The request headers to match, by exact value.
+	Headers map[string]*string
+
+	// The HTTP method to match. Ex - GET.
+	Method *string
+
+	// The query parameters to match, by exact value.
+	QueryParameters map[string]*string
+}
+
+// GatewayRouteRetryPolicy - Retry policy for a gateway route.
+type GatewayRouteRetryPolicy struct {
+	// REQUIRED; The maximum number of retries.
+	Attempts *int32
+
+	// The timeout for each attempt, as a duration. Ex - 5s.
+	PerTryTimeout *string
 }
 
 // GatewayTLS - TLS configuration definition for Gateway resource.
@@ -1060,6 +1348,58 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm recipe properties. The template path is the reference of a chart in an OCI
+// registry, such as 'oci://ghcr.io/myorg/charts/redis', or the URL of a chart repository followed by the name of the
+// chart, such as 'https://charts.example.com/stable/redis'.
+type HelmRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Version of the Helm chart to deploy. The latest version of the chart is deployed if omitted.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
+// HelmRecipePropertiesUpdate - Represents Helm recipe properties. The template path is the reference of a chart in an OCI
+// registry, such as 'oci://ghcr.io/myorg/charts/redis', or the URL of a chart repository followed by the name of the
+// chart, such as 'https://charts.example.com/stable/redis'.
+type HelmRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Version of the Helm chart to deploy. The latest version of the chart is deployed if omitted.
+	TemplateVersion *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: h.Parameters,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 	// REQUIRED; The kind of IAM provider to configure
@@ -1223,6 +1563,23 @@ func (m *ManualScalingExtension) GetExtension() *Extension {
 	}
 }
 
+// ModuleSourceAuthentication - Authentication information used to download Terraform modules from a private module source.
+type ModuleSourceAuthentication struct {
+	// REQUIRED; The ID of an Applications.Core/secretStores resource containing the credentials. For Git and HTTP module
+	// sources the secret store must contain either 'username' and 'password' keys, or a 'token' key. For module registries the
+	// secret store must contain a 'token' key.
+	Secret *string
+}
+
+// ModuleSourceAuthenticationUpdate - Authentication information used to download Terraform modules from a private module
+// source.
+type ModuleSourceAuthenticationUpdate struct {
+	// The ID of an Applications.Core/secretStores resource containing the credentials. For Git and HTTP module sources the
+	// secret store must contain either 'username' and 'password' keys, or a 'token' key. For module registries the secret
+	// store must contain a 'token' key.
+	Secret *string
+}
+
 // Operation - Details of a REST API operation, returned from the Resource Provider Operations API
 type Operation struct {
 	// Localized display information for this particular operation.
@@ -1309,6 +1666,15 @@ func (p *PersistentVolume) GetVolume() *Volume {
 	}
 }
 
+// PlannedResource - A resource which would be changed by the deployment of a recipe.
+type PlannedResource struct {
+	// REQUIRED; The change the deployment of the recipe would make to the resource.
+	Action *PlanAction
+
+	// REQUIRED; The UCP resource ID of the resource, or the recipe address of the resource when it has no resource ID yet.
+	ID *string
+}
+
 // Providers - The Cloud providers configuration
 type Providers struct {
 	// The AWS cloud provider configuration
@@ -1316,6 +1682,9 @@ type Providers struct {
 
 	// The Azure cloud provider configuration
 	Azure *ProvidersAzure
+
+	// The GCP cloud provider configuration
+	Gcp *ProvidersGcp
 }
 
 // ProvidersAws - The AWS cloud provider definition
@@ -1342,6 +1711,18 @@ type ProvidersAzureUpdate struct {
 	Scope *string
 }
 
+// ProvidersGcp - The GCP cloud provider definition
+type ProvidersGcp struct {
+	// REQUIRED; Target scope for GCP resources to be deployed into. For example: '/planes/gcp/gcp/projects/my-project/regions/us-central1'
+	Scope *string
+}
+
+// ProvidersGcpUpdate - The GCP cloud provider definition
+type ProvidersGcpUpdate struct {
+	// Target scope for GCP resources to be deployed into. For example: '/planes/gcp/gcp/projects/my-project/regions/us-central1'
+	Scope *string
+}
+
 // ProvidersUpdate - The Cloud providers configuration
 type ProvidersUpdate struct {
 	// The AWS cloud provider configuration
@@ -1349,6 +1730,9 @@ type ProvidersUpdate struct {
 
 	// The Azure cloud provider configuration
 	Azure *ProvidersAzureUpdate
+
+	// The GCP cloud provider configuration
+	Gcp *ProvidersGcpUpdate
 }
 
 // Recipe - The recipe used to automatically deploy underlying infrastructure for a portable resource
@@ -1360,6 +1744,36 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeConfigProperties - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigProperties struct {
+	// Configuration for Bicep Recipes. Controls how Bicep templates are fetched from registries.
+	Bicep *BicepConfigProperties
+
+	// Configuration for Terraform Recipes. Controls how Terraform modules are downloaded and how Terraform is run.
+	Terraform *TerraformConfigProperties
+}
+
+// RecipeConfigPropertiesUpdate - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigPropertiesUpdate struct {
+	// Configuration for Bicep Recipes. Controls how Bicep templates are fetched from registries.
+	Bicep *BicepConfigPropertiesUpdate
+
+	// Configuration for Terraform Recipes. Controls how Terraform modules are downloaded and how Terraform is run.
+	Terraform *TerraformConfigPropertiesUpdate
+}
+
+// RecipeDriftStatus - The drift status of the infrastructure deployed by a recipe.
+type RecipeDriftStatus struct {
+	// REQUIRED; The time when the drift was last checked.
+	LastCheckedTime *time.Time
+
+	// REQUIRED; The drift state of the infrastructure deployed by the recipe.
+	State *RecipeDriftState
+
+	// The resources which have drifted from the recipe.
+	DriftedResources []*DriftedResource
+}
+
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
 type RecipeGetMetadata struct {
 	// REQUIRED; The name of the recipe registered to the environment
@@ -1374,7 +1788,7 @@ type RecipeGetMetadataResponse struct {
 	// REQUIRED; The key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
-	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 	TemplateKind *string
 
 	// REQUIRED; The path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
@@ -1385,7 +1799,13 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePlan - The preview of the changes a deployment of a recipe would make to the infrastructure.
+type RecipePlan struct {
+	// REQUIRED; The resources which would be created, updated or deleted by the deployment of the recipe.
+	Resources []*PlannedResource
+}
+
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1400,7 +1820,7 @@ type RecipeProperties struct {
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
-// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm.
 type RecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1415,6 +1835,52 @@ type RecipePropertiesUpdate struct {
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type RecipePropertiesUpdate.
 func (r *RecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate { return r }
 
+// RecipeStateExport - Represents the request body of the exportRecipeState action.
+type RecipeStateExport struct {
+	// REQUIRED; The ID of the resource deployed by a Terraform recipe.
+	ResourceID *string
+}
+
+// RecipeStateResponse - The Terraform state of the recipe deployed for a resource.
+type RecipeStateResponse struct {
+	// REQUIRED; The kind of the Terraform backend storing the state.
+	Backend *string
+
+	// The Terraform state of the resource. Only returned by the exportRecipeState action.
+	State map[string]any
+}
+
+// RecipeStateUnlock - Represents the request body of the unlockRecipeState action.
+type RecipeStateUnlock struct {
+	// The ID of the lock to release. Required unless the state is stored in a kubernetes or local backend.
+	LockID *string
+
+	// REQUIRED; The ID of the resource deployed by a Terraform recipe.
+	ResourceID *string
+}
+
+// RecipeStatus - The recipe which deployed the infrastructure of a resource.
+type RecipeStatus struct {
+	// The parameters the recipe was deployed with, after merging the parameters of the environment and of the resource.
+	Parameters map[string]any
+
+	// The version of the template resolved during the deployment: the tag of the template path for Bicep recipes, and the
+	// module version selected by Terraform for Terraform recipes.
+	ResolvedVersion *string
+
+	// The digest of the content of the deployed template.
+	TemplateDigest *string
+
+	// REQUIRED; The format of the template provided by the recipe.
+	TemplateKind *string
+
+	// REQUIRED; The path to the template provided by the recipe.
+	TemplatePath *string
+
+	// The version of the template requested by the recipe.
+	TemplateVersion *string
+}
+
 // RecipeUpdate - The recipe used to automatically deploy underlying infrastructure for a portable resource
 type RecipeUpdate struct {
 	// The name of the recipe within the environment to use
@@ -1424,6 +1890,52 @@ type RecipeUpdate struct {
 	Parameters map[string]any
 }
 
+// RecipeUpgrade - Represents the request body of the upgradeRecipes action.
+type RecipeUpgrade struct {
+	// The number of resources upgraded concurrently. The upgrade stops after the first batch with a failure. Defaults to 5.
+	BatchSize *int32
+}
+
+// RecipeUpgradeStatus - The status of the upgrade of the recipes of the resources of an environment.
+type RecipeUpgradeStatus struct {
+	// REQUIRED; The number of resources upgraded concurrently.
+	BatchSize *int32
+
+	// The IDs of the resources which failed to be upgraded.
+	FailedResources []*string
+
+	// REQUIRED; The time when the status was last updated.
+	LastUpdatedTime *time.Time
+
+	// The reason the upgrade was halted.
+	Message *string
+
+	// REQUIRED; The state of the upgrade.
+	State *RecipeUpgradeState
+
+	// REQUIRED; The number of resources deployed by an older version of their recipe when the upgrade started.
+	Total *int32
+
+	// REQUIRED; The number of resources which were upgraded.
+	Upgraded *int32
+}
+
+// RegistryAuthentication - Authentication information used to access a private OCI registry. Credentials are read from
+// a secret store so that they are never stored in or returned by the environment.
+type RegistryAuthentication struct {
+	// REQUIRED; The ID of an Applications.Core/secretStores resource containing the credentials. The secret store must contain
+	// either 'username' and 'password' keys, or a 'token' key.
+	Secret *string
+}
+
+// RegistryAuthenticationUpdate - Authentication information used to access a private OCI registry. Credentials are read
+// from a secret store so that they are never stored in or returned by the environment.
+type RegistryAuthenticationUpdate struct {
+	// The ID of an Applications.Core/secretStores resource containing the credentials. The secret store must contain either
+	// 'username' and 'password' keys, or a 'token' key.
+	Secret *string
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -1452,6 +1964,12 @@ type ResourceStatus struct {
 
 	// Properties of an output resource
 	OutputResources []*OutputResource
+
+	// The recipe which deployed the infrastructure of the resource.
+	Recipe *RecipeStatus
+
+	// The drift status of the infrastructure deployed by the recipe of the resource.
+	RecipeDrift *RecipeDriftStatus
 }
 
 // RuntimesProperties - The properties for runtime configuration
@@ -1475,6 +1993,28 @@ type SecretObjectProperties struct {
 	Version *string
 }
 
+// SecretReference - This specifies a reference to a secret. Secrets are encrypted, often have fine-grained access control,
+// auditing and are recommended to be used to hold sensitive data.
+type SecretReference struct {
+	// REQUIRED; The key of the secret in the secret store, or the name of the secret or computed value of the resource.
+	Key *string
+
+	// REQUIRED; The ID of an Applications.Core/secretStores resource, or of another Radius resource whose secret or computed
+// value is referenced.
+	Source *string
+}
+
+// SecretReferenceUpdate - This specifies a reference to a secret. Secrets are encrypted, often have fine-grained access
+// control, auditing and are recommended to be used to hold sensitive data.
+type SecretReferenceUpdate struct {
+	// The key of the secret in the secret store, or the name of the secret or computed value of the resource.
+	Key *string
+
+	// The ID of an Applications.Core/secretStores resource, or of another Radius resource whose secret or computed value is
+// referenced.
+	Source *string
+}
+
 // SecretStoreListSecretsResult - The list of secrets
 type SecretStoreListSecretsResult struct {
 	// REQUIRED; An object to represent key-value type secrets
@@ -1633,6 +2173,100 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformAuthenticationConfig - Authentication information used to download Terraform modules from private module
+// sources.
+type TerraformAuthenticationConfig struct {
+	// Credentials for Git module sources using the 'git::https://' prefix, keyed by host. For example: 'github.com'.
+	Git map[string]*ModuleSourceAuthentication
+
+	// Credentials for HTTP module sources, keyed by host. For example: 'artifacts.example.com'.
+	HTTP map[string]*ModuleSourceAuthentication
+
+	// Credentials for private Terraform module registries, keyed by host. For example: 'app.terraform.io'.
+	Registry map[string]*ModuleSourceAuthentication
+}
+
+// TerraformAuthenticationConfigUpdate - Authentication information used to download Terraform modules from private module
+// sources.
+type TerraformAuthenticationConfigUpdate struct {
+	// Credentials for Git module sources using the 'git::https://' prefix, keyed by host. For example: 'github.com'.
+	Git map[string]*ModuleSourceAuthenticationUpdate
+
+	// Credentials for HTTP module sources, keyed by host. For example: 'artifacts.example.com'.
+	HTTP map[string]*ModuleSourceAuthenticationUpdate
+
+	// Credentials for private Terraform module registries, keyed by host. For example: 'app.terraform.io'.
+	Registry map[string]*ModuleSourceAuthenticationUpdate
+}
+
+// TerraformBackendConfig - Configuration of the Terraform backend storing the state of Terraform recipes.
+type TerraformBackendConfig struct {
+	// Configuration of the backend passed to Terraform, for example the bucket and region of an s3 backend. Radius sets the
+	// key, path or address of the state of each resource, and uses the configured 'key' of s3 and azurerm backends as a
+	// prefix.
+	Config map[string]any
+
+	// REQUIRED; The kind of the Terraform backend. Allowed values: kubernetes, s3, azurerm, http, local.
+	Kind *string
+
+	// The ID of an Applications.Core/secretStores resource whose keys are added to the configuration of the backend, for
+	// example the credentials used to access the backend.
+	Secret *string
+}
+
+// TerraformBackendConfigUpdate - Configuration of the Terraform backend storing the state of Terraform recipes.
+type TerraformBackendConfigUpdate struct {
+	// Configuration of the backend passed to Terraform, for example the bucket and region of an s3 backend. Radius sets the
+	// key, path or address of the state of each resource, and uses the configured 'key' of s3 and azurerm backends as a
+	// prefix.
+	Config map[string]any
+
+	// The kind of the Terraform backend. Allowed values: kubernetes, s3, azurerm, http, local.
+	Kind *string
+
+	// The ID of an Applications.Core/secretStores resource whose keys are added to the configuration of the backend, for
+	// example the credentials used to access the backend.
+	Secret *string
+}
+
+// TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform modules are downloaded and how
+// Terraform is run.
+type TerraformConfigProperties struct {
+	// Authentication information used to download Terraform modules from private module sources.
+	Authentication *TerraformAuthenticationConfig
+
+	// The Terraform backend storing the state of the recipes deployed to the environment. Defaults to a Kubernetes secret
+	// backend.
+	Backend *TerraformBackendConfig
+
+	// Environment variables set for the Terraform process.
+	Env map[string]*string
+
+	// Configuration of Terraform providers, keyed by provider name. Each entry is a list of provider configurations, where a
+	// configuration with an 'alias' key defines an alternate provider configuration. The configuration is merged with the
+	// configuration Radius generates for the provider.
+	Providers map[string][]map[string]any
+}
+
+// TerraformConfigPropertiesUpdate - Configuration for Terraform Recipes. Controls how Terraform modules are downloaded and
+// how Terraform is run.
+type TerraformConfigPropertiesUpdate struct {
+	// Authentication information used to download Terraform modules from private module sources.
+	Authentication *TerraformAuthenticationConfigUpdate
+
+	// The Terraform backend storing the state of the recipes deployed to the environment. Defaults to a Kubernetes secret
+	// backend.
+	Backend *TerraformBackendConfigUpdate
+
+	// Environment variables set for the Terraform process.
+	Env map[string]*string
+
+	// Configuration of Terraform providers, keyed by provider name. Each entry is a list of provider configurations, where a
+	// configuration with an 'alias' key defines an alternate provider configuration. The configuration is merged with the
+	// configuration Radius generates for the provider.
+	Providers map[string][]map[string]any
+}
+
 // TerraformRecipeProperties - Represents Terraform recipe properties.
 type TerraformRecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
@@ -1726,6 +2360,30 @@ type Volume struct {
 // GetVolume implements the VolumeClassification interface for type Volume.
 func (v *Volume) GetVolume() *Volume { return v }
 
+// VolumeMount - Specifies a volume of the container resource mounted into an init container or a sidecar container
+type VolumeMount struct {
+	// REQUIRED; The path where the volume is mounted.
+	MountPath *string
+
+	// REQUIRED; The name of the volume in the volumes of the container.
+	Volume *string
+
+	// Mounts the volume read-only when true. Defaults to false.
+	ReadOnly *bool
+}
+
+// VolumeMountUpdate - Specifies a volume of the container resource mounted into an init container or a sidecar container
+type VolumeMountUpdate struct {
+	// The path where the volume is mounted.
+	MountPath *string
+
+	// Mounts the volume read-only when true. Defaults to false.
+	ReadOnly *bool
+
+	// The name of the volume in the volumes of the container.
+	Volume *string
+}
+
 // VolumeProperties - Volume properties
 type VolumeProperties struct {
 	// REQUIRED; Fully qualified resource ID for the application that the portable resource is consumed by
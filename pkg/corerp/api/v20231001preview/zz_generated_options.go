@@ -66,6 +66,13 @@ type ContainersClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientBeginUpgradeRecipesOptions contains the optional parameters for the EnvironmentsClient.BeginUpgradeRecipes
+// method.
+type EnvironmentsClientBeginUpgradeRecipesOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // EnvironmentsClientCreateOrUpdateOptions contains the optional parameters for the EnvironmentsClient.CreateOrUpdate method.
 type EnvironmentsClientCreateOrUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -76,6 +83,11 @@ type EnvironmentsClientDeleteOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientExportRecipeStateOptions contains the optional parameters for the EnvironmentsClient.ExportRecipeState method.
+type EnvironmentsClientExportRecipeStateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientGetMetadataOptions contains the optional parameters for the EnvironmentsClient.GetMetadata method.
 type EnvironmentsClientGetMetadataOptions struct {
 	// placeholder for future optional parameters
@@ -91,6 +103,11 @@ type EnvironmentsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientUnlockRecipeStateOptions contains the optional parameters for the EnvironmentsClient.UnlockRecipeState method.
+type EnvironmentsClientUnlockRecipeStateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientUpdateOptions contains the optional parameters for the EnvironmentsClient.Update method.
 type EnvironmentsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -109,6 +126,18 @@ type ExtendersClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// ExtendersClientBeginDetectDriftOptions contains the optional parameters for the ExtendersClient.BeginDetectDrift method.
+type ExtendersClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// ExtendersClientBeginReconcileDriftOptions contains the optional parameters for the ExtendersClient.BeginReconcileDrift method.
+type ExtendersClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // ExtendersClientBeginUpdateOptions contains the optional parameters for the ExtendersClient.BeginUpdate method.
 type ExtendersClientBeginUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -130,6 +159,11 @@ type ExtendersClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ExtendersClientPlanOptions contains the optional parameters for the ExtendersClient.Plan method.
+type ExtendersClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // GatewaysClientBeginCreateOptions contains the optional parameters for the GatewaysClient.BeginCreate method.
 type GatewaysClientBeginCreateOptions struct {
 	// Resumes the LRO from the provided token.
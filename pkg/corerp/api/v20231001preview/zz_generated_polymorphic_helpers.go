@@ -61,8 +61,12 @@ func unmarshalExtensionClassification(rawMsg json.RawMessage) (ExtensionClassifi
 	}
 	var b ExtensionClassification
 	switch m["kind"] {
+	case "autoScaling":
+		b = &AutoScalingExtension{}
 	case "daprSidecar":
 		b = &DaprSidecarExtension{}
+	case "gatewayApi":
+		b = &GatewayAPIExtension{}
 	case "kubernetesMetadata":
 		b = &KubernetesMetadataExtension{}
 	case "kubernetesNamespace":
@@ -134,6 +138,8 @@ func unmarshalRecipePropertiesClassification(rawMsg json.RawMessage) (RecipeProp
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipeProperties{}
+	case "helm":
+		b = &HelmRecipeProperties{}
 	case "terraform":
 		b = &TerraformRecipeProperties{}
 	default:
@@ -176,6 +182,8 @@ func unmarshalRecipePropertiesUpdateClassification(rawMsg json.RawMessage) (Reci
 	switch m["templateKind"] {
 	case "bicep":
 		b = &BicepRecipePropertiesUpdate{}
+	case "helm":
+		b = &HelmRecipePropertiesUpdate{}
 	case "terraform":
 		b = &TerraformRecipePropertiesUpdate{}
 	default:
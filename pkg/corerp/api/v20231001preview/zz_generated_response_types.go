@@ -82,6 +82,12 @@ type EnvironmentsClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// EnvironmentsClientExportRecipeStateResponse contains the response from method EnvironmentsClient.ExportRecipeState.
+type EnvironmentsClientExportRecipeStateResponse struct {
+	// The Terraform state of the recipe deployed for a resource.
+	RecipeStateResponse
+}
+
 // EnvironmentsClientGetMetadataResponse contains the response from method EnvironmentsClient.GetMetadata.
 type EnvironmentsClientGetMetadataResponse struct {
 	// The properties of a Recipe linked to an Environment.
@@ -100,12 +106,23 @@ type EnvironmentsClientListByScopeResponse struct {
 	EnvironmentResourceListResult
 }
 
+// EnvironmentsClientUnlockRecipeStateResponse contains the response from method EnvironmentsClient.UnlockRecipeState.
+type EnvironmentsClientUnlockRecipeStateResponse struct {
+	// The Terraform state of the recipe deployed for a resource.
+	RecipeStateResponse
+}
+
 // EnvironmentsClientUpdateResponse contains the response from method EnvironmentsClient.Update.
 type EnvironmentsClientUpdateResponse struct {
 	// The environment resource
 	EnvironmentResource
 }
 
+// EnvironmentsClientUpgradeRecipesResponse contains the response from method EnvironmentsClient.BeginUpgradeRecipes.
+type EnvironmentsClientUpgradeRecipesResponse struct {
+	// placeholder for future response values
+}
+
 // ExtendersClientCreateOrUpdateResponse contains the response from method ExtendersClient.BeginCreateOrUpdate.
 type ExtendersClientCreateOrUpdateResponse struct {
 	// ExtenderResource portable resource
@@ -117,6 +134,11 @@ type ExtendersClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// ExtendersClientDetectDriftResponse contains the response from method ExtendersClient.BeginDetectDrift.
+type ExtendersClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // ExtendersClientGetResponse contains the response from method ExtendersClient.Get.
 type ExtendersClientGetResponse struct {
 	// ExtenderResource portable resource
@@ -135,6 +157,17 @@ type ExtendersClientListSecretsResponse struct {
 	Object map[string]any
 }
 
+// ExtendersClientPlanResponse contains the response from method ExtendersClient.Plan.
+type ExtendersClientPlanResponse struct {
+	// The preview of the changes a deployment of a recipe would make to the infrastructure.
+	RecipePlan
+}
+
+// ExtendersClientReconcileDriftResponse contains the response from method ExtendersClient.BeginReconcileDrift.
+type ExtendersClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
 // ExtendersClientUpdateResponse contains the response from method ExtendersClient.BeginUpdate.
 type ExtendersClientUpdateResponse struct {
 	// ExtenderResource portable resource
@@ -40,8 +40,11 @@ import (
 	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/resourcemodel"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
@@ -60,8 +63,8 @@ type DeploymentProcessor interface {
 }
 
 // NewDeploymentProcessor creates a new instance of the DeploymentProcessor struct with the given parameters.
-func NewDeploymentProcessor(appmodel model.ApplicationModel, sp dataprovider.DataStorageProvider, k8sClient controller_runtime.Client, k8sClientSet kubernetes.Interface) DeploymentProcessor {
-	return &deploymentProcessor{appmodel: appmodel, sp: sp, k8sClient: k8sClient, k8sClientSet: k8sClientSet}
+func NewDeploymentProcessor(appmodel model.ApplicationModel, sp dataprovider.DataStorageProvider, k8sClient controller_runtime.Client, k8sClientSet kubernetes.Interface, clusters ucp_kubernetes.ClusterProvider) DeploymentProcessor {
+	return &deploymentProcessor{appmodel: appmodel, sp: sp, k8sClient: k8sClient, k8sClientSet: k8sClientSet, clusters: clusters}
 }
 
 var _ DeploymentProcessor = (*deploymentProcessor)(nil)
@@ -73,6 +76,8 @@ type deploymentProcessor struct {
 	k8sClient controller_runtime.Client
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+	// clusters provides the clients of the clusters registered as Kubernetes planes.
+	clusters ucp_kubernetes.ClusterProvider
 }
 
 type ResourceData struct {
@@ -274,6 +279,16 @@ func (dp *deploymentProcessor) Deploy(ctx context.Context, id resources.ID, rend
 		return rpv1.DeploymentOutput{}, err
 	}
 
+	// Kubernetes resources are deployed to the cluster targeted by the environment.
+	planeName := env.Properties.Compute.KubernetesCompute.PlaneName()
+	if !ucp_kubernetes.IsLocalPlane(planeName) {
+		for i, outputResource := range orderedOutputResources {
+			if outputResource.GetResourceType().Provider == resourcemodel.ProviderKubernetes && resources_kubernetes.PlaneNameFromID(outputResource.ID) != "" {
+				orderedOutputResources[i].ID = resources_kubernetes.WithPlaneName(outputResource.ID, planeName)
+			}
+		}
+	}
+
 	deployedOutputResources := []rpv1.OutputResource{}
 
 	// Values consumed by other Radius resource types through connections
@@ -417,6 +432,11 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
 	}
 
+	// Get Environment GatewayAPI Info
+	if envExt := corerp_dm.FindExtension(env.Properties.Extensions, corerp_dm.GatewayAPI); envExt != nil && envExt.GatewayAPI != nil {
+		envOpts.GatewayAPI = envExt.GatewayAPI
+	}
+
 	if publicEndpointOverride != "" {
 		// Check if publicEndpointOverride contains a scheme,
 		// and if so, throw an error to the user
@@ -441,10 +461,15 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		return envOpts, nil
 	}
 
-	if dp.k8sClient != nil {
+	k8sClient, err := dp.getKubernetesClient(ctx, env)
+	if err != nil {
+		return renderers.EnvironmentOptions{}, err
+	}
+
+	if k8sClient != nil {
 		// Find the public endpoint of the cluster (External IP or hostname of the contour-envoy service)
 		var services corev1.ServiceList
-		err := dp.k8sClient.List(ctx, &services, &controller_runtime.ListOptions{Namespace: "radius-system"})
+		err := k8sClient.List(ctx, &services, &controller_runtime.ListOptions{Namespace: "radius-system"})
 		if err != nil {
 			return renderers.EnvironmentOptions{}, fmt.Errorf("failed to look up Services: %w", err)
 		}
@@ -466,6 +491,21 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 	return envOpts, nil
 }
 
+// getKubernetesClient returns the client of the cluster targeted by the environment.
+func (dp *deploymentProcessor) getKubernetesClient(ctx context.Context, env *corerp_dm.Environment) (controller_runtime.Client, error) {
+	planeName := env.Properties.Compute.KubernetesCompute.PlaneName()
+	if dp.clusters == nil || ucp_kubernetes.IsLocalPlane(planeName) {
+		return dp.k8sClient, nil
+	}
+
+	cluster, err := dp.clusters.GetCluster(ctx, planeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster.Clients.RuntimeClient, nil
+}
+
 // getAppOptions: Populates and Returns ApplicationOptions.
 func (dp *deploymentProcessor) getAppOptions(ctx context.Context, appProp *corerp_dm.ApplicationProperties) (renderers.ApplicationOptions, error) {
 	appOpts := renderers.ApplicationOptions{}
@@ -302,7 +302,7 @@ func Test_Render(t *testing.T) {
 	}
 
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+	dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 	t.Run("verify render success", func(t *testing.T) {
 		testResource := getTestResource()
@@ -855,6 +855,10 @@ func Test_Render(t *testing.T) {
 }
 
 func setupDeployMocks(mocks SharedMocks, simulated bool) {
+	setupDeployMocksWithComputeResourceID(mocks, simulated, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster")
+}
+
+func setupDeployMocksWithComputeResourceID(mocks SharedMocks, simulated bool, computeResourceID string) {
 	testResource := getTestResource()
 	mocks.dbProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).AnyTimes().Return(mocks.db, nil)
 	cr := store.Object{
@@ -897,7 +901,7 @@ func setupDeployMocks(mocks SharedMocks, simulated bool) {
 			Compute: rpv1.EnvironmentCompute{
 				Kind: "kubernetes",
 				KubernetesCompute: rpv1.KubernetesComputeProperties{
-					ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+					ResourceID: computeResourceID,
 					Namespace:  "default",
 				},
 			},
@@ -921,7 +925,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy success", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -958,10 +962,35 @@ func Test_Deploy(t *testing.T) {
 		require.Equal(t, map[string]any{"url": testRendererOutput.ComputedValues["url"].Value}, deploymentOutput.ComputedValues)
 	})
 
+	t.Run("Verify deploy to a registered cluster", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		mocks := setup(t)
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
+
+		testResource := getTestResource()
+		testRendererOutput := getTestRendererOutput()
+		testRendererOutput.Resources[0].ID = resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "test-namespace", "test-service")
+		resourceID := getTestResourceID(testResource.ID)
+
+		setupDeployMocksWithComputeResourceID(mocks, false, "/planes/kubernetes/cluster1")
+
+		mocks.resourceHandler.
+			EXPECT().
+			Put(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, options *handlers.PutOptions) (map[string]string, error) {
+				require.Equal(t, "/planes/kubernetes/cluster1/namespaces/test-namespace/providers/core/Service/test-service", options.Resource.ID.String())
+				return map[string]string{}, nil
+			})
+
+		deploymentOutput, err := dp.Deploy(ctx, resourceID, testRendererOutput)
+		require.NoError(t, err)
+		require.Equal(t, "/planes/kubernetes/cluster1/namespaces/test-namespace/providers/core/Service/test-service", deploymentOutput.DeployedOutputResources[0].ID.String())
+	})
+
 	t.Run("Verify deploy success with simulated env", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -979,7 +1008,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy failure", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -996,7 +1025,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Output resource dependency missing local ID", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -1014,7 +1043,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Invalid output resource type", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -1032,7 +1061,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Missing output resource identity", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -1058,7 +1087,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete success", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -1072,7 +1101,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete failure", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -1086,7 +1115,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete with no output resources", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -1102,7 +1131,7 @@ func Test_Delete(t *testing.T) {
 func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+	dp := deploymentProcessor{mocks.model, nil, nil, nil, nil}
 
 	env := &datamodel.Environment{
 		Properties: datamodel.EnvironmentProperties{
@@ -1158,10 +1187,46 @@ func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 	})
 }
 
+func Test_getEnvOptions_GatewayAPI(t *testing.T) {
+	ctx := testcontext.New(t)
+	mocks := setup(t)
+	dp := deploymentProcessor{mocks.model, nil, nil, nil, nil}
+
+	env := &datamodel.Environment{
+		Properties: datamodel.EnvironmentProperties{
+			Compute: rpv1.EnvironmentCompute{
+				Kind: rpv1.KubernetesComputeKind,
+				KubernetesCompute: rpv1.KubernetesComputeProperties{
+					Namespace: "radius-system",
+				},
+			},
+		},
+	}
+
+	t.Run("without extension", func(t *testing.T) {
+		options, err := dp.getEnvOptions(ctx, env)
+		require.NoError(t, err)
+		require.Nil(t, options.GatewayAPI)
+	})
+
+	t.Run("with extension", func(t *testing.T) {
+		env.Properties.Extensions = []datamodel.Extension{
+			{
+				Kind:       datamodel.GatewayAPI,
+				GatewayAPI: &datamodel.GatewayAPIExtension{GatewayClassName: "istio"},
+			},
+		}
+
+		options, err := dp.getEnvOptions(ctx, env)
+		require.NoError(t, err)
+		require.Equal(t, &datamodel.GatewayAPIExtension{GatewayClassName: "istio"}, options.GatewayAPI)
+	})
+}
+
 func Test_getResourceDataByID(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil}
+	dp := deploymentProcessor{mocks.model, mocks.dbProvider, nil, nil, nil}
 
 	t.Run("Get recipe data from connected mongoDB resources", func(t *testing.T) {
 		mocks.dbProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Times(1).Return(mocks.db, nil)
@@ -1188,7 +1253,7 @@ func Test_fetchSecrets(t *testing.T) {
 	ctx := testcontext.New(t)
 
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+	dp := deploymentProcessor{mocks.model, nil, nil, nil, nil}
 
 	t.Run("Get secrets from recipe data when resource has associated recipe", func(t *testing.T) {
 		mongoResource := buildMongoDBResourceDataWithRecipeAndSecrets()
@@ -17,6 +17,8 @@ limitations under the License.
 package datamodel
 
 import (
+	"encoding/json"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -73,6 +75,8 @@ type ContainerProperties struct {
 	rpv1.BasicResourceProperties
 	Connections          map[string]ConnectionProperties `json:"connections,omitempty"`
 	Container            Container                       `json:"container,omitempty"`
+	InitContainers       []AdditionalContainer           `json:"initContainers,omitempty"`
+	Sidecars             []AdditionalContainer           `json:"sidecars,omitempty"`
 	Extensions           []Extension                     `json:"extensions,omitempty"`
 	Identity             *rpv1.IdentitySettings          `json:"identity,omitempty"`
 	Runtimes             *RuntimeProperties              `json:"runtimes,omitempty"`
@@ -115,16 +119,92 @@ type ConnectionProperties struct {
 
 // Container - Definition of a container.
 type Container struct {
-	Image           string                      `json:"image,omitempty"`
-	ImagePullPolicy string                      `json:"imagePullPolicy,omitempty"`
-	Env             map[string]string           `json:"env,omitempty"`
-	LivenessProbe   HealthProbeProperties       `json:"livenessProbe,omitempty"`
-	Ports           map[string]ContainerPort    `json:"ports,omitempty"`
-	ReadinessProbe  HealthProbeProperties       `json:"readinessProbe,omitempty"`
-	Volumes         map[string]VolumeProperties `json:"volumes,omitempty"`
-	Command         []string                    `json:"command,omitempty"`
-	Args            []string                    `json:"args,omitempty"`
-	WorkingDir      string                      `json:"workingDir,omitempty"`
+	Image           string                         `json:"image,omitempty"`
+	ImagePullPolicy string                         `json:"imagePullPolicy,omitempty"`
+	Env             map[string]EnvironmentVariable `json:"env,omitempty"`
+	LivenessProbe   HealthProbeProperties          `json:"livenessProbe,omitempty"`
+	Ports           map[string]ContainerPort       `json:"ports,omitempty"`
+	ReadinessProbe  HealthProbeProperties          `json:"readinessProbe,omitempty"`
+	Volumes         map[string]VolumeProperties    `json:"volumes,omitempty"`
+	Command         []string                       `json:"command,omitempty"`
+	Args            []string                       `json:"args,omitempty"`
+	WorkingDir      string                         `json:"workingDir,omitempty"`
+	Resources       *ResourceRequirements          `json:"resources,omitempty"`
+}
+
+// AdditionalContainer - Specifies an init container or a sidecar container that runs in the same pod as the container.
+type AdditionalContainer struct {
+	Name            string                             `json:"name,omitempty"`
+	Image           string                             `json:"image,omitempty"`
+	ImagePullPolicy string                             `json:"imagePullPolicy,omitempty"`
+	Env             map[string]EnvironmentVariable     `json:"env,omitempty"`
+	Ports           map[string]AdditionalContainerPort `json:"ports,omitempty"`
+	VolumeMounts    []VolumeMount                      `json:"volumeMounts,omitempty"`
+	Command         []string                           `json:"command,omitempty"`
+	Args            []string                           `json:"args,omitempty"`
+	WorkingDir      string                             `json:"workingDir,omitempty"`
+	Resources       *ResourceRequirements              `json:"resources,omitempty"`
+}
+
+// AdditionalContainerPort - Specifies a listening port of an init container or a sidecar container.
+type AdditionalContainerPort struct {
+	ContainerPort int32    `json:"containerPort,omitempty"`
+	Protocol      Protocol `json:"protocol,omitempty"`
+}
+
+// VolumeMount - Specifies a volume of the container mounted into an init container or a sidecar container.
+type VolumeMount struct {
+	// Volume is the name of the volume in the volumes of the container.
+	Volume    string `json:"volume,omitempty"`
+	MountPath string `json:"mountPath,omitempty"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// EnvironmentVariable - Specifies an environment variable of the container. Either Value or ValueFrom is set.
+type EnvironmentVariable struct {
+	// Value is the literal value of the environment variable.
+	Value *string `json:"value,omitempty"`
+	// ValueFrom is the reference to the secret holding the value of the environment variable.
+	ValueFrom *EnvironmentVariableReference `json:"valueFrom,omitempty"`
+}
+
+// UnmarshalJSON unmarshals an environment variable. Container resources stored before environment variables
+// supported references hold plain string values, which are read as literal values.
+func (e *EnvironmentVariable) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*e = EnvironmentVariable{Value: &value}
+		return nil
+	}
+
+	type environmentVariable EnvironmentVariable
+	return json.Unmarshal(data, (*environmentVariable)(e))
+}
+
+// EnvironmentVariableReference - Specifies the reference to the value of an environment variable.
+type EnvironmentVariableReference struct {
+	SecretRef *EnvironmentVariableSecretReference `json:"secretRef,omitempty"`
+}
+
+// EnvironmentVariableSecretReference - Specifies a secret of an Applications.Core/secretStores resource, or a secret
+// or computed value of another Radius resource.
+type EnvironmentVariableSecretReference struct {
+	// Source is the resource ID of the secret store or of the resource.
+	Source string `json:"source,omitempty"`
+	// Key is the key of the secret in the secret store, or the name of the secret or computed value of the resource.
+	Key string `json:"key,omitempty"`
+}
+
+// ResourceRequirements - Specifies the compute resources requested by and the limits enforced on the container.
+type ResourceRequirements struct {
+	Requests *ComputeResources `json:"requests,omitempty"`
+	Limits   *ComputeResources `json:"limits,omitempty"`
+}
+
+// ComputeResources - Specifies amounts of CPU and memory expressed as Kubernetes quantities, e.g. "500m" or "256Mi".
+type ComputeResources struct {
+	CPU    string `json:"cpu,omitempty"`
+	Memory string `json:"memory,omitempty"`
 }
 
 // ContainerPort - Specifies a listening port for the container
@@ -252,6 +332,14 @@ type ManualScalingExtension struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 }
 
+// AutoScalingExtension - AutoScaling Extension
+type AutoScalingExtension struct {
+	MinReplicas             *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas             int32  `json:"maxReplicas,omitempty"`
+	TargetCPUUtilization    *int32 `json:"targetCpuUtilization,omitempty"`
+	TargetMemoryUtilization *int32 `json:"targetMemoryUtilization,omitempty"`
+}
+
 // DaprSidecarExtension - Specifies the resource should have a Dapr sidecar injected
 type DaprSidecarExtension struct {
 	AppID    string   `json:"appId,omitempty"`
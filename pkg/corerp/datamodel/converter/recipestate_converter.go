@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"encoding/json"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+// RecipeStateResponseDataModelToVersioned converts version agnostic recipe state response datamodel to versioned model.
+func RecipeStateResponseDataModelToVersioned(model *datamodel.RecipeStateResponse, version string) (v1.VersionedModelInterface, error) {
+	switch version {
+	case v20231001preview.Version:
+		versioned := &v20231001preview.RecipeStateResponse{}
+		if err := versioned.ConvertFrom(model); err != nil {
+			return nil, err
+		}
+		return versioned, nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// RecipeStateExportDataModelFromVersioned converts the versioned exportRecipeState request to datamodel.
+func RecipeStateExportDataModelFromVersioned(content []byte, version string) (*datamodel.RecipeState, error) {
+	switch version {
+	case v20231001preview.Version:
+		am := &v20231001preview.RecipeStateExport{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.RecipeState), nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
+
+// RecipeStateUnlockDataModelFromVersioned converts the versioned unlockRecipeState request to datamodel.
+func RecipeStateUnlockDataModelFromVersioned(content []byte, version string) (*datamodel.RecipeState, error) {
+	switch version {
+	case v20231001preview.Version:
+		am := &v20231001preview.RecipeStateUnlock{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.RecipeState), nil
+
+	default:
+		return nil, v1.ErrUnsupportedAPIVersion
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+// NOTENOTE: this test is to validate the type conversion between versioned model and data model.
+// Converted content must be tested in ConvertFrom and ConvertTo tests in api models under /pkg/api/[api-version].
+
+func TestRecipeStateResponseDataModelToVersioned(t *testing.T) {
+	dm := &datamodel.RecipeStateResponse{Backend: "kubernetes"}
+
+	am, err := RecipeStateResponseDataModelToVersioned(dm, v20231001preview.Version)
+	require.NoError(t, err)
+	require.IsType(t, &v20231001preview.RecipeStateResponse{}, am)
+
+	_, err = RecipeStateResponseDataModelToVersioned(dm, "unsupported")
+	require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+}
+
+func TestRecipeStateDataModelFromVersioned(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+	content := []byte(`{"resourceId": "` + resourceID + `", "lockId": "lock-id"}`)
+
+	dm, err := RecipeStateExportDataModelFromVersioned(content, v20231001preview.Version)
+	require.NoError(t, err)
+	require.Equal(t, &datamodel.RecipeState{ResourceID: resourceID}, dm)
+
+	dm, err = RecipeStateUnlockDataModelFromVersioned(content, v20231001preview.Version)
+	require.NoError(t, err)
+	require.Equal(t, &datamodel.RecipeState{ResourceID: resourceID, LockID: "lock-id"}, dm)
+
+	_, err = RecipeStateExportDataModelFromVersioned([]byte("{"), v20231001preview.Version)
+	require.Error(t, err)
+
+	_, err = RecipeStateExportDataModelFromVersioned(content, "unsupported")
+	require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+
+	_, err = RecipeStateUnlockDataModelFromVersioned(content, "unsupported")
+	require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+}
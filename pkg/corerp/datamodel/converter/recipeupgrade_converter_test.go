@@ -0,0 +1,44 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+// NOTENOTE: this test is to validate the type conversion between versioned model and data model.
+// Converted content must be tested in ConvertFrom and ConvertTo tests in api models under /pkg/api/[api-version].
+
+func TestRecipeUpgradeDataModelFromVersioned(t *testing.T) {
+	dm, err := RecipeUpgradeDataModelFromVersioned([]byte(`{"batchSize": 2}`), v20231001preview.Version)
+	require.NoError(t, err)
+	require.Equal(t, &datamodel.RecipeUpgrade{BatchSize: 2}, dm)
+
+	_, err = RecipeUpgradeDataModelFromVersioned([]byte("{"), v20231001preview.Version)
+	require.Error(t, err)
+
+	_, err = RecipeUpgradeDataModelFromVersioned([]byte(`{"batchSize": -1}`), v20231001preview.Version)
+	require.Error(t, err)
+
+	_, err = RecipeUpgradeDataModelFromVersioned([]byte("{}"), "unsupported")
+	require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package datamodel
 
 import (
+	"time"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
@@ -38,11 +40,116 @@ func (e *Environment) ResourceTypeName() string {
 
 // EnvironmentProperties represents the properties of Environment.
 type EnvironmentProperties struct {
-	Compute    rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
-	Recipes    map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
-	Providers  Providers                                         `json:"providers,omitempty"`
-	Extensions []Extension                                       `json:"extensions,omitempty"`
-	Simulated  bool                                              `json:"simulated,omitempty"`
+	Compute      rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
+	Recipes      map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
+	Providers    Providers                                         `json:"providers,omitempty"`
+	Extensions   []Extension                                       `json:"extensions,omitempty"`
+	Simulated    bool                                              `json:"simulated,omitempty"`
+
+	// RecipeUpgrade is the status of the last upgrade of the recipes of the resources of the environment.
+	RecipeUpgrade *RecipeUpgradeStatus `json:"recipeUpgrade,omitempty"`
+}
+
+// DefaultRecipeUpgradeBatchSize is the number of resources upgraded concurrently when the batch size is not set.
+const DefaultRecipeUpgradeBatchSize = 5
+
+// RecipeUpgradeState is the state of the upgrade of the recipes of the resources of an environment.
+type RecipeUpgradeState string
+
+const (
+	// RecipeUpgradeStateInProgress represents an upgrade which is in progress.
+	RecipeUpgradeStateInProgress RecipeUpgradeState = "InProgress"
+	// RecipeUpgradeStateSucceeded represents an upgrade of all of the resources.
+	RecipeUpgradeStateSucceeded RecipeUpgradeState = "Succeeded"
+	// RecipeUpgradeStateFailed represents an upgrade which was stopped because a resource failed to be upgraded.
+	RecipeUpgradeStateFailed RecipeUpgradeState = "Failed"
+)
+
+// RecipeUpgradeStatus represents the status of the upgrade of the recipes of the resources of an environment.
+type RecipeUpgradeStatus struct {
+	// State is the state of the upgrade.
+	State RecipeUpgradeState `json:"state"`
+	// BatchSize is the number of resources upgraded concurrently.
+	BatchSize int `json:"batchSize"`
+	// Total is the number of resources deployed by an older version of their recipe when the upgrade started.
+	Total int `json:"total"`
+	// Upgraded is the number of resources which were upgraded.
+	Upgraded int `json:"upgraded"`
+	// FailedResources is the IDs of the resources which failed to be upgraded.
+	FailedResources []string `json:"failedResources,omitempty"`
+	// Message is the error message of the first resource which failed to be upgraded.
+	Message string `json:"message,omitempty"`
+	// LastUpdatedTime is the time when the status was last updated.
+	LastUpdatedTime time.Time `json:"lastUpdatedTime"`
+	// OperationID is the ID of the async operation running the upgrade.
+	OperationID string `json:"operationId,omitempty"`
+}
+
+// IsTerminal returns true if the upgrade is no longer in progress.
+func (s *RecipeUpgradeStatus) IsTerminal() bool {
+	return s.State != RecipeUpgradeStateInProgress
+}
+
+// RecipeConfigProperties represents the configuration for recipes of the environment.
+type RecipeConfigProperties struct {
+	// Terraform is the configuration for Terraform recipes.
+	Terraform TerraformConfigProperties `json:"terraform,omitempty"`
+	// Bicep is the configuration for Bicep recipes.
+	Bicep BicepConfigProperties `json:"bicep,omitempty"`
+}
+
+// TerraformConfigProperties represents the configuration for Terraform recipes.
+type TerraformConfigProperties struct {
+	// Authentication is the authentication information used to download Terraform modules from private module sources.
+	Authentication TerraformAuthenticationConfig `json:"authentication,omitempty"`
+	// Providers is the configuration of Terraform providers keyed by provider name. Each provider can have multiple
+	// configurations, distinguished by their "alias" setting.
+	Providers map[string][]map[string]any `json:"providers,omitempty"`
+	// Env is the environment variables set for the Terraform process.
+	Env map[string]string `json:"env,omitempty"`
+	// Backend is the configuration of the Terraform backend storing the state of the recipes.
+	Backend TerraformBackendConfig `json:"backend,omitempty"`
+}
+
+// TerraformBackendConfig represents the configuration of the Terraform backend storing the state of Terraform recipes.
+type TerraformBackendConfig struct {
+	// Kind is the kind of the backend, for example "s3". Defaults to the Kubernetes secret backend when empty.
+	Kind string `json:"kind,omitempty"`
+	// Config is the configuration of the backend passed to Terraform.
+	Config map[string]any `json:"config,omitempty"`
+	// Secret is the resource ID of the Applications.Core/secretStores resource whose keys are added to Config.
+	Secret string `json:"secret,omitempty"`
+}
+
+// TerraformAuthenticationConfig represents the authentication information used to download Terraform modules
+// from private module sources. Each map is keyed by the host of the module source.
+type TerraformAuthenticationConfig struct {
+	// Git is the authentication information for Git module sources.
+	Git map[string]ModuleSourceAuthentication `json:"git,omitempty"`
+	// HTTP is the authentication information for HTTP module sources.
+	HTTP map[string]ModuleSourceAuthentication `json:"http,omitempty"`
+	// Registry is the authentication information for private Terraform module registries.
+	Registry map[string]ModuleSourceAuthentication `json:"registry,omitempty"`
+}
+
+// ModuleSourceAuthentication represents the authentication information used to download Terraform modules from
+// a private module source.
+type ModuleSourceAuthentication struct {
+	// Secret is the resource ID of the Applications.Core/secretStores resource containing the credentials.
+	Secret string `json:"secret,omitempty"`
+}
+
+// BicepConfigProperties represents the configuration for Bicep recipes.
+type BicepConfigProperties struct {
+	// Authentication is the authentication information used to access private OCI registries, keyed by registry host.
+	Authentication map[string]RegistryAuthentication `json:"authentication,omitempty"`
+}
+
+// RegistryAuthentication represents the authentication information used to access a private OCI registry.
+type RegistryAuthentication struct {
+	// Secret is the resource ID of the Applications.Core/secretStores resource containing the credentials.
+	Secret string `json:"secret,omitempty"`
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
@@ -67,17 +174,58 @@ func (e *Recipe) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
+// RecipeState represents input properties for the exportRecipeState and unlockRecipeState apis.
+type RecipeState struct {
+	// ResourceID is the ID of the resource deployed by a Terraform recipe.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// LockID is the ID of the lock to release on the Terraform state of the resource.
+	LockID string `json:"lockId,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipeState instance.
+func (e *RecipeState) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipeStateResponse represents the Terraform state of the recipe deployed for a resource.
+type RecipeStateResponse struct {
+	// Backend is the kind of the Terraform backend storing the state.
+	Backend string `json:"backend"`
+
+	// State is the Terraform state of the resource.
+	State map[string]any `json:"state,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipeStateResponse instance.
+func (e *RecipeStateResponse) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipeUpgrade represents input properties for the upgradeRecipes api.
+type RecipeUpgrade struct {
+	// BatchSize is the number of resources upgraded concurrently.
+	BatchSize int `json:"batchSize,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipeUpgrade instance.
+func (e *RecipeUpgrade) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
 // ResourceTypeName returns the resource type of the EnvironmentRecipeProperties instance.
 func (e *EnvironmentRecipeProperties) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
-// Providers represents configs for providers for the environment, eg azure,aws
+// Providers represents configs for providers for the environment, eg azure,aws,gcp
 type Providers struct {
 	// Azure provider information
 	Azure ProvidersAzure `json:"azure,omitempty"`
 	// AWS provider information
 	AWS ProvidersAWS `json:"aws,omitempty"`
+	// GCP provider information
+	GCP ProvidersGCP `json:"gcp,omitempty"`
 }
 
 // ProvidersAzure represents the azure provider configs
@@ -91,3 +239,9 @@ type ProvidersAWS struct {
 	// Scope is the target level for deploying the aws resources
 	Scope string `json:"scope,omitempty"`
 }
+
+// ProvidersGCP represents the gcp provider configs
+type ProvidersGCP struct {
+	// Scope is the target level for deploying the gcp resources
+	Scope string `json:"scope,omitempty"`
+}
@@ -21,18 +21,22 @@ type ExtensionKind string
 
 const (
 	ManualScaling                ExtensionKind = "manualScaling"
+	AutoScaling                  ExtensionKind = "autoScaling"
 	DaprSidecar                  ExtensionKind = "daprSidecar"
 	KubernetesMetadata           ExtensionKind = "kubernetesMetadata"
 	KubernetesNamespaceExtension ExtensionKind = "kubernetesNamespace"
+	GatewayAPI                   ExtensionKind = "gatewayApi"
 )
 
 // Extension of a resource.
 type Extension struct {
 	Kind                ExtensionKind           `json:"kind,omitempty"`
 	ManualScaling       *ManualScalingExtension `json:"manualScaling,omitempty"`
+	AutoScaling         *AutoScalingExtension   `json:"autoScaling,omitempty"`
 	DaprSidecar         *DaprSidecarExtension   `json:"daprSidecar,omitempty"`
 	KubernetesMetadata  *KubeMetadataExtension  `json:"kubernetesMetadata,omitempty"`
 	KubernetesNamespace *KubeNamespaceExtension `json:"kubernetesNamespace,omitempty"`
+	GatewayAPI          *GatewayAPIExtension    `json:"gatewayApi,omitempty"`
 }
 
 // KubeMetadataExtension represents the extension of kubernetes resource.
@@ -46,6 +50,12 @@ type KubeNamespaceExtension struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// GatewayAPIExtension represents the extension to render the gateways of an environment as Kubernetes Gateway API objects.
+type GatewayAPIExtension struct {
+	// GatewayClassName is the name of the GatewayClass used by the rendered Gateway objects.
+	GatewayClassName string `json:"gatewayClassName,omitempty"`
+}
+
 // FindExtension searches a slice of Extensions for one with a matching ExtensionKind.
 func FindExtension(exts []Extension, kind ExtensionKind) *Extension {
 	for _, ext := range exts {
@@ -72,9 +72,55 @@ type GatewayProperties struct {
 
 // GatewayRoute represents the route attached to Gateway.
 type GatewayRoute struct {
-	Destination   string `json:"destination,omitempty"`
-	Path          string `json:"path,omitempty"`
-	ReplacePrefix string `json:"replacePrefix,omitempty"`
+	Destination     string                      `json:"destination,omitempty"`
+	Destinations    []GatewayRouteDestination   `json:"destinations,omitempty"`
+	Path            string                      `json:"path,omitempty"`
+	ReplacePrefix   string                      `json:"replacePrefix,omitempty"`
+	Match           *GatewayRouteMatch          `json:"match,omitempty"`
+	RequestHeaders  *GatewayRouteHeaderModifier `json:"requestHeaders,omitempty"`
+	ResponseHeaders *GatewayRouteHeaderModifier `json:"responseHeaders,omitempty"`
+	Timeout         string                      `json:"timeout,omitempty"`
+	RetryPolicy     *GatewayRouteRetryPolicy    `json:"retryPolicy,omitempty"`
+}
+
+// HasTrafficPolicy returns true if the route uses weighted destinations, match conditions, header modifications,
+// a timeout or a retry policy on top of the path based routing.
+func (r *GatewayRoute) HasTrafficPolicy() bool {
+	return len(r.Destinations) > 0 || r.Match != nil || r.RequestHeaders != nil || r.ResponseHeaders != nil || r.Timeout != "" || r.RetryPolicy != nil
+}
+
+// GetDestinations returns the destinations of the route. A route with a single destination returns it with no weight.
+func (r *GatewayRoute) GetDestinations() []GatewayRouteDestination {
+	if len(r.Destinations) > 0 {
+		return r.Destinations
+	}
+
+	return []GatewayRouteDestination{{Destination: r.Destination}}
+}
+
+// GatewayRouteDestination represents a weighted destination of a gateway route.
+type GatewayRouteDestination struct {
+	Destination string `json:"destination,omitempty"`
+	Weight      int32  `json:"weight"`
+}
+
+// GatewayRouteMatch represents the conditions the request must match, on top of the path.
+type GatewayRouteMatch struct {
+	Method          string            `json:"method,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	QueryParameters map[string]string `json:"queryParameters,omitempty"`
+}
+
+// GatewayRouteHeaderModifier represents the headers to set or remove on a request or response.
+type GatewayRouteHeaderModifier struct {
+	Set    map[string]string `json:"set,omitempty"`
+	Remove []string          `json:"remove,omitempty"`
+}
+
+// GatewayRouteRetryPolicy represents the retry policy of a gateway route.
+type GatewayRouteRetryPolicy struct {
+	Attempts      int32  `json:"attempts"`
+	PerTryTimeout string `json:"perTryTimeout,omitempty"`
 }
 
 // GatewayPropertiesHostname - Declare hostname information for the Gateway.
@@ -20,22 +20,33 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 const (
-	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
-	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	manifestTargetProperty   = "$.properties.runtimes.kubernetes.base"
+	podTargetProperty        = "$.properties.runtimes.kubernetes.pod"
+	resourcesTargetProperty  = "$.properties.container.resources"
+	envTargetProperty        = "$.properties.container.env"
+	extensionsTargetProperty = "$.properties.extensions"
+	initContainersProperty   = "$.properties.initContainers"
+	sidecarsProperty         = "$.properties.sidecars"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +63,22 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateEnvironmentVariables(newResource.Properties.Container.Env, envTargetProperty); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateResourceRequirements(newResource.Properties.Container.Resources, resourcesTargetProperty); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateAdditionalContainers(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
+	if err := validateAutoScaling(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(v1.ErrorDetails)}), nil
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -88,6 +115,290 @@ func validatePodSpec(patch []byte) error {
 	return nil
 }
 
+// validateEnvironmentVariables ensures that each environment variable has either a literal value or a reference to a
+// secret, and that referenced sources are valid resource IDs. envTarget is the JSON path of the env property.
+func validateEnvironmentVariables(env map[string]datamodel.EnvironmentVariable, envTarget string) error {
+	names := []string{}
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		e := env[name]
+		target := fmt.Sprintf("%s.%s", envTarget, name)
+
+		if e.Value != nil && e.ValueFrom != nil {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target,
+				Message: fmt.Sprintf("Environment variable %s must specify either value or valueFrom, but not both.", name),
+			}
+		}
+
+		if e.ValueFrom == nil {
+			continue
+		}
+
+		if e.ValueFrom.SecretRef == nil || e.ValueFrom.SecretRef.Source == "" || e.ValueFrom.SecretRef.Key == "" {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target + ".valueFrom.secretRef",
+				Message: fmt.Sprintf("Environment variable %s must specify the source and key of the secret reference.", name),
+			}
+		}
+
+		if _, err := resources.ParseResource(e.ValueFrom.SecretRef.Source); err != nil {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target + ".valueFrom.secretRef.source",
+				Message: fmt.Sprintf("Environment variable %s references an invalid resource ID %q.", name, e.ValueFrom.SecretRef.Source),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateResourceRequirements ensures that the CPU and memory amounts of the container are valid Kubernetes
+// quantities and that requests do not exceed limits. resourcesTarget is the JSON path of the resources property.
+func validateResourceRequirements(r *datamodel.ResourceRequirements, resourcesTarget string) error {
+	if r == nil {
+		return nil
+	}
+
+	requests, err := parseComputeResources(r.Requests, resourcesTarget+".requests")
+	if err != nil {
+		return err
+	}
+
+	limits, err := parseComputeResources(r.Limits, resourcesTarget+".limits")
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{"cpu", "memory"} {
+		request, hasRequest := requests[name]
+		limit, hasLimit := limits[name]
+		if hasRequest && hasLimit && request.Cmp(limit) > 0 {
+			return v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  resourcesTarget + ".requests." + name,
+				Message: fmt.Sprintf("%s request %s must be less than or equal to the limit %s.", name, request.String(), limit.String()),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAdditionalContainers validates the init containers and sidecars of the container resource. Names must be
+// unique DNS-1123 labels that do not clash with the main container, and volume mounts must reference volumes that are
+// defined on the main container.
+func validateAdditionalContainers(newResource *datamodel.ContainerResource) error {
+	names := map[string]bool{}
+	if newResource.Name != "" {
+		names[kubernetes.NormalizeResourceName(newResource.Name)] = true
+	}
+
+	groups := []struct {
+		target     string
+		containers []datamodel.AdditionalContainer
+	}{
+		{initContainersProperty, newResource.Properties.InitContainers},
+		{sidecarsProperty, newResource.Properties.Sidecars},
+	}
+
+	for _, group := range groups {
+		for i, c := range group.containers {
+			target := fmt.Sprintf("%s[%d]", group.target, i)
+			invalid := func(property, message string) error {
+				return v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  target + property,
+					Message: message,
+				}
+			}
+
+			if errs := validation.IsDNS1123Label(c.Name); len(errs) > 0 {
+				return invalid(".name", fmt.Sprintf("Invalid container name %q: %s.", c.Name, strings.Join(errs, ", ")))
+			}
+			if names[c.Name] {
+				return invalid(".name", fmt.Sprintf("Container name %q is already used by another container in the resource.", c.Name))
+			}
+			names[c.Name] = true
+
+			if c.Image == "" {
+				return invalid(".image", fmt.Sprintf("Container %s must specify an image.", c.Name))
+			}
+
+			portNames := []string{}
+			for name := range c.Ports {
+				portNames = append(portNames, name)
+			}
+			sort.Strings(portNames)
+
+			for _, name := range portNames {
+				port := c.Ports[name]
+				if port.ContainerPort < 1 || port.ContainerPort > 65535 {
+					return invalid(".ports."+name+".containerPort", fmt.Sprintf("Port %s of container %s must be between 1 and 65535.", name, c.Name))
+				}
+			}
+
+			for j, vm := range c.VolumeMounts {
+				if _, ok := newResource.Properties.Container.Volumes[vm.Volume]; !ok {
+					return invalid(fmt.Sprintf(".volumeMounts[%d].volume", j), fmt.Sprintf("Container %s mounts volume %q which is not defined in the container volumes.", c.Name, vm.Volume))
+				}
+				if vm.MountPath == "" {
+					return invalid(fmt.Sprintf(".volumeMounts[%d].mountPath", j), fmt.Sprintf("Container %s must specify a mount path for volume %q.", c.Name, vm.Volume))
+				}
+			}
+
+			if err := validateEnvironmentVariables(c.Env, target+".env"); err != nil {
+				return err
+			}
+
+			if err := validateResourceRequirements(c.Resources, target+".resources"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseComputeResources(c *datamodel.ComputeResources, target string) (map[string]resource.Quantity, error) {
+	quantities := map[string]resource.Quantity{}
+	if c == nil {
+		return quantities, nil
+	}
+
+	for _, r := range []struct{ name, value string }{{"cpu", c.CPU}, {"memory", c.Memory}} {
+		name, value := r.name, r.value
+		if value == "" {
+			continue
+		}
+
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target + "." + name,
+				Message: fmt.Sprintf("Invalid quantity %q: %s.", value, err.Error()),
+			}
+		}
+		if q.Sign() <= 0 {
+			return nil, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target + "." + name,
+				Message: fmt.Sprintf("Quantity %q must be greater than zero.", value),
+			}
+		}
+		quantities[name] = q
+	}
+
+	return quantities, nil
+}
+
+// validateAutoScaling validates the replica range and utilization targets of the autoScaling extension. The
+// HorizontalPodAutoscaler computes utilization relative to the requests of the containers of the pod, so the container
+// and its sidecars must request the resources whose utilization is targeted.
+func validateAutoScaling(newResource *datamodel.ContainerResource) error {
+	exts := newResource.Properties.Extensions
+	ext := datamodel.FindExtension(exts, datamodel.AutoScaling)
+	if ext == nil || ext.AutoScaling == nil {
+		return nil
+	}
+
+	invalid := func(message string) error {
+		return v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  extensionsTargetProperty,
+			Message: message,
+		}
+	}
+
+	if datamodel.FindExtension(exts, datamodel.ManualScaling) != nil {
+		return invalid("autoScaling and manualScaling extensions cannot be used together.")
+	}
+
+	as := ext.AutoScaling
+	if as.MaxReplicas < 1 {
+		return invalid("maxReplicas of the autoScaling extension must be greater than or equal to 1.")
+	}
+	if as.MinReplicas != nil && (*as.MinReplicas < 1 || *as.MinReplicas > as.MaxReplicas) {
+		return invalid(fmt.Sprintf("minReplicas of the autoScaling extension must be between 1 and maxReplicas (%d).", as.MaxReplicas))
+	}
+	if as.TargetCPUUtilization == nil && as.TargetMemoryUtilization == nil {
+		return invalid("autoScaling extension must specify targetCpuUtilization, targetMemoryUtilization, or both.")
+	}
+	if as.TargetCPUUtilization != nil && *as.TargetCPUUtilization < 1 {
+		return invalid("targetCpuUtilization of the autoScaling extension must be greater than 0.")
+	}
+	if as.TargetMemoryUtilization != nil && *as.TargetMemoryUtilization < 1 {
+		return invalid("targetMemoryUtilization of the autoScaling extension must be greater than 0.")
+	}
+
+	containers := []struct {
+		name      string
+		target    string
+		resources *datamodel.ResourceRequirements
+	}{
+		{newResource.Name, resourcesTargetProperty, newResource.Properties.Container.Resources},
+	}
+	for i, c := range newResource.Properties.Sidecars {
+		containers = append(containers, struct {
+			name      string
+			target    string
+			resources *datamodel.ResourceRequirements
+		}{c.Name, fmt.Sprintf("%s[%d].resources", sidecarsProperty, i), c.Resources})
+	}
+
+	targets := []struct {
+		property string
+		resource string
+		set      bool
+	}{
+		{"targetCpuUtilization", "cpu", as.TargetCPUUtilization != nil},
+		{"targetMemoryUtilization", "memory", as.TargetMemoryUtilization != nil},
+	}
+	for _, t := range targets {
+		if !t.set {
+			continue
+		}
+		for _, c := range containers {
+			if !hasComputeRequest(c.resources, t.resource) {
+				return v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  c.target + ".requests." + t.resource,
+					Message: fmt.Sprintf("%s of the autoScaling extension requires container %s to specify a %s request.", t.property, c.name, t.resource),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasComputeRequest returns true if the container requests the given resource. Kubernetes defaults the request of a
+// resource to its limit, so a limit is sufficient.
+func hasComputeRequest(r *datamodel.ResourceRequirements, name string) bool {
+	if r == nil {
+		return false
+	}
+
+	for _, c := range []*datamodel.ComputeResources{r.Requests, r.Limits} {
+		if c == nil {
+			continue
+		}
+		if (name == "cpu" && c.CPU != "") || (name == "memory" && c.Memory != "") {
+			return true
+		}
+	}
+
+	return false
+}
+
 func errMultipleResources(typeName string, num int) v1.ErrorDetails {
 	return v1.ErrorDetails{
 		Code:    v1.CodeInvalidRequestContent,
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 )
@@ -444,3 +445,399 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateResourceRequirements(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources *datamodel.ResourceRequirements
+		err       error
+	}{
+		{
+			name:      "no resources",
+			resources: nil,
+		},
+		{
+			name: "valid requests and limits",
+			resources: &datamodel.ResourceRequirements{
+				Requests: &datamodel.ComputeResources{CPU: "250m", Memory: "128Mi"},
+				Limits:   &datamodel.ComputeResources{CPU: "1", Memory: "512Mi"},
+			},
+		},
+		{
+			name: "invalid quantity",
+			resources: &datamodel.ResourceRequirements{
+				Requests: &datamodel.ComputeResources{CPU: "lots"},
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.resources.requests.cpu",
+				Message: "Invalid quantity \"lots\": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.",
+			},
+		},
+		{
+			name: "zero quantity",
+			resources: &datamodel.ResourceRequirements{
+				Limits: &datamodel.ComputeResources{Memory: "0"},
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.resources.limits.memory",
+				Message: "Quantity \"0\" must be greater than zero.",
+			},
+		},
+		{
+			name: "request exceeds limit",
+			resources: &datamodel.ResourceRequirements{
+				Requests: &datamodel.ComputeResources{Memory: "1Gi"},
+				Limits:   &datamodel.ComputeResources{Memory: "512Mi"},
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.resources.requests.memory",
+				Message: "memory request 1Gi must be less than or equal to the limit 512Mi.",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateResourceRequirements(tc.resources, resourcesTargetProperty)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAutoScaling(t *testing.T) {
+	autoScaling := func(as datamodel.AutoScalingExtension) datamodel.Extension {
+		return datamodel.Extension{Kind: datamodel.AutoScaling, AutoScaling: &as}
+	}
+
+	requests := func(cpu, memory string) *datamodel.ResourceRequirements {
+		return &datamodel.ResourceRequirements{Requests: &datamodel.ComputeResources{CPU: cpu, Memory: memory}}
+	}
+
+	tests := []struct {
+		name      string
+		exts      []datamodel.Extension
+		resources *datamodel.ResourceRequirements
+		sidecars  []datamodel.AdditionalContainer
+		target    string
+		message   string
+	}{
+		{
+			name: "no autoScaling extension",
+			exts: []datamodel.Extension{{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)}}},
+		},
+		{
+			name:      "valid autoScaling extension",
+			exts:      []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MinReplicas: to.Ptr[int32](2), MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](70)})},
+			resources: requests("250m", ""),
+		},
+		{
+			name: "memory limit without request",
+			exts: []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5, TargetMemoryUtilization: to.Ptr[int32](80)})},
+			resources: &datamodel.ResourceRequirements{
+				Limits: &datamodel.ComputeResources{Memory: "256Mi"},
+			},
+		},
+		{
+			name:    "cpu utilization without cpu request",
+			exts:    []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](70)})},
+			target:  "$.properties.container.resources.requests.cpu",
+			message: "targetCpuUtilization of the autoScaling extension requires container test-container to specify a cpu request.",
+		},
+		{
+			name:      "memory utilization without sidecar memory request",
+			exts:      []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5, TargetMemoryUtilization: to.Ptr[int32](80)})},
+			resources: requests("", "256Mi"),
+			sidecars:  []datamodel.AdditionalContainer{{Name: "proxy", Resources: requests("100m", "")}},
+			target:    "$.properties.sidecars[0].resources.requests.memory",
+			message:   "targetMemoryUtilization of the autoScaling extension requires container proxy to specify a memory request.",
+		},
+		{
+			name: "combined with manualScaling",
+			exts: []datamodel.Extension{
+				autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](70)}),
+				{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)}},
+			},
+			message: "autoScaling and manualScaling extensions cannot be used together.",
+		},
+		{
+			name:    "missing maxReplicas",
+			exts:    []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{TargetCPUUtilization: to.Ptr[int32](70)})},
+			message: "maxReplicas of the autoScaling extension must be greater than or equal to 1.",
+		},
+		{
+			name:    "minReplicas greater than maxReplicas",
+			exts:    []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MinReplicas: to.Ptr[int32](6), MaxReplicas: 5, TargetCPUUtilization: to.Ptr[int32](70)})},
+			message: "minReplicas of the autoScaling extension must be between 1 and maxReplicas (5).",
+		},
+		{
+			name:    "no utilization target",
+			exts:    []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5})},
+			message: "autoScaling extension must specify targetCpuUtilization, targetMemoryUtilization, or both.",
+		},
+		{
+			name:    "invalid memory utilization target",
+			exts:    []datamodel.Extension{autoScaling(datamodel.AutoScalingExtension{MaxReplicas: 5, TargetMemoryUtilization: to.Ptr[int32](0)})},
+			message: "targetMemoryUtilization of the autoScaling extension must be greater than 0.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &datamodel.ContainerResource{}
+			resource.Name = "test-container"
+			resource.Properties.Extensions = tc.exts
+			resource.Properties.Container.Resources = tc.resources
+			resource.Properties.Sidecars = tc.sidecars
+
+			err := validateAutoScaling(resource)
+			if tc.message == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			target := tc.target
+			if target == "" {
+				target = "$.properties.extensions"
+			}
+			require.Equal(t, v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  target,
+				Message: tc.message,
+			}, err)
+		})
+	}
+}
+
+func TestValidateEnvironmentVariables(t *testing.T) {
+	secretStoreID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/dbsecrets"
+
+	tests := []struct {
+		name string
+		env  map[string]datamodel.EnvironmentVariable
+		err  error
+	}{
+		{
+			name: "literal value and secret reference",
+			env: map[string]datamodel.EnvironmentVariable{
+				"LOG_LEVEL": {Value: to.Ptr("debug")},
+				"DB_PASSWORD": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"},
+					},
+				},
+			},
+		},
+		{
+			name: "both value and valueFrom",
+			env: map[string]datamodel.EnvironmentVariable{
+				"DB_PASSWORD": {
+					Value: to.Ptr("password"),
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID, Key: "password"},
+					},
+				},
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.env.DB_PASSWORD",
+				Message: "Environment variable DB_PASSWORD must specify either value or valueFrom, but not both.",
+			},
+		},
+		{
+			name: "secret reference without key",
+			env: map[string]datamodel.EnvironmentVariable{
+				"DB_PASSWORD": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID},
+					},
+				},
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.env.DB_PASSWORD.valueFrom.secretRef",
+				Message: "Environment variable DB_PASSWORD must specify the source and key of the secret reference.",
+			},
+		},
+		{
+			name: "secret reference with invalid source",
+			env: map[string]datamodel.EnvironmentVariable{
+				"DB_PASSWORD": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: "dbsecrets", Key: "password"},
+					},
+				},
+			},
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.container.env.DB_PASSWORD.valueFrom.secretRef.source",
+				Message: "Environment variable DB_PASSWORD references an invalid resource ID \"dbsecrets\".",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateEnvironmentVariables(tc.env, envTargetProperty)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAdditionalContainers(t *testing.T) {
+	newResource := func(initContainers, sidecars []datamodel.AdditionalContainer) *datamodel.ContainerResource {
+		return &datamodel.ContainerResource{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					Name: "frontend",
+				},
+			},
+			Properties: datamodel.ContainerProperties{
+				Container: datamodel.Container{
+					Image: "frontend:latest",
+					Volumes: map[string]datamodel.VolumeProperties{
+						"logs": {
+							Kind:      datamodel.Ephemeral,
+							Ephemeral: &datamodel.EphemeralVolume{VolumeBase: datamodel.VolumeBase{MountPath: "/var/log/app"}, ManagedStore: datamodel.ManagedStoreMemory},
+						},
+					},
+				},
+				InitContainers: initContainers,
+				Sidecars:       sidecars,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		resource *datamodel.ContainerResource
+		err      error
+	}{
+		{
+			name: "valid init container and sidecar",
+			resource: newResource(
+				[]datamodel.AdditionalContainer{{Name: "migrate", Image: "migrate:latest", Command: []string{"/bin/migrate"}}},
+				[]datamodel.AdditionalContainer{{
+					Name:         "log-shipper",
+					Image:        "fluent-bit:2.2",
+					Ports:        map[string]datamodel.AdditionalContainerPort{"metrics": {ContainerPort: 2020}},
+					VolumeMounts: []datamodel.VolumeMount{{Volume: "logs", MountPath: "/logs", ReadOnly: true}},
+				}},
+			),
+		},
+		{
+			name:     "invalid name",
+			resource: newResource([]datamodel.AdditionalContainer{{Name: "Migrate_DB", Image: "migrate:latest"}}, nil),
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.initContainers[0].name",
+				Message: "Invalid container name \"Migrate_DB\": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?').",
+			},
+		},
+		{
+			name:     "name clashes with the main container",
+			resource: newResource(nil, []datamodel.AdditionalContainer{{Name: "frontend", Image: "fluent-bit:2.2"}}),
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.sidecars[0].name",
+				Message: "Container name \"frontend\" is already used by another container in the resource.",
+			},
+		},
+		{
+			name: "name clashes between init container and sidecar",
+			resource: newResource(
+				[]datamodel.AdditionalContainer{{Name: "setup", Image: "setup:latest"}},
+				[]datamodel.AdditionalContainer{{Name: "setup", Image: "setup:latest"}},
+			),
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.sidecars[0].name",
+				Message: "Container name \"setup\" is already used by another container in the resource.",
+			},
+		},
+		{
+			name:     "missing image",
+			resource: newResource(nil, []datamodel.AdditionalContainer{{Name: "log-shipper"}}),
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.sidecars[0].image",
+				Message: "Container log-shipper must specify an image.",
+			},
+		},
+		{
+			name: "invalid port",
+			resource: newResource(nil, []datamodel.AdditionalContainer{{
+				Name:  "log-shipper",
+				Image: "fluent-bit:2.2",
+				Ports: map[string]datamodel.AdditionalContainerPort{"metrics": {ContainerPort: 70000}},
+			}}),
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.sidecars[0].ports.metrics.containerPort",
+				Message: "Port metrics of container log-shipper must be between 1 and 65535.",
+			},
+		},
+		{
+			name: "undefined volume",
+			resource: newResource(nil, []datamodel.AdditionalContainer{{
+				Name:         "log-shipper",
+				Image:        "fluent-bit:2.2",
+				VolumeMounts: []datamodel.VolumeMount{{Volume: "data", MountPath: "/data"}},
+			}}),
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.sidecars[0].volumeMounts[0].volume",
+				Message: "Container log-shipper mounts volume \"data\" which is not defined in the container volumes.",
+			},
+		},
+		{
+			name: "invalid environment variable",
+			resource: newResource([]datamodel.AdditionalContainer{{
+				Name:  "migrate",
+				Image: "migrate:latest",
+				Env: map[string]datamodel.EnvironmentVariable{
+					"DB_PASSWORD": {ValueFrom: &datamodel.EnvironmentVariableReference{}},
+				},
+			}}, nil),
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.initContainers[0].env.DB_PASSWORD.valueFrom.secretRef",
+				Message: "Environment variable DB_PASSWORD must specify the source and key of the secret reference.",
+			},
+		},
+		{
+			name: "invalid resources",
+			resource: newResource([]datamodel.AdditionalContainer{{
+				Name:      "migrate",
+				Image:     "migrate:latest",
+				Resources: &datamodel.ResourceRequirements{Limits: &datamodel.ComputeResources{CPU: "-1"}},
+			}}, nil),
+			err: v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  "$.properties.initContainers[0].resources.limits.cpu",
+				Message: "Quantity \"-1\" must be greater than zero.",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAdditionalContainers(tc.resource)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
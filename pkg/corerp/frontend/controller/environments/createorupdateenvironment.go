@@ -50,7 +50,9 @@ func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
 
 // Run checks if a resource with the same namespace already exists, and if not, updates the resource with the new values.
 // If a resource with the same namespace already exists, a conflict response is returned.
-func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (resp rest.Response, err error) {
+	defer func() { e.RecordSyncOperation(ctx, resp, err) }()
+
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
 	if err != nil {
@@ -89,6 +91,11 @@ func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWrit
 		}
 	}
 
+	// The status of the last recipe upgrade is owned by the upgradeRecipes operation.
+	if old != nil {
+		newResource.Properties.RecipeUpgrade = old.Properties.RecipeUpgrade
+	}
+
 	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
 	newEtag, err := e.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
 	if err != nil {
@@ -24,6 +24,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
@@ -39,6 +41,7 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 	defer mctrl.Finish()
 
 	mStorageClient := store.NewMockStorageClient(mctrl)
+	mStatusManager := statusmanager.NewMockStatusManager(mctrl)
 	ctx := context.Background()
 
 	createNewResourceCases := []struct {
@@ -97,8 +100,18 @@ func TestCreateOrUpdateEnvironmentRun_20231001Preview(t *testing.T) {
 					})
 			}
 
+			state := v1.ProvisioningStateSucceeded
+			if tt.shouldFail {
+				state = v1.ProvisioningStateFailed
+			}
+			mStatusManager.
+				EXPECT().
+				RecordOperation(gomock.Any(), gomock.Any(), state, gomock.Any()).
+				Return(nil)
+
 			opts := ctrl.Options{
 				StorageClient: mStorageClient,
+				StatusManager: mStatusManager,
 			}
 
 			ctl, err := NewCreateOrUpdateEnvironment(opts)
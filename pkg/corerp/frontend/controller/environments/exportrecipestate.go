@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*ExportRecipeState)(nil)
+
+// ExportRecipeState is the controller implementation to export the Terraform state of the recipe deployed for a resource of the environment.
+type ExportRecipeState struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine.Engine
+}
+
+// NewExportRecipeState creates a new controller for exporting the Terraform state of recipes deployed to an environment.
+func NewExportRecipeState(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &ExportRecipeState{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// Run exports the Terraform state of the recipe deployed for the resource in the request body from the backend
+// configured for the environment, and returns it along with the kind of the backend.
+func (r *ExportRecipeState) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	environment, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if environment == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recipeState, err := converter.RecipeStateExportDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe, response, err := getRecipeStateResource(ctx, r.StorageClient(), serviceCtx.ResourceID, recipeState.ResourceID)
+	if response != nil || err != nil {
+		return response, err
+	}
+
+	state, err := r.ExportRecipeState(ctx, engine.RecipeStateOptions{
+		BaseOptions: engine.BaseOptions{Recipe: *recipe},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(state) == 0 {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Terraform state of the recipe deployed for resource %q was not found", recipeState.ResourceID)), nil
+	}
+
+	ret := datamodel.RecipeStateResponse{
+		Backend: terraformBackendKind(environment),
+	}
+	if err := json.Unmarshal(state, &ret.State); err != nil {
+		return nil, fmt.Errorf("failed to parse the Terraform state of resource %q: %w", recipeState.ResourceID, err)
+	}
+
+	versioned, err := converter.RecipeStateResponseDataModelToVersioned(&ret, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
+
+// getRecipeStateResource returns the metadata of the recipe deployed for the resource, which identifies the Terraform
+// state of the resource. A response is returned instead when the resource is not found or is not deployed to the environment.
+func getRecipeStateResource(ctx context.Context, storageClient store.StorageClient, environmentID resources.ID, resourceID string) (*recipes.ResourceMetadata, rest.Response, error) {
+	obj, err := storageClient.Get(ctx, resourceID)
+	if errors.Is(&store.ErrNotFound{ID: resourceID}, err) {
+		return nil, rest.NewNotFoundMessageResponse(fmt.Sprintf("resource %q was not found", resourceID)), nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	resource := struct {
+		Properties rpv1.BasicResourceProperties `json:"properties"`
+	}{}
+	if err := obj.As(&resource); err != nil {
+		return nil, nil, err
+	}
+
+	if !strings.EqualFold(resource.Properties.Environment, environmentID.String()) {
+		return nil, rest.NewBadRequestResponse(fmt.Sprintf("resource %q is not deployed to environment %q", resourceID, environmentID.String())), nil
+	}
+
+	return &recipes.ResourceMetadata{
+		EnvironmentID: resource.Properties.Environment,
+		ApplicationID: resource.Properties.Application,
+		ResourceID:    resourceID,
+	}, nil, nil
+}
+
+// terraformBackendKind returns the kind of the Terraform backend configured for the environment.
+func terraformBackendKind(environment *datamodel.Environment) string {
+	if kind := environment.Properties.RecipeConfig.Terraform.Backend.Kind; kind != "" {
+		return kind
+	}
+	return backends.BackendKubernetes
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRecipeStateEnvironmentID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+	testRecipeStateApplicationID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/applications/app0"
+	testRecipeStateResourceID    = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/redis0"
+)
+
+// setupRecipeStateStorage sets up the storage client to return the environment and the resource deployed by a recipe.
+func setupRecipeStateStorage(mStorageClient *store.MockStorageClient, env *datamodel.Environment, resourceEnvironmentID string) {
+	mStorageClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			if !strings.EqualFold(id, testRecipeStateResourceID) {
+				return &store.Object{Metadata: store.Metadata{ID: id, ETag: "etag"}, Data: env}, nil
+			}
+			if resourceEnvironmentID == "" {
+				return nil, &store.ErrNotFound{ID: id}
+			}
+			return &store.Object{
+				Metadata: store.Metadata{ID: id, ETag: "etag"},
+				Data: map[string]any{
+					"properties": map[string]any{
+						"environment": resourceEnvironmentID,
+						"application": testRecipeStateApplicationID,
+					},
+				},
+			}, nil
+		}).
+		AnyTimes()
+}
+
+func testRecipeStateEnvironment(backend string) *datamodel.Environment {
+	env := &datamodel.Environment{}
+	env.Properties.RecipeConfig.Terraform.Backend.Kind = backend
+	return env
+}
+
+func TestExportRecipeStateRun_20231001Preview(t *testing.T) {
+	input := &v20231001preview.RecipeStateExport{ResourceID: to.Ptr(testRecipeStateResourceID)}
+	expectedRecipe := recipes.ResourceMetadata{
+		EnvironmentID: testRecipeStateEnvironmentID,
+		ApplicationID: testRecipeStateApplicationID,
+		ResourceID:    testRecipeStateResourceID,
+	}
+
+	tests := []struct {
+		name                  string
+		backend               string
+		resourceEnvironmentID string
+		callEngine            bool
+		state                 []byte
+		engineErr             error
+		statusCode            int
+		expected              *v20231001preview.RecipeStateResponse
+		err                   error
+	}{
+		{
+			name:                  "export state from default backend",
+			resourceEnvironmentID: testRecipeStateEnvironmentID,
+			callEngine:            true,
+			state:                 []byte(`{"version": 4, "serial": 1}`),
+			statusCode:            200,
+			expected: &v20231001preview.RecipeStateResponse{
+				Backend: to.Ptr("kubernetes"),
+				State:   map[string]any{"version": float64(4), "serial": float64(1)},
+			},
+		},
+		{
+			name:                  "export state from s3 backend",
+			backend:               "s3",
+			resourceEnvironmentID: testRecipeStateEnvironmentID,
+			callEngine:            true,
+			state:                 []byte(`{"version": 4}`),
+			statusCode:            200,
+			expected: &v20231001preview.RecipeStateResponse{
+				Backend: to.Ptr("s3"),
+				State:   map[string]any{"version": float64(4)},
+			},
+		},
+		{
+			name:                  "state not found",
+			resourceEnvironmentID: testRecipeStateEnvironmentID,
+			callEngine:            true,
+			statusCode:            404,
+		},
+		{
+			name:       "resource not found",
+			statusCode: 404,
+		},
+		{
+			name:                  "resource deployed to another environment",
+			resourceEnvironmentID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env1",
+			statusCode:            400,
+		},
+		{
+			name:                  "engine failure",
+			resourceEnvironmentID: testRecipeStateEnvironmentID,
+			callEngine:            true,
+			engineErr:             errors.New("failed to export state"),
+			err:                   errors.New("failed to export state"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mEngine := engine.NewMockEngine(mctrl)
+			setupRecipeStateStorage(mStorageClient, testRecipeStateEnvironment(tt.backend), tt.resourceEnvironmentID)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, input)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			if tt.callEngine {
+				mEngine.EXPECT().
+					ExportRecipeState(ctx, engine.RecipeStateOptions{BaseOptions: engine.BaseOptions{Recipe: expectedRecipe}}).
+					Return(tt.state, tt.engineErr)
+			}
+
+			ctl, err := NewExportRecipeState(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.expected != nil {
+				actual := &v20231001preview.RecipeStateResponse{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+				require.Equal(t, tt.expected, actual)
+			}
+		})
+	}
+}
@@ -74,7 +74,10 @@ func TestGetRecipeMetadataRun_20231001Preview(t *testing.T) {
 				"mongodbName":    map[string]any{"type": "string"},
 			},
 		}
-		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition).Return(recipeData, nil)
+		mEngine.EXPECT().GetRecipeMetadata(ctx, engine.GetRecipeMetadataOptions{
+			EnvironmentID:    v1.ARMRequestContextFromContext(ctx).ResourceID.String(),
+			RecipeDefinition: recipeDefinition,
+		}).Return(recipeData, nil)
 
 		opts := ctrl.Options{
 			StorageClient: mStorageClient,
@@ -122,7 +125,10 @@ func TestGetRecipeMetadataRun_20231001Preview(t *testing.T) {
 				"mongodbName":    map[string]any{"type": "string"},
 			},
 		}
-		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition).Return(recipeData, nil)
+		mEngine.EXPECT().GetRecipeMetadata(ctx, engine.GetRecipeMetadataOptions{
+			EnvironmentID:    v1.ARMRequestContextFromContext(ctx).ResourceID.String(),
+			RecipeDefinition: recipeDefinition,
+		}).Return(recipeData, nil)
 
 		opts := ctrl.Options{
 			StorageClient: mStorageClient,
@@ -240,7 +246,10 @@ func TestGetRecipeMetadataRun_20231001Preview(t *testing.T) {
 			ResourceType:    *envInput.ResourceType,
 		}
 		engineErr := fmt.Errorf("could not find driver %s", "invalidDriver")
-		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition).Return(nil, engineErr)
+		mEngine.EXPECT().GetRecipeMetadata(ctx, engine.GetRecipeMetadataOptions{
+			EnvironmentID:    v1.ARMRequestContextFromContext(ctx).ResourceID.String(),
+			RecipeDefinition: recipeDefinition,
+		}).Return(nil, engineErr)
 
 		opts := ctrl.Options{
 			StorageClient: mStorageClient,
@@ -16,8 +16,19 @@ limitations under the License.
 
 package environments
 
+import "time"
+
 const (
 	ResourceTypeName = "Applications.Core/environments"
 	// User defined operation names
 	OperationGetRecipeMetadata = "GETRECIPEMETADATA"
+	OperationExportRecipeState = "EXPORTRECIPESTATE"
+	OperationUnlockRecipeState = "UNLOCKRECIPESTATE"
+	OperationUpgradeRecipes    = "UPGRADERECIPES"
+
+	// AsyncUpgradeRecipesTimeout is the timeout for the async operation upgrading the recipes of the resources of an environment.
+	AsyncUpgradeRecipesTimeout = time.Duration(120) * time.Minute
+
+	// AsyncUpgradeRecipesRetryAfter is the polling interval for the async operation upgrading the recipes of the resources of an environment.
+	AsyncUpgradeRecipesRetryAfter = time.Duration(5) * time.Second
 )
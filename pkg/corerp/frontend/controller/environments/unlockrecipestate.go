@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
+)
+
+var _ ctrl.Controller = (*UnlockRecipeState)(nil)
+
+// UnlockRecipeState is the controller implementation to release the lock on the Terraform state of the recipe deployed for a resource of the environment.
+type UnlockRecipeState struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine.Engine
+}
+
+// NewUnlockRecipeState creates a new controller for releasing the lock on the Terraform state of recipes deployed to an environment.
+func NewUnlockRecipeState(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &UnlockRecipeState{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// Run releases the lock on the Terraform state of the recipe deployed for the resource in the request body, in the
+// backend configured for the environment, and returns the kind of the backend.
+func (r *UnlockRecipeState) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (resp rest.Response, err error) {
+	defer func() { r.RecordSyncOperation(ctx, resp, err) }()
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	environment, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if environment == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recipeState, err := converter.RecipeStateUnlockDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the lock on the state stored in the kubernetes and local backends can be released without the ID of the lock.
+	backend := terraformBackendKind(environment)
+	if recipeState.LockID == "" && backend != backends.BackendKubernetes && backend != backends.BackendLocal {
+		return rest.NewBadRequestResponse(fmt.Sprintf("lockId is required to unlock the Terraform state stored in the %s backend", backend)), nil
+	}
+
+	recipe, response, err := getRecipeStateResource(ctx, r.StorageClient(), serviceCtx.ResourceID, recipeState.ResourceID)
+	if response != nil || err != nil {
+		return response, err
+	}
+
+	err = r.UnlockRecipeState(ctx, engine.RecipeStateOptions{
+		BaseOptions: engine.BaseOptions{Recipe: *recipe},
+		LockID:      recipeState.LockID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := datamodel.RecipeStateResponse{
+		Backend: backend,
+	}
+	versioned, err := converter.RecipeStateResponseDataModelToVersioned(&ret, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
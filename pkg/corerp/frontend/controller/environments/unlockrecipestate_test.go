@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnlockRecipeStateRun_20231001Preview(t *testing.T) {
+	expectedRecipe := recipes.ResourceMetadata{
+		EnvironmentID: testRecipeStateEnvironmentID,
+		ApplicationID: testRecipeStateApplicationID,
+		ResourceID:    testRecipeStateResourceID,
+	}
+
+	tests := []struct {
+		name       string
+		backend    string
+		lockID     string
+		callEngine bool
+		engineErr  error
+		statusCode int
+		expected   *v20231001preview.RecipeStateResponse
+		err        error
+	}{
+		{
+			name:       "unlock default backend without lock ID",
+			callEngine: true,
+			statusCode: 200,
+			expected:   &v20231001preview.RecipeStateResponse{Backend: to.Ptr("kubernetes")},
+		},
+		{
+			name:       "unlock local backend without lock ID",
+			backend:    "local",
+			callEngine: true,
+			statusCode: 200,
+			expected:   &v20231001preview.RecipeStateResponse{Backend: to.Ptr("local")},
+		},
+		{
+			name:       "unlock s3 backend with lock ID",
+			backend:    "s3",
+			lockID:     "lock-id",
+			callEngine: true,
+			statusCode: 200,
+			expected:   &v20231001preview.RecipeStateResponse{Backend: to.Ptr("s3")},
+		},
+		{
+			name:       "unlock s3 backend without lock ID",
+			backend:    "s3",
+			statusCode: 400,
+		},
+		{
+			name:       "engine failure",
+			callEngine: true,
+			engineErr:  errors.New("failed to unlock state"),
+			err:        errors.New("failed to unlock state"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mEngine := engine.NewMockEngine(mctrl)
+			setupRecipeStateStorage(mStorageClient, testRecipeStateEnvironment(tt.backend), testRecipeStateEnvironmentID)
+
+			input := &v20231001preview.RecipeStateUnlock{ResourceID: to.Ptr(testRecipeStateResourceID)}
+			if tt.lockID != "" {
+				input.LockID = to.Ptr(tt.lockID)
+			}
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, input)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			if tt.callEngine {
+				mEngine.EXPECT().
+					UnlockRecipeState(ctx, engine.RecipeStateOptions{BaseOptions: engine.BaseOptions{Recipe: expectedRecipe}, LockID: tt.lockID}).
+					Return(tt.engineErr)
+			}
+
+			ctl, err := NewUnlockRecipeState(ctrl.Options{StorageClient: mStorageClient}, mEngine)
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.expected != nil {
+				actual := &v20231001preview.RecipeStateResponse{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+				require.Equal(t, tt.expected, actual)
+			}
+		})
+	}
+}
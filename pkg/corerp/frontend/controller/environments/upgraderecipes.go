@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*UpgradeRecipes)(nil)
+
+// UpgradeRecipes is the controller implementation to queue the async operation upgrading the recipes of the resources of an environment.
+type UpgradeRecipes struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+}
+
+// NewUpgradeRecipes creates a new controller for upgrading the recipes of the resources of an environment.
+func NewUpgradeRecipes(opts ctrl.Options) (ctrl.Controller, error) {
+	return &UpgradeRecipes{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:         converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter:        converter.EnvironmentDataModelToVersioned,
+				AsyncOperationTimeout:    AsyncUpgradeRecipesTimeout,
+				AsyncOperationRetryAfter: AsyncUpgradeRecipesRetryAfter,
+			},
+		),
+	}, nil
+}
+
+// Run validates the request, records the start of the upgrade in the status of the environment, starts the async
+// operation upgrading the recipes and returns an async response. Only one upgrade can run at a time for an environment.
+func (r *UpgradeRecipes) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for the action has name of the operation as suffix which should be removed to get the resource id.
+	// The async operation is tracked on the environment itself.
+	serviceCtx.ResourceID = serviceCtx.ResourceID.Truncate()
+
+	environment, etag, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if environment == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	upgrade, err := converter.RecipeUpgradeDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if environment.Properties.RecipeUpgrade != nil {
+		running, err := r.isUpgradeRunning(ctx, serviceCtx.ResourceID, environment.Properties.RecipeUpgrade)
+		if err != nil {
+			return nil, err
+		}
+		if running {
+			return rest.NewConflictResponse(fmt.Sprintf("The recipes of environment %q are already being upgraded.", serviceCtx.ResourceID.Name())), nil
+		}
+	}
+
+	if r, err := r.PrepareResource(ctx, req, nil, environment, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	environment.Properties.RecipeUpgrade = &datamodel.RecipeUpgradeStatus{
+		State:           datamodel.RecipeUpgradeStateInProgress,
+		BatchSize:       upgrade.BatchSize,
+		LastUpdatedTime: time.Now().UTC(),
+		OperationID:     serviceCtx.OperationID.String(),
+	}
+
+	if r, err := r.PrepareAsyncOperation(ctx, environment, v1.ProvisioningStateAccepted, r.AsyncOperationTimeout(), &etag); r != nil || err != nil {
+		return r, err
+	}
+
+	versioned, err := r.ResponseConverter()(environment, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewAsyncOperationResponse(versioned, serviceCtx.Location, http.StatusAccepted, serviceCtx.ResourceID, serviceCtx.OperationID, serviceCtx.APIVersion, "", ""), nil
+}
+
+// isUpgradeRunning returns true if the async operation recorded in the status of the upgrade is still running. An upgrade
+// whose operation was completed, no longer exists or exceeded its timeout is stopped, so that an upgrade interrupted by a
+// crash of the worker doesn't block the next upgrades.
+func (r *UpgradeRecipes) isUpgradeRunning(ctx context.Context, id resources.ID, status *datamodel.RecipeUpgradeStatus) (bool, error) {
+	if status.IsTerminal() {
+		return false, nil
+	}
+
+	operationID, err := uuid.Parse(status.OperationID)
+	if err != nil {
+		// The operation running the upgrade is unknown, the upgrade is running until it would have timed out.
+		return time.Since(status.LastUpdatedTime) < r.AsyncOperationTimeout(), nil
+	}
+
+	operation, err := r.StatusManager().Get(ctx, id, operationID)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if operation.EndTime != nil {
+		return false, nil
+	}
+
+	return time.Since(operation.StartTime) < r.AsyncOperationTimeout(), nil
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const testUpgradeOperationID = "b2a3c4d5-0000-0000-0000-000000000001"
+
+func TestUpgradeRecipesRun_20231001Preview(t *testing.T) {
+	tests := []struct {
+		name              string
+		notFound          bool
+		provisioningState v1.ProvisioningState
+		upgradeState      datamodel.RecipeUpgradeState
+		operationID       string
+		operation         *statusmanager.Status
+		operationErr      error
+		batchSize         *int32
+		statusCode        int
+		expectedBatchSize int32
+	}{
+		{
+			name:              "upgrade with default batch size",
+			statusCode:        http.StatusAccepted,
+			expectedBatchSize: datamodel.DefaultRecipeUpgradeBatchSize,
+		},
+		{
+			name:              "upgrade after a failed upgrade",
+			upgradeState:      datamodel.RecipeUpgradeStateFailed,
+			batchSize:         to.Ptr(int32(2)),
+			statusCode:        http.StatusAccepted,
+			expectedBatchSize: 2,
+		},
+		{
+			name:         "upgrade in progress",
+			upgradeState: datamodel.RecipeUpgradeStateInProgress,
+			operationID:  testUpgradeOperationID,
+			operation:    &statusmanager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{StartTime: time.Now()}},
+			statusCode:   http.StatusConflict,
+		},
+		{
+			name:              "upgrade whose operation was completed",
+			upgradeState:      datamodel.RecipeUpgradeStateInProgress,
+			operationID:       testUpgradeOperationID,
+			operation:         &statusmanager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{StartTime: time.Now(), EndTime: to.Ptr(time.Now())}},
+			statusCode:        http.StatusAccepted,
+			expectedBatchSize: datamodel.DefaultRecipeUpgradeBatchSize,
+		},
+		{
+			name:              "upgrade whose operation timed out",
+			upgradeState:      datamodel.RecipeUpgradeStateInProgress,
+			operationID:       testUpgradeOperationID,
+			operation:         &statusmanager.Status{AsyncOperationStatus: v1.AsyncOperationStatus{StartTime: time.Now().Add(-AsyncUpgradeRecipesTimeout)}},
+			statusCode:        http.StatusAccepted,
+			expectedBatchSize: datamodel.DefaultRecipeUpgradeBatchSize,
+		},
+		{
+			name:              "upgrade whose operation does not exist",
+			upgradeState:      datamodel.RecipeUpgradeStateInProgress,
+			operationID:       testUpgradeOperationID,
+			operationErr:      &store.ErrNotFound{},
+			statusCode:        http.StatusAccepted,
+			expectedBatchSize: datamodel.DefaultRecipeUpgradeBatchSize,
+		},
+		{
+			name:         "upgrade without operation",
+			upgradeState: datamodel.RecipeUpgradeStateInProgress,
+			statusCode:   http.StatusConflict,
+		},
+		{
+			name:              "environment not in terminal state",
+			provisioningState: v1.ProvisioningStateUpdating,
+			statusCode:        http.StatusConflict,
+		},
+		{
+			name:       "environment not found",
+			notFound:   true,
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mStatusManager := statusmanager.NewMockStatusManager(mctrl)
+
+			_, env, _ := getTestModels20231001preview()
+			env.InternalMetadata.AsyncProvisioningState = v1.ProvisioningStateSucceeded
+			if tt.provisioningState != "" {
+				env.InternalMetadata.AsyncProvisioningState = tt.provisioningState
+			}
+			if tt.upgradeState != "" {
+				env.Properties.RecipeUpgrade = &datamodel.RecipeUpgradeStatus{State: tt.upgradeState, OperationID: tt.operationID, LastUpdatedTime: time.Now()}
+			}
+			if tt.operation != nil || tt.operationErr != nil {
+				mStatusManager.
+					EXPECT().
+					Get(gomock.Any(), gomock.Any(), uuid.MustParse(tt.operationID)).
+					Return(tt.operation, tt.operationErr)
+			}
+
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					if tt.notFound {
+						return nil, &store.ErrNotFound{ID: id}
+					}
+					return &store.Object{Metadata: store.Metadata{ID: id, ETag: "etag"}, Data: env}, nil
+				})
+
+			if tt.statusCode == http.StatusAccepted {
+				mStatusManager.
+					EXPECT().
+					QueueAsyncOperation(gomock.Any(), gomock.Any(), statusmanager.QueueOperationOptions{
+						OperationTimeout: AsyncUpgradeRecipesTimeout,
+						RetryAfter:       AsyncUpgradeRecipesRetryAfter,
+					}).
+					Return(nil)
+				mStorageClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+						saved := obj.Data.(*datamodel.Environment)
+						require.Equal(t, v1.ProvisioningStateAccepted, saved.InternalMetadata.AsyncProvisioningState)
+						require.Equal(t, datamodel.RecipeUpgradeStateInProgress, saved.Properties.RecipeUpgrade.State)
+						require.Equal(t, int(tt.expectedBatchSize), saved.Properties.RecipeUpgrade.BatchSize)
+						require.NotEmpty(t, saved.Properties.RecipeUpgrade.OperationID)
+						require.NotEqual(t, testUpgradeOperationID, saved.Properties.RecipeUpgrade.OperationID)
+						return nil
+					})
+			}
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfileUpgradeRecipes, &v20231001preview.RecipeUpgrade{BatchSize: tt.batchSize})
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			ctl, err := NewUpgradeRecipes(ctrl.Options{StorageClient: mStorageClient, StatusManager: mStatusManager})
+			require.NoError(t, err)
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.statusCode == http.StatusAccepted {
+				actual := &v20231001preview.EnvironmentResource{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+				require.Equal(t, v20231001preview.RecipeUpgradeStateInProgress, *actual.Properties.RecipeUpgrade.State)
+				require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+			}
+		})
+	}
+}
+
+func TestUpgradeRecipesRun_InvalidBatchSize(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mStorageClient := store.NewMockStorageClient(mctrl)
+
+	env := &datamodel.Environment{}
+	env.ID = testRecipeStateEnvironmentID
+	mStorageClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(&store.Object{Metadata: store.Metadata{ID: testRecipeStateEnvironmentID, ETag: "etag"}, Data: env}, nil)
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfileUpgradeRecipes, &v20231001preview.RecipeUpgrade{BatchSize: to.Ptr(int32(0))})
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	ctl, err := NewUpgradeRecipes(ctrl.Options{StorageClient: mStorageClient})
+	require.NoError(t, err)
+	_, err = ctl.Run(ctx, w, req)
+	require.ErrorIs(t, err, v1.NewClientErrInvalidRequest("batchSize must be greater than 0, got 0"))
+}
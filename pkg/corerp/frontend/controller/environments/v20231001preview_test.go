@@ -27,6 +27,7 @@ import (
 const testHeaderfile = "requestheaders20231001preview.json"
 const testHeaderfilegetrecipemetadata = "requestheadersgetrecipemetadata20231001preview.json"
 const testHeaderfilegetrecipemetadatanotexisting = "requestheadersgetrecipemetadatanotexisting20231001preview.json"
+const testHeaderfileUpgradeRecipes = "requestheadersupgraderecipes20231001preview.json"
 
 func getTestModels20231001preview() (*v20231001preview.EnvironmentResource, *datamodel.Environment, *v20231001preview.EnvironmentResource) {
 	rawInput := testutil.ReadFixture("environment20231001preview_input.json")
@@ -18,15 +18,18 @@ package gateways
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
-// ValidateAndMutateRequest checks if the TLS configuration is valid and sets the TLS protocol version to 1.2 if it is not
-// specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both configured or if TLS
-// protocol version is set but certificateFrom is not.
+// ValidateAndMutateRequest checks if the TLS configuration and the routes are valid and sets the TLS protocol version to
+// 1.2 if it is not specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both
+// configured, if TLS protocol version is set but certificateFrom is not, or if a route is invalid.
 func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *datamodel.Gateway, options *controller.Options) (rest.Response, error) {
 	if newResource.Properties.TLS != nil {
 		// If SSL Passthrough and TLS termination are both configured, then report an error
@@ -45,5 +48,78 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		}
 	}
 
+	sslPassthrough := newResource.Properties.TLS != nil && newResource.Properties.TLS.SSLPassthrough
+	for i, route := range newResource.Properties.Routes {
+		if msg := validateRoute(fmt.Sprintf("$.properties.routes[%d]", i), &route, sslPassthrough); msg != "" {
+			return rest.NewBadRequestResponse(msg), nil
+		}
+	}
+
 	return nil, nil
 }
+
+// validateRoute validates the destinations, the match conditions, the timeout and the retry policy of the route, and
+// returns the error message if the route is invalid.
+func validateRoute(path string, route *datamodel.GatewayRoute, sslPassthrough bool) string {
+	if sslPassthrough && route.HasTrafficPolicy() {
+		return fmt.Sprintf("Fields destinations, match, requestHeaders, responseHeaders, timeout and retryPolicy of %s cannot be specified when $.properties.tls.sslPassthrough is true.", path)
+	}
+
+	if len(route.Destinations) > 0 {
+		if route.Destination != "" {
+			return fmt.Sprintf("Only one of %s.destination and %s.destinations can be specified at a time.", path, path)
+		}
+
+		total := int32(0)
+		for j, d := range route.Destinations {
+			if d.Destination == "" {
+				return fmt.Sprintf("Field %s.destinations[%d].destination is required.", path, j)
+			}
+
+			if d.Weight < 0 || d.Weight > 100 {
+				return fmt.Sprintf("Field %s.destinations[%d].weight must be between 0 and 100.", path, j)
+			}
+
+			total += d.Weight
+		}
+
+		if total != 100 {
+			return fmt.Sprintf("The weights of %s.destinations must add up to 100, got %d.", path, total)
+		}
+	}
+
+	if route.Match != nil && route.Match.Method != "" && !isValidMethod(route.Match.Method) {
+		return fmt.Sprintf("Field %s.match.method has an invalid HTTP method %q.", path, route.Match.Method)
+	}
+
+	if route.Timeout != "" && !isValidDuration(route.Timeout) {
+		return fmt.Sprintf("Field %s.timeout must be a positive duration such as 30s, got %q.", path, route.Timeout)
+	}
+
+	if route.RetryPolicy != nil {
+		if route.RetryPolicy.Attempts < 1 {
+			return fmt.Sprintf("Field %s.retryPolicy.attempts must be greater than 0.", path)
+		}
+
+		if route.RetryPolicy.PerTryTimeout != "" && !isValidDuration(route.RetryPolicy.PerTryTimeout) {
+			return fmt.Sprintf("Field %s.retryPolicy.perTryTimeout must be a positive duration such as 5s, got %q.", path, route.RetryPolicy.PerTryTimeout)
+		}
+	}
+
+	return ""
+}
+
+func isValidMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+func isValidDuration(s string) bool {
+	d, err := time.ParseDuration(s)
+	return err == nil && d > 0
+}
@@ -108,6 +108,118 @@ func TestValidateAndMutateRequest_Gateway(t *testing.T) {
 			},
 			resp: nil,
 		},
+		{
+			desc: "weighted destinations",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Path:         "/",
+							Destinations: []datamodel.GatewayRouteDestination{{Destination: "blue", Weight: 90}, {Destination: "green", Weight: 10}},
+							Match:        &datamodel.GatewayRouteMatch{Method: "GET", Headers: map[string]string{"x-canary": "true"}},
+							Timeout:      "30s",
+							RetryPolicy:  &datamodel.GatewayRouteRetryPolicy{Attempts: 3, PerTryTimeout: "5s"},
+						},
+					},
+				},
+			},
+			oldResource: nil,
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{
+							Path:         "/",
+							Destinations: []datamodel.GatewayRouteDestination{{Destination: "blue", Weight: 90}, {Destination: "green", Weight: 10}},
+							Match:        &datamodel.GatewayRouteMatch{Method: "GET", Headers: map[string]string{"x-canary": "true"}},
+							Timeout:      "30s",
+							RetryPolicy:  &datamodel.GatewayRouteRetryPolicy{Attempts: 3, PerTryTimeout: "5s"},
+						},
+					},
+				},
+			},
+			resp: nil,
+		},
+		{
+			desc: "weights do not add up to 100",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{Destination: "blue"},
+						{Destinations: []datamodel.GatewayRouteDestination{{Destination: "blue", Weight: 80}, {Destination: "green", Weight: 10}}},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("The weights of $.properties.routes[1].destinations must add up to 100, got 90."),
+		},
+		{
+			desc: "weight out of range",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{Destinations: []datamodel.GatewayRouteDestination{{Destination: "blue", Weight: 110}, {Destination: "green", Weight: -10}}},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].destinations[0].weight must be between 0 and 100."),
+		},
+		{
+			desc: "specify both destination and destinations",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{Destination: "blue", Destinations: []datamodel.GatewayRouteDestination{{Destination: "green", Weight: 100}}},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Only one of $.properties.routes[0].destination and $.properties.routes[0].destinations can be specified at a time."),
+		},
+		{
+			desc: "invalid method",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{Destination: "blue", Match: &datamodel.GatewayRouteMatch{Method: "FETCH"}},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].match.method has an invalid HTTP method \"FETCH\"."),
+		},
+		{
+			desc: "invalid timeout",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{Destination: "blue", Timeout: "30"},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].timeout must be a positive duration such as 30s, got \"30\"."),
+		},
+		{
+			desc: "invalid retry attempts",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{
+						{Destination: "blue", RetryPolicy: &datamodel.GatewayRouteRetryPolicy{Attempts: 0}},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].retryPolicy.attempts must be greater than 0."),
+		},
+		{
+			desc: "traffic policy with SSL Passthrough",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						SSLPassthrough: true,
+					},
+					Routes: []datamodel.GatewayRoute{
+						{Destination: "blue", Timeout: "30s"},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Fields destinations, match, requestHeaders, responseHeaders, timeout and retryPolicy of $.properties.routes[0] cannot be specified when $.properties.tls.sslPassthrough is true."),
+		},
 	}
 
 	for _, tc := range requestTests {
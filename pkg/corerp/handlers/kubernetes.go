@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -56,8 +57,13 @@ type ResourceWaiter interface {
 	waitUntilReady(ctx context.Context, item client.Object) error
 }
 
-// NewKubernetesHandler creates a new KubernetesHandler which is used to handle Kubernetes resources.
-func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discoveryClient discovery.ServerResourcesInterface, dynamicClientSet dynamic.Interface) ResourceHandler {
+// NewKubernetesHandler creates a new KubernetesHandler which is used to handle Kubernetes resources. Each resource is
+// handled in the cluster of the Kubernetes plane of its ID.
+func NewKubernetesHandler(clusters ucp_kubernetes.ClusterProvider) ResourceHandler {
+	return &clusterKubernetesHandler{clusters: clusters}
+}
+
+func newKubernetesHandler(client client.Client, clientSet k8s.Interface, discoveryClient discovery.ServerResourcesInterface, dynamicClientSet dynamic.Interface) *kubernetesHandler {
 	return &kubernetesHandler{
 		client:             client,
 		k8sDiscoveryClient: discoveryClient,
@@ -66,6 +72,41 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 	}
 }
 
+// clusterKubernetesHandler dispatches the Kubernetes resources to the handler of their cluster.
+type clusterKubernetesHandler struct {
+	clusters ucp_kubernetes.ClusterProvider
+}
+
+// Put stores the Kubernetes resource in the cluster of its Kubernetes plane.
+func (h *clusterKubernetesHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
+	handler, err := h.handlerFor(ctx, options.Resource.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.Put(ctx, options)
+}
+
+// Delete deletes the Kubernetes resource from the cluster of its Kubernetes plane.
+func (h *clusterKubernetesHandler) Delete(ctx context.Context, options *DeleteOptions) error {
+	handler, err := h.handlerFor(ctx, options.Resource.ID)
+	if err != nil {
+		return err
+	}
+
+	return handler.Delete(ctx, options)
+}
+
+func (h *clusterKubernetesHandler) handlerFor(ctx context.Context, id resources.ID) (*kubernetesHandler, error) {
+	cluster, err := h.clusters.GetCluster(ctx, resources_kubernetes.PlaneNameFromID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	clients := cluster.Clients
+	return newKubernetesHandler(clients.RuntimeClient, clients.ClientSet, clients.DiscoveryClient, clients.DynamicClient), nil
+}
+
 type kubernetesHandler struct {
 	client client.Client
 	// k8sDiscoveryClient is the Kubernetes client to used for API version lookups on Kubernetes resources. Override this for testing.
@@ -112,8 +153,14 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		return nil, err
 	}
 
+	// The resource keeps the plane of the cluster it is deployed to.
+	planeName := resources_kubernetes.PlaneNameFromID(options.Resource.ID)
+	if planeName == "" {
+		planeName = resources_kubernetes.PlaneNameLocal
+	}
+
 	id := resources_kubernetes.IDFromParts(
-		resources_kubernetes.PlaneNameTODO,
+		planeName,
 		groupVersion.Group,
 		item.GetKind(),
 		item.GetNamespace(),
@@ -137,6 +184,13 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("HTTP Proxy %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "httproute", "tlsroute":
+		err = handler.httpProxyWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("%s %s in namespace %s is ready", item.GetKind(), item.GetName(), item.GetNamespace()))
+		return properties, nil
 	default:
 		// We do not monitor the other resource types.
 		return properties, nil
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// isGatewayAPIRoute returns true if the object is a Gateway API route such as HTTPRoute or TLSRoute.
+func isGatewayAPIRoute(obj client.Object) bool {
+	return obj.GetObjectKind().GroupVersionKind().Group == gatewayv1beta1.GroupName
+}
+
+// informerResource returns the resource to watch for the object.
+func informerResource(obj client.Object) schema.GroupVersionResource {
+	if isGatewayAPIRoute(obj) {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		return gvk.GroupVersion().WithResource(strings.ToLower(gvk.Kind) + "s")
+	}
+
+	return contourv1.HTTPProxyGVR
+}
+
+// checkGatewayAPIRouteStatus checks the status conditions reported by the parent Gateways of the route. The route is
+// ready when every parent has accepted it, and failed when a parent rejected it or could not resolve its backends.
+func (handler *httpProxyWaiter) checkGatewayAPIRouteStatus(ctx context.Context, dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory, obj client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("routeName", obj.GetName(), "namespace", obj.GetNamespace())
+
+	route, status, err := getGatewayAPIRouteStatus(dynamicInformerFactory.ForResource(informerResource(obj)).Lister(), obj)
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to get route status: %s", err.Error()))
+		return false
+	}
+
+	// The parents are populated once the Gateway controller has reconciled the route.
+	if len(status.Parents) == 0 {
+		return false
+	}
+
+	for _, parent := range status.Parents {
+		for _, conditionType := range []gatewayv1beta1.RouteConditionType{gatewayv1beta1.RouteConditionAccepted, gatewayv1beta1.RouteConditionResolvedRefs} {
+			c := meta.FindStatusCondition(parent.Conditions, string(conditionType))
+			if c != nil && c.ObservedGeneration == route.GetGeneration() && c.Status == metav1.ConditionFalse {
+				doneCh <- fmt.Errorf("Failed to deploy %s %s. Gateway: %s, Condition: %s, Reason: %s, Message: %s", route.GetKind(), route.GetName(), parent.ParentRef.Name, c.Type, c.Reason, c.Message)
+				return false
+			}
+		}
+
+		accepted := meta.FindStatusCondition(parent.Conditions, string(gatewayv1beta1.RouteConditionAccepted))
+		if accepted == nil || accepted.ObservedGeneration != route.GetGeneration() || accepted.Status != metav1.ConditionTrue {
+			return false
+		}
+	}
+
+	// The route is accepted by all the parent Gateways
+	doneCh <- nil
+	return true
+}
+
+// gatewayAPIRouteTimeoutError returns the error for a route which did not become ready, including the latest
+// conditions reported by the parent Gateways.
+func gatewayAPIRouteTimeoutError(lister cache.GenericLister, obj client.Object) error {
+	_, status, err := getGatewayAPIRouteStatus(lister, obj)
+	if err != nil {
+		return fmt.Errorf("route deployment timed out, name: %s, namespace %s, error occured while fetching latest status: %w", obj.GetName(), obj.GetNamespace(), err)
+	}
+
+	conditions := []string{}
+	for _, parent := range status.Parents {
+		for _, c := range parent.Conditions {
+			conditions = append(conditions, fmt.Sprintf("%s/%s=%s (%s: %s)", parent.ParentRef.Name, c.Type, c.Status, c.Reason, c.Message))
+		}
+	}
+
+	return fmt.Errorf("route deployment timed out, name: %s, namespace %s, conditions: [%s]", obj.GetName(), obj.GetNamespace(), strings.Join(conditions, ", "))
+}
+
+func getGatewayAPIRouteStatus(lister cache.GenericLister, obj client.Object) (*unstructured.Unstructured, *gatewayv1beta1.RouteStatus, error) {
+	item, err := lister.ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	route, ok := item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected type %T", item)
+	}
+
+	status := &gatewayv1beta1.RouteStatus{}
+	raw, found, err := unstructured.NestedMap(route.Object, "status")
+	if err != nil {
+		return nil, nil, err
+	} else if !found {
+		return route, status, nil
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, status); err != nil {
+		return nil, nil, err
+	}
+
+	return route, status, nil
+}
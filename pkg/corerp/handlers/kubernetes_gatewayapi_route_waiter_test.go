@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func makeTestHTTPRoute(t *testing.T, conditions ...metav1.Condition) *unstructured.Unstructured {
+	route := &gatewayv1beta1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Name:       "frontend",
+			Generation: 1,
+		},
+	}
+
+	if len(conditions) > 0 {
+		route.Status.Parents = []gatewayv1beta1.RouteParentStatus{
+			{
+				ParentRef:      gatewayv1beta1.ParentReference{Name: "gateway"},
+				ControllerName: "example.com/gateway-controller",
+				Conditions:     conditions,
+			},
+		}
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(route)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func setupGatewayAPIRouteInformer(t *testing.T, route *unstructured.Unstructured) (*httpProxyWaiter, dynamicinformer.DynamicSharedInformerFactory) {
+	s := runtime.NewScheme()
+	err := gatewayv1beta1.AddToScheme(s)
+	require.NoError(t, err)
+	fakeClient := fakedynamic.NewSimpleDynamicClient(s, route)
+
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(fakeClient, 0, "default", nil)
+	err = dynamicInformerFactory.ForResource(informerResource(route)).Informer().GetIndexer().Add(route)
+	require.NoError(t, err, "Could not add test route to informer cache")
+
+	ctx := context.Background()
+	dynamicInformerFactory.Start(ctx.Done())
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	return &httpProxyWaiter{dynamicClientSet: fakeClient}, dynamicInformerFactory
+}
+
+func TestInformerResource_GatewayAPIRoute(t *testing.T) {
+	route := makeTestHTTPRoute(t)
+	gvr := informerResource(route)
+	require.Equal(t, gatewayv1beta1.GroupName, gvr.Group)
+	require.Equal(t, "v1beta1", gvr.Version)
+	require.Equal(t, "httproutes", gvr.Resource)
+}
+
+func TestCheckGatewayAPIRouteStatus_Accepted(t *testing.T) {
+	route := makeTestHTTPRoute(t,
+		metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 1, Reason: "Accepted"},
+		metav1.Condition{Type: string(gatewayv1beta1.RouteConditionResolvedRefs), Status: metav1.ConditionTrue, ObservedGeneration: 1, Reason: "ResolvedRefs"},
+	)
+	waiter, dynamicInformerFactory := setupGatewayAPIRouteInformer(t, route)
+
+	doneCh := make(chan error)
+	go waiter.checkStatus(context.Background(), dynamicInformerFactory, route, doneCh)
+	err := <-doneCh
+	require.NoError(t, err)
+}
+
+func TestCheckGatewayAPIRouteStatus_NotAccepted(t *testing.T) {
+	route := makeTestHTTPRoute(t,
+		metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionFalse, ObservedGeneration: 1, Reason: "NotAllowedByListeners", Message: "hostname does not match"},
+	)
+	waiter, dynamicInformerFactory := setupGatewayAPIRouteInformer(t, route)
+
+	doneCh := make(chan error)
+	go waiter.checkStatus(context.Background(), dynamicInformerFactory, route, doneCh)
+	err := <-doneCh
+	require.ErrorContains(t, err, "Failed to deploy HTTPRoute frontend. Gateway: gateway, Condition: Accepted, Reason: NotAllowedByListeners, Message: hostname does not match")
+}
+
+func TestCheckGatewayAPIRouteStatus_UnresolvedRefs(t *testing.T) {
+	route := makeTestHTTPRoute(t,
+		metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 1, Reason: "Accepted"},
+		metav1.Condition{Type: string(gatewayv1beta1.RouteConditionResolvedRefs), Status: metav1.ConditionFalse, ObservedGeneration: 1, Reason: "BackendNotFound", Message: "service not found"},
+	)
+	waiter, dynamicInformerFactory := setupGatewayAPIRouteInformer(t, route)
+
+	doneCh := make(chan error)
+	go waiter.checkStatus(context.Background(), dynamicInformerFactory, route, doneCh)
+	err := <-doneCh
+	require.ErrorContains(t, err, "Condition: ResolvedRefs, Reason: BackendNotFound")
+}
+
+func TestCheckGatewayAPIRouteStatus_Pending(t *testing.T) {
+	tests := []struct {
+		name  string
+		route *unstructured.Unstructured
+	}{
+		{
+			name:  "no parents",
+			route: makeTestHTTPRoute(t),
+		},
+		{
+			name: "stale generation",
+			route: makeTestHTTPRoute(t,
+				metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionTrue, ObservedGeneration: 0, Reason: "Accepted"},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waiter, dynamicInformerFactory := setupGatewayAPIRouteInformer(t, tt.route)
+
+			doneCh := make(chan error, 1)
+			ready := waiter.checkStatus(context.Background(), dynamicInformerFactory, tt.route, doneCh)
+			require.False(t, ready)
+			require.Empty(t, doneCh)
+		})
+	}
+}
+
+func TestGatewayAPIRouteTimeoutError(t *testing.T) {
+	route := makeTestHTTPRoute(t,
+		metav1.Condition{Type: string(gatewayv1beta1.RouteConditionAccepted), Status: metav1.ConditionUnknown, ObservedGeneration: 1, Reason: "Pending", Message: "waiting for controller"},
+	)
+	_, dynamicInformerFactory := setupGatewayAPIRouteInformer(t, route)
+
+	err := gatewayAPIRouteTimeoutError(dynamicInformerFactory.ForResource(informerResource(route)).Lister(), route)
+	require.EqualError(t, err, "route deployment timed out, name: frontend, namespace default, conditions: [gateway/Accepted=Unknown (Pending: waiting for controller)]")
+}
@@ -48,10 +48,10 @@ func (handler *httpProxyWaiter) addDynamicEventHandler(ctx context.Context, info
 
 	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj any) {
-			handler.checkHTTPProxyStatus(ctx, informerFactory, item, doneCh)
+			handler.checkStatus(ctx, informerFactory, item, doneCh)
 		},
 		UpdateFunc: func(_, newObj any) {
-			handler.checkHTTPProxyStatus(ctx, informerFactory, item, doneCh)
+			handler.checkStatus(ctx, informerFactory, item, doneCh)
 		},
 	})
 
@@ -73,9 +73,9 @@ func (handler *httpProxyWaiter) waitUntilReady(ctx context.Context, obj client.O
 	// This ensures that the informer is stopped when this function is returned.
 	defer cancel()
 
-	// Create dynamic informer for HTTPProxy
+	// Create dynamic informer for HTTPProxy or the Gateway API route
 	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(handler.dynamicClientSet, 0, obj.GetNamespace(), nil)
-	httpProxyInformer := dynamicInformerFactory.ForResource(contourv1.HTTPProxyGVR)
+	httpProxyInformer := dynamicInformerFactory.ForResource(informerResource(obj))
 	// Add event handlers to the http proxy informer
 	handler.addDynamicEventHandler(ctx, dynamicInformerFactory, httpProxyInformer.Informer(), obj, doneCh)
 
@@ -87,6 +87,10 @@ func (handler *httpProxyWaiter) waitUntilReady(ctx context.Context, obj client.O
 
 	select {
 	case <-ctx.Done():
+		if isGatewayAPIRoute(obj) {
+			return gatewayAPIRouteTimeoutError(httpProxyInformer.Lister(), obj)
+		}
+
 		// Get the final status
 		proxy, err := httpProxyInformer.Lister().Get(obj.GetName())
 
@@ -113,6 +117,15 @@ func (handler *httpProxyWaiter) waitUntilReady(ctx context.Context, obj client.O
 	}
 }
 
+// checkStatus checks the status of the HTTPProxy or the Gateway API route and signals doneCh once it is ready or failed.
+func (handler *httpProxyWaiter) checkStatus(ctx context.Context, dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory, obj client.Object, doneCh chan<- error) bool {
+	if isGatewayAPIRoute(obj) {
+		return handler.checkGatewayAPIRouteStatus(ctx, dynamicInformerFactory, obj, doneCh)
+	}
+
+	return handler.checkHTTPProxyStatus(ctx, dynamicInformerFactory, obj, doneCh)
+}
+
 func (handler *httpProxyWaiter) checkHTTPProxyStatus(ctx context.Context, dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory, obj client.Object, doneCh chan<- error) bool {
 	logger := ucplog.FromContextOrDiscard(ctx).WithValues("httpProxyName", obj.GetName(), "namespace", obj.GetNamespace())
 	selector := labels.SelectorFromSet(
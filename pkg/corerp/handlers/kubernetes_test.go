@@ -24,6 +24,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
@@ -120,6 +121,57 @@ func TestPut(t *testing.T) {
 	}
 }
 
+func TestPut_RemoteCluster(t *testing.T) {
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-secret",
+			Namespace: "test-namespace",
+		},
+	}
+
+	// The output resource is moved to the plane of the cluster before it is deployed.
+	resource := rpv1.NewKubernetesOutputResource("Secret", secret, secret.ObjectMeta)
+	resource.ID = resources_kubernetes.WithPlaneName(resource.ID, "cluster1")
+
+	handler := kubernetesHandler{
+		client: k8sutil.NewFakeKubeClient(nil),
+	}
+
+	_, err := handler.Put(context.Background(), &PutOptions{Resource: &resource})
+	require.NoError(t, err)
+	require.Equal(t, "/planes/kubernetes/cluster1/namespaces/test-namespace/providers/core/Secret/test-secret", resource.ID.String())
+}
+
+type testClusterProvider struct {
+	planeNames []string
+}
+
+func (p *testClusterProvider) GetCluster(ctx context.Context, planeName string) (*ucp_kubernetes.Cluster, error) {
+	p.planeNames = append(p.planeNames, planeName)
+	return nil, &ucp_kubernetes.ErrClusterNotRegistered{PlaneName: planeName}
+}
+
+func TestClusterKubernetesHandler(t *testing.T) {
+	clusters := &testClusterProvider{}
+	handler := NewKubernetesHandler(clusters)
+
+	resource := &rpv1.OutputResource{
+		ID: resources_kubernetes.IDFromParts("cluster1", "apps", "Deployment", "test-namespace", "test-deployment"),
+	}
+
+	_, err := handler.Put(context.Background(), &PutOptions{Resource: resource})
+	require.ErrorIs(t, err, &ucp_kubernetes.ErrClusterNotRegistered{})
+
+	err = handler.Delete(context.Background(), &DeleteOptions{Resource: resource})
+	require.ErrorIs(t, err, &ucp_kubernetes.ErrClusterNotRegistered{})
+
+	require.Equal(t, []string{"cluster1", "cluster1"}, clusters.planeNames)
+}
+
 func TestDelete(t *testing.T) {
 	ctx := context.Background()
 	// Create first deployment that will be watched
@@ -32,13 +32,9 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers/volume"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
-
-	"k8s.io/client-go/discovery"
-	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/kubernetes"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -49,7 +45,7 @@ const (
 
 // NewApplicationModel configures RBAC support on connections based on connection kind, configures the providers supported by the appmodel,
 // registers the renderers and handlers for various resources, and checks for duplicate registrations.
-func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sClientSet kubernetes.Interface, discoveryClient discovery.ServerResourcesInterface, k8sDynamicClientSet dynamic.Interface) (ApplicationModel, error) {
+func NewApplicationModel(arm *armauth.ArmConfig, clusters ucp_kubernetes.ClusterProvider) (ApplicationModel, error) {
 	// Configure RBAC support on connections based connection kind.
 	// Role names can be user input or default roles assigned by Radius.
 	// Leave RoleNames field empty if no default roles are supported for a connection kind.
@@ -117,7 +113,7 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 				Type:     AnyResourceType,
 				Provider: resourcemodel.ProviderKubernetes,
 			},
-			ResourceHandler: handlers.NewKubernetesHandler(k8sClient, k8sClientSet, discoveryClient, k8sDynamicClientSet),
+			ResourceHandler: handlers.NewKubernetesHandler(clusters),
 		},
 		{
 			ResourceType: resourcemodel.ResourceType{
@@ -125,7 +121,7 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 				Provider: resourcemodel.ProviderKubernetes,
 			},
 			ResourceTransformer: azcontainer.TransformSecretProviderClass,
-			ResourceHandler:     handlers.NewKubernetesHandler(k8sClient, k8sClientSet, discoveryClient, k8sDynamicClientSet),
+			ResourceHandler:     handlers.NewKubernetesHandler(clusters),
 		},
 		{
 			ResourceType: resourcemodel.ResourceType{
@@ -133,7 +129,7 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 				Provider: resourcemodel.ProviderKubernetes,
 			},
 			ResourceTransformer: azcontainer.TransformFederatedIdentitySA,
-			ResourceHandler:     handlers.NewKubernetesHandler(k8sClient, k8sClientSet, discoveryClient, k8sDynamicClientSet),
+			ResourceHandler:     handlers.NewKubernetesHandler(clusters),
 		},
 	}
 
@@ -20,13 +20,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/url"
 	"sort"
 	"strconv"
 	"strings"
 
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -44,6 +47,7 @@ import (
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
 
@@ -146,6 +150,33 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 		}
 	}
 
+	// Environment variables of the main container, init containers, and sidecars can reference the secrets of
+	// secret stores and the secrets or computed values of other Radius resources.
+	envs := []map[string]datamodel.EnvironmentVariable{properties.Container.Env}
+	for _, c := range properties.InitContainers {
+		envs = append(envs, c.Env)
+	}
+	for _, c := range properties.Sidecars {
+		envs = append(envs, c.Env)
+	}
+
+	for _, env := range envs {
+		for name, e := range env {
+			if e.ValueFrom == nil || e.ValueFrom.SecretRef == nil {
+				continue
+			}
+
+			resourceID, err := resources.ParseResource(e.ValueFrom.SecretRef.Source)
+			if err != nil {
+				return nil, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid source of environment variable %s: %s", name, err.Error()))
+			}
+
+			if resources_radius.IsRadiusResource(resourceID) {
+				radiusResourceIDs = append(radiusResourceIDs, resourceID)
+			}
+		}
+	}
+
 	return radiusResourceIDs, azureResourceIDs, nil
 }
 
@@ -251,6 +282,12 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	}
 	outputResources = append(outputResources, deploymentResources...)
 
+	// If the container has the autoScaling extension, the replica count of the deployment is managed by a
+	// HorizontalPodAutoscaler instead of the manualScaling extension.
+	if ext := datamodel.FindExtension(properties.Extensions, datamodel.AutoScaling); ext != nil && ext.AutoScaling != nil {
+		outputResources = append(outputResources, r.makeHorizontalPodAutoscaler(*resource, appId.Name(), ext.AutoScaling, options))
+	}
+
 	// If there are secrets we'll use a Kubernetes secret to hold them. This is already referenced
 	// by the deployment.
 	if len(secretData) > 0 {
@@ -389,12 +426,20 @@ func (r Renderer) makeDeployment(
 	container.Args = properties.Container.Args
 	container.WorkingDir = properties.Container.WorkingDir
 
+	var err error
+
+	if properties.Container.Resources != nil {
+		container.Resources, err = makeResourceRequirements(properties.Container.Resources)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid container resources: %s", err.Error()))
+		}
+	}
+
 	// If the user has specified an image pull policy, use it. Else, we will use Kubernetes default.
 	if properties.Container.ImagePullPolicy != "" {
 		container.ImagePullPolicy = corev1.PullPolicy(properties.Container.ImagePullPolicy)
 	}
 
-	var err error
 	if !properties.Container.ReadinessProbe.IsEmpty() {
 		container.ReadinessProbe, err = r.makeHealthProbe(properties.Container.ReadinessProbe)
 		if err != nil {
@@ -416,8 +461,14 @@ func (r Renderer) makeDeployment(
 		return []rpv1.OutputResource{}, nil, fmt.Errorf("failed to obtain environment variables and secret data: %w", err)
 	}
 
+	// Init containers and sidecars get the same connection environment variables as the main container.
+	connectionEnv := maps.Clone(env)
+
 	for k, v := range properties.Container.Env {
-		env[k] = corev1.EnvVar{Name: k, Value: v}
+		env[k], err = makeEnvVar(k, k, v, resource, options, secretData)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
 	}
 
 	// Append in sorted order
@@ -635,6 +686,21 @@ func (r Renderer) makeDeployment(
 
 	podSpec.Volumes = append(podSpec.Volumes, volumes...)
 
+	// Init containers and sidecars are added after the main container has been configured because appending to
+	// podSpec.Containers may invalidate the container pointer.
+	for _, c := range properties.InitContainers {
+		podSpec.InitContainers, err = addAdditionalContainer(podSpec.InitContainers, c, connectionEnv, resource, options, secretData)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+	}
+	for _, c := range properties.Sidecars {
+		podSpec.Containers, err = addAdditionalContainer(podSpec.Containers, c, connectionEnv, resource, options, secretData)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+	}
+
 	// See: https://github.com/kubernetes/kubernetes/issues/92226 and
 	// 		https://github.com/radius-project/radius/issues/3002
 	//
@@ -739,6 +805,168 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, applicationN
 	return env, secretData, nil
 }
 
+// addAdditionalContainer renders an init container or sidecar and adds it to containers. If the base manifest already
+// defines a container with the same name, the container is rendered on top of it.
+func addAdditionalContainer(containers []corev1.Container, c datamodel.AdditionalContainer, connectionEnv map[string]corev1.EnvVar, resource *datamodel.ContainerResource, options renderers.RenderOptions, secretData map[string][]byte) ([]corev1.Container, error) {
+	index := -1
+	for i := range containers {
+		if containers[i].Name == c.Name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		containers = append(containers, corev1.Container{Name: c.Name})
+		index = len(containers) - 1
+	}
+	container := &containers[index]
+
+	container.Image = c.Image
+	container.Command = c.Command
+	container.Args = c.Args
+	container.WorkingDir = c.WorkingDir
+
+	if c.ImagePullPolicy != "" {
+		container.ImagePullPolicy = corev1.PullPolicy(c.ImagePullPolicy)
+	}
+
+	if c.Resources != nil {
+		var err error
+		container.Resources, err = makeResourceRequirements(c.Resources)
+		if err != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid resources of container %s: %s", c.Name, err.Error()))
+		}
+	}
+
+	portNames := []string{}
+	for name := range c.Ports {
+		portNames = append(portNames, name)
+	}
+	sort.Strings(portNames)
+
+	for _, name := range portNames {
+		port := c.Ports[name]
+		protocol := corev1.ProtocolTCP
+		if port.Protocol == datamodel.ProtocolUDP {
+			protocol = corev1.ProtocolUDP
+		}
+		container.Ports = append(container.Ports, corev1.ContainerPort{
+			ContainerPort: port.ContainerPort,
+			Protocol:      protocol,
+		})
+	}
+
+	for _, vm := range c.VolumeMounts {
+		if _, ok := resource.Properties.Container.Volumes[vm.Volume]; !ok {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("container %s mounts volume %s which is not found", c.Name, vm.Volume))
+		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      vm.Volume,
+			MountPath: vm.MountPath,
+			ReadOnly:  vm.ReadOnly,
+		})
+	}
+
+	// Values stored in the secret of the resource are keyed by the container name so that they don't collide with
+	// the environment variables of other containers.
+	env := maps.Clone(connectionEnv)
+	for k, v := range c.Env {
+		var err error
+		env[k], err = makeEnvVar(k, c.Name+"."+k, v, resource, options, secretData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, key := range getSortedKeys(env) {
+		container.Env = append(container.Env, env[key])
+	}
+
+	return containers, nil
+}
+
+// makeEnvVar creates the Kubernetes environment variable for an environment variable of the container. A value
+// referenced from a secret store is read from the Kubernetes secret of the secret store. A secret or computed value
+// of another resource is stored in the secret of the container under secretKey, so that the value is never inlined
+// in the deployment.
+func makeEnvVar(name string, secretKey string, env datamodel.EnvironmentVariable, resource *datamodel.ContainerResource, options renderers.RenderOptions, secretData map[string][]byte) (corev1.EnvVar, error) {
+	if env.ValueFrom == nil || env.ValueFrom.SecretRef == nil {
+		return corev1.EnvVar{Name: name, Value: to.String(env.Value)}, nil
+	}
+
+	ref := env.ValueFrom.SecretRef
+	dependency, ok := options.Dependencies[ref.Source]
+	if !ok || dependency.Resource == nil {
+		return corev1.EnvVar{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %s references resource %s which is not found", name, ref.Source))
+	}
+
+	if strings.EqualFold(dependency.Resource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		secretName, err := getSecretStoreSecretName(name, ref, dependency, options.Environment.Namespace)
+		if err != nil {
+			return corev1.EnvVar{}, err
+		}
+
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  ref.Key,
+				},
+			},
+		}, nil
+	}
+
+	var value string
+	switch v := dependency.ComputedValues[ref.Key].(type) {
+	case string:
+		value = v
+	case float64:
+		value = strconv.Itoa(int(v))
+	case int:
+		value = strconv.Itoa(v)
+	default:
+		return corev1.EnvVar{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %s references %s of resource %s which is not found", name, ref.Key, ref.Source))
+	}
+
+	secretData[secretKey] = []byte(value)
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: kubernetes.NormalizeResourceName(resource.Name),
+				},
+				Key: secretKey,
+			},
+		},
+	}, nil
+}
+
+// getSecretStoreSecretName validates that the secret store referenced by an environment variable holds the key and
+// returns the name of its Kubernetes secret. The secret must be in the namespace of the container.
+func getSecretStoreSecretName(name string, ref *datamodel.EnvironmentVariableSecretReference, dependency renderers.RendererDependency, namespace string) (string, error) {
+	secretStore, ok := dependency.Resource.(*datamodel.SecretStore)
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %s must reference a secretStore resource", name))
+	}
+
+	if _, ok := secretStore.Properties.Data[ref.Key]; !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %s references key %s which is not found in secretStore %s", name, ref.Key, ref.Source))
+	}
+
+	secretID, ok := dependency.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", ref.Source))
+	}
+
+	if secretNamespace := secretID.FindScope(resources_kubernetes.ScopeNamespaces); secretNamespace != namespace {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %s references secretStore %s in namespace %s, but the container is deployed to namespace %s", name, ref.Source, secretNamespace, namespace))
+	}
+
+	return secretID.Name(), nil
+}
+
 func (r Renderer) makeHealthProbe(p datamodel.HealthProbeProperties) (*corev1.Probe, error) {
 	probeSpec := corev1.Probe{}
 
@@ -841,6 +1069,102 @@ func (r Renderer) makeSecret(ctx context.Context, resource datamodel.ContainerRe
 	return output
 }
 
+// makeResourceRequirements converts the CPU and memory requests and limits of the container to Kubernetes
+// resource requirements.
+func makeResourceRequirements(r *datamodel.ResourceRequirements) (corev1.ResourceRequirements, error) {
+	requests, err := makeResourceList(r.Requests)
+	if err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+
+	limits, err := makeResourceList(r.Limits)
+	if err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+
+	return corev1.ResourceRequirements{Requests: requests, Limits: limits}, nil
+}
+
+func makeResourceList(c *datamodel.ComputeResources) (corev1.ResourceList, error) {
+	if c == nil || (c.CPU == "" && c.Memory == "") {
+		return nil, nil
+	}
+
+	list := corev1.ResourceList{}
+	if c.CPU != "" {
+		q, err := resource.ParseQuantity(c.CPU)
+		if err != nil {
+			return nil, fmt.Errorf("cpu %q: %w", c.CPU, err)
+		}
+		list[corev1.ResourceCPU] = q
+	}
+	if c.Memory != "" {
+		q, err := resource.ParseQuantity(c.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("memory %q: %w", c.Memory, err)
+		}
+		list[corev1.ResourceMemory] = q
+	}
+
+	return list, nil
+}
+
+// makeHorizontalPodAutoscaler creates a HorizontalPodAutoscaler scaling the deployment of the container
+// on the CPU and memory utilization targets of the autoScaling extension.
+func (r Renderer) makeHorizontalPodAutoscaler(resource datamodel.ContainerResource, applicationName string, ext *datamodel.AutoScalingExtension, options renderers.RenderOptions) rpv1.OutputResource {
+	name := kubernetes.NormalizeResourceName(resource.Name)
+
+	metrics := []autoscalingv2.MetricSpec{}
+	for _, target := range []struct {
+		name        corev1.ResourceName
+		utilization *int32
+	}{
+		{name: corev1.ResourceCPU, utilization: ext.TargetCPUUtilization},
+		{name: corev1.ResourceMemory, utilization: ext.TargetMemoryUtilization},
+	} {
+		if target.utilization == nil {
+			continue
+		}
+
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: target.name,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: target.utilization,
+				},
+			},
+		})
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: options.Environment.Namespace,
+			Labels:    kubernetes.MakeDescriptiveLabels(applicationName, resource.Name, resource.ResourceTypeName()),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       name,
+				APIVersion: "apps/v1",
+			},
+			MinReplicas: ext.MinReplicas,
+			MaxReplicas: ext.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+
+	output := rpv1.NewKubernetesOutputResource(rpv1.LocalIDHorizontalPodAutoscaler, hpa, hpa.ObjectMeta)
+	output.CreateResource.Dependencies = []string{rpv1.LocalIDDeployment}
+	return output
+}
+
 func (r Renderer) isIdentitySupported(kind datamodel.IAMKind) bool {
 	if r.RoleAssignmentMap == nil || !kind.IsValid() {
 		return false
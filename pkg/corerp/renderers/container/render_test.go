@@ -40,7 +40,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -274,9 +276,9 @@ func Test_Render_Basic(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 	}
@@ -342,9 +344,9 @@ func Test_Render_WithCommandArgsWorkingDir(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			Command:    []string{"command1", "command2"},
 			Args:       []string{"arg1", "arg2"},
@@ -555,9 +557,9 @@ func Test_Render_Connections(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 	}
@@ -717,9 +719,9 @@ func Test_Render_Connections_SecretsGetHashed(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 	}
@@ -1012,9 +1014,9 @@ func Test_Render_EphemeralVolumes(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			Volumes: map[string]datamodel.VolumeProperties{
 				tempVolName: {
@@ -1270,9 +1272,9 @@ func Test_Render_ReadinessProbeHttpGet(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			ReadinessProbe: datamodel.HealthProbeProperties{
 				Kind: datamodel.HTTPGetHealthProbe,
@@ -1349,9 +1351,9 @@ func Test_Render_ReadinessProbeTcp(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			ReadinessProbe: datamodel.HealthProbeProperties{
 				Kind: datamodel.TCPHealthProbe,
@@ -1419,9 +1421,9 @@ func Test_Render_LivenessProbeExec(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 			LivenessProbe: datamodel.HealthProbeProperties{
 				Kind: datamodel.ExecHealthProbe,
@@ -1634,9 +1636,9 @@ func Test_Render_ImagePullPolicySpecified(t *testing.T) {
 		Container: datamodel.Container{
 			Image:           "someimage:latest",
 			ImagePullPolicy: "Never",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 	}
@@ -1663,6 +1665,430 @@ func Test_Render_ImagePullPolicySpecified(t *testing.T) {
 	})
 }
 
+func Test_Render_ResourceRequirements(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: &datamodel.ResourceRequirements{
+				Requests: &datamodel.ComputeResources{CPU: "250m", Memory: "128Mi"},
+				Limits:   &datamodel.ComputeResources{Memory: "512Mi"},
+			},
+		},
+	}
+	res := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, res, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	require.Equal(t, corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("250m"),
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("512Mi"),
+		},
+	}, container.Resources)
+
+	// No autoScaling extension, no HorizontalPodAutoscaler.
+	for _, r := range output.Resources {
+		require.NotEqual(t, rpv1.LocalIDHorizontalPodAutoscaler, r.LocalID)
+	}
+}
+
+func Test_Render_InvalidResourceRequirements(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: &datamodel.ResourceRequirements{
+				Requests: &datamodel.ComputeResources{CPU: "lots"},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+	require.Error(t, err)
+	require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+}
+
+func Test_Render_AutoScaling(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Resources: &datamodel.ResourceRequirements{
+				Requests: &datamodel.ComputeResources{CPU: "250m", Memory: "128Mi"},
+			},
+		},
+		Extensions: []datamodel.Extension{
+			{
+				Kind: datamodel.AutoScaling,
+				AutoScaling: &datamodel.AutoScalingExtension{
+					MinReplicas:             to.Ptr[int32](2),
+					MaxReplicas:             10,
+					TargetCPUUtilization:    to.Ptr[int32](70),
+					TargetMemoryUtilization: to.Ptr[int32](80),
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{
+		Dependencies: map[string]renderers.RendererDependency{},
+		Environment:  renderers.EnvironmentOptions{Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	var hpaOutput *rpv1.OutputResource
+	for i := range output.Resources {
+		if output.Resources[i].LocalID == rpv1.LocalIDHorizontalPodAutoscaler {
+			hpaOutput = &output.Resources[i]
+		}
+	}
+	require.NotNil(t, hpaOutput)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, hpaOutput.CreateResource.Dependencies)
+	require.Equal(t, "autoscaling/HorizontalPodAutoscaler", hpaOutput.GetResourceType().Type)
+
+	hpa := hpaOutput.CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.Equal(t, resourceName, hpa.Name)
+	require.Equal(t, "default", hpa.Namespace)
+	require.Equal(t, kubernetes.MakeDescriptiveLabels(applicationName, resourceName, ResourceType), hpa.Labels)
+	require.Equal(t, autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			Kind:       "Deployment",
+			Name:       resourceName,
+			APIVersion: "apps/v1",
+		},
+		MinReplicas: to.Ptr[int32](2),
+		MaxReplicas: 10,
+		Metrics: []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: to.Ptr[int32](70),
+					},
+				},
+			},
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceMemory,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: to.Ptr[int32](80),
+					},
+				},
+			},
+		},
+	}, hpa.Spec)
+
+	// The replica count is owned by the HorizontalPodAutoscaler.
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Nil(t, deployment.Spec.Replicas)
+}
+
+func Test_Render_EnvironmentVariableReferences(t *testing.T) {
+	secretStoreID := makeRadiusResourceID(t, "Applications.Core/secretStores", "dbsecrets")
+	redisID := makeRadiusResourceID(t, "Applications.Datastores/redisCaches", "redis")
+
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Env: map[string]datamodel.EnvironmentVariable{
+				"LOG_LEVEL": {Value: to.Ptr("debug")},
+				"DB_PASSWORD": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: secretStoreID.String(), Key: "password"},
+					},
+				},
+				"REDIS_URL": {
+					ValueFrom: &datamodel.EnvironmentVariableReference{
+						SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: redisID.String(), Key: "url"},
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+
+	radiusResourceIDs, _, err := renderer.GetDependencyIDs(ctx, resource)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []resources.ID{secretStoreID, redisID}, radiusResourceIDs)
+
+	dependencies := map[string]renderers.RendererDependency{
+		secretStoreID.String(): {
+			ResourceID: secretStoreID,
+			Resource: &datamodel.SecretStore{
+				Properties: &datamodel.SecretStoreProperties{
+					Type: datamodel.SecretTypeGeneric,
+					Data: map[string]*datamodel.SecretStoreDataValue{
+						"password": {},
+					},
+				},
+			},
+			OutputResources: map[string]resources.ID{
+				rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", "default", "dbsecrets"),
+			},
+		},
+		redisID.String(): {
+			ResourceID: redisID,
+			Resource:   &datamodel.ContainerResource{},
+			ComputedValues: map[string]any{
+				"url": "redis://redis:6379",
+			},
+		},
+	}
+
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+
+	expected := []corev1.EnvVar{
+		{
+			Name: "DB_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "dbsecrets"},
+					Key:                  "password",
+				},
+			},
+		},
+		{Name: "LOG_LEVEL", Value: "debug"},
+		{
+			Name: "REDIS_URL",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: resourceName},
+					Key:                  "REDIS_URL",
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, deployment.Spec.Template.Spec.Containers[0].Env)
+
+	// The value of the resource is stored in the secret of the container rather than in the deployment.
+	secret, _ := kubernetes.FindSecret(output.Resources)
+	require.NotNil(t, secret)
+	require.Equal(t, map[string][]byte{"REDIS_URL": []byte("redis://redis:6379")}, secret.Data)
+
+	t.Run("missing key in secret store", func(t *testing.T) {
+		properties.Container.Env["DB_PASSWORD"].ValueFrom.SecretRef.Key = "username"
+		t.Cleanup(func() { properties.Container.Env["DB_PASSWORD"].ValueFrom.SecretRef.Key = "password" })
+
+		_, err := renderer.Render(ctx, makeResource(t, properties), renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.Error(t, err)
+		require.Equal(t, "environment variable DB_PASSWORD references key username which is not found in secretStore "+secretStoreID.String(), err.(*apiv1.ErrClientRP).Message)
+	})
+
+	t.Run("secret store in another namespace", func(t *testing.T) {
+		_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "app-ns"}})
+		require.Error(t, err)
+		require.Equal(t, "environment variable DB_PASSWORD references secretStore "+secretStoreID.String()+" in namespace default, but the container is deployed to namespace app-ns", err.(*apiv1.ErrClientRP).Message)
+	})
+
+	t.Run("missing value of resource", func(t *testing.T) {
+		properties.Container.Env["REDIS_URL"].ValueFrom.SecretRef.Key = "password"
+		t.Cleanup(func() { properties.Container.Env["REDIS_URL"].ValueFrom.SecretRef.Key = "url" })
+
+		_, err := renderer.Render(ctx, makeResource(t, properties), renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.Error(t, err)
+		require.Equal(t, "environment variable REDIS_URL references password of resource "+redisID.String()+" which is not found", err.(*apiv1.ErrClientRP).Message)
+	})
+}
+
+func Test_Render_AdditionalContainers(t *testing.T) {
+	connectionID := makeRadiusResourceID(t, "SomeProvider/ResourceType", "A")
+	redisID := makeRadiusResourceID(t, "Applications.Datastores/redisCaches", "redis")
+
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"A": {
+				Source: connectionID.String(),
+			},
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Volumes: map[string]datamodel.VolumeProperties{
+				"logs": {
+					Kind: datamodel.Ephemeral,
+					Ephemeral: &datamodel.EphemeralVolume{
+						VolumeBase:   datamodel.VolumeBase{MountPath: "/var/log/app"},
+						ManagedStore: datamodel.ManagedStoreMemory,
+					},
+				},
+			},
+		},
+		InitContainers: []datamodel.AdditionalContainer{
+			{
+				Name:    "migrate",
+				Image:   "migrate:latest",
+				Command: []string{"/bin/migrate"},
+				Args:    []string{"up"},
+				Env: map[string]datamodel.EnvironmentVariable{
+					"REDIS_URL": {
+						ValueFrom: &datamodel.EnvironmentVariableReference{
+							SecretRef: &datamodel.EnvironmentVariableSecretReference{Source: redisID.String(), Key: "url"},
+						},
+					},
+				},
+			},
+		},
+		Sidecars: []datamodel.AdditionalContainer{
+			{
+				Name:            "log-shipper",
+				Image:           "fluent-bit:2.2",
+				ImagePullPolicy: "IfNotPresent",
+				Env: map[string]datamodel.EnvironmentVariable{
+					"LOG_LEVEL": {Value: to.Ptr("info")},
+				},
+				Ports: map[string]datamodel.AdditionalContainerPort{
+					"metrics": {ContainerPort: 2020},
+					"syslog":  {ContainerPort: 5140, Protocol: datamodel.ProtocolUDP},
+				},
+				VolumeMounts: []datamodel.VolumeMount{
+					{Volume: "logs", MountPath: "/logs", ReadOnly: true},
+				},
+				Resources: &datamodel.ResourceRequirements{
+					Limits: &datamodel.ComputeResources{CPU: "100m", Memory: "64Mi"},
+				},
+			},
+		},
+	}
+	res := makeResource(t, properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+
+	radiusResourceIDs, _, err := renderer.GetDependencyIDs(ctx, res)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []resources.ID{connectionID, redisID}, radiusResourceIDs)
+
+	dependencies := map[string]renderers.RendererDependency{
+		connectionID.String(): {
+			ResourceID: connectionID,
+			ComputedValues: map[string]any{
+				"host": "a.default.svc",
+			},
+		},
+		redisID.String(): {
+			ResourceID: redisID,
+			Resource:   &datamodel.ContainerResource{},
+			ComputedValues: map[string]any{
+				"url": "redis://redis:6379",
+			},
+		},
+	}
+
+	output, err := renderer.Render(ctx, res, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+
+	connectionEnv := corev1.EnvVar{
+		Name: "CONNECTION_A_HOST",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  "CONNECTION_A_HOST",
+			},
+		},
+	}
+
+	podSpec := deployment.Spec.Template.Spec
+	require.Len(t, podSpec.Containers, 2)
+	require.Equal(t, resourceName, podSpec.Containers[0].Name)
+	require.Equal(t, []corev1.EnvVar{connectionEnv}, podSpec.Containers[0].Env)
+
+	expectedInitContainers := []corev1.Container{
+		{
+			Name:    "migrate",
+			Image:   "migrate:latest",
+			Command: []string{"/bin/migrate"},
+			Args:    []string{"up"},
+			Env: []corev1.EnvVar{
+				connectionEnv,
+				{
+					Name: "REDIS_URL",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+							Key:                  "migrate.REDIS_URL",
+						},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedInitContainers, podSpec.InitContainers)
+
+	expectedSidecar := corev1.Container{
+		Name:            "log-shipper",
+		Image:           "fluent-bit:2.2",
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Env: []corev1.EnvVar{
+			connectionEnv,
+			{Name: "LOG_LEVEL", Value: "info"},
+		},
+		Ports: []corev1.ContainerPort{
+			{ContainerPort: 2020, Protocol: corev1.ProtocolTCP},
+			{ContainerPort: 5140, Protocol: corev1.ProtocolUDP},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "logs", MountPath: "/logs", ReadOnly: true},
+		},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+		},
+	}
+	require.Equal(t, expectedSidecar, podSpec.Containers[1])
+
+	secret, _ := kubernetes.FindSecret(output.Resources)
+	require.NotNil(t, secret)
+	require.Equal(t, map[string][]byte{
+		"CONNECTION_A_HOST": []byte("a.default.svc"),
+		"migrate.REDIS_URL": []byte("redis://redis:6379"),
+	}, secret.Data)
+}
+
 func Test_Render_StrategicPatchMerge(t *testing.T) {
 	const contianerPatchObject = `
 {
@@ -1691,9 +2117,9 @@ func Test_Render_StrategicPatchMerge(t *testing.T) {
 		},
 		Container: datamodel.Container{
 			Image: "someimage:latest",
-			Env: map[string]string{
-				envVarName1: envVarValue1,
-				envVarName2: envVarValue2,
+			Env: map[string]datamodel.EnvironmentVariable{
+				envVarName1: {Value: to.Ptr(envVarValue1)},
+				envVarName2: {Value: to.Ptr(envVarValue2)},
 			},
 		},
 		Runtimes: &datamodel.RuntimeProperties{
@@ -1753,9 +2179,9 @@ func Test_Render_BaseManifest(t *testing.T) {
 				},
 				Container: datamodel.Container{
 					Image: "someimage:latest",
-					Env: map[string]string{
-						envVarName1: envVarValue1,
-						envVarName2: envVarValue2,
+					Env: map[string]datamodel.EnvironmentVariable{
+						envVarName1: {Value: to.Ptr(envVarValue1)},
+						envVarName2: {Value: to.Ptr(envVarValue2)},
 					},
 					Volumes: map[string]datamodel.VolumeProperties{
 						"ephemeralVolume": {
@@ -1781,9 +2207,9 @@ func Test_Render_BaseManifest(t *testing.T) {
 				},
 				Container: datamodel.Container{
 					Image: "someimage:latest",
-					Env: map[string]string{
-						envVarName1: envVarValue1,
-						envVarName2: envVarValue2,
+					Env: map[string]datamodel.EnvironmentVariable{
+						envVarName1: {Value: to.Ptr(envVarValue1)},
+						envVarName2: {Value: to.Ptr(envVarValue2)},
 					},
 				},
 			},
@@ -0,0 +1,364 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// GatewayAPIListenerName is the name of the listener of the Gateway object.
+	GatewayAPIListenerName = "radius"
+)
+
+// MakeGatewayAPIGateway validates the Gateway resource and its dependencies, and creates a Gateway API Gateway object
+// with a single listener for the hostname of the gateway.
+func MakeGatewayAPIGateway(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
+	if len(gateway.Properties.Routes) < 1 {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
+	}
+
+	listener := gatewayv1beta1.Listener{
+		Name:     GatewayAPIListenerName,
+		Port:     gatewayv1beta1.PortNumber(renderers.DefaultPort),
+		Protocol: gatewayv1beta1.HTTPProtocolType,
+		AllowedRoutes: &gatewayv1beta1.AllowedRoutes{
+			Kinds: []gatewayv1beta1.RouteGroupKind{{Kind: "HTTPRoute"}},
+		},
+	}
+
+	if hostname != "" {
+		listener.Hostname = to.Ptr(gatewayv1beta1.Hostname(hostname))
+	}
+
+	if gateway.Properties.TLS != nil {
+		if gateway.Properties.TLS.SSLPassthrough {
+			if len(gateway.Properties.Routes) > 1 {
+				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
+			}
+
+			route := gateway.Properties.Routes[0]
+			if route.Path != "" || route.ReplacePrefix != "" {
+				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
+			}
+
+			listener.Port = gatewayv1beta1.PortNumber(renderers.DefaultSecurePort)
+			listener.Protocol = gatewayv1beta1.TLSProtocolType
+			listener.TLS = &gatewayv1beta1.GatewayTLSConfig{
+				Mode: to.Ptr(gatewayv1beta1.TLSModePassthrough),
+			}
+			listener.AllowedRoutes.Kinds = []gatewayv1beta1.RouteGroupKind{{Kind: "TLSRoute"}}
+		} else if gateway.Properties.TLS.CertificateFrom != "" {
+			secretNamespace, secretName, err := getCertificateSecret(options, gateway)
+			if err != nil {
+				return rpv1.OutputResource{}, err
+			}
+
+			// The minimum TLS version is not part of the Gateway API specification and is left to the implementation.
+			listener.Port = gatewayv1beta1.PortNumber(renderers.DefaultSecurePort)
+			listener.Protocol = gatewayv1beta1.HTTPSProtocolType
+			listener.TLS = &gatewayv1beta1.GatewayTLSConfig{
+				Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+				CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+					{
+						Kind:      to.Ptr(gatewayv1beta1.Kind("Secret")),
+						Name:      gatewayv1beta1.ObjectName(secretName),
+						Namespace: to.Ptr(gatewayv1beta1.Namespace(secretNamespace)),
+					},
+				},
+			}
+		}
+	}
+
+	gatewayObject := &gatewayv1beta1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Gateway",
+			APIVersion: gatewayv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        kubernetes.NormalizeResourceName(resourceName),
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, resourceName, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: gatewayv1beta1.ObjectName(options.Environment.GatewayAPI.GatewayClassName),
+			Listeners:        []gatewayv1beta1.Listener{listener},
+		},
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, gatewayObject, gatewayObject.ObjectMeta), nil
+}
+
+// MakeGatewayAPIRoutes creates a Gateway API route object attached to the Gateway object for each destination of the
+// gateway routes. A TLSRoute is created when SSL passthrough is enabled, otherwise an HTTPRoute with one rule per
+// gateway route is created. Gateway routes with a traffic policy get an HTTPRoute of their own.
+func MakeGatewayAPIRoutes(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, applicationName string, hostname string) ([]rpv1.OutputResource, error) {
+	parentRefs := []gatewayv1beta1.ParentReference{
+		{
+			Name:        gatewayv1beta1.ObjectName(gatewayName),
+			SectionName: to.Ptr(gatewayv1beta1.SectionName(GatewayAPIListenerName)),
+		},
+	}
+
+	hostnames := []gatewayv1beta1.Hostname{}
+	if hostname != "" {
+		hostnames = append(hostnames, gatewayv1beta1.Hostname(hostname))
+	}
+
+	sslPassthrough := gateway.TLS != nil && gateway.TLS.SSLPassthrough
+
+	localIDs := []string{}
+	httpRoutes := map[string]*gatewayv1beta1.HTTPRoute{}
+	tlsRoutes := map[string]*gatewayv1alpha2.TLSRoute{}
+	for i, route := range gateway.Routes {
+		var routeName string
+		if route.HasTrafficPolicy() {
+			// Routes with a traffic policy are rendered to their own HTTPRoute, like for Contour.
+			routeName = getTrafficPolicyRouteName(gatewayName, i)
+		} else {
+			name, err := getRouteName(&route)
+			if err != nil {
+				return []rpv1.OutputResource{}, err
+			}
+			routeName = name
+		}
+
+		// Create unique localID for dependency graph
+		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+		routeResourceName := kubernetes.NormalizeResourceName(routeName)
+		objectMeta := metav1.ObjectMeta{
+			Name:        routeResourceName,
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, routeName, resource.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		}
+
+		if sslPassthrough {
+			port, err := getDestinationPort(options, route.Destination)
+			if err != nil {
+				return []rpv1.OutputResource{}, err
+			}
+
+			tlsRoutes[localID] = &gatewayv1alpha2.TLSRoute{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "TLSRoute",
+					APIVersion: gatewayv1alpha2.GroupVersion.String(),
+				},
+				ObjectMeta: objectMeta,
+				Spec: gatewayv1alpha2.TLSRouteSpec{
+					CommonRouteSpec: gatewayv1alpha2.CommonRouteSpec{ParentRefs: parentRefs},
+					Hostnames:       hostnames,
+					Rules: []gatewayv1alpha2.TLSRouteRule{
+						{
+							BackendRefs: []gatewayv1alpha2.BackendRef{
+								{
+									BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+										Name: gatewayv1beta1.ObjectName(routeResourceName),
+										Port: to.Ptr(gatewayv1beta1.PortNumber(port)),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			localIDs = append(localIDs, localID)
+			continue
+		}
+
+		rule, err := makeHTTPRouteRule(options, &route)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
+		// If this route already exists, append the rule to it
+		if object, exists := httpRoutes[localID]; exists {
+			object.Spec.Rules = append(object.Spec.Rules, rule)
+			continue
+		}
+
+		httpRoutes[localID] = &gatewayv1beta1.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "HTTPRoute",
+				APIVersion: gatewayv1beta1.GroupVersion.String(),
+			},
+			ObjectMeta: objectMeta,
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{ParentRefs: parentRefs},
+				Hostnames:       hostnames,
+				Rules:           []gatewayv1beta1.HTTPRouteRule{rule},
+			},
+		}
+		localIDs = append(localIDs, localID)
+	}
+
+	outputResources := []rpv1.OutputResource{}
+	for _, localID := range localIDs {
+		var outputResource rpv1.OutputResource
+		if object, ok := httpRoutes[localID]; ok {
+			outputResource = rpv1.NewKubernetesOutputResource(localID, object, object.ObjectMeta)
+		} else {
+			object := tlsRoutes[localID]
+			outputResource = rpv1.NewKubernetesOutputResource(localID, object, object.ObjectMeta)
+		}
+
+		// The routes are only accepted once the Gateway exists, so the Gateway is created before the routes.
+		outputResource.CreateResource.Dependencies = append(outputResource.CreateResource.Dependencies, rpv1.LocalIDGateway)
+		outputResources = append(outputResources, outputResource)
+	}
+
+	return outputResources, nil
+}
+
+// makeHTTPRouteRule creates the HTTPRoute rule for the gateway route, with the match conditions, the filters and a
+// backend for each destination. Timeouts and retries are not part of the Gateway API HTTPRoute, so routes using them are
+// rejected.
+func makeHTTPRouteRule(options renderers.RenderOptions, route *datamodel.GatewayRoute) (gatewayv1beta1.HTTPRouteRule, error) {
+	if route.Timeout != "" || route.RetryPolicy != nil {
+		return gatewayv1beta1.HTTPRouteRule{}, v1.NewClientErrInvalidRequest("`timeout` and `retryPolicy` of gateway routes are not supported by the Gateway API")
+	}
+
+	match := gatewayv1beta1.HTTPRouteMatch{
+		Path: &gatewayv1beta1.HTTPPathMatch{
+			Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+			Value: to.Ptr(getPathPrefix(route.Path)),
+		},
+	}
+
+	if route.Match != nil {
+		if route.Match.Method != "" {
+			match.Method = to.Ptr(gatewayv1beta1.HTTPMethod(route.Match.Method))
+		}
+
+		for _, name := range sortedKeys(route.Match.Headers) {
+			match.Headers = append(match.Headers, gatewayv1beta1.HTTPHeaderMatch{
+				Type:  to.Ptr(gatewayv1beta1.HeaderMatchExact),
+				Name:  gatewayv1beta1.HTTPHeaderName(name),
+				Value: route.Match.Headers[name],
+			})
+		}
+
+		for _, name := range sortedKeys(route.Match.QueryParameters) {
+			match.QueryParams = append(match.QueryParams, gatewayv1beta1.HTTPQueryParamMatch{
+				Type:  to.Ptr(gatewayv1beta1.QueryParamMatchExact),
+				Name:  gatewayv1beta1.HTTPHeaderName(name),
+				Value: route.Match.QueryParameters[name],
+			})
+		}
+	}
+
+	rule := gatewayv1beta1.HTTPRouteRule{
+		Matches: []gatewayv1beta1.HTTPRouteMatch{match},
+	}
+
+	for _, destination := range route.GetDestinations() {
+		name, err := getDestinationName(destination.Destination)
+		if err != nil {
+			return gatewayv1beta1.HTTPRouteRule{}, err
+		}
+
+		port, err := getDestinationPort(options, destination.Destination)
+		if err != nil {
+			return gatewayv1beta1.HTTPRouteRule{}, err
+		}
+
+		backendRef := gatewayv1beta1.BackendRef{
+			BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+				Name: gatewayv1beta1.ObjectName(kubernetes.NormalizeResourceName(name)),
+				Port: to.Ptr(gatewayv1beta1.PortNumber(port)),
+			},
+		}
+		if len(route.Destinations) > 0 {
+			backendRef.Weight = to.Ptr(destination.Weight)
+		}
+
+		rule.BackendRefs = append(rule.BackendRefs, gatewayv1beta1.HTTPBackendRef{BackendRef: backendRef})
+	}
+
+	if route.ReplacePrefix != "" {
+		rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+			Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+			URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+				Path: &gatewayv1beta1.HTTPPathModifier{
+					Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: to.Ptr(route.ReplacePrefix),
+				},
+			},
+		})
+	}
+
+	if route.RequestHeaders != nil {
+		rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+			Type:                  gatewayv1beta1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: makeHTTPHeaderFilter(route.RequestHeaders),
+		})
+	}
+
+	if route.ResponseHeaders != nil {
+		rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+			Type:                   gatewayv1beta1.HTTPRouteFilterResponseHeaderModifier,
+			ResponseHeaderModifier: makeHTTPHeaderFilter(route.ResponseHeaders),
+		})
+	}
+
+	return rule, nil
+}
+
+func makeHTTPHeaderFilter(modifier *datamodel.GatewayRouteHeaderModifier) *gatewayv1beta1.HTTPHeaderFilter {
+	filter := &gatewayv1beta1.HTTPHeaderFilter{
+		Remove: modifier.Remove,
+	}
+	for _, name := range sortedKeys(modifier.Set) {
+		filter.Set = append(filter.Set, gatewayv1beta1.HTTPHeader{Name: gatewayv1beta1.HTTPHeaderName(name), Value: modifier.Set[name]})
+	}
+
+	return filter
+}
+
+// getGatewayAPIHostname returns the hostname used by the listener and the routes. Gateway API hostnames must not be
+// IP addresses, and the application name placeholder used when there is no public endpoint would restrict the routes
+// to an unreachable host, so both are omitted to match any host.
+func getGatewayAPIHostname(hostname string, hasPublicEndpoint bool) string {
+	if !hasPublicEndpoint || net.ParseIP(hostname) != nil {
+		return ""
+	}
+
+	return hostname
+}
+
+// getPathPrefix returns the path prefix to match for the route path. An empty path matches all requests.
+func getPathPrefix(path string) string {
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
@@ -0,0 +1,462 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const testGatewayClassName = "istio"
+
+func getGatewayAPIEnvironmentOptions(hostname, externalIP string) renderers.EnvironmentOptions {
+	environmentOptions := getEnvironmentOptions(hostname, externalIP, "", false, false)
+	environmentOptions.GatewayAPI = &datamodel.GatewayAPIExtension{GatewayClassName: testGatewayClassName}
+	return environmentOptions
+}
+
+func findGatewayAPIGateway(t *testing.T, outputResources []rpv1.OutputResource) *gatewayv1beta1.Gateway {
+	for _, o := range outputResources {
+		if o.LocalID == rpv1.LocalIDGateway {
+			gateway, ok := o.CreateResource.Data.(*gatewayv1beta1.Gateway)
+			require.True(t, ok)
+			return gateway
+		}
+	}
+
+	require.Fail(t, "gateway output resource not found")
+	return nil
+}
+
+func findGatewayAPIRoute(t *testing.T, outputResources []rpv1.OutputResource, routeName string) rpv1.OutputResource {
+	for _, o := range outputResources {
+		if o.LocalID == fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName) {
+			return o
+		}
+	}
+
+	require.Failf(t, "route output resource not found", "route: %s", routeName)
+	return rpv1.OutputResource{}
+}
+
+func Test_Render_GatewayAPI_Routes(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: makeRouteResourceID("frontend"),
+			},
+			{
+				Destination:   makeRouteResourceID("backend"),
+				Path:          "/api",
+				ReplacePrefix: "/",
+			},
+			{
+				Destination: makeRouteResourceID("backend"),
+				Path:        "/health",
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	output, err := r.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "http://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	gateway := findGatewayAPIGateway(t, output.Resources)
+	require.Equal(t, resourceName, gateway.Name)
+	require.Equal(t, applicationName, gateway.Namespace)
+	expectedGatewaySpec := gatewayv1beta1.GatewaySpec{
+		GatewayClassName: testGatewayClassName,
+		Listeners: []gatewayv1beta1.Listener{
+			{
+				Name:     GatewayAPIListenerName,
+				Hostname: to.Ptr(gatewayv1beta1.Hostname(expectedHostname)),
+				Port:     80,
+				Protocol: gatewayv1beta1.HTTPProtocolType,
+				AllowedRoutes: &gatewayv1beta1.AllowedRoutes{
+					Kinds: []gatewayv1beta1.RouteGroupKind{{Kind: "HTTPRoute"}},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedGatewaySpec, gateway.Spec)
+
+	expectedParentRefs := []gatewayv1beta1.ParentReference{
+		{
+			Name:        resourceName,
+			SectionName: to.Ptr(gatewayv1beta1.SectionName(GatewayAPIListenerName)),
+		},
+	}
+
+	frontend := findGatewayAPIRoute(t, output.Resources, "frontend")
+	require.Equal(t, []string{rpv1.LocalIDGateway}, frontend.CreateResource.Dependencies)
+	frontendRoute, ok := frontend.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+	require.True(t, ok)
+	require.Equal(t, "frontend", frontendRoute.Name)
+	require.Equal(t, expectedParentRefs, frontendRoute.Spec.ParentRefs)
+	require.Equal(t, []gatewayv1beta1.Hostname{gatewayv1beta1.Hostname(expectedHostname)}, frontendRoute.Spec.Hostnames)
+	require.Equal(t, []gatewayv1beta1.HTTPRouteRule{
+		{
+			Matches: []gatewayv1beta1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1beta1.HTTPPathMatch{
+						Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+						Value: to.Ptr("/"),
+					},
+				},
+			},
+			BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+				{
+					BackendRef: gatewayv1beta1.BackendRef{
+						BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+							Name: "frontend",
+							Port: to.Ptr(gatewayv1beta1.PortNumber(80)),
+						},
+					},
+				},
+			},
+		},
+	}, frontendRoute.Spec.Rules)
+
+	backend := findGatewayAPIRoute(t, output.Resources, "backend")
+	backendRoute, ok := backend.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+	require.True(t, ok)
+	require.Len(t, backendRoute.Spec.Rules, 2)
+	require.Equal(t, "/api", *backendRoute.Spec.Rules[0].Matches[0].Path.Value)
+	require.Equal(t, []gatewayv1beta1.HTTPRouteFilter{
+		{
+			Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+			URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+				Path: &gatewayv1beta1.HTTPPathModifier{
+					Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: to.Ptr("/"),
+				},
+			},
+		},
+	}, backendRoute.Spec.Rules[0].Filters)
+	require.Equal(t, "/health", *backendRoute.Spec.Rules[1].Matches[0].Path.Value)
+	require.Empty(t, backendRoute.Spec.Rules[1].Filters)
+}
+
+func Test_Render_GatewayAPI_SSLPassthrough(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: makeRouteResourceID("routename"),
+			},
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			SSLPassthrough: true,
+		},
+	}
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	output, err := r.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	gateway := findGatewayAPIGateway(t, output.Resources)
+	require.Len(t, gateway.Spec.Listeners, 1)
+	listener := gateway.Spec.Listeners[0]
+	require.Equal(t, gatewayv1beta1.PortNumber(443), listener.Port)
+	require.Equal(t, gatewayv1beta1.TLSProtocolType, listener.Protocol)
+	require.Equal(t, gatewayv1beta1.TLSModePassthrough, *listener.TLS.Mode)
+	require.Equal(t, []gatewayv1beta1.RouteGroupKind{{Kind: "TLSRoute"}}, listener.AllowedRoutes.Kinds)
+
+	route := findGatewayAPIRoute(t, output.Resources, "routename")
+	tlsRoute, ok := route.CreateResource.Data.(*gatewayv1alpha2.TLSRoute)
+	require.True(t, ok)
+	require.Equal(t, "TLSRoute", tlsRoute.Kind)
+	require.Len(t, tlsRoute.Spec.Rules, 1)
+	require.Equal(t, gatewayv1alpha2.ObjectName("routename"), tlsRoute.Spec.Rules[0].BackendRefs[0].Name)
+}
+
+func Test_Render_GatewayAPI_SSLPassthroughWithRoutePath(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: makeRouteResourceID("routename"),
+				Path:        "/api",
+			},
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			SSLPassthrough: true,
+		},
+	}
+	resource := makeResource(t, properties)
+
+	_, err := r.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getGatewayAPIEnvironmentOptions("", testExternalIP)})
+	require.Error(t, err)
+	require.Equal(t, "cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true", err.(*v1.ErrClientRP).Message)
+}
+
+func Test_Render_GatewayAPI_TLSTermination(t *testing.T) {
+	r := &Renderer{}
+
+	secretName := "myapp-tls-secret"
+	secretStoreResourceId := makeSecretStoreResourceID(secretName)
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			CertificateFrom: secretStoreResourceId,
+		},
+	})
+	resource := makeResource(t, properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP)
+
+	dependencies := map[string]renderers.RendererDependency{
+		(makeResourceID(t, secretStoreResourceId).String()): {
+			ResourceID: makeResourceID(t, secretStoreResourceId),
+			Resource: &datamodel.SecretStore{
+				Properties: &datamodel.SecretStoreProperties{
+					Type: "certificate",
+					Data: map[string]*datamodel.SecretStoreDataValue{
+						"tls.crt": {
+							Value: to.Ptr("test-crt"),
+						},
+						"tls.key": {
+							Value: to.Ptr("test-crt"),
+						},
+					},
+				},
+			},
+			OutputResources: map[string]resources.ID{
+				"Secret": resources_kubernetes.IDFromParts(
+					resources_kubernetes.PlaneNameTODO,
+					"",
+					"Secret",
+					environmentOptions.Namespace,
+					secretName),
+			},
+		},
+	}
+
+	output, err := r.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	gateway := findGatewayAPIGateway(t, output.Resources)
+	listener := gateway.Spec.Listeners[0]
+	require.Equal(t, gatewayv1beta1.PortNumber(443), listener.Port)
+	require.Equal(t, gatewayv1beta1.HTTPSProtocolType, listener.Protocol)
+	require.Equal(t, &gatewayv1beta1.GatewayTLSConfig{
+		Mode: to.Ptr(gatewayv1beta1.TLSModeTerminate),
+		CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+			{
+				Kind:      to.Ptr(gatewayv1beta1.Kind("Secret")),
+				Name:      gatewayv1beta1.ObjectName(secretName),
+				Namespace: to.Ptr(gatewayv1beta1.Namespace(environmentOptions.Namespace)),
+			},
+		},
+	}, listener.TLS)
+}
+
+func Test_Render_GatewayAPI_NoPublicEndpoint(t *testing.T) {
+	r := &Renderer{}
+
+	properties, _ := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	resource := makeResource(t, properties)
+
+	output, err := r.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getGatewayAPIEnvironmentOptions("", "")})
+	require.NoError(t, err)
+	require.Equal(t, "unknown", output.ComputedValues["url"].Value)
+
+	gateway := findGatewayAPIGateway(t, output.Resources)
+	require.Nil(t, gateway.Spec.Listeners[0].Hostname)
+
+	route := findGatewayAPIRoute(t, output.Resources, "routeName")
+	require.Empty(t, route.CreateResource.Data.(*gatewayv1beta1.HTTPRoute).Spec.Hostnames)
+}
+
+func Test_Render_GatewayAPI_TrafficPolicy(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: makeRouteResourceID("frontend"),
+			},
+			{
+				Path:          "/api",
+				ReplacePrefix: "/",
+				Destinations: []datamodel.GatewayRouteDestination{
+					{Destination: makeRouteResourceID("stable"), Weight: 90},
+					{Destination: makeRouteResourceID("canary"), Weight: 10},
+				},
+				Match: &datamodel.GatewayRouteMatch{
+					Method:          "GET",
+					Headers:         map[string]string{"x-version": "v2", "x-canary": "true"},
+					QueryParameters: map[string]string{"debug": "true"},
+				},
+				RequestHeaders: &datamodel.GatewayRouteHeaderModifier{
+					Set:    map[string]string{"x-forwarded-by": "radius"},
+					Remove: []string{"x-internal"},
+				},
+				ResponseHeaders: &datamodel.GatewayRouteHeaderModifier{
+					Remove: []string{"server"},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		makeRouteResourceID("canary"): {
+			ComputedValues: map[string]any{"port": float64(8080)},
+		},
+	}
+
+	output, err := r.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: getGatewayAPIEnvironmentOptions("", testExternalIP)})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	trafficPolicyRouteName := resourceName + "-route-1"
+	route := findGatewayAPIRoute(t, output.Resources, trafficPolicyRouteName)
+	require.Equal(t, []string{rpv1.LocalIDGateway}, route.CreateResource.Dependencies)
+	httpRoute, ok := route.CreateResource.Data.(*gatewayv1beta1.HTTPRoute)
+	require.True(t, ok)
+	require.Equal(t, trafficPolicyRouteName, httpRoute.Name)
+	require.Equal(t, []gatewayv1beta1.HTTPRouteRule{
+		{
+			Matches: []gatewayv1beta1.HTTPRouteMatch{
+				{
+					Path: &gatewayv1beta1.HTTPPathMatch{
+						Type:  to.Ptr(gatewayv1beta1.PathMatchPathPrefix),
+						Value: to.Ptr("/api"),
+					},
+					Headers: []gatewayv1beta1.HTTPHeaderMatch{
+						{Type: to.Ptr(gatewayv1beta1.HeaderMatchExact), Name: "x-canary", Value: "true"},
+						{Type: to.Ptr(gatewayv1beta1.HeaderMatchExact), Name: "x-version", Value: "v2"},
+					},
+					QueryParams: []gatewayv1beta1.HTTPQueryParamMatch{
+						{Type: to.Ptr(gatewayv1beta1.QueryParamMatchExact), Name: "debug", Value: "true"},
+					},
+					Method: to.Ptr(gatewayv1beta1.HTTPMethodGet),
+				},
+			},
+			Filters: []gatewayv1beta1.HTTPRouteFilter{
+				{
+					Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+						Path: &gatewayv1beta1.HTTPPathModifier{
+							Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: to.Ptr("/"),
+						},
+					},
+				},
+				{
+					Type: gatewayv1beta1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayv1beta1.HTTPHeaderFilter{
+						Set:    []gatewayv1beta1.HTTPHeader{{Name: "x-forwarded-by", Value: "radius"}},
+						Remove: []string{"x-internal"},
+					},
+				},
+				{
+					Type: gatewayv1beta1.HTTPRouteFilterResponseHeaderModifier,
+					ResponseHeaderModifier: &gatewayv1beta1.HTTPHeaderFilter{
+						Remove: []string{"server"},
+					},
+				},
+			},
+			BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+				{
+					BackendRef: gatewayv1beta1.BackendRef{
+						BackendObjectReference: gatewayv1beta1.BackendObjectReference{Name: "stable", Port: to.Ptr(gatewayv1beta1.PortNumber(80))},
+						Weight:                 to.Ptr(int32(90)),
+					},
+				},
+				{
+					BackendRef: gatewayv1beta1.BackendRef{
+						BackendObjectReference: gatewayv1beta1.BackendObjectReference{Name: "canary", Port: to.Ptr(gatewayv1beta1.PortNumber(8080))},
+						Weight:                 to.Ptr(int32(10)),
+					},
+				},
+			},
+		},
+	}, httpRoute.Spec.Rules)
+
+	// The route without a traffic policy is still rendered per destination.
+	findGatewayAPIRoute(t, output.Resources, "frontend")
+}
+
+func Test_Render_GatewayAPI_Fails_TimeoutAndRetryPolicy(t *testing.T) {
+	r := &Renderer{}
+
+	for _, route := range []datamodel.GatewayRoute{
+		{Destination: makeRouteResourceID("frontend"), Timeout: "30s"},
+		{Destination: makeRouteResourceID("frontend"), RetryPolicy: &datamodel.GatewayRouteRetryPolicy{Attempts: 3}},
+	} {
+		properties := datamodel.GatewayProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+			},
+			Routes: []datamodel.GatewayRoute{route},
+		}
+		resource := makeResource(t, properties)
+
+		_, err := r.Render(testcontext.New(t), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: getGatewayAPIEnvironmentOptions("", testExternalIP)})
+		require.Equal(t, v1.NewClientErrInvalidRequest("`timeout` and `retryPolicy` of gateway routes are not supported by the Gateway API"), err)
+	}
+}
+
+func Test_GetGatewayAPIHostname(t *testing.T) {
+	require.Equal(t, "www.contoso.com", getGatewayAPIHostname("www.contoso.com", true))
+	require.Equal(t, "", getGatewayAPIHostname("10.0.0.1", true))
+	require.Equal(t, "", getGatewayAPIHostname("test-application", false))
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -51,17 +52,19 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 
 	// Get all httpRoutes that are used by this gateway
 	for _, route := range gtwyProperties.Routes {
-		// Skip if destination is a URL. DNS-SD will resolve the route.
-		if isURL(route.Destination) {
-			continue
-		}
+		for _, destination := range route.GetDestinations() {
+			// Skip if destination is a URL. DNS-SD will resolve the route.
+			if isURL(destination.Destination) {
+				continue
+			}
 
-		resourceID, err := resources.ParseResource(route.Destination)
-		if err != nil {
-			return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
-		}
+			resourceID, err := resources.ParseResource(destination.Destination)
+			if err != nil {
+				return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+			}
 
-		radiusResourceIDs = append(radiusResourceIDs, resourceID)
+			radiusResourceIDs = append(radiusResourceIDs, resourceID)
+		}
 	}
 
 	// Get secretStore resource ID from certificateFrom property
@@ -78,7 +81,8 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 }
 
 // Render creates a gateway object and http route objects based on the given parameters, and returns them along
-// with a computed value for the gateway's public endpoint.
+// with a computed value for the gateway's public endpoint. Contour HTTPProxy objects are rendered unless the environment
+// has the GatewayAPI extension, in which case Gateway API objects are rendered.
 func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
 	outputResources := []rpv1.OutputResource{}
 	gateway, ok := dm.(*datamodel.Gateway)
@@ -94,8 +98,10 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	hostname, err := getHostname(*gateway, &gateway.Properties, applicationName, options.Environment.Gateway)
 
 	var publicEndpoint string
+	hasPublicEndpoint := true
 	if errors.Is(err, &ErrNoPublicEndpoint{}) {
 		publicEndpoint = "unknown"
+		hasPublicEndpoint = false
 	} else if err != nil {
 		return renderers.RendererOutput{}, fmt.Errorf("getting hostname failed with error: %s", err)
 	} else {
@@ -103,6 +109,32 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
 	}
 
+	computedValues := map[string]rpv1.ComputedValueReference{
+		"url": {
+			Value: publicEndpoint,
+		},
+	}
+
+	if options.Environment.GatewayAPI != nil {
+		gatewayAPIHostname := getGatewayAPIHostname(hostname, hasPublicEndpoint)
+		gatewayObject, err := MakeGatewayAPIGateway(ctx, options, gateway, gateway.Name, applicationName, gatewayAPIHostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+		outputResources = append(outputResources, gatewayObject)
+
+		routeObjects, err := MakeGatewayAPIRoutes(ctx, options, *gateway, &gateway.Properties, gatewayName, applicationName, gatewayAPIHostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+		outputResources = append(outputResources, routeObjects...)
+
+		return renderers.RendererOutput{
+			Resources:      outputResources,
+			ComputedValues: computedValues,
+		}, nil
+	}
+
 	gatewayObject, err := MakeRootHTTPProxy(ctx, options, gateway, gateway.Name, applicationName, hostname)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -110,12 +142,6 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 	outputResources = append(outputResources, gatewayObject)
 
-	computedValues := map[string]rpv1.ComputedValueReference{
-		"url": {
-			Value: publicEndpoint,
-		},
-	}
-
 	httpRouteObjects, err := MakeRoutesHTTPProxies(ctx, options, *gateway, &gateway.Properties, gatewayName, gatewayObject, applicationName)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -132,7 +158,6 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 // to act as the Gateway.
 func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
 	includes := []contourv1.Include{}
-	dependencies := options.Dependencies
 
 	if len(gateway.Properties.Routes) < 1 {
 		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
@@ -146,49 +171,9 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
 
 		if gateway.Properties.TLS.CertificateFrom != "" {
-			secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
-			secretStoreResource, ok := dependencies[secretStoreResourceId]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			referencedResource := dependencies[secretStoreResourceId].Resource
-			if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
-			secretStore, ok := referencedResource.(*datamodel.SecretStore)
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
-			}
-
-			if secretStore.Properties.Type != datamodel.SecretTypeCert {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
-			}
-
-			if secretStore.Properties.Data["tls.crt"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
-			}
-
-			if secretStore.Properties.Data["tls.key"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
-			}
-
-			// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
-			if secretStoreResource.OutputResources == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
-			}
-
-			secretName := secretResourceID.Name()
-			secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
-			if secretNamespace == "" {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+			secretNamespace, secretName, err := getCertificateSecret(options, gateway)
+			if err != nil {
+				return rpv1.OutputResource{}, err
 			}
 
 			contourTLSConfig = &contourv1.TLS{
@@ -204,16 +189,23 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 	}
 
 	var route datamodel.GatewayRoute //route will hold the one sslPassthrough route, if sslPassthrough is true
-	for _, route = range gateway.Properties.Routes {
+	for i := range gateway.Properties.Routes {
+		route = gateway.Properties.Routes[i]
 		if sslPassthrough && (route.Path != "" || route.ReplacePrefix != "") {
 			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
 		}
-		routeName, err := getRouteName(&route)
-		if err != nil {
-			return rpv1.OutputResource{}, err
-		}
 
-		routeResourceName := kubernetes.NormalizeResourceName(routeName)
+		var routeResourceName string
+		if route.HasTrafficPolicy() {
+			routeResourceName = getTrafficPolicyRouteName(resourceName, i)
+		} else {
+			routeName, err := getRouteName(&route)
+			if err != nil {
+				return rpv1.OutputResource{}, err
+			}
+
+			routeResourceName = kubernetes.NormalizeResourceName(routeName)
+		}
 		prefix := route.Path
 		if sslPassthrough {
 			prefix = "/"
@@ -295,26 +287,42 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 // MakeRoutesHTTPProxies creates HTTPProxy objects for each route in the gateway and returns them as OutputResources. It returns
 // an error if it fails to get the route name.
 func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
-	dependencies := options.Dependencies
 	objects := make(map[string]*contourv1.HTTPProxy)
 
-	for _, route := range gateway.Routes {
-		port := renderers.DefaultPort
-
-		if isURL(route.Destination) {
-			_, _, urlPort, err := parseURL(route.Destination)
+	for i, route := range gateway.Routes {
+		// Routes with a traffic policy are rendered to their own HTTPProxy, since their conditions and policies
+		// only apply to that route.
+		if route.HasTrafficPolicy() {
+			routeResourceName := getTrafficPolicyRouteName(gatewayName, i)
+			localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeResourceName)
+			contourRoute, err := makeTrafficPolicyContourRoute(options, &route)
 			if err != nil {
 				return []rpv1.OutputResource{}, err
 			}
-			port = urlPort
 
-		} else {
-			routeProperties := dependencies[route.Destination]
-			routePort, ok := routeProperties.ComputedValues["port"].(float64)
-			if ok {
-				port = int32(routePort)
+			objects[localID] = &contourv1.HTTPProxy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "HTTPProxy",
+					APIVersion: contourv1.SchemeGroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        routeResourceName,
+					Namespace:   options.Environment.Namespace,
+					Labels:      renderers.GetLabels(options, applicationName, routeResourceName, resource.ResourceTypeName()),
+					Annotations: renderers.GetAnnotations(options),
+				},
+				Spec: contourv1.HTTPProxySpec{
+					Routes: []contourv1.Route{contourRoute},
+				},
 			}
 
+			gatewayOutPutResource.CreateResource.Dependencies = append(gatewayOutPutResource.CreateResource.Dependencies, localID)
+			continue
+		}
+
+		port, err := getDestinationPort(options, route.Destination)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
 		}
 
 		routeName, err := getRouteName(&route)
@@ -400,10 +408,119 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// makeTrafficPolicyContourRoute creates the Contour route for a gateway route with a traffic policy. The path prefix is
+// matched by the include of the root HTTPProxy, so the route only holds the additional conditions.
+func makeTrafficPolicyContourRoute(options renderers.RenderOptions, route *datamodel.GatewayRoute) (contourv1.Route, error) {
+	contourRoute := contourv1.Route{}
+
+	for _, destination := range route.GetDestinations() {
+		name, err := getDestinationName(destination.Destination)
+		if err != nil {
+			return contourv1.Route{}, err
+		}
+
+		port, err := getDestinationPort(options, destination.Destination)
+		if err != nil {
+			return contourv1.Route{}, err
+		}
+
+		contourRoute.Services = append(contourRoute.Services, contourv1.Service{
+			Name:   kubernetes.NormalizeResourceName(name),
+			Port:   int(port),
+			Weight: int64(destination.Weight),
+		})
+	}
+
+	if route.Match != nil {
+		// Contour does not have a method condition, so the method is matched on the :method pseudo-header.
+		if route.Match.Method != "" {
+			contourRoute.Conditions = append(contourRoute.Conditions, contourv1.MatchCondition{
+				Header: &contourv1.HeaderMatchCondition{Name: ":method", Exact: route.Match.Method},
+			})
+		}
+
+		for _, name := range sortedKeys(route.Match.Headers) {
+			contourRoute.Conditions = append(contourRoute.Conditions, contourv1.MatchCondition{
+				Header: &contourv1.HeaderMatchCondition{Name: name, Exact: route.Match.Headers[name]},
+			})
+		}
+
+		for _, name := range sortedKeys(route.Match.QueryParameters) {
+			contourRoute.Conditions = append(contourRoute.Conditions, contourv1.MatchCondition{
+				QueryParameter: &contourv1.QueryParameterMatchCondition{Name: name, Exact: route.Match.QueryParameters[name]},
+			})
+		}
+	}
+
+	if route.ReplacePrefix != "" {
+		contourRoute.PathRewritePolicy = &contourv1.PathRewritePolicy{
+			ReplacePrefix: []contourv1.ReplacePrefix{
+				{
+					Prefix:      route.Path,
+					Replacement: route.ReplacePrefix,
+				},
+			},
+		}
+	}
+
+	contourRoute.RequestHeadersPolicy = makeContourHeadersPolicy(route.RequestHeaders)
+	contourRoute.ResponseHeadersPolicy = makeContourHeadersPolicy(route.ResponseHeaders)
+
+	if route.Timeout != "" {
+		contourRoute.TimeoutPolicy = &contourv1.TimeoutPolicy{
+			Response: route.Timeout,
+		}
+	}
+
+	if route.RetryPolicy != nil {
+		contourRoute.RetryPolicy = &contourv1.RetryPolicy{
+			NumRetries:    int64(route.RetryPolicy.Attempts),
+			PerTryTimeout: route.RetryPolicy.PerTryTimeout,
+		}
+	}
+
+	return contourRoute, nil
+}
+
+func makeContourHeadersPolicy(modifier *datamodel.GatewayRouteHeaderModifier) *contourv1.HeadersPolicy {
+	if modifier == nil {
+		return nil
+	}
+
+	policy := &contourv1.HeadersPolicy{
+		Remove: modifier.Remove,
+	}
+	for _, name := range sortedKeys(modifier.Set) {
+		policy.Set = append(policy.Set, contourv1.HeaderValue{Name: name, Value: modifier.Set[name]})
+	}
+
+	return policy
+}
+
+// getTrafficPolicyRouteName returns the name of the route object rendered for the gateway route at the given index when
+// it has a traffic policy.
+func getTrafficPolicyRouteName(gatewayName string, index int) string {
+	return kubernetes.NormalizeResourceName(fmt.Sprintf("%s-route-%d", gatewayName, index))
+}
+
+// sortedKeys returns the keys of the map in a deterministic order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
+	return getDestinationName(route.Destination)
+}
+
+func getDestinationName(destination string) (string, error) {
 	// if isURL, then name is hostname (DNS-SD case)
-	if isURL(route.Destination) {
-		u, err := url.Parse(route.Destination)
+	if isURL(destination) {
+		u, err := url.Parse(destination)
 		if err != nil {
 			return "", v1.NewClientErrInvalidRequest(err.Error())
 		}
@@ -412,7 +529,7 @@ func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	}
 
 	// if not URL, then name is the resourceID (HTTProute case)
-	resourceID, err := resources.ParseResource(route.Destination)
+	resourceID, err := resources.ParseResource(destination)
 	if err != nil {
 		return "", v1.NewClientErrInvalidRequest(err.Error())
 	}
@@ -420,6 +537,78 @@ func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	return resourceID.Name(), nil
 }
 
+// getDestinationPort returns the port of the route destination, which is either the port of the URL or the port of the
+// httpRoute resource.
+func getDestinationPort(options renderers.RenderOptions, destination string) (int32, error) {
+	if isURL(destination) {
+		_, _, port, err := parseURL(destination)
+		if err != nil {
+			return 0, err
+		}
+		return port, nil
+	}
+
+	port := renderers.DefaultPort
+	routeProperties := options.Dependencies[destination]
+	if routePort, ok := routeProperties.ComputedValues["port"].(float64); ok {
+		port = int32(routePort)
+	}
+
+	return port, nil
+}
+
+// getCertificateSecret validates the secretStore referenced by the certificateFrom property of the gateway and returns
+// the namespace and name of the Kubernetes secret holding the certificate.
+func getCertificateSecret(options renderers.RenderOptions, gateway *datamodel.Gateway) (string, string, error) {
+	dependencies := options.Dependencies
+	secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
+	secretStoreResource, ok := dependencies[secretStoreResourceId]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	referencedResource := dependencies[secretStoreResourceId].Resource
+	if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
+
+	// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
+	secretStore, ok := referencedResource.(*datamodel.SecretStore)
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource")
+	}
+
+	if secretStore.Properties.Type != datamodel.SecretTypeCert {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with type certificate")
+	}
+
+	if secretStore.Properties.Data["tls.crt"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.crt")
+	}
+
+	if secretStore.Properties.Data["tls.key"] == nil {
+		return "", "", v1.NewClientErrInvalidRequest("certificateFrom must reference a secretStore resource with tls.key")
+	}
+
+	// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
+	if secretStoreResource.OutputResources == nil {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	secretName := secretResourceID.Name()
+	secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if secretNamespace == "" {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("secretStore resource %s not found", secretStoreResourceId))
+	}
+
+	return secretNamespace, secretName, nil
+}
+
 // getHostname returns the hostname of the public endpoint of the Gateway.
 // This sometimes involves transforming the external IP of the cluster into
 // a hostname that's unique to this Gateway and Application.
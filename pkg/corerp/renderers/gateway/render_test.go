@@ -878,6 +878,133 @@ func Test_Render_Multiple_Routes(t *testing.T) {
 	validateHttpRoute(t, output.Resources, routeBName, 80, nil, "")
 }
 
+func Test_GetDependencyIDs_WeightedDestinations(t *testing.T) {
+	stableResourceID := makeRouteResourceID("stable")
+	canaryResourceID := makeRouteResourceID("canary")
+	properties := datamodel.GatewayProperties{
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destinations: []datamodel.GatewayRouteDestination{
+					{Destination: stableResourceID, Weight: 90},
+					{Destination: canaryResourceID, Weight: 10},
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+
+	renderer := Renderer{}
+	radiusResourceIDs, _, err := renderer.GetDependencyIDs(testcontext.New(t), resource)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []resources.ID{makeResourceID(t, stableResourceID), makeResourceID(t, canaryResourceID)}, radiusResourceIDs)
+}
+
+func Test_Render_Route_WithTrafficPolicy(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: makeRouteResourceID("frontend"),
+			},
+			{
+				Path:          "/api",
+				ReplacePrefix: "/",
+				Destinations: []datamodel.GatewayRouteDestination{
+					{Destination: makeRouteResourceID("stable"), Weight: 90},
+					{Destination: makeRouteResourceID("canary"), Weight: 10},
+				},
+				Match: &datamodel.GatewayRouteMatch{
+					Method:          "GET",
+					Headers:         map[string]string{"x-version": "v2", "x-canary": "true"},
+					QueryParameters: map[string]string{"debug": "true"},
+				},
+				RequestHeaders: &datamodel.GatewayRouteHeaderModifier{
+					Set:    map[string]string{"x-forwarded-by": "radius"},
+					Remove: []string{"x-internal"},
+				},
+				ResponseHeaders: &datamodel.GatewayRouteHeaderModifier{
+					Remove: []string{"server"},
+				},
+				Timeout: "30s",
+				RetryPolicy: &datamodel.GatewayRouteRetryPolicy{
+					Attempts:      3,
+					PerTryTimeout: "5s",
+				},
+			},
+		},
+	}
+	resource := makeResource(t, properties)
+	dependencies := map[string]renderers.RendererDependency{
+		makeRouteResourceID("canary"): {
+			ComputedValues: map[string]any{"port": float64(8080)},
+		},
+	}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	trafficPolicyRouteName := resourceName + "-route-1"
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+		},
+		Includes: []contourv1.Include{
+			{
+				Name:       "frontend",
+				Conditions: []contourv1.MatchCondition{{Prefix: ""}},
+			},
+			{
+				Name:       trafficPolicyRouteName,
+				Conditions: []contourv1.MatchCondition{{Prefix: "/api"}},
+			},
+		},
+	}
+	validateHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+	validateHttpRoute(t, output.Resources, "frontend", 80, nil, "")
+
+	expectedLocalID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, trafficPolicyRouteName)
+	httpRoute, _ := kubernetes.FindContourHTTPProxyByLocalID(output.Resources, expectedLocalID)
+	require.Equal(t, trafficPolicyRouteName, httpRoute.Name)
+	require.Equal(t, kubernetes.MakeDescriptiveLabels(applicationName, trafficPolicyRouteName, ResourceType), httpRoute.Labels)
+
+	expectedHttpRouteSpec := contourv1.HTTPProxySpec{
+		Routes: []contourv1.Route{
+			{
+				Conditions: []contourv1.MatchCondition{
+					{Header: &contourv1.HeaderMatchCondition{Name: ":method", Exact: "GET"}},
+					{Header: &contourv1.HeaderMatchCondition{Name: "x-canary", Exact: "true"}},
+					{Header: &contourv1.HeaderMatchCondition{Name: "x-version", Exact: "v2"}},
+					{QueryParameter: &contourv1.QueryParameterMatchCondition{Name: "debug", Exact: "true"}},
+				},
+				Services: []contourv1.Service{
+					{Name: "stable", Port: 80, Weight: 90},
+					{Name: "canary", Port: 8080, Weight: 10},
+				},
+				PathRewritePolicy: &contourv1.PathRewritePolicy{
+					ReplacePrefix: []contourv1.ReplacePrefix{{Prefix: "/api", Replacement: "/"}},
+				},
+				RequestHeadersPolicy: &contourv1.HeadersPolicy{
+					Set:    []contourv1.HeaderValue{{Name: "x-forwarded-by", Value: "radius"}},
+					Remove: []string{"x-internal"},
+				},
+				ResponseHeadersPolicy: &contourv1.HeadersPolicy{
+					Remove: []string{"server"},
+				},
+				TimeoutPolicy: &contourv1.TimeoutPolicy{Response: "30s"},
+				RetryPolicy:   &contourv1.RetryPolicy{NumRetries: 3, PerTryTimeout: "5s"},
+			},
+		},
+	}
+	require.Equal(t, expectedHttpRouteSpec, httpRoute.Spec)
+}
+
 func Test_Render_Route_WithPrefixRewrite(t *testing.T) {
 	r := &Renderer{}
 
@@ -72,6 +72,8 @@ type EnvironmentOptions struct {
 	Identity *rpv1.IdentitySettings
 	// KubernetesMetadata represents the Environment KubernetesMetadata extension.
 	KubernetesMetadata *datamodel.KubeMetadataExtension
+	// GatewayAPI represents the Environment GatewayAPI extension. Gateways are rendered as Contour HTTPProxy objects when it is not set.
+	GatewayAPI *datamodel.GatewayAPIExtension
 	// Simulated represents whether the environment is a simulated environment.
 	Simulated bool
 }
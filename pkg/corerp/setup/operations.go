@@ -69,6 +69,36 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/environments/exportrecipestate/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "environments",
+			Operation:   "Export recipe state",
+			Description: "Export the Terraform state of a recipe.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/environments/unlockrecipestate/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "environments",
+			Operation:   "Unlock recipe state",
+			Description: "Release the lock on the Terraform state of a recipe.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/environments/upgraderecipes/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "environments",
+			Operation:   "Upgrade recipes",
+			Description: "Re-deploy the recipes of the resources deployed with an older recipe version.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Core/environments/join/action",
 		Display: &v1.OperationDisplayProperties{
@@ -259,4 +289,34 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/extenders/detectdrift/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "extenders",
+			Operation:   "Detect drift",
+			Description: "Detects the drift of the infrastructure deployed by the extender recipe.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/extenders/reconciledrift/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "extenders",
+			Operation:   "Reconcile drift",
+			Description: "Detects the drift of the infrastructure deployed by the extender recipe and re-applies the recipe.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/extenders/plan/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "extenders",
+			Operation:   "Plan",
+			Description: "Previews the changes the extender recipe would make to the infrastructure.",
+		},
+		IsDataAction: false,
+	},
 }
@@ -34,10 +34,15 @@ import (
 	gw_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/gateways"
 	secret_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/secretstores"
 	vol_ctrl "github.com/radius-project/radius/pkg/corerp/frontend/controller/volumes"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 
 	ext_processor "github.com/radius-project/radius/pkg/corerp/processors/extenders"
 	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
+	pr_conv "github.com/radius-project/radius/pkg/portableresources/datamodel/converter"
+	pr_frontend_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 )
 
 const (
@@ -65,6 +70,22 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return env_ctrl.NewGetRecipeMetadata(opt, recipeControllerConfig.Engine)
 				},
 			},
+			"exportrecipestate": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewExportRecipeState(opt, recipeControllerConfig.Engine)
+				},
+			},
+			"unlockrecipestate": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewUnlockRecipeState(opt, recipeControllerConfig.Engine)
+				},
+			},
+			"upgraderecipes": {
+				APIController: env_ctrl.NewUpgradeRecipes,
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewUpgradeRecipes(options, recipeUpgradeResourceTypes, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader, recipeControllerConfig.Engine)
+				},
+			},
 		},
 	})
 
@@ -247,6 +268,29 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 			"listsecrets": {
 				APIController: ext_ctrl.NewListSecretsExtender,
 			},
+			"detectdrift": {
+				APIController: newDetectDriftExtender,
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewDetectDriftResource[*datamodel.Extender, datamodel.Extender](options, recipeControllerConfig.Engine)
+				},
+			},
+			"reconciledrift": {
+				APIController: newDetectDriftExtender,
+				AsyncJobController: func(options asyncctrl.Options) (asyncctrl.Controller, error) {
+					return pr_ctrl.NewReconcileDriftResource[*datamodel.Extender, datamodel.Extender](options, &ext_processor.Processor{}, recipeControllerConfig.Engine, recipeControllerConfig.ResourceClient, recipeControllerConfig.ConfigLoader)
+				},
+			},
+			"plan": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return pr_frontend_ctrl.NewPlanResource[*datamodel.Extender](opt, apictrl.ResourceOptions[datamodel.Extender]{
+						RequestConverter:  converter.ExtenderDataModelFromVersioned,
+						ResponseConverter: converter.ExtenderDataModelToVersioned,
+						UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
+							rp_frontend.PrepareRadiusResource[*datamodel.Extender],
+						},
+					}, recipeControllerConfig.Engine, pr_conv.RecipePlanDataModelToVersioned)
+				},
+			},
 		},
 	})
 
@@ -255,3 +299,25 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 
 	return ns
 }
+
+// newDetectDriftExtender creates the controller queueing the async operations detecting and reconciling the drift of an extender.
+func newDetectDriftExtender(opt apictrl.Options) (apictrl.Controller, error) {
+	return pr_frontend_ctrl.NewDetectDrift[*datamodel.Extender](opt, apictrl.ResourceOptions[datamodel.Extender]{
+		ResponseConverter:        converter.ExtenderDataModelToVersioned,
+		AsyncOperationTimeout:    pr_frontend_ctrl.AsyncDetectDriftTimeout,
+		AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+	})
+}
+
+// recipeUpgradeResourceTypes are the resource types of the portable resources and extenders whose recipes are upgraded
+// by the upgradeRecipes operation of an environment.
+var recipeUpgradeResourceTypes = []string{
+	msg_ctrl.RabbitMQQueuesResourceType,
+	dapr_ctrl.DaprStateStoresResourceType,
+	dapr_ctrl.DaprSecretStoresResourceType,
+	dapr_ctrl.DaprPubSubBrokersResourceType,
+	ds_ctrl.MongoDatabasesResourceType,
+	ds_ctrl.RedisCachesResourceType,
+	ds_ctrl.SqlDatabasesResourceType,
+	ext_ctrl.ResourceTypeName,
+}
@@ -119,6 +119,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONGETMETADATA"},
 		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/getmetadata",
 		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONEXPORTRECIPESTATE"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/exportrecipestate",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONUNLOCKRECIPESTATE"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/unlockrecipestate",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: env_ctrl.ResourceTypeName, Method: "ACTIONUPGRADERECIPES"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/environments/env0/upgraderecipes",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: gtwy_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/gateways",
@@ -130,6 +130,8 @@ func (dst *DaprPubSubBrokerResource) ConvertFrom(src v1.DataModelInterface) erro
 		ProvisioningState:    fromProvisioningStateDataModel(daprPubSub.InternalMetadata.AsyncProvisioningState),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprPubSub.Properties.Status.OutputResources),
+			RecipeDrift:     toRecipeDrift(daprPubSub.Properties.Status.RecipeDrift),
+			Recipe:          toRecipeStatus(daprPubSub.Properties.Status.Recipe),
 		},
 	}
 
@@ -124,6 +124,8 @@ func (dst *DaprSecretStoreResource) ConvertFrom(src v1.DataModelInterface) error
 		ComponentName:        to.Ptr(daprSecretStore.Properties.ComponentName),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprSecretStore.Properties.Status.OutputResources),
+			RecipeDrift:     toRecipeDrift(daprSecretStore.Properties.Status.RecipeDrift),
+			Recipe:          toRecipeStatus(daprSecretStore.Properties.Status.Recipe),
 		},
 	}
 	if daprSecretStore.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
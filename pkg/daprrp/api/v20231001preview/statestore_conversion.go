@@ -107,6 +107,8 @@ func (dst *DaprStateStoreResource) ConvertFrom(src v1.DataModelInterface) error
 	dst.Properties = &DaprStateStoreProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(daprStateStore.Properties.Status.OutputResources),
+			RecipeDrift:     toRecipeDrift(daprStateStore.Properties.Status.RecipeDrift),
+			Recipe:          toRecipeStatus(daprStateStore.Properties.Status.Recipe),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(daprStateStore.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(daprStateStore.Properties.Environment),
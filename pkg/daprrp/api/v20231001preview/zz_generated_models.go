@@ -321,6 +321,15 @@ type DaprStateStoreResourceUpdateProperties struct {
 	Version *string
 }
 
+// DriftedResource - A resource which has drifted from the recipe.
+type DriftedResource struct {
+	// REQUIRED; The action required to reconcile the resource with the recipe.
+	Action *DriftAction
+
+	// REQUIRED; The UCP resource ID of the drifted resource, or the recipe address of the resource when it has no resource ID.
+	ID *string
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -469,6 +478,15 @@ type OutputResource struct {
 	RadiusManaged *bool
 }
 
+// PlannedResource - A resource which would be changed by the deployment of a recipe.
+type PlannedResource struct {
+	// REQUIRED; The change the deployment of the recipe would make to the resource.
+	Action *PlanAction
+
+	// REQUIRED; The UCP resource ID of the resource, or the recipe address of the resource when it has no resource ID yet.
+	ID *string
+}
+
 // Recipe - The recipe used to automatically deploy underlying infrastructure for a portable resource
 type Recipe struct {
 	// REQUIRED; The name of the recipe within the environment to use
@@ -478,6 +496,46 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeDriftStatus - The drift status of the infrastructure deployed by a recipe.
+type RecipeDriftStatus struct {
+	// REQUIRED; The time when the drift was last checked.
+	LastCheckedTime *time.Time
+
+	// REQUIRED; The drift state of the infrastructure deployed by the recipe.
+	State *RecipeDriftState
+
+	// The resources which have drifted from the recipe.
+	DriftedResources []*DriftedResource
+}
+
+// RecipePlan - The preview of the changes a deployment of a recipe would make to the infrastructure.
+type RecipePlan struct {
+	// REQUIRED; The resources which would be created, updated or deleted by the deployment of the recipe.
+	Resources []*PlannedResource
+}
+
+// RecipeStatus - The recipe which deployed the infrastructure of a resource.
+type RecipeStatus struct {
+	// The parameters the recipe was deployed with, after merging the parameters of the environment and of the resource.
+	Parameters map[string]any
+
+	// The version of the template resolved during the deployment: the tag of the template path for Bicep recipes, and the
+	// module version selected by Terraform for Terraform recipes.
+	ResolvedVersion *string
+
+	// The digest of the content of the deployed template.
+	TemplateDigest *string
+
+	// REQUIRED; The format of the template provided by the recipe.
+	TemplateKind *string
+
+	// REQUIRED; The path to the template provided by the recipe.
+	TemplatePath *string
+
+	// The version of the template requested by the recipe.
+	TemplateVersion *string
+}
+
 // RecipeUpdate - The recipe used to automatically deploy underlying infrastructure for a portable resource
 type RecipeUpdate struct {
 	// The name of the recipe within the environment to use
@@ -515,6 +573,12 @@ type ResourceStatus struct {
 
 	// Properties of an output resource
 	OutputResources []*OutputResource
+
+	// The recipe which deployed the infrastructure of the resource.
+	Recipe *RecipeStatus
+
+	// The drift status of the infrastructure deployed by the recipe of the resource.
+	RecipeDrift *RecipeDriftStatus
 }
 
 // SystemData - Metadata pertaining to creation and last modification of the resource.
@@ -25,6 +25,18 @@ type PubSubBrokersClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// PubSubBrokersClientBeginDetectDriftOptions contains the optional parameters for the PubSubBrokersClient.BeginDetectDrift method.
+type PubSubBrokersClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// PubSubBrokersClientBeginReconcileDriftOptions contains the optional parameters for the PubSubBrokersClient.BeginReconcileDrift method.
+type PubSubBrokersClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // PubSubBrokersClientBeginUpdateOptions contains the optional parameters for the PubSubBrokersClient.BeginUpdate method.
 type PubSubBrokersClientBeginUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -42,6 +54,11 @@ type PubSubBrokersClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// PubSubBrokersClientPlanOptions contains the optional parameters for the PubSubBrokersClient.Plan method.
+type PubSubBrokersClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // SecretStoresClientBeginCreateOrUpdateOptions contains the optional parameters for the SecretStoresClient.BeginCreateOrUpdate
 // method.
 type SecretStoresClientBeginCreateOrUpdateOptions struct {
@@ -55,6 +72,18 @@ type SecretStoresClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// SecretStoresClientBeginDetectDriftOptions contains the optional parameters for the SecretStoresClient.BeginDetectDrift method.
+type SecretStoresClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// SecretStoresClientBeginReconcileDriftOptions contains the optional parameters for the SecretStoresClient.BeginReconcileDrift method.
+type SecretStoresClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // SecretStoresClientBeginUpdateOptions contains the optional parameters for the SecretStoresClient.BeginUpdate method.
 type SecretStoresClientBeginUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -71,6 +100,11 @@ type SecretStoresClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// SecretStoresClientPlanOptions contains the optional parameters for the SecretStoresClient.Plan method.
+type SecretStoresClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // StateStoresClientBeginCreateOrUpdateOptions contains the optional parameters for the StateStoresClient.BeginCreateOrUpdate
 // method.
 type StateStoresClientBeginCreateOrUpdateOptions struct {
@@ -84,6 +118,18 @@ type StateStoresClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// StateStoresClientBeginDetectDriftOptions contains the optional parameters for the StateStoresClient.BeginDetectDrift method.
+type StateStoresClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// StateStoresClientBeginReconcileDriftOptions contains the optional parameters for the StateStoresClient.BeginReconcileDrift method.
+type StateStoresClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // StateStoresClientBeginUpdateOptions contains the optional parameters for the StateStoresClient.BeginUpdate method.
 type StateStoresClientBeginUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -100,3 +146,8 @@ type StateStoresClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// StateStoresClientPlanOptions contains the optional parameters for the StateStoresClient.Plan method.
+type StateStoresClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
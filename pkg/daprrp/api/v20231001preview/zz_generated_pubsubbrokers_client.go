@@ -172,6 +172,73 @@ func (client *PubSubBrokersClient) deleteCreateRequest(ctx context.Context, pubS
 	return req, nil
 }
 
+// BeginDetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprPubSubBroker
+// resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - pubSubBrokerName - PubSubBroker name
+//   - body - The content of the action request
+//   - options - PubSubBrokersClientBeginDetectDriftOptions contains the optional parameters for the PubSubBrokersClient.BeginDetectDrift
+//     method.
+func (client *PubSubBrokersClient) BeginDetectDrift(ctx context.Context, pubSubBrokerName string, body map[string]any, options *PubSubBrokersClientBeginDetectDriftOptions) (*runtime.Poller[PubSubBrokersClientDetectDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.detectDrift(ctx, pubSubBrokerName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[PubSubBrokersClientDetectDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[PubSubBrokersClientDetectDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// DetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprPubSubBroker resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *PubSubBrokersClient) detectDrift(ctx context.Context, pubSubBrokerName string, body map[string]any, options *PubSubBrokersClientBeginDetectDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.detectDriftCreateRequest(ctx, pubSubBrokerName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// detectDriftCreateRequest creates the DetectDrift request.
+func (client *PubSubBrokersClient) detectDriftCreateRequest(ctx context.Context, pubSubBrokerName string, body map[string]any, options *PubSubBrokersClientBeginDetectDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/pubSubBrokers/{pubSubBrokerName}/detectDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if pubSubBrokerName == "" {
+		return nil, errors.New("parameter pubSubBrokerName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{pubSubBrokerName}", url.PathEscape(pubSubBrokerName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // Get - Get a DaprPubSubBrokerResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -281,6 +348,131 @@ func (client *PubSubBrokersClient) listByScopeHandleResponse(resp *http.Response
 	return result, nil
 }
 
+// Plan - Previews the changes a deployment of the recipe of the specified DaprPubSubBroker resource would make to the
+// infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - pubSubBrokerName - PubSubBroker name
+//   - body - The content of the action request
+//   - options - PubSubBrokersClientPlanOptions contains the optional parameters for the PubSubBrokersClient.Plan method.
+func (client *PubSubBrokersClient) Plan(ctx context.Context, pubSubBrokerName string, body DaprPubSubBrokerResource, options *PubSubBrokersClientPlanOptions) (PubSubBrokersClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, pubSubBrokerName, body, options)
+	if err != nil {
+		return PubSubBrokersClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return PubSubBrokersClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return PubSubBrokersClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *PubSubBrokersClient) planCreateRequest(ctx context.Context, pubSubBrokerName string, body DaprPubSubBrokerResource, options *PubSubBrokersClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/pubSubBrokers/{pubSubBrokerName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if pubSubBrokerName == "" {
+		return nil, errors.New("parameter pubSubBrokerName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{pubSubBrokerName}", url.PathEscape(pubSubBrokerName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *PubSubBrokersClient) planHandleResponse(resp *http.Response) (PubSubBrokersClientPlanResponse, error) {
+	result := PubSubBrokersClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlan); err != nil {
+		return PubSubBrokersClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprPubSubBroker
+// resource and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - pubSubBrokerName - PubSubBroker name
+//   - body - The content of the action request
+//   - options - PubSubBrokersClientBeginReconcileDriftOptions contains the optional parameters for the PubSubBrokersClient.BeginReconcileDrift
+//     method.
+func (client *PubSubBrokersClient) BeginReconcileDrift(ctx context.Context, pubSubBrokerName string, body map[string]any, options *PubSubBrokersClientBeginReconcileDriftOptions) (*runtime.Poller[PubSubBrokersClientReconcileDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.reconcileDrift(ctx, pubSubBrokerName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[PubSubBrokersClientReconcileDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[PubSubBrokersClientReconcileDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// ReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprPubSubBroker
+// resource and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *PubSubBrokersClient) reconcileDrift(ctx context.Context, pubSubBrokerName string, body map[string]any, options *PubSubBrokersClientBeginReconcileDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.reconcileDriftCreateRequest(ctx, pubSubBrokerName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// reconcileDriftCreateRequest creates the ReconcileDrift request.
+func (client *PubSubBrokersClient) reconcileDriftCreateRequest(ctx context.Context, pubSubBrokerName string, body map[string]any, options *PubSubBrokersClientBeginReconcileDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/pubSubBrokers/{pubSubBrokerName}/reconcileDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if pubSubBrokerName == "" {
+		return nil, errors.New("parameter pubSubBrokerName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{pubSubBrokerName}", url.PathEscape(pubSubBrokerName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // BeginUpdate - Update a DaprPubSubBrokerResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
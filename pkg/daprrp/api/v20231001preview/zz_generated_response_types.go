@@ -24,6 +24,11 @@ type PubSubBrokersClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// PubSubBrokersClientDetectDriftResponse contains the response from method PubSubBrokersClient.BeginDetectDrift.
+type PubSubBrokersClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // PubSubBrokersClientGetResponse contains the response from method PubSubBrokersClient.Get.
 type PubSubBrokersClientGetResponse struct {
 	// Dapr PubSubBroker portable resource
@@ -36,6 +41,17 @@ type PubSubBrokersClientListByScopeResponse struct {
 	DaprPubSubBrokerResourceListResult
 }
 
+// PubSubBrokersClientPlanResponse contains the response from method PubSubBrokersClient.Plan.
+type PubSubBrokersClientPlanResponse struct {
+	// The preview of the changes a deployment of a recipe would make to the infrastructure.
+	RecipePlan
+}
+
+// PubSubBrokersClientReconcileDriftResponse contains the response from method PubSubBrokersClient.BeginReconcileDrift.
+type PubSubBrokersClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
 // PubSubBrokersClientUpdateResponse contains the response from method PubSubBrokersClient.BeginUpdate.
 type PubSubBrokersClientUpdateResponse struct {
 	// Dapr PubSubBroker portable resource
@@ -53,6 +69,11 @@ type SecretStoresClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// SecretStoresClientDetectDriftResponse contains the response from method SecretStoresClient.BeginDetectDrift.
+type SecretStoresClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // SecretStoresClientGetResponse contains the response from method SecretStoresClient.Get.
 type SecretStoresClientGetResponse struct {
 	// Dapr SecretStore portable resource
@@ -65,6 +86,17 @@ type SecretStoresClientListByScopeResponse struct {
 	DaprSecretStoreResourceListResult
 }
 
+// SecretStoresClientPlanResponse contains the response from method SecretStoresClient.Plan.
+type SecretStoresClientPlanResponse struct {
+	// The preview of the changes a deployment of a recipe would make to the infrastructure.
+	RecipePlan
+}
+
+// SecretStoresClientReconcileDriftResponse contains the response from method SecretStoresClient.BeginReconcileDrift.
+type SecretStoresClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
 // SecretStoresClientUpdateResponse contains the response from method SecretStoresClient.BeginUpdate.
 type SecretStoresClientUpdateResponse struct {
 	// Dapr SecretStore portable resource
@@ -82,6 +114,11 @@ type StateStoresClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// StateStoresClientDetectDriftResponse contains the response from method StateStoresClient.BeginDetectDrift.
+type StateStoresClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // StateStoresClientGetResponse contains the response from method StateStoresClient.Get.
 type StateStoresClientGetResponse struct {
 	// Dapr StateStore portable resource
@@ -94,6 +131,17 @@ type StateStoresClientListByScopeResponse struct {
 	DaprStateStoreResourceListResult
 }
 
+// StateStoresClientPlanResponse contains the response from method StateStoresClient.Plan.
+type StateStoresClientPlanResponse struct {
+	// The preview of the changes a deployment of a recipe would make to the infrastructure.
+	RecipePlan
+}
+
+// StateStoresClientReconcileDriftResponse contains the response from method StateStoresClient.BeginReconcileDrift.
+type StateStoresClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
 // StateStoresClientUpdateResponse contains the response from method StateStoresClient.BeginUpdate.
 type StateStoresClientUpdateResponse struct {
 	// Dapr StateStore portable resource
@@ -172,6 +172,73 @@ func (client *SecretStoresClient) deleteCreateRequest(ctx context.Context, secre
 	return req, nil
 }
 
+// BeginDetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprSecretStore
+// resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - secretStoreName - SecretStore name
+//   - body - The content of the action request
+//   - options - SecretStoresClientBeginDetectDriftOptions contains the optional parameters for the SecretStoresClient.BeginDetectDrift
+//     method.
+func (client *SecretStoresClient) BeginDetectDrift(ctx context.Context, secretStoreName string, body map[string]any, options *SecretStoresClientBeginDetectDriftOptions) (*runtime.Poller[SecretStoresClientDetectDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.detectDrift(ctx, secretStoreName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[SecretStoresClientDetectDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[SecretStoresClientDetectDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// DetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprSecretStore resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *SecretStoresClient) detectDrift(ctx context.Context, secretStoreName string, body map[string]any, options *SecretStoresClientBeginDetectDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.detectDriftCreateRequest(ctx, secretStoreName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// detectDriftCreateRequest creates the DetectDrift request.
+func (client *SecretStoresClient) detectDriftCreateRequest(ctx context.Context, secretStoreName string, body map[string]any, options *SecretStoresClientBeginDetectDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/secretStores/{secretStoreName}/detectDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if secretStoreName == "" {
+		return nil, errors.New("parameter secretStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{secretStoreName}", url.PathEscape(secretStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // Get - Get a DaprSecretStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -281,6 +348,131 @@ func (client *SecretStoresClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Plan - Previews the changes a deployment of the recipe of the specified DaprSecretStore resource would make to the
+// infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - secretStoreName - SecretStore name
+//   - body - The content of the action request
+//   - options - SecretStoresClientPlanOptions contains the optional parameters for the SecretStoresClient.Plan method.
+func (client *SecretStoresClient) Plan(ctx context.Context, secretStoreName string, body DaprSecretStoreResource, options *SecretStoresClientPlanOptions) (SecretStoresClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, secretStoreName, body, options)
+	if err != nil {
+		return SecretStoresClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return SecretStoresClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return SecretStoresClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *SecretStoresClient) planCreateRequest(ctx context.Context, secretStoreName string, body DaprSecretStoreResource, options *SecretStoresClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/secretStores/{secretStoreName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if secretStoreName == "" {
+		return nil, errors.New("parameter secretStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{secretStoreName}", url.PathEscape(secretStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *SecretStoresClient) planHandleResponse(resp *http.Response) (SecretStoresClientPlanResponse, error) {
+	result := SecretStoresClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlan); err != nil {
+		return SecretStoresClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprSecretStore
+// resource and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - secretStoreName - SecretStore name
+//   - body - The content of the action request
+//   - options - SecretStoresClientBeginReconcileDriftOptions contains the optional parameters for the SecretStoresClient.BeginReconcileDrift
+//     method.
+func (client *SecretStoresClient) BeginReconcileDrift(ctx context.Context, secretStoreName string, body map[string]any, options *SecretStoresClientBeginReconcileDriftOptions) (*runtime.Poller[SecretStoresClientReconcileDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.reconcileDrift(ctx, secretStoreName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[SecretStoresClientReconcileDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[SecretStoresClientReconcileDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// ReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprSecretStore
+// resource and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *SecretStoresClient) reconcileDrift(ctx context.Context, secretStoreName string, body map[string]any, options *SecretStoresClientBeginReconcileDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.reconcileDriftCreateRequest(ctx, secretStoreName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// reconcileDriftCreateRequest creates the ReconcileDrift request.
+func (client *SecretStoresClient) reconcileDriftCreateRequest(ctx context.Context, secretStoreName string, body map[string]any, options *SecretStoresClientBeginReconcileDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/secretStores/{secretStoreName}/reconcileDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if secretStoreName == "" {
+		return nil, errors.New("parameter secretStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{secretStoreName}", url.PathEscape(secretStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // BeginUpdate - Update a DaprSecretStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -171,6 +171,73 @@ func (client *StateStoresClient) deleteCreateRequest(ctx context.Context, stateS
 	return req, nil
 }
 
+// BeginDetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprStateStore
+// resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - stateStoreName - StateStore name
+//   - body - The content of the action request
+//   - options - StateStoresClientBeginDetectDriftOptions contains the optional parameters for the StateStoresClient.BeginDetectDrift
+//     method.
+func (client *StateStoresClient) BeginDetectDrift(ctx context.Context, stateStoreName string, body map[string]any, options *StateStoresClientBeginDetectDriftOptions) (*runtime.Poller[StateStoresClientDetectDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.detectDrift(ctx, stateStoreName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[StateStoresClientDetectDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[StateStoresClientDetectDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// DetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprStateStore resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *StateStoresClient) detectDrift(ctx context.Context, stateStoreName string, body map[string]any, options *StateStoresClientBeginDetectDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.detectDriftCreateRequest(ctx, stateStoreName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// detectDriftCreateRequest creates the DetectDrift request.
+func (client *StateStoresClient) detectDriftCreateRequest(ctx context.Context, stateStoreName string, body map[string]any, options *StateStoresClientBeginDetectDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/stateStores/{stateStoreName}/detectDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if stateStoreName == "" {
+		return nil, errors.New("parameter stateStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{stateStoreName}", url.PathEscape(stateStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // Get - Get a DaprStateStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -280,6 +347,131 @@ func (client *StateStoresClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// Plan - Previews the changes a deployment of the recipe of the specified DaprStateStore resource would make to the
+// infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - stateStoreName - StateStore name
+//   - body - The content of the action request
+//   - options - StateStoresClientPlanOptions contains the optional parameters for the StateStoresClient.Plan method.
+func (client *StateStoresClient) Plan(ctx context.Context, stateStoreName string, body DaprStateStoreResource, options *StateStoresClientPlanOptions) (StateStoresClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, stateStoreName, body, options)
+	if err != nil {
+		return StateStoresClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return StateStoresClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return StateStoresClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *StateStoresClient) planCreateRequest(ctx context.Context, stateStoreName string, body DaprStateStoreResource, options *StateStoresClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/stateStores/{stateStoreName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if stateStoreName == "" {
+		return nil, errors.New("parameter stateStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{stateStoreName}", url.PathEscape(stateStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *StateStoresClient) planHandleResponse(resp *http.Response) (StateStoresClientPlanResponse, error) {
+	result := StateStoresClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlan); err != nil {
+		return StateStoresClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprStateStore
+// resource and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - stateStoreName - StateStore name
+//   - body - The content of the action request
+//   - options - StateStoresClientBeginReconcileDriftOptions contains the optional parameters for the StateStoresClient.BeginReconcileDrift
+//     method.
+func (client *StateStoresClient) BeginReconcileDrift(ctx context.Context, stateStoreName string, body map[string]any, options *StateStoresClientBeginReconcileDriftOptions) (*runtime.Poller[StateStoresClientReconcileDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.reconcileDrift(ctx, stateStoreName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[StateStoresClientReconcileDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[StateStoresClientReconcileDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// ReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified DaprStateStore resource
+// and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *StateStoresClient) reconcileDrift(ctx context.Context, stateStoreName string, body map[string]any, options *StateStoresClientBeginReconcileDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.reconcileDriftCreateRequest(ctx, stateStoreName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// reconcileDriftCreateRequest creates the ReconcileDrift request.
+func (client *StateStoresClient) reconcileDriftCreateRequest(ctx context.Context, stateStoreName string, body map[string]any, options *StateStoresClientBeginReconcileDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Dapr/stateStores/{stateStoreName}/reconcileDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if stateStoreName == "" {
+		return nil, errors.New("parameter stateStoreName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{stateStoreName}", url.PathEscape(stateStoreName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // BeginUpdate - Update a DaprStateStoreResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
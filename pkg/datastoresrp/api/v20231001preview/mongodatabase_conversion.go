@@ -99,6 +99,8 @@ func (dst *MongoDatabaseResource) ConvertFrom(src v1.DataModelInterface) error {
 		Database:  to.Ptr(mongo.Properties.Database),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(mongo.Properties.Status.OutputResources),
+			RecipeDrift:     toRecipeDrift(mongo.Properties.Status.RecipeDrift),
+			Recipe:          toRecipeStatus(mongo.Properties.Status.Recipe),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(mongo.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(mongo.Properties.Environment),
@@ -101,6 +101,8 @@ func (dst *RedisCacheResource) ConvertFrom(src v1.DataModelInterface) error {
 		Username:             to.Ptr(redis.Properties.Username),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(redis.Properties.Status.OutputResources),
+			RecipeDrift:     toRecipeDrift(redis.Properties.Status.RecipeDrift),
+			Recipe:          toRecipeStatus(redis.Properties.Status.Recipe),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(redis.InternalMetadata.AsyncProvisioningState),
 		Environment:       to.Ptr(redis.Properties.Environment),
@@ -99,6 +99,8 @@ func (dst *SQLDatabaseResource) ConvertFrom(src v1.DataModelInterface) error {
 		Port:                 to.Ptr(sql.Properties.Port),
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(sql.Properties.Status.OutputResources),
+			RecipeDrift:     toRecipeDrift(sql.Properties.Status.RecipeDrift),
+			Recipe:          toRecipeStatus(sql.Properties.Status.Recipe),
 		},
 		ProvisioningState: fromProvisioningStateDataModel(sql.InternalMetadata.AsyncProvisioningState),
 		Environment:       to.Ptr(sql.Properties.Environment),
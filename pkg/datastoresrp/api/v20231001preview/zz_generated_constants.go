@@ -46,6 +46,30 @@ func PossibleCreatedByTypeValues() []CreatedByType {
 	}
 }
 
+// DriftAction - The action required to reconcile a drifted resource with the recipe.
+type DriftAction string
+
+const (
+	// DriftActionCreate - The resource is missing and must be created.
+	DriftActionCreate DriftAction = "Create"
+	// DriftActionDelete - The resource is not part of the recipe and must be deleted.
+	DriftActionDelete DriftAction = "Delete"
+	// DriftActionReplace - The resource must be destroyed and created again.
+	DriftActionReplace DriftAction = "Replace"
+	// DriftActionUpdate - The resource must be updated in place.
+	DriftActionUpdate DriftAction = "Update"
+)
+
+// PossibleDriftActionValues returns the possible values for the DriftAction const type.
+func PossibleDriftActionValues() []DriftAction {
+	return []DriftAction{	
+		DriftActionCreate,
+		DriftActionDelete,
+		DriftActionReplace,
+		DriftActionUpdate,
+	}
+}
+
 // IdentitySettingKind - IdentitySettingKind is the kind of supported external identity setting
 type IdentitySettingKind string
 
@@ -83,6 +107,27 @@ func PossibleOriginValues() []Origin {
 	}
 }
 
+// PlanAction - The change a deployment of a recipe would make to a resource.
+type PlanAction string
+
+const (
+	// PlanActionCreate - The resource would be created.
+	PlanActionCreate PlanAction = "Create"
+	// PlanActionDelete - The resource would be deleted.
+	PlanActionDelete PlanAction = "Delete"
+	// PlanActionUpdate - The resource would be updated in place.
+	PlanActionUpdate PlanAction = "Update"
+)
+
+// PossiblePlanActionValues returns the possible values for the PlanAction const type.
+func PossiblePlanActionValues() []PlanAction {
+	return []PlanAction{	
+		PlanActionCreate,
+		PlanActionDelete,
+		PlanActionUpdate,
+	}
+}
+
 // ProvisioningState - Provisioning state of the portable resource at the time the operation was called
 type ProvisioningState string
 
@@ -116,6 +161,27 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RecipeDriftState - The drift state of the infrastructure deployed by a recipe.
+type RecipeDriftState string
+
+const (
+	// RecipeDriftStateDrifted - The deployed infrastructure has drifted from the recipe.
+	RecipeDriftStateDrifted RecipeDriftState = "Drifted"
+	// RecipeDriftStateInSync - The deployed infrastructure matches the recipe.
+	RecipeDriftStateInSync RecipeDriftState = "InSync"
+	// RecipeDriftStateReconciled - The deployed infrastructure had drifted from the recipe and the recipe was re-applied.
+	RecipeDriftStateReconciled RecipeDriftState = "Reconciled"
+)
+
+// PossibleRecipeDriftStateValues returns the possible values for the RecipeDriftState const type.
+func PossibleRecipeDriftStateValues() []RecipeDriftState {
+	return []RecipeDriftState{	
+		RecipeDriftStateDrifted,
+		RecipeDriftStateInSync,
+		RecipeDriftStateReconciled,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user
 // manages the resource and provides the values.
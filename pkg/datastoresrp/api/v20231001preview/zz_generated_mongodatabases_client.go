@@ -172,6 +172,73 @@ func (client *MongoDatabasesClient) deleteCreateRequest(ctx context.Context, mon
 	return req, nil
 }
 
+// BeginDetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified MongoDatabase
+// resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - mongoDatabaseName - The name of the MongoDatabase portable resource resource
+//   - body - The content of the action request
+//   - options - MongoDatabasesClientBeginDetectDriftOptions contains the optional parameters for the MongoDatabasesClient.BeginDetectDrift
+//     method.
+func (client *MongoDatabasesClient) BeginDetectDrift(ctx context.Context, mongoDatabaseName string, body map[string]any, options *MongoDatabasesClientBeginDetectDriftOptions) (*runtime.Poller[MongoDatabasesClientDetectDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.detectDrift(ctx, mongoDatabaseName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[MongoDatabasesClientDetectDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[MongoDatabasesClientDetectDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// DetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified MongoDatabase resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *MongoDatabasesClient) detectDrift(ctx context.Context, mongoDatabaseName string, body map[string]any, options *MongoDatabasesClientBeginDetectDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.detectDriftCreateRequest(ctx, mongoDatabaseName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// detectDriftCreateRequest creates the DetectDrift request.
+func (client *MongoDatabasesClient) detectDriftCreateRequest(ctx context.Context, mongoDatabaseName string, body map[string]any, options *MongoDatabasesClientBeginDetectDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/mongoDatabases/{mongoDatabaseName}/detectDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if mongoDatabaseName == "" {
+		return nil, errors.New("parameter mongoDatabaseName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{mongoDatabaseName}", url.PathEscape(mongoDatabaseName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // Get - Get a MongoDatabaseResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -338,6 +405,131 @@ func (client *MongoDatabasesClient) listSecretsHandleResponse(resp *http.Respons
 	return result, nil
 }
 
+// Plan - Previews the changes a deployment of the recipe of the specified MongoDatabase resource would make to the
+// infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - mongoDatabaseName - The name of the MongoDatabase portable resource resource
+//   - body - The content of the action request
+//   - options - MongoDatabasesClientPlanOptions contains the optional parameters for the MongoDatabasesClient.Plan method.
+func (client *MongoDatabasesClient) Plan(ctx context.Context, mongoDatabaseName string, body MongoDatabaseResource, options *MongoDatabasesClientPlanOptions) (MongoDatabasesClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, mongoDatabaseName, body, options)
+	if err != nil {
+		return MongoDatabasesClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return MongoDatabasesClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return MongoDatabasesClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *MongoDatabasesClient) planCreateRequest(ctx context.Context, mongoDatabaseName string, body MongoDatabaseResource, options *MongoDatabasesClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/mongoDatabases/{mongoDatabaseName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if mongoDatabaseName == "" {
+		return nil, errors.New("parameter mongoDatabaseName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{mongoDatabaseName}", url.PathEscape(mongoDatabaseName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *MongoDatabasesClient) planHandleResponse(resp *http.Response) (MongoDatabasesClientPlanResponse, error) {
+	result := MongoDatabasesClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlan); err != nil {
+		return MongoDatabasesClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified MongoDatabase
+// resource and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - mongoDatabaseName - The name of the MongoDatabase portable resource resource
+//   - body - The content of the action request
+//   - options - MongoDatabasesClientBeginReconcileDriftOptions contains the optional parameters for the MongoDatabasesClient.BeginReconcileDrift
+//     method.
+func (client *MongoDatabasesClient) BeginReconcileDrift(ctx context.Context, mongoDatabaseName string, body map[string]any, options *MongoDatabasesClientBeginReconcileDriftOptions) (*runtime.Poller[MongoDatabasesClientReconcileDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.reconcileDrift(ctx, mongoDatabaseName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[MongoDatabasesClientReconcileDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[MongoDatabasesClientReconcileDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// ReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified MongoDatabase resource
+// and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *MongoDatabasesClient) reconcileDrift(ctx context.Context, mongoDatabaseName string, body map[string]any, options *MongoDatabasesClientBeginReconcileDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.reconcileDriftCreateRequest(ctx, mongoDatabaseName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// reconcileDriftCreateRequest creates the ReconcileDrift request.
+func (client *MongoDatabasesClient) reconcileDriftCreateRequest(ctx context.Context, mongoDatabaseName string, body map[string]any, options *MongoDatabasesClientBeginReconcileDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Datastores/mongoDatabases/{mongoDatabaseName}/reconcileDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if mongoDatabaseName == "" {
+		return nil, errors.New("parameter mongoDatabaseName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{mongoDatabaseName}", url.PathEscape(mongoDatabaseName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // BeginUpdate - Update a MongoDatabaseResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
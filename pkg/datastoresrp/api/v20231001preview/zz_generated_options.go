@@ -20,6 +20,18 @@ type MongoDatabasesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// MongoDatabasesClientBeginDetectDriftOptions contains the optional parameters for the MongoDatabasesClient.BeginDetectDrift method.
+type MongoDatabasesClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// MongoDatabasesClientBeginReconcileDriftOptions contains the optional parameters for the MongoDatabasesClient.BeginReconcileDrift method.
+type MongoDatabasesClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // MongoDatabasesClientBeginUpdateOptions contains the optional parameters for the MongoDatabasesClient.BeginUpdate method.
 type MongoDatabasesClientBeginUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -42,6 +54,11 @@ type MongoDatabasesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// MongoDatabasesClientPlanOptions contains the optional parameters for the MongoDatabasesClient.Plan method.
+type MongoDatabasesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // OperationsClientListOptions contains the optional parameters for the OperationsClient.NewListPager method.
 type OperationsClientListOptions struct {
 	// placeholder for future optional parameters
@@ -60,6 +77,18 @@ type RedisCachesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// RedisCachesClientBeginDetectDriftOptions contains the optional parameters for the RedisCachesClient.BeginDetectDrift method.
+type RedisCachesClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// RedisCachesClientBeginReconcileDriftOptions contains the optional parameters for the RedisCachesClient.BeginReconcileDrift method.
+type RedisCachesClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // RedisCachesClientBeginUpdateOptions contains the optional parameters for the RedisCachesClient.BeginUpdate method.
 type RedisCachesClientBeginUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -81,6 +110,11 @@ type RedisCachesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// RedisCachesClientPlanOptions contains the optional parameters for the RedisCachesClient.Plan method.
+type RedisCachesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
 // SQLDatabasesClientBeginCreateOrUpdateOptions contains the optional parameters for the SQLDatabasesClient.BeginCreateOrUpdate
 // method.
 type SQLDatabasesClientBeginCreateOrUpdateOptions struct {
@@ -94,6 +128,18 @@ type SQLDatabasesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// SQLDatabasesClientBeginDetectDriftOptions contains the optional parameters for the SQLDatabasesClient.BeginDetectDrift method.
+type SQLDatabasesClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// SQLDatabasesClientBeginReconcileDriftOptions contains the optional parameters for the SQLDatabasesClient.BeginReconcileDrift method.
+type SQLDatabasesClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // SQLDatabasesClientBeginUpdateOptions contains the optional parameters for the SQLDatabasesClient.BeginUpdate method.
 type SQLDatabasesClientBeginUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -115,3 +161,8 @@ type SQLDatabasesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// SQLDatabasesClientPlanOptions contains the optional parameters for the SQLDatabasesClient.Plan method.
+type SQLDatabasesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
@@ -18,6 +18,11 @@ type MongoDatabasesClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// MongoDatabasesClientDetectDriftResponse contains the response from method MongoDatabasesClient.BeginDetectDrift.
+type MongoDatabasesClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // MongoDatabasesClientGetResponse contains the response from method MongoDatabasesClient.Get.
 type MongoDatabasesClientGetResponse struct {
 	// MongoDatabase portable resource
@@ -36,6 +41,17 @@ type MongoDatabasesClientListSecretsResponse struct {
 	MongoDatabaseListSecretsResult
 }
 
+// MongoDatabasesClientPlanResponse contains the response from method MongoDatabasesClient.Plan.
+type MongoDatabasesClientPlanResponse struct {
+	// The preview of the changes a deployment of a recipe would make to the infrastructure.
+	RecipePlan
+}
+
+// MongoDatabasesClientReconcileDriftResponse contains the response from method MongoDatabasesClient.BeginReconcileDrift.
+type MongoDatabasesClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
 // MongoDatabasesClientUpdateResponse contains the response from method MongoDatabasesClient.BeginUpdate.
 type MongoDatabasesClientUpdateResponse struct {
 	// MongoDatabase portable resource
@@ -59,6 +75,11 @@ type RedisCachesClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// RedisCachesClientDetectDriftResponse contains the response from method RedisCachesClient.BeginDetectDrift.
+type RedisCachesClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // RedisCachesClientGetResponse contains the response from method RedisCachesClient.Get.
 type RedisCachesClientGetResponse struct {
 	// RedisCache portable resource
@@ -77,6 +98,17 @@ type RedisCachesClientListSecretsResponse struct {
 	RedisCacheListSecretsResult
 }
 
+// RedisCachesClientPlanResponse contains the response from method RedisCachesClient.Plan.
+type RedisCachesClientPlanResponse struct {
+	// The preview of the changes a deployment of a recipe would make to the infrastructure.
+	RecipePlan
+}
+
+// RedisCachesClientReconcileDriftResponse contains the response from method RedisCachesClient.BeginReconcileDrift.
+type RedisCachesClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
 // RedisCachesClientUpdateResponse contains the response from method RedisCachesClient.BeginUpdate.
 type RedisCachesClientUpdateResponse struct {
 	// RedisCache portable resource
@@ -94,6 +126,11 @@ type SQLDatabasesClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// SQLDatabasesClientDetectDriftResponse contains the response from method SQLDatabasesClient.BeginDetectDrift.
+type SQLDatabasesClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // SQLDatabasesClientGetResponse contains the response from method SQLDatabasesClient.Get.
 type SQLDatabasesClientGetResponse struct {
 	// SqlDatabase portable resource
@@ -112,6 +149,17 @@ type SQLDatabasesClientListSecretsResponse struct {
 	SQLDatabaseListSecretsResult
 }
 
+// SQLDatabasesClientPlanResponse contains the response from method SQLDatabasesClient.Plan.
+type SQLDatabasesClientPlanResponse struct {
+	// The preview of the changes a deployment of a recipe would make to the infrastructure.
+	RecipePlan
+}
+
+// SQLDatabasesClientReconcileDriftResponse contains the response from method SQLDatabasesClient.BeginReconcileDrift.
+type SQLDatabasesClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
 // SQLDatabasesClientUpdateResponse contains the response from method SQLDatabasesClient.BeginUpdate.
 type SQLDatabasesClientUpdateResponse struct {
 	// SqlDatabase portable resource
@@ -179,3 +179,38 @@ func toOutputResources(outputResources []rpv1.OutputResource) []*OutputResource
 	}
 	return outResources
 }
+
+func toRecipeDrift(drift *rpv1.RecipeDriftStatus) *RecipeDriftStatus {
+	if drift == nil {
+		return nil
+	}
+
+	var driftedResources []*DriftedResource
+	for _, dr := range drift.DriftedResources {
+		driftedResources = append(driftedResources, &DriftedResource{
+			ID:     to.Ptr(dr.ID),
+			Action: to.Ptr(DriftAction(dr.Action)),
+		})
+	}
+
+	return &RecipeDriftStatus{
+		State:            to.Ptr(RecipeDriftState(drift.State)),
+		LastCheckedTime:  to.Ptr(drift.LastCheckedTime),
+		DriftedResources: driftedResources,
+	}
+}
+
+func toRecipeStatus(recipe *rpv1.RecipeStatus) *RecipeStatus {
+	if recipe == nil {
+		return nil
+	}
+
+	return &RecipeStatus{
+		TemplateKind:    to.Ptr(recipe.TemplateKind),
+		TemplatePath:    to.Ptr(recipe.TemplatePath),
+		TemplateVersion: to.Ptr(recipe.TemplateVersion),
+		ResolvedVersion: to.Ptr(recipe.ResolvedVersion),
+		TemplateDigest:  to.Ptr(recipe.TemplateDigest),
+		Parameters:      recipe.Parameters,
+	}
+}
@@ -18,9 +18,11 @@ package v20231001preview
 
 import (
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
 )
@@ -201,3 +203,88 @@ func TestToResourcesDataModel(t *testing.T) {
 
 	}
 }
+
+func TestToRecipeDrift(t *testing.T) {
+	lastChecked := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	testset := []struct {
+		DMDrift        *rpv1.RecipeDriftStatus
+		VersionedDrift *RecipeDriftStatus
+	}{
+		{
+			DMDrift:        nil,
+			VersionedDrift: nil,
+		},
+		{
+			DMDrift: &rpv1.RecipeDriftStatus{
+				State:           rpv1.RecipeDriftStateInSync,
+				LastCheckedTime: lastChecked,
+			},
+			VersionedDrift: &RecipeDriftStatus{
+				State:           to.Ptr(RecipeDriftStateInSync),
+				LastCheckedTime: to.Ptr(lastChecked),
+			},
+		},
+		{
+			DMDrift: &rpv1.RecipeDriftStatus{
+				State:           rpv1.RecipeDriftStateDrifted,
+				LastCheckedTime: lastChecked,
+				DriftedResources: []rpv1.DriftedResource{
+					{ID: "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.EC2/Subnet/subnet-0", Action: rpv1.DriftActionUpdate},
+					{ID: "kubernetes_deployment.redis", Action: rpv1.DriftActionCreate},
+				},
+			},
+			VersionedDrift: &RecipeDriftStatus{
+				State:           to.Ptr(RecipeDriftStateDrifted),
+				LastCheckedTime: to.Ptr(lastChecked),
+				DriftedResources: []*DriftedResource{
+					{ID: to.Ptr("/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.EC2/Subnet/subnet-0"), Action: to.Ptr(DriftActionUpdate)},
+					{ID: to.Ptr("kubernetes_deployment.redis"), Action: to.Ptr(DriftActionCreate)},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testset {
+		versioned := toRecipeDrift(tt.DMDrift)
+		require.Equal(t, tt.VersionedDrift, versioned)
+	}
+}
+
+func TestToRecipeStatus(t *testing.T) {
+	testset := []struct {
+		DMRecipe        *rpv1.RecipeStatus
+		VersionedRecipe *RecipeStatus
+	}{
+		{
+			DMRecipe:        nil,
+			VersionedRecipe: nil,
+		},
+		{
+			DMRecipe: &rpv1.RecipeStatus{
+				TemplateKind:    "terraform",
+				TemplatePath:    "Azure/redis/azurerm",
+				TemplateVersion: "~> 1.1",
+				ResolvedVersion: "1.1.5",
+				TemplateDigest:  "sha256:2f9c3b1e",
+				Parameters: map[string]any{
+					"port": float64(6379),
+				},
+			},
+			VersionedRecipe: &RecipeStatus{
+				TemplateKind:    to.Ptr("terraform"),
+				TemplatePath:    to.Ptr("Azure/redis/azurerm"),
+				TemplateVersion: to.Ptr("~> 1.1"),
+				ResolvedVersion: to.Ptr("1.1.5"),
+				TemplateDigest:  to.Ptr("sha256:2f9c3b1e"),
+				Parameters: map[string]any{
+					"port": float64(6379),
+				},
+			},
+		},
+	}
+
+	for _, tt := range testset {
+		versioned := toRecipeStatus(tt.DMRecipe)
+		require.Equal(t, tt.VersionedRecipe, versioned)
+	}
+}
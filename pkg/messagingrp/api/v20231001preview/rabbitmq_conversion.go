@@ -96,6 +96,8 @@ func (dst *RabbitMQQueueResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &RabbitMQQueueProperties{
 		Status: &ResourceStatus{
 			OutputResources: toOutputResources(rabbitmq.Properties.Status.OutputResources),
+			RecipeDrift:     toRecipeDrift(rabbitmq.Properties.Status.RecipeDrift),
+			Recipe:          toRecipeStatus(rabbitmq.Properties.Status.Recipe),
 		},
 		ProvisioningState:    fromProvisioningStateDataModel(rabbitmq.InternalMetadata.AsyncProvisioningState),
 		Environment:          to.Ptr(rabbitmq.Properties.Environment),
@@ -25,6 +25,18 @@ type RabbitMqQueuesClientBeginDeleteOptions struct {
 	ResumeToken string
 }
 
+// RabbitMqQueuesClientBeginDetectDriftOptions contains the optional parameters for the RabbitMqQueuesClient.BeginDetectDrift method.
+type RabbitMqQueuesClientBeginDetectDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
+// RabbitMqQueuesClientBeginReconcileDriftOptions contains the optional parameters for the RabbitMqQueuesClient.BeginReconcileDrift method.
+type RabbitMqQueuesClientBeginReconcileDriftOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // RabbitMqQueuesClientBeginUpdateOptions contains the optional parameters for the RabbitMqQueuesClient.BeginUpdate method.
 type RabbitMqQueuesClientBeginUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -47,3 +59,8 @@ type RabbitMqQueuesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
 
+// RabbitMqQueuesClientPlanOptions contains the optional parameters for the RabbitMqQueuesClient.Plan method.
+type RabbitMqQueuesClientPlanOptions struct {
+	// placeholder for future optional parameters
+}
+
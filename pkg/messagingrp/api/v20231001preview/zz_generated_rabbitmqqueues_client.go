@@ -172,6 +172,73 @@ func (client *RabbitMqQueuesClient) deleteCreateRequest(ctx context.Context, rab
 	return req, nil
 }
 
+// BeginDetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified RabbitMQQueue
+// resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - rabbitMQQueueName - The name of the RabbitMQQueue portable resource resource
+//   - body - The content of the action request
+//   - options - RabbitMqQueuesClientBeginDetectDriftOptions contains the optional parameters for the RabbitMqQueuesClient.BeginDetectDrift
+//     method.
+func (client *RabbitMqQueuesClient) BeginDetectDrift(ctx context.Context, rabbitMQQueueName string, body map[string]any, options *RabbitMqQueuesClientBeginDetectDriftOptions) (*runtime.Poller[RabbitMqQueuesClientDetectDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.detectDrift(ctx, rabbitMQQueueName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[RabbitMqQueuesClientDetectDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[RabbitMqQueuesClientDetectDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// DetectDrift - Detects the drift of the infrastructure deployed by the recipe of the specified RabbitMQQueue resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *RabbitMqQueuesClient) detectDrift(ctx context.Context, rabbitMQQueueName string, body map[string]any, options *RabbitMqQueuesClientBeginDetectDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.detectDriftCreateRequest(ctx, rabbitMQQueueName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// detectDriftCreateRequest creates the DetectDrift request.
+func (client *RabbitMqQueuesClient) detectDriftCreateRequest(ctx context.Context, rabbitMQQueueName string, body map[string]any, options *RabbitMqQueuesClientBeginDetectDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Messaging/rabbitMQQueues/{rabbitMQQueueName}/detectDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if rabbitMQQueueName == "" {
+		return nil, errors.New("parameter rabbitMQQueueName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{rabbitMQQueueName}", url.PathEscape(rabbitMQQueueName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // Get - Get a RabbitMQQueueResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -338,6 +405,131 @@ func (client *RabbitMqQueuesClient) listSecretsHandleResponse(resp *http.Respons
 	return result, nil
 }
 
+// Plan - Previews the changes a deployment of the recipe of the specified RabbitMQQueue resource would make to the
+// infrastructure
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - rabbitMQQueueName - The name of the RabbitMQQueue portable resource resource
+//   - body - The content of the action request
+//   - options - RabbitMqQueuesClientPlanOptions contains the optional parameters for the RabbitMqQueuesClient.Plan method.
+func (client *RabbitMqQueuesClient) Plan(ctx context.Context, rabbitMQQueueName string, body RabbitMQQueueResource, options *RabbitMqQueuesClientPlanOptions) (RabbitMqQueuesClientPlanResponse, error) {
+	var err error
+	req, err := client.planCreateRequest(ctx, rabbitMQQueueName, body, options)
+	if err != nil {
+		return RabbitMqQueuesClientPlanResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return RabbitMqQueuesClientPlanResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return RabbitMqQueuesClientPlanResponse{}, err
+	}
+	resp, err := client.planHandleResponse(httpResp)
+	return resp, err
+}
+
+// planCreateRequest creates the Plan request.
+func (client *RabbitMqQueuesClient) planCreateRequest(ctx context.Context, rabbitMQQueueName string, body RabbitMQQueueResource, options *RabbitMqQueuesClientPlanOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Messaging/rabbitMQQueues/{rabbitMQQueueName}/plan"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if rabbitMQQueueName == "" {
+		return nil, errors.New("parameter rabbitMQQueueName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{rabbitMQQueueName}", url.PathEscape(rabbitMQQueueName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planHandleResponse handles the Plan response.
+func (client *RabbitMqQueuesClient) planHandleResponse(resp *http.Response) (RabbitMqQueuesClientPlanResponse, error) {
+	result := RabbitMqQueuesClientPlanResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlan); err != nil {
+		return RabbitMqQueuesClientPlanResponse{}, err
+	}
+	return result, nil
+}
+
+// BeginReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified RabbitMQQueue
+// resource and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - rabbitMQQueueName - The name of the RabbitMQQueue portable resource resource
+//   - body - The content of the action request
+//   - options - RabbitMqQueuesClientBeginReconcileDriftOptions contains the optional parameters for the RabbitMqQueuesClient.BeginReconcileDrift
+//     method.
+func (client *RabbitMqQueuesClient) BeginReconcileDrift(ctx context.Context, rabbitMQQueueName string, body map[string]any, options *RabbitMqQueuesClientBeginReconcileDriftOptions) (*runtime.Poller[RabbitMqQueuesClientReconcileDriftResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.reconcileDrift(ctx, rabbitMQQueueName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[RabbitMqQueuesClientReconcileDriftResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[RabbitMqQueuesClientReconcileDriftResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// ReconcileDrift - Detects the drift of the infrastructure deployed by the recipe of the specified RabbitMQQueue resource
+// and re-applies the recipe when drift is detected
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *RabbitMqQueuesClient) reconcileDrift(ctx context.Context, rabbitMQQueueName string, body map[string]any, options *RabbitMqQueuesClientBeginReconcileDriftOptions) (*http.Response, error) {
+	var err error
+	req, err := client.reconcileDriftCreateRequest(ctx, rabbitMQQueueName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// reconcileDriftCreateRequest creates the ReconcileDrift request.
+func (client *RabbitMqQueuesClient) reconcileDriftCreateRequest(ctx context.Context, rabbitMQQueueName string, body map[string]any, options *RabbitMqQueuesClientBeginReconcileDriftOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Messaging/rabbitMQQueues/{rabbitMQQueueName}/reconcileDrift"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if rabbitMQQueueName == "" {
+		return nil, errors.New("parameter rabbitMQQueueName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{rabbitMQQueueName}", url.PathEscape(rabbitMQQueueName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
 // BeginUpdate - Update a RabbitMQQueueResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -24,6 +24,11 @@ type RabbitMqQueuesClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// RabbitMqQueuesClientDetectDriftResponse contains the response from method RabbitMqQueuesClient.BeginDetectDrift.
+type RabbitMqQueuesClientDetectDriftResponse struct {
+	// placeholder for future response values
+}
+
 // RabbitMqQueuesClientGetResponse contains the response from method RabbitMqQueuesClient.Get.
 type RabbitMqQueuesClientGetResponse struct {
 	// RabbitMQQueue portable resource
@@ -42,6 +47,17 @@ type RabbitMqQueuesClientListSecretsResponse struct {
 	RabbitMQListSecretsResult
 }
 
+// RabbitMqQueuesClientPlanResponse contains the response from method RabbitMqQueuesClient.Plan.
+type RabbitMqQueuesClientPlanResponse struct {
+	// The preview of the changes a deployment of a recipe would make to the infrastructure.
+	RecipePlan
+}
+
+// RabbitMqQueuesClientReconcileDriftResponse contains the response from method RabbitMqQueuesClient.BeginReconcileDrift.
+type RabbitMqQueuesClientReconcileDriftResponse struct {
+	// placeholder for future response values
+}
+
 // RabbitMqQueuesClientUpdateResponse contains the response from method RabbitMqQueuesClient.BeginUpdate.
 type RabbitMqQueuesClientUpdateResponse struct {
 	// RabbitMQQueue portable resource
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// RecipePlan is the preview of the changes a deployment of the recipe of a portable resource would make to the
+// infrastructure. The plan has the same schema for all portable resource providers, so this model is used to return
+// the plan of every portable resource type.
+type RecipePlan struct {
+	// Resources is the list of resources which would be created, updated or deleted by the deployment of the recipe.
+	Resources []*PlannedResource `json:"resources"`
+}
+
+// PlannedResource is a resource which would be changed by the deployment of the recipe.
+type PlannedResource struct {
+	// ID is the UCP resource ID of the resource, or the recipe address of the resource when it has no resource ID yet.
+	ID *string `json:"id,omitempty"`
+
+	// Action is the change the deployment of the recipe would make to the resource.
+	Action *PlanAction `json:"action,omitempty"`
+}
+
+// PlanAction is the change the deployment of the recipe would make to a resource.
+type PlanAction string
+
+const (
+	// PlanActionCreate represents a resource which would be created.
+	PlanActionCreate PlanAction = "Create"
+	// PlanActionUpdate represents a resource which would be updated in-place.
+	PlanActionUpdate PlanAction = "Update"
+	// PlanActionDelete represents a resource which would be deleted.
+	PlanActionDelete PlanAction = "Delete"
+)
+
+// ConvertTo returns an error as it does not support converting the recipe plan to a version-agnostic object.
+func (src *RecipePlan) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting recipe plan to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned recipe plan.
+func (dst *RecipePlan) ConvertFrom(src v1.DataModelInterface) error {
+	plan, ok := src.(*pr_dm.RecipePlan)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.Resources = []*PlannedResource{}
+	for _, resource := range plan.Resources {
+		dst.Resources = append(dst.Resources, &PlannedResource{
+			ID:     to.Ptr(resource.ID),
+			Action: fromPlanAction(resource.Action),
+		})
+	}
+
+	return nil
+}
+
+func fromPlanAction(action recipes.PlanAction) *PlanAction {
+	switch action {
+	case recipes.PlanActionCreate:
+		return to.Ptr(PlanActionCreate)
+	case recipes.PlanActionUpdate:
+		return to.Ptr(PlanActionUpdate)
+	case recipes.PlanActionDelete:
+		return to.Ptr(PlanActionDelete)
+	default:
+		return nil
+	}
+}
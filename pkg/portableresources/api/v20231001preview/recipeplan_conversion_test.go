@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipePlanConversion(t *testing.T) {
+	t.Run("convert to data model", func(t *testing.T) {
+		r := &RecipePlan{}
+		_, err := r.ConvertTo()
+		require.ErrorContains(t, err, "converting recipe plan to a version-agnostic object is not supported")
+	})
+
+	t.Run("convert from data model", func(t *testing.T) {
+		versioned := &RecipePlan{}
+		err := versioned.ConvertFrom(&pr_dm.RecipePlan{
+			RecipePlan: recipes.RecipePlan{
+				Resources: []recipes.PlannedResource{
+					{ID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-new", Action: recipes.PlanActionCreate},
+					{ID: "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-old", Action: recipes.PlanActionDelete},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &RecipePlan{
+			Resources: []*PlannedResource{
+				{ID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-new"), Action: to.Ptr(PlanActionCreate)},
+				{ID: to.Ptr("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/redis-old"), Action: to.Ptr(PlanActionDelete)},
+			},
+		}, versioned)
+	})
+
+	t.Run("empty plan", func(t *testing.T) {
+		versioned := &RecipePlan{}
+		err := versioned.ConvertFrom(&pr_dm.RecipePlan{})
+		require.NoError(t, err)
+		require.Equal(t, &RecipePlan{Resources: []*PlannedResource{}}, versioned)
+	})
+
+	t.Run("invalid data model", func(t *testing.T) {
+		versioned := &RecipePlan{}
+		err := versioned.ConvertFrom(&resourcetypeutil.FakeResource{})
+		require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+	})
+}
@@ -118,6 +118,11 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		if err != nil {
 			return ctrl.Result{}, err
 		}
+
+		// Record the recipe which produced the resource so that it can be upgraded when the recipe changes.
+		if recipeOutput != nil {
+			data.ResourceMetadata().Status.Recipe = recipeOutput.Status
+		}
 	}
 
 	if recipeDataModel.Recipe() != nil {
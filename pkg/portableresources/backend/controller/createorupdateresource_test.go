@@ -340,6 +340,13 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 			prevState := []string{
 				oldOutputResourceResourceID,
 			}
+			recipeStatus := &rpv1.RecipeStatus{
+				TemplateKind:    recipes.TemplateKindBicep,
+				TemplatePath:    "ghcr.io/radius-project/recipes/test:1.0",
+				ResolvedVersion: "1.0",
+				TemplateDigest:  "sha256:digest",
+				Parameters:      map[string]any{"p1": "v1"},
+			}
 
 			if stillPassing && tt.runtimeConfigurationErr != nil {
 				stillPassing = false
@@ -389,7 +396,7 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 						},
 						PreviousState: prevState,
 					}).
-					Return(&recipes.RecipeOutput{}, nil).
+					Return(&recipes.RecipeOutput{Status: recipeStatus}, nil).
 					Times(1)
 			}
 
@@ -407,7 +414,11 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 			} else if stillPassing && tt.resourceClientErr == nil {
 				msc.EXPECT().
 					Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						// The recipe which produced the resource is recorded in its status.
+						require.Equal(t, recipeStatus, obj.Data.(*TestResource).Properties.Status.Recipe)
+						return nil
+					}).
 					Times(1)
 			}
 
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// DetectDriftResource is the async operation controller to detect the drift of the infrastructure deployed by the
+// recipe of a portable resource. The drift is recorded in the status of the resource.
+type DetectDriftResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.BaseController
+	engine engine.Engine
+
+	// reconciler re-applies the recipe when drift is detected. Drift is only detected if reconciler is nil.
+	reconciler ctrl.Controller
+}
+
+// NewDetectDriftResource creates a new controller for detecting the drift of the infrastructure deployed by the recipe of a resource.
+func NewDetectDriftResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, eng engine.Engine) (ctrl.Controller, error) {
+	return &DetectDriftResource[P, T]{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		engine:         eng,
+	}, nil
+}
+
+// NewReconcileDriftResource creates a new controller for detecting the drift of the infrastructure deployed by the recipe
+// of a resource, which re-applies the recipe the same way as a create or update of the resource when drift is detected.
+func NewReconcileDriftResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, processor processors.ResourceProcessor[P, T], eng engine.Engine, client processors.ResourceClient, configurationLoader configloader.ConfigurationLoader) (ctrl.Controller, error) {
+	reconciler, err := NewCreateOrUpdateResource[P, T](opts, processor, eng, client, configurationLoader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DetectDriftResource[P, T]{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		engine:         eng,
+		reconciler:     reconciler,
+	}, nil
+}
+
+// Run retrieves the resource, compares the infrastructure deployed by its recipe with the recipe and saves the drift
+// status of the resource. When the controller reconciles drift and drift is detected, the recipe is re-applied.
+func (c *DetectDriftResource[P, T]) Run(ctx context.Context, req *ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	obj, err := c.StorageClient().Get(ctx, req.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	data := P(new(T))
+	if err = obj.As(data); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
+	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.Recipe() == nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("resource %q is not provisioned by a recipe", req.ResourceID),
+		}), nil
+	}
+
+	driftedResources, err := c.engine.DetectDrift(ctx, engine.DetectDriftOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          recipeDataModel.Recipe().Name,
+				Parameters:    recipeDataModel.Recipe().Parameters,
+				EnvironmentID: data.ResourceMetadata().Environment,
+				ApplicationID: data.ResourceMetadata().Application,
+				ResourceID:    data.GetBaseResource().ID,
+			},
+		},
+		OutputResources: data.OutputResources(),
+	})
+	if err != nil {
+		if recipeError, ok := err.(*recipes.RecipeError); ok {
+			return ctrl.NewFailedResult(recipeError.ErrorDetails), nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	driftStatus := &rpv1.RecipeDriftStatus{
+		State:            rpv1.RecipeDriftStateInSync,
+		LastCheckedTime:  time.Now().UTC(),
+		DriftedResources: driftedResources,
+	}
+	if len(driftedResources) > 0 {
+		driftStatus.State = rpv1.RecipeDriftStateDrifted
+	}
+	logger.Info(fmt.Sprintf("Detected drift state %q with %d drifted resource(s)", driftStatus.State, len(driftedResources)))
+
+	data.ResourceMetadata().Status.RecipeDrift = driftStatus
+	if err := c.save(ctx, req.ResourceID, data, obj.ETag); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if driftStatus.State != rpv1.RecipeDriftStateDrifted || c.reconciler == nil {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Re-applying the recipe to reconcile the drifted resources")
+	result, err := c.reconciler.Run(ctx, req)
+	if err != nil || result.Error != nil {
+		return result, err
+	}
+
+	// The resource is updated by the reconciler, so it's retrieved again to record the reconciliation.
+	obj, err = c.StorageClient().Get(ctx, req.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	data = P(new(T))
+	if err = obj.As(data); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	driftStatus.State = rpv1.RecipeDriftStateReconciled
+	data.ResourceMetadata().Status.RecipeDrift = driftStatus
+	if err := c.save(ctx, req.ResourceID, data, obj.ETag); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (c *DetectDriftResource[P, T]) save(ctx context.Context, id string, data P, etag string) error {
+	update := &store.Object{
+		Metadata: store.Metadata{
+			ID: id,
+		},
+		Data: data,
+	}
+	return c.StorageClient().Save(ctx, update, store.WithETag(etag))
+}
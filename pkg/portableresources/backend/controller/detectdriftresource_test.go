@@ -0,0 +1,244 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func TestDetectDriftResource_Run(t *testing.T) {
+	driftedResources := []rpv1.DriftedResource{
+		{ID: oldOutputResourceResourceID, Action: rpv1.DriftActionUpdate},
+	}
+
+	cases := []struct {
+		description      string
+		reconcile        bool
+		getErr           error
+		driftedResources []rpv1.DriftedResource
+		driftErr         error
+		saveErr          error
+		expectedState    rpv1.RecipeDriftState
+		expectedErr      error
+		expectedFailure  *v1.ErrorDetails
+	}{
+		{
+			description:   "in-sync",
+			expectedState: rpv1.RecipeDriftStateInSync,
+		},
+		{
+			description:      "drifted",
+			driftedResources: driftedResources,
+			expectedState:    rpv1.RecipeDriftStateDrifted,
+		},
+		{
+			description:      "drifted-reconciled",
+			reconcile:        true,
+			driftedResources: driftedResources,
+			expectedState:    rpv1.RecipeDriftStateReconciled,
+		},
+		{
+			description:   "in-sync-not-reconciled",
+			reconcile:     true,
+			expectedState: rpv1.RecipeDriftStateInSync,
+		},
+		{
+			description: "get-error",
+			getErr:      errors.New("get error"),
+			expectedErr: errors.New("get error"),
+		},
+		{
+			description: "recipe-error",
+			driftErr: &recipes.RecipeError{
+				ErrorDetails: v1.ErrorDetails{
+					Code:    recipes.RecipeDriftDetectionFailed,
+					Message: "terraform plan failure",
+				},
+			},
+			expectedFailure: &v1.ErrorDetails{
+				Code:    recipes.RecipeDriftDetectionFailed,
+				Message: "terraform plan failure",
+			},
+		},
+		{
+			description: "save-error",
+			saveErr:     errors.New("save error"),
+			expectedErr: errors.New("save error"),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			msc := store.NewMockStorageClient(mctrl)
+			eng := engine.NewMockEngine(mctrl)
+			cfg := configloader.NewMockConfigurationLoader(mctrl)
+			client := processors.NewMockResourceClient(mctrl)
+
+			req := &ctrl.Request{
+				OperationID:      uuid.New(),
+				OperationType:    "APPLICATIONS.TEST/TESTRESOURCES|DETECTDRIFT",
+				ResourceID:       TestResourceID,
+				CorrelationID:    uuid.NewString(),
+				OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+			}
+
+			newData := func() map[string]any {
+				return map[string]any{
+					"name":     "tr",
+					"type":     "Applications.Test/testResources",
+					"id":       TestResourceID,
+					"location": v1.LocationGlobal,
+					"properties": map[string]any{
+						"application":       TestApplicationID,
+						"environment":       TestEnvironmentID,
+						"provisioningState": "Succeeded",
+						"status": map[string]any{
+							"outputResources": []map[string]any{
+								{
+									"id": oldOutputResourceResourceID,
+								},
+							},
+						},
+						"recipe": map[string]any{
+							"name": "test-recipe",
+						},
+					},
+				}
+			}
+
+			recipeMetadata := recipes.ResourceMetadata{
+				Name:          "test-recipe",
+				EnvironmentID: TestEnvironmentID,
+				ApplicationID: TestApplicationID,
+				ResourceID:    TestResourceID,
+			}
+
+			msc.EXPECT().
+				Get(gomock.Any(), TestResourceID).
+				Return(&store.Object{Data: newData()}, tt.getErr).
+				Times(1)
+
+			var saved []*TestResource
+			saveResource := func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+				saved = append(saved, obj.Data.(*TestResource))
+				return tt.saveErr
+			}
+
+			if tt.getErr == nil {
+				eng.EXPECT().
+					DetectDrift(gomock.Any(), engine.DetectDriftOptions{
+						BaseOptions: engine.BaseOptions{
+							Recipe: recipeMetadata,
+						},
+						OutputResources: []rpv1.OutputResource{
+							{ID: resources.MustParse(oldOutputResourceResourceID)},
+						},
+					}).
+					Return(tt.driftedResources, tt.driftErr).
+					Times(1)
+
+				if tt.driftErr == nil {
+					msc.EXPECT().
+						Save(gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(saveResource).
+						Times(1)
+				}
+			}
+
+			if tt.reconcile && len(tt.driftedResources) > 0 {
+				// The reconciler re-applies the recipe.
+				msc.EXPECT().
+					Get(gomock.Any(), TestResourceID).
+					Return(&store.Object{Data: newData()}, nil).
+					Times(1)
+				cfg.EXPECT().
+					LoadConfiguration(gomock.Any(), gomock.Any()).
+					Return(&recipes.Configuration{}, nil).
+					Times(1)
+				eng.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(&recipes.RecipeOutput{}, nil).
+					Times(1)
+				msc.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				// The reconciliation is recorded on the updated resource.
+				msc.EXPECT().
+					Get(gomock.Any(), TestResourceID).
+					Return(&store.Object{Data: newData()}, nil).
+					Times(1)
+				msc.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(saveResource).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				StorageClient: msc,
+			}
+
+			var controller ctrl.Controller
+			var err error
+			if tt.reconcile {
+				controller, err = NewReconcileDriftResource(opts, successProcessorReference, eng, client, cfg)
+			} else {
+				controller, err = NewDetectDriftResource[*TestResource](opts, eng)
+			}
+			require.NoError(t, err)
+
+			result, err := controller.Run(context.Background(), req)
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expectedFailure != nil {
+				require.Equal(t, ctrl.NewFailedResult(*tt.expectedFailure), result)
+				require.Empty(t, saved)
+				return
+			}
+			require.Equal(t, ctrl.Result{}, result)
+
+			require.NotEmpty(t, saved)
+			drift := saved[len(saved)-1].Properties.Status.RecipeDrift
+			require.NotNil(t, drift)
+			require.Equal(t, tt.expectedState, drift.State)
+			require.Equal(t, tt.driftedResources, drift.DriftedResources)
+			require.False(t, drift.LastCheckedTime.IsZero())
+		})
+	}
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ ctrl.Controller = (*UpgradeRecipes)(nil)
+var _ ctrl.FailureHandler = (*UpgradeRecipes)(nil)
+
+// UpgradeRecipes is the async operation controller to re-apply the recipes of all the resources of an environment which
+// were deployed with a different recipe template or version than the one currently registered in the environment.
+// Resources are upgraded in batches, and the upgrade is halted after the first batch in which a recipe fails.
+//
+// The resources are re-deployed through their API rather than by running their controllers in-process, so that
+// the re-deployment is tracked as an operation of the resource and does not overwrite concurrent changes to it.
+type UpgradeRecipes struct {
+	ctrl.BaseController
+
+	// resourceTypes are the resource types whose recipes can be upgraded.
+	resourceTypes  []string
+	resourceClient processors.ResourceClient
+	configLoader   configloader.ConfigurationLoader
+	engine         engine.Engine
+}
+
+// upgradeResource is the subset of the properties of a portable resource needed to decide whether its recipe should be upgraded.
+type upgradeResource struct {
+	v1.BaseResource
+
+	Properties struct {
+		rpv1.BasicResourceProperties
+		Recipe               portableresources.ResourceRecipe       `json:"recipe,omitempty"`
+		ResourceProvisioning portableresources.ResourceProvisioning `json:"resourceProvisioning,omitempty"`
+	} `json:"properties"`
+}
+
+// NewUpgradeRecipes creates a new controller for upgrading the recipes of the resources of an environment. Only the
+// resources of resourceTypes are upgraded, and they are re-deployed with resourceClient. The recipe engine is used to
+// resolve the digest of the recipe templates.
+func NewUpgradeRecipes(opts ctrl.Options, resourceTypes []string, resourceClient processors.ResourceClient, configLoader configloader.ConfigurationLoader, engine engine.Engine) (ctrl.Controller, error) {
+	return &UpgradeRecipes{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		resourceTypes:  resourceTypes,
+		resourceClient: resourceClient,
+		configLoader:   configLoader,
+		engine:         engine,
+	}, nil
+}
+
+// Run finds the resources of the environment whose recipe is out of date and re-applies their recipes in batches. The
+// progress of the upgrade is recorded in the status of the environment after every batch.
+func (c *UpgradeRecipes) Run(ctx context.Context, req *ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	obj, err := c.StorageClient().Get(ctx, req.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	env := &corerp_dm.Environment{}
+	if err = obj.As(env); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	status := env.Properties.RecipeUpgrade
+	if status == nil {
+		status = &corerp_dm.RecipeUpgradeStatus{}
+		env.Properties.RecipeUpgrade = status
+	}
+	if status.BatchSize < 1 {
+		status.BatchSize = corerp_dm.DefaultRecipeUpgradeBatchSize
+	}
+	etag := obj.ETag
+	status.State = corerp_dm.RecipeUpgradeStateInProgress
+	status.OperationID = req.OperationID.String()
+	status.Upgraded = 0
+	status.FailedResources = nil
+	status.Message = ""
+
+	outdated, failed, err := c.findOutdatedResources(ctx, env)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	status.Total = len(outdated) + len(failed)
+	if len(failed) > 0 {
+		return c.halt(ctx, req.ResourceID, env, etag, failed, fmt.Sprintf("the recipes of %d resource(s) could not be loaded", len(failed)))
+	}
+
+	if etag, err = c.save(ctx, req.ResourceID, env, etag); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.Info(fmt.Sprintf("Upgrading the recipes of %d resource(s) in batches of %d", len(outdated), status.BatchSize))
+	for start := 0; start < len(outdated); start += status.BatchSize {
+		end := start + status.BatchSize
+		if end > len(outdated) {
+			end = len(outdated)
+		}
+
+		failed := c.upgradeBatch(ctx, outdated[start:end])
+
+		status.Upgraded += end - start - len(failed)
+		if len(failed) > 0 {
+			return c.halt(ctx, req.ResourceID, env, etag, failed, fmt.Sprintf("the recipes of %d resource(s) failed to deploy", len(failed)))
+		}
+
+		if etag, err = c.save(ctx, req.ResourceID, env, etag); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	status.State = corerp_dm.RecipeUpgradeStateSucceeded
+	if _, err := c.save(ctx, req.ResourceID, env, etag); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// OnFailure records the failure of the upgrade in the status of the environment when the operation is completed by the
+// worker, for example because it timed out, was canceled or exceeded the maximum retry count. The status is only updated
+// if the upgrade run by the operation is still in progress.
+func (c *UpgradeRecipes) OnFailure(ctx context.Context, req *ctrl.Request, result ctrl.Result) error {
+	obj, err := c.StorageClient().Get(ctx, req.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	env := &corerp_dm.Environment{}
+	if err = obj.As(env); err != nil {
+		return err
+	}
+
+	status := env.Properties.RecipeUpgrade
+	if status == nil || status.IsTerminal() || status.OperationID != req.OperationID.String() {
+		return nil
+	}
+
+	status.State = corerp_dm.RecipeUpgradeStateFailed
+	status.Message = fmt.Sprintf("The recipe upgrade was stopped. %d of %d resource(s) were upgraded.", status.Upgraded, status.Total)
+	if result.Error != nil {
+		status.Message = fmt.Sprintf("The recipe upgrade was stopped: %s %d of %d resource(s) were upgraded.", result.Error.Message, status.Upgraded, status.Total)
+	}
+	status.LastUpdatedTime = time.Now().UTC()
+
+	update := &store.Object{
+		Metadata: store.Metadata{
+			ID: req.ResourceID,
+		},
+		Data: env,
+	}
+	return c.StorageClient().Save(ctx, update, store.WithETag(obj.ETag))
+}
+
+// findOutdatedResources returns the IDs of the resources of the environment whose recipe was deployed with a different
+// template, version, template content or parameters than the ones registered in the environment, and the IDs of the
+// resources whose recipe could not be loaded. The resources are sorted by ID so that the batches are deterministic.
+func (c *UpgradeRecipes) findOutdatedResources(ctx context.Context, env *corerp_dm.Environment) (outdated []resources.ID, failed []string, err error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	envID, err := resources.ParseResource(env.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The digest of each recipe template is resolved once for all the resources deploying it.
+	digests := map[templateKey]templateDigest{}
+	for _, resourceType := range c.resourceTypes {
+		storageClient, err := c.DataProvider().GetStorageClient(ctx, resourceType)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		query := store.Query{
+			RootScope:      envID.PlaneScope(),
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+			Filters: []store.QueryFilter{
+				{
+					Field: "properties.environment",
+					Value: env.ID,
+				},
+			},
+		}
+
+		token := ""
+		for {
+			result, err := storageClient.Query(ctx, query, store.WithPaginationToken(token))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for _, item := range result.Items {
+				resource := &upgradeResource{}
+				if err := item.As(resource); err != nil {
+					return nil, nil, err
+				}
+
+				if resource.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual {
+					continue
+				}
+
+				id, err := resources.ParseResource(resource.ID)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				definition, err := c.configLoader.LoadRecipe(ctx, &recipes.ResourceMetadata{
+					Name:          resource.Properties.Recipe.Name,
+					Parameters:    resource.Properties.Recipe.Parameters,
+					EnvironmentID: resource.Properties.Environment,
+					ApplicationID: resource.Properties.Application,
+					ResourceID:    resource.ID,
+				})
+				if err != nil {
+					logger.Error(err, "failed to load the recipe of the resource", "resourceID", resource.ID)
+					failed = append(failed, resource.ID)
+					continue
+				}
+
+				status := resource.Properties.Status.Recipe
+				digest := ""
+				if status != nil && status.TemplateDigest != "" {
+					digest, err = c.getTemplateDigest(ctx, digests, env.ID, definition)
+					if err != nil {
+						logger.Error(err, "failed to resolve the digest of the recipe of the resource", "resourceID", resource.ID)
+						failed = append(failed, resource.ID)
+						continue
+					}
+				}
+
+				if isRecipeOutdated(status, definition, resource.Properties.Recipe.Parameters, digest) {
+					outdated = append(outdated, id)
+				}
+			}
+
+			token = result.PaginationToken
+			if token == "" {
+				break
+			}
+		}
+	}
+
+	sort.Slice(outdated, func(i, j int) bool { return outdated[i].String() < outdated[j].String() })
+	sort.Strings(failed)
+	return outdated, failed, nil
+}
+
+// templateKey identifies a recipe template within an environment.
+type templateKey struct {
+	driver  string
+	path    string
+	version string
+}
+
+// templateDigest is the digest of a recipe template, or the error resolving it.
+type templateDigest struct {
+	digest string
+	err    error
+}
+
+// getTemplateDigest returns the digest of the template of the recipe definition. The digests, and the errors resolving
+// them, are reused for all the resources of the environment deploying the same template.
+func (c *UpgradeRecipes) getTemplateDigest(ctx context.Context, digests map[templateKey]templateDigest, environmentID string, definition *recipes.EnvironmentDefinition) (string, error) {
+	key := templateKey{
+		driver:  definition.Driver,
+		path:    definition.TemplatePath,
+		version: definition.TemplateVersion,
+	}
+	result, ok := digests[key]
+	if !ok {
+		result.digest, result.err = c.engine.GetTemplateDigest(ctx, engine.GetRecipeMetadataOptions{
+			EnvironmentID:    environmentID,
+			RecipeDefinition: *definition,
+		})
+		digests[key] = result
+	}
+
+	return result.digest, result.err
+}
+
+// upgradeBatch re-deploys a batch of resources concurrently and returns the IDs of the resources whose recipe failed.
+func (c *UpgradeRecipes) upgradeBatch(ctx context.Context, batch []resources.ID) []string {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	mu := sync.Mutex{}
+	failed := []string{}
+	wg := sync.WaitGroup{}
+	for _, id := range batch {
+		wg.Add(1)
+		go func(id resources.ID) {
+			defer wg.Done()
+
+			if err := c.resourceClient.Redeploy(ctx, id.String()); err != nil {
+				logger.Error(err, "failed to upgrade the recipe of the resource", "resourceID", id.String())
+
+				mu.Lock()
+				defer mu.Unlock()
+				failed = append(failed, id.String())
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	sort.Strings(failed)
+	return failed
+}
+
+// halt records the failure of the upgrade in the status of the environment and returns the failed result of the operation.
+func (c *UpgradeRecipes) halt(ctx context.Context, id string, env *corerp_dm.Environment, etag string, failed []string, reason string) (ctrl.Result, error) {
+	status := env.Properties.RecipeUpgrade
+	status.State = corerp_dm.RecipeUpgradeStateFailed
+	status.FailedResources = failed
+	status.Message = fmt.Sprintf("The recipe upgrade was halted because %s. %d of %d resource(s) were upgraded.", reason, status.Upgraded, status.Total)
+	if _, err := c.save(ctx, id, env, etag); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.NewFailedResult(v1.ErrorDetails{
+		Code:    v1.CodeInternal,
+		Message: status.Message,
+	}), nil
+}
+
+// save records the progress of the upgrade in the environment and returns the new etag of the environment. The
+// environment is saved with the etag it was last read or saved with, so that concurrent changes to the environment
+// fail the upgrade instead of being overwritten.
+func (c *UpgradeRecipes) save(ctx context.Context, id string, env *corerp_dm.Environment, etag string) (string, error) {
+	env.Properties.RecipeUpgrade.LastUpdatedTime = time.Now().UTC()
+	update := &store.Object{
+		Metadata: store.Metadata{
+			ID: id,
+		},
+		Data: env,
+	}
+	if err := c.StorageClient().Save(ctx, update, store.WithETag(etag)); err != nil {
+		return "", err
+	}
+
+	return update.ETag, nil
+}
+
+// isRecipeOutdated returns true if the resource was not deployed by the recipe template and version registered in the
+// environment, or with the parameters of the environment and of the resource. digest is the current digest of the
+// template, which detects a template pushed again with the same tag. The digest is not compared if it is empty.
+func isRecipeOutdated(status *rpv1.RecipeStatus, definition *recipes.EnvironmentDefinition, resourceParameters map[string]any, digest string) bool {
+	if status == nil {
+		return true
+	}
+
+	if status.TemplateKind != definition.Driver ||
+		status.TemplatePath != definition.TemplatePath ||
+		status.TemplateVersion != definition.TemplateVersion {
+		return true
+	}
+
+	if digest != "" && status.TemplateDigest != "" && digest != status.TemplateDigest {
+		return true
+	}
+
+	// The recipe is deployed with the parameters of the environment overridden by the parameters of the resource.
+	parameters := map[string]any{}
+	for k, v := range definition.Parameters {
+		parameters[k] = v
+	}
+	for k, v := range resourceParameters {
+		parameters[k] = v
+	}
+
+	return !equalParameters(status.Parameters, parameters)
+}
+
+// equalParameters compares the parameters by their JSON representation, as the parameters recorded in the recipe
+// status are read back from the store and may not have the same Go types as the parameters of the recipe.
+func equalParameters(a, b map[string]any) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(aJSON, bJSON)
+}
@@ -0,0 +1,453 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const testResourceType = "Applications.Test/testResources"
+
+func testUpgradeResource(name string, recipeStatus map[string]any, manual bool) *store.Object {
+	id := "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Test/testResources/" + name
+	properties := map[string]any{
+		"application": TestApplicationID,
+		"environment": TestEnvironmentID,
+		"recipe": map[string]any{
+			"name": "default",
+		},
+	}
+	if recipeStatus != nil {
+		properties["status"] = map[string]any{"recipe": recipeStatus}
+	}
+	if manual {
+		properties["resourceProvisioning"] = "manual"
+	}
+	return &store.Object{
+		Metadata: store.Metadata{ID: id},
+		Data: map[string]any{
+			"id":         id,
+			"name":       name,
+			"type":       testResourceType,
+			"properties": properties,
+		},
+	}
+}
+
+func TestUpgradeRecipes_Run(t *testing.T) {
+	definition := &recipes.EnvironmentDefinition{
+		Name:            "default",
+		Driver:          recipes.TemplateKindTerraform,
+		TemplatePath:    "Azure/redis/azurerm",
+		TemplateVersion: "1.1.0",
+	}
+	upToDate := map[string]any{"templateKind": "terraform", "templatePath": "Azure/redis/azurerm", "templateVersion": "1.1.0", "templateDigest": "sha256:1"}
+	outdated := map[string]any{"templateKind": "terraform", "templatePath": "Azure/redis/azurerm", "templateVersion": "1.0.0"}
+
+	resourceID := func(name string) string {
+		return "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Test/testResources/" + name
+	}
+
+	cases := []struct {
+		description      string
+		batchSize        int
+		failures         map[string]bool
+		loadErr          error
+		digestErr        error
+		saveErr          error
+		expectedUpgraded []string
+		expectedStatus   corerp_dm.RecipeUpgradeStatus
+	}{
+		{
+			description:      "upgrade all outdated resources",
+			batchSize:        2,
+			expectedUpgraded: []string{resourceID("a"), resourceID("b"), resourceID("c")},
+			expectedStatus: corerp_dm.RecipeUpgradeStatus{
+				State:     corerp_dm.RecipeUpgradeStateSucceeded,
+				BatchSize: 2,
+				Total:     3,
+				Upgraded:  3,
+			},
+		},
+		{
+			description:      "default batch size",
+			expectedUpgraded: []string{resourceID("a"), resourceID("b"), resourceID("c")},
+			expectedStatus: corerp_dm.RecipeUpgradeStatus{
+				State:     corerp_dm.RecipeUpgradeStateSucceeded,
+				BatchSize: corerp_dm.DefaultRecipeUpgradeBatchSize,
+				Total:     3,
+				Upgraded:  3,
+			},
+		},
+		{
+			description:      "halt after failed batch",
+			batchSize:        1,
+			failures:         map[string]bool{resourceID("b"): true},
+			expectedUpgraded: []string{resourceID("a"), resourceID("b")},
+			expectedStatus: corerp_dm.RecipeUpgradeStatus{
+				State:           corerp_dm.RecipeUpgradeStateFailed,
+				BatchSize:       1,
+				Total:           3,
+				Upgraded:        1,
+				FailedResources: []string{resourceID("b")},
+				Message:         "The recipe upgrade was halted because the recipes of 1 resource(s) failed to deploy. 1 of 3 resource(s) were upgraded.",
+			},
+		},
+		{
+			description: "recipe load failure",
+			batchSize:   1,
+			loadErr:     errors.New("recipe not found"),
+			expectedStatus: corerp_dm.RecipeUpgradeStatus{
+				State:           corerp_dm.RecipeUpgradeStateFailed,
+				BatchSize:       1,
+				Total:           4,
+				FailedResources: []string{resourceID("a"), resourceID("b"), resourceID("c"), resourceID("d")},
+				Message:         "The recipe upgrade was halted because the recipes of 4 resource(s) could not be loaded. 0 of 4 resource(s) were upgraded.",
+			},
+		},
+		{
+			description: "template digest failure",
+			batchSize:   1,
+			digestErr:   errors.New("registry unavailable"),
+			expectedStatus: corerp_dm.RecipeUpgradeStatus{
+				State:           corerp_dm.RecipeUpgradeStateFailed,
+				BatchSize:       1,
+				Total:           5,
+				FailedResources: []string{resourceID("d"), resourceID("e")},
+				Message:         "The recipe upgrade was halted because the recipes of 2 resource(s) could not be loaded. 0 of 5 resource(s) were upgraded.",
+			},
+		},
+		{
+			description: "environment changed concurrently",
+			batchSize:   1,
+			saveErr:     &store.ErrConcurrency{},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			envStorage := store.NewMockStorageClient(mctrl)
+			resourceStorage := store.NewMockStorageClient(mctrl)
+			dataProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+			cfg := configloader.NewMockConfigurationLoader(mctrl)
+
+			env := &corerp_dm.Environment{}
+			env.ID = TestEnvironmentID
+			if tt.batchSize > 0 {
+				env.Properties.RecipeUpgrade = &corerp_dm.RecipeUpgradeStatus{State: corerp_dm.RecipeUpgradeStateInProgress, BatchSize: tt.batchSize}
+			}
+			envStorage.EXPECT().
+				Get(gomock.Any(), TestEnvironmentID).
+				Return(&store.Object{Metadata: store.Metadata{ID: TestEnvironmentID, ETag: "etag-0"}, Data: env}, nil)
+
+			// Every save is expected to use the etag of the previous save.
+			var saved *corerp_dm.RecipeUpgradeStatus
+			saves := 0
+			envStorage.EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+					require.Equal(t, fmt.Sprintf("etag-%d", saves), store.NewSaveConfig(options...).ETag)
+					if tt.saveErr != nil {
+						return tt.saveErr
+					}
+					saves++
+					obj.ETag = fmt.Sprintf("etag-%d", saves)
+
+					status := *obj.Data.(*corerp_dm.Environment).Properties.RecipeUpgrade
+					require.False(t, status.LastUpdatedTime.IsZero())
+					saved = &status
+					return nil
+				}).
+				AnyTimes()
+
+			dataProvider.EXPECT().
+				GetStorageClient(gomock.Any(), testResourceType).
+				Return(resourceStorage, nil).
+				AnyTimes()
+
+			// d and e are up to date, and f is not provisioned by a recipe.
+			resourceStorage.EXPECT().
+				Query(gomock.Any(), store.Query{
+					RootScope:      "/planes/radius/local",
+					ScopeRecursive: true,
+					ResourceType:   testResourceType,
+					Filters:        []store.QueryFilter{{Field: "properties.environment", Value: TestEnvironmentID}},
+				}, gomock.Any()).
+				Return(&store.ObjectQueryResult{
+					Items: []store.Object{
+						*testUpgradeResource("c", outdated, false),
+						*testUpgradeResource("e", upToDate, false),
+						*testUpgradeResource("a", nil, false),
+						*testUpgradeResource("f", nil, true),
+						*testUpgradeResource("b", outdated, false),
+						*testUpgradeResource("d", upToDate, false),
+					},
+				}, nil)
+
+			cfg.EXPECT().
+				LoadRecipe(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error) {
+					require.Equal(t, TestEnvironmentID, recipe.EnvironmentID)
+					require.Equal(t, "default", recipe.Name)
+					if tt.loadErr != nil {
+						// Fail for every resource but e so that the failures are reported in order.
+						if recipe.ResourceID == resourceID("e") {
+							return definition, nil
+						}
+						return nil, tt.loadErr
+					}
+					return definition, nil
+				}).
+				AnyTimes()
+
+			// The digest of the template is resolved once for all the resources.
+			eng := engine.NewMockEngine(mctrl)
+			eng.EXPECT().
+				GetTemplateDigest(gomock.Any(), engine.GetRecipeMetadataOptions{EnvironmentID: TestEnvironmentID, RecipeDefinition: *definition}).
+				Return("sha256:1", tt.digestErr).
+				MaxTimes(1)
+
+			mu := &sync.Mutex{}
+			upgraded := []string{}
+			client := processors.NewMockResourceClient(mctrl)
+			client.EXPECT().
+				Redeploy(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string) error {
+					mu.Lock()
+					defer mu.Unlock()
+					upgraded = append(upgraded, id)
+					if tt.failures[id] {
+						return &processors.ResourceError{ID: id, Inner: errors.New("deployment failed")}
+					}
+					return nil
+				}).
+				AnyTimes()
+
+			opts := ctrl.Options{
+				StorageClient: envStorage,
+				DataProvider:  dataProvider,
+			}
+			controller, err := NewUpgradeRecipes(opts, []string{testResourceType}, client, cfg, eng)
+			require.NoError(t, err)
+
+			req := &ctrl.Request{ResourceID: TestEnvironmentID, OperationID: uuid.New()}
+			res, err := controller.Run(context.Background(), req)
+			if tt.saveErr != nil {
+				require.ErrorIs(t, err, tt.saveErr)
+				require.Empty(t, upgraded)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectedStatus.State == corerp_dm.RecipeUpgradeStateFailed {
+				require.NotNil(t, res.Error)
+				require.Equal(t, tt.expectedStatus.Message, res.Error.Message)
+			} else {
+				require.Nil(t, res.Error)
+			}
+
+			require.ElementsMatch(t, tt.expectedUpgraded, upgraded)
+			require.NotNil(t, saved)
+			saved.LastUpdatedTime = tt.expectedStatus.LastUpdatedTime
+			tt.expectedStatus.OperationID = req.OperationID.String()
+			require.Equal(t, tt.expectedStatus, *saved)
+		})
+	}
+}
+
+func TestUpgradeRecipes_OnFailure(t *testing.T) {
+	operationID := uuid.New()
+	result := ctrl.NewCanceledResult("Operation (APPLICATIONS.CORE/ENVIRONMENTS|UPGRADERECIPES) has timed out.")
+
+	cases := []struct {
+		description string
+		status      *corerp_dm.RecipeUpgradeStatus
+		expected    *corerp_dm.RecipeUpgradeStatus
+	}{
+		{
+			description: "upgrade in progress",
+			status:      &corerp_dm.RecipeUpgradeStatus{State: corerp_dm.RecipeUpgradeStateInProgress, OperationID: operationID.String(), Total: 3, Upgraded: 1},
+			expected: &corerp_dm.RecipeUpgradeStatus{
+				State:       corerp_dm.RecipeUpgradeStateFailed,
+				OperationID: operationID.String(),
+				Total:       3,
+				Upgraded:    1,
+				Message:     "The recipe upgrade was stopped: Operation (APPLICATIONS.CORE/ENVIRONMENTS|UPGRADERECIPES) has timed out. 1 of 3 resource(s) were upgraded.",
+			},
+		},
+		{
+			description: "upgrade of another operation",
+			status:      &corerp_dm.RecipeUpgradeStatus{State: corerp_dm.RecipeUpgradeStateInProgress, OperationID: uuid.New().String()},
+		},
+		{
+			description: "upgrade completed",
+			status:      &corerp_dm.RecipeUpgradeStatus{State: corerp_dm.RecipeUpgradeStateSucceeded, OperationID: operationID.String()},
+		},
+		{
+			description: "no upgrade",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			envStorage := store.NewMockStorageClient(mctrl)
+
+			env := &corerp_dm.Environment{}
+			env.ID = TestEnvironmentID
+			env.Properties.RecipeUpgrade = tt.status
+			envStorage.EXPECT().
+				Get(gomock.Any(), TestEnvironmentID).
+				Return(&store.Object{Metadata: store.Metadata{ID: TestEnvironmentID, ETag: "etag"}, Data: env}, nil)
+
+			if tt.expected != nil {
+				envStorage.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						require.Equal(t, "etag", store.NewSaveConfig(options...).ETag)
+						status := *obj.Data.(*corerp_dm.Environment).Properties.RecipeUpgrade
+						require.False(t, status.LastUpdatedTime.IsZero())
+						status.LastUpdatedTime = tt.expected.LastUpdatedTime
+						require.Equal(t, *tt.expected, status)
+						return nil
+					})
+			}
+
+			controller, err := NewUpgradeRecipes(ctrl.Options{StorageClient: envStorage}, nil, nil, nil, nil)
+			require.NoError(t, err)
+
+			err = controller.(ctrl.FailureHandler).OnFailure(context.Background(), &ctrl.Request{ResourceID: TestEnvironmentID, OperationID: operationID}, result)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIsRecipeOutdated(t *testing.T) {
+	definition := &recipes.EnvironmentDefinition{
+		Driver:          recipes.TemplateKindBicep,
+		TemplatePath:    "radiusdev.azurecr.io/recipes/redis:1.0",
+		TemplateVersion: "",
+		Parameters:      map[string]any{"size": "small", "replicas": 1},
+	}
+	status := func() *rpv1.RecipeStatus {
+		return &rpv1.RecipeStatus{
+			TemplateKind:   recipes.TemplateKindBicep,
+			TemplatePath:   "radiusdev.azurecr.io/recipes/redis:1.0",
+			TemplateDigest: "sha256:1",
+			Parameters:     map[string]any{"size": "small", "replicas": float64(1)},
+		}
+	}
+
+	cases := []struct {
+		description        string
+		status             func() *rpv1.RecipeStatus
+		resourceParameters map[string]any
+		digest             string
+		expected           bool
+	}{
+		{
+			description: "up to date",
+			status:      status,
+			digest:      "sha256:1",
+		},
+		{
+			description: "not deployed by a recipe",
+			status:      func() *rpv1.RecipeStatus { return nil },
+			expected:    true,
+		},
+		{
+			description: "template changed",
+			status: func() *rpv1.RecipeStatus {
+				s := status()
+				s.TemplatePath = "radiusdev.azurecr.io/recipes/redis:0.9"
+				return s
+			},
+			digest:   "sha256:1",
+			expected: true,
+		},
+		{
+			description: "template pushed again with the same tag",
+			status:      status,
+			digest:      "sha256:2",
+			expected:    true,
+		},
+		{
+			description: "digest not supported by the driver",
+			status:      status,
+		},
+		{
+			description: "digest not recorded",
+			status: func() *rpv1.RecipeStatus {
+				s := status()
+				s.TemplateDigest = ""
+				return s
+			},
+			digest: "sha256:2",
+		},
+		{
+			description: "environment parameters changed",
+			status: func() *rpv1.RecipeStatus {
+				s := status()
+				s.Parameters["size"] = "large"
+				return s
+			},
+			digest:   "sha256:1",
+			expected: true,
+		},
+		{
+			description: "overridden by the resource parameters",
+			status: func() *rpv1.RecipeStatus {
+				s := status()
+				s.Parameters["size"] = "large"
+				return s
+			},
+			resourceParameters: map[string]any{"size": "large"},
+			digest:             "sha256:1",
+		},
+		{
+			description:        "resource parameters changed",
+			status:             status,
+			resourceParameters: map[string]any{"size": "large"},
+			digest:             "sha256:1",
+			expected:           true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.expected, isRecipeOutdated(tt.status(), definition, tt.resourceParameters, tt.digest))
+		})
+	}
+}
@@ -37,8 +37,12 @@ import (
 	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/messagingrp/processors/rabbitmqqueues"
+	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 	"github.com/radius-project/radius/pkg/portableresources/frontend/handler"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	recipes_engine "github.com/radius-project/radius/pkg/recipes/engine"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	backend_ctrl "github.com/radius-project/radius/pkg/portableresources/backend/controller"
@@ -89,6 +93,10 @@ func (s *Service) Run(ctx context.Context) error {
 		TypeName               string
 		CreatePutController    func(options ctrl.Options) (ctrl.Controller, error)
 		CreateDeleteController func(options ctrl.Options) (ctrl.Controller, error)
+		// CreateDetectDriftController creates the controller detecting the drift of the infrastructure deployed by the recipe.
+		CreateDetectDriftController func(options ctrl.Options) (ctrl.Controller, error)
+		// CreateReconcileDriftController creates the controller detecting the drift and re-applying the recipe when drift is detected.
+		CreateReconcileDriftController func(options ctrl.Options) (ctrl.Controller, error)
 	}{
 		{
 			msg_ctrl.RabbitMQQueuesResourceType,
@@ -100,6 +108,13 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &rabbitmqqueues.Processor{}
 				return backend_ctrl.NewDeleteResource[*msg_dm.RabbitMQQueue, msg_dm.RabbitMQQueue](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewDetectDriftResource[*msg_dm.RabbitMQQueue, msg_dm.RabbitMQQueue](options, engine)
+			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				processor := &rabbitmqqueues.Processor{}
+				return backend_ctrl.NewReconcileDriftResource[*msg_dm.RabbitMQQueue, msg_dm.RabbitMQQueue](options, processor, engine, client, configLoader)
+			},
 		},
 		{
 			dapr_ctrl.DaprStateStoresResourceType,
@@ -111,6 +126,13 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &statestores.Processor{Client: k8s.RuntimeClient}
 				return backend_ctrl.NewDeleteResource[*dapr_dm.DaprStateStore, dapr_dm.DaprStateStore](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewDetectDriftResource[*dapr_dm.DaprStateStore, dapr_dm.DaprStateStore](options, engine)
+			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				processor := &statestores.Processor{Client: k8s.RuntimeClient}
+				return backend_ctrl.NewReconcileDriftResource[*dapr_dm.DaprStateStore, dapr_dm.DaprStateStore](options, processor, engine, client, configLoader)
+			},
 		},
 		{
 			dapr_ctrl.DaprSecretStoresResourceType,
@@ -122,6 +144,13 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &secretstores.Processor{Client: k8s.RuntimeClient}
 				return backend_ctrl.NewDeleteResource[*dapr_dm.DaprSecretStore, dapr_dm.DaprSecretStore](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewDetectDriftResource[*dapr_dm.DaprSecretStore, dapr_dm.DaprSecretStore](options, engine)
+			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				processor := &secretstores.Processor{Client: k8s.RuntimeClient}
+				return backend_ctrl.NewReconcileDriftResource[*dapr_dm.DaprSecretStore, dapr_dm.DaprSecretStore](options, processor, engine, client, configLoader)
+			},
 		},
 		{
 			dapr_ctrl.DaprPubSubBrokersResourceType,
@@ -133,6 +162,13 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &pubsubbrokers.Processor{Client: k8s.RuntimeClient}
 				return backend_ctrl.NewDeleteResource[*dapr_dm.DaprPubSubBroker, dapr_dm.DaprPubSubBroker](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewDetectDriftResource[*dapr_dm.DaprPubSubBroker, dapr_dm.DaprPubSubBroker](options, engine)
+			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				processor := &pubsubbrokers.Processor{Client: k8s.RuntimeClient}
+				return backend_ctrl.NewReconcileDriftResource[*dapr_dm.DaprPubSubBroker, dapr_dm.DaprPubSubBroker](options, processor, engine, client, configLoader)
+			},
 		},
 		{
 			ds_ctrl.MongoDatabasesResourceType,
@@ -144,6 +180,13 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &mongo_prc.Processor{}
 				return backend_ctrl.NewDeleteResource[*ds_dm.MongoDatabase, ds_dm.MongoDatabase](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewDetectDriftResource[*ds_dm.MongoDatabase, ds_dm.MongoDatabase](options, engine)
+			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				processor := &mongo_prc.Processor{}
+				return backend_ctrl.NewReconcileDriftResource[*ds_dm.MongoDatabase, ds_dm.MongoDatabase](options, processor, engine, client, configLoader)
+			},
 		},
 		{
 			ds_ctrl.RedisCachesResourceType,
@@ -155,6 +198,13 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &redis_prc.Processor{}
 				return backend_ctrl.NewDeleteResource[*ds_dm.RedisCache, ds_dm.RedisCache](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewDetectDriftResource[*ds_dm.RedisCache, ds_dm.RedisCache](options, engine)
+			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				processor := &redis_prc.Processor{}
+				return backend_ctrl.NewReconcileDriftResource[*ds_dm.RedisCache, ds_dm.RedisCache](options, processor, engine, client, configLoader)
+			},
 		},
 		{
 			ds_ctrl.SqlDatabasesResourceType,
@@ -166,6 +216,13 @@ func (s *Service) Run(ctx context.Context) error {
 				processor := &sql_prc.Processor{}
 				return backend_ctrl.NewDeleteResource[*ds_dm.SqlDatabase, ds_dm.SqlDatabase](options, processor, engine, configLoader)
 			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				return backend_ctrl.NewDetectDriftResource[*ds_dm.SqlDatabase, ds_dm.SqlDatabase](options, engine)
+			},
+			func(options ctrl.Options) (ctrl.Controller, error) {
+				processor := &sql_prc.Processor{}
+				return backend_ctrl.NewReconcileDriftResource[*ds_dm.SqlDatabase, ds_dm.SqlDatabase](options, processor, engine, client, configLoader)
+			},
 		},
 	}
 
@@ -184,8 +241,18 @@ func (s *Service) Run(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		err = s.Controllers.Register(ctx, rt.TypeName, pr_ctrl.OperationDetectDrift, rt.CreateDetectDriftController, opts)
+		if err != nil {
+			return err
+		}
+		err = s.Controllers.Register(ctx, rt.TypeName, pr_ctrl.OperationReconcileDrift, rt.CreateReconcileDriftController, opts)
+		if err != nil {
+			return err
+		}
+	}
+	workerOpts := worker.Options{
+		DeadLetterScope: worker.DeadLetterScope(handler.PortableResourcesNamespace, s.Options.Config.Env.RoleLocation),
 	}
-	workerOpts := worker.Options{}
 	if s.Options.Config.WorkerServer != nil {
 		if s.Options.Config.WorkerServer.MaxOperationConcurrency != nil {
 			workerOpts.MaxOperationConcurrency = *s.Options.Config.WorkerServer.MaxOperationConcurrency
@@ -197,3 +264,35 @@ func (s *Service) Run(ctx context.Context) error {
 
 	return s.Start(ctx, workerOpts)
 }
+
+// NewCreateOrUpdateControllers returns the factories of the controllers deploying the recipes of the portable resources,
+// keyed by resource type. The factories are used to re-apply the recipes of the resources of an environment when the
+// recipes of the environment are upgraded.
+func NewCreateOrUpdateControllers(engine recipes_engine.Engine, client processors.ResourceClient, configLoader configloader.ConfigurationLoader) map[string]func(options ctrl.Options) (ctrl.Controller, error) {
+	return map[string]func(options ctrl.Options) (ctrl.Controller, error){
+		msg_ctrl.RabbitMQQueuesResourceType: func(options ctrl.Options) (ctrl.Controller, error) {
+			return backend_ctrl.NewCreateOrUpdateResource[*msg_dm.RabbitMQQueue, msg_dm.RabbitMQQueue](options, &rabbitmqqueues.Processor{}, engine, client, configLoader)
+		},
+		dapr_ctrl.DaprStateStoresResourceType: func(options ctrl.Options) (ctrl.Controller, error) {
+			processor := &statestores.Processor{Client: options.KubeClient}
+			return backend_ctrl.NewCreateOrUpdateResource[*dapr_dm.DaprStateStore, dapr_dm.DaprStateStore](options, processor, engine, client, configLoader)
+		},
+		dapr_ctrl.DaprSecretStoresResourceType: func(options ctrl.Options) (ctrl.Controller, error) {
+			processor := &secretstores.Processor{Client: options.KubeClient}
+			return backend_ctrl.NewCreateOrUpdateResource[*dapr_dm.DaprSecretStore, dapr_dm.DaprSecretStore](options, processor, engine, client, configLoader)
+		},
+		dapr_ctrl.DaprPubSubBrokersResourceType: func(options ctrl.Options) (ctrl.Controller, error) {
+			processor := &pubsubbrokers.Processor{Client: options.KubeClient}
+			return backend_ctrl.NewCreateOrUpdateResource[*dapr_dm.DaprPubSubBroker, dapr_dm.DaprPubSubBroker](options, processor, engine, client, configLoader)
+		},
+		ds_ctrl.MongoDatabasesResourceType: func(options ctrl.Options) (ctrl.Controller, error) {
+			return backend_ctrl.NewCreateOrUpdateResource[*ds_dm.MongoDatabase, ds_dm.MongoDatabase](options, &mongo_prc.Processor{}, engine, client, configLoader)
+		},
+		ds_ctrl.RedisCachesResourceType: func(options ctrl.Options) (ctrl.Controller, error) {
+			return backend_ctrl.NewCreateOrUpdateResource[*ds_dm.RedisCache, ds_dm.RedisCache](options, &redis_prc.Processor{}, engine, client, configLoader)
+		},
+		ds_ctrl.SqlDatabasesResourceType: func(options ctrl.Options) (ctrl.Controller, error) {
+			return backend_ctrl.NewCreateOrUpdateResource[*ds_dm.SqlDatabase, ds_dm.SqlDatabase](options, &sql_prc.Processor{}, engine, client, configLoader)
+		},
+	}
+}
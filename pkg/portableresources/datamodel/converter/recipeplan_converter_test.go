@@ -0,0 +1,37 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/portableresources/api/v20231001preview"
+	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipePlanDataModelToVersioned(t *testing.T) {
+	dm := &pr_dm.RecipePlan{}
+
+	am, err := RecipePlanDataModelToVersioned(dm, v20231001preview.Version)
+	require.NoError(t, err)
+	require.IsType(t, &v20231001preview.RecipePlan{}, am)
+
+	_, err = RecipePlanDataModelToVersioned(dm, "unsupported")
+	require.ErrorIs(t, err, v1.ErrUnsupportedAPIVersion)
+}
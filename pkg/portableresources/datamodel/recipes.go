@@ -22,6 +22,7 @@ package datamodel
 
 import (
 	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
 )
 
 // RecipeDataModel should be implemented on the datamodel of types that support recipes.
@@ -29,3 +30,17 @@ type RecipeDataModel interface {
 	// Recipe provides access to the user-specified recipe configuration. Can return nil.
 	Recipe() *portableresources.ResourceRecipe
 }
+
+// RecipePlan represents the preview of the changes a deployment of the recipe of a portable resource would make to the
+// infrastructure.
+type RecipePlan struct {
+	recipes.RecipePlan
+
+	// ResourceType is the type of the portable resource the recipe is deployed for.
+	ResourceType string
+}
+
+// ResourceTypeName returns the type of the portable resource the recipe is deployed for.
+func (p *RecipePlan) ResourceTypeName() string {
+	return p.ResourceType
+}
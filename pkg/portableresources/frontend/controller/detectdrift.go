@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const (
+	// OperationDetectDrift is the user defined operation to detect the drift of the infrastructure deployed by the
+	// recipe of a portable resource.
+	OperationDetectDrift v1.OperationMethod = "DETECTDRIFT"
+
+	// OperationReconcileDrift is the user defined operation to detect the drift of the infrastructure deployed by the
+	// recipe of a portable resource, and to re-apply the recipe when drift is detected.
+	OperationReconcileDrift v1.OperationMethod = "RECONCILEDRIFT"
+
+	// AsyncDetectDriftTimeout is the timeout for the async operations detecting and reconciling drift.
+	AsyncDetectDriftTimeout = time.Duration(60) * time.Minute
+)
+
+// DetectDrift is the controller implementation to queue the async operation detecting the drift of the infrastructure
+// deployed by the recipe of a portable resource. The controller handles both the detectDrift and reconcileDrift actions.
+type DetectDrift[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewDetectDrift creates a new DetectDrift controller.
+func NewDetectDrift[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T]) (ctrl.Controller, error) {
+	return &DetectDrift[P, T]{ctrl.NewOperation[P](opts, resourceOpts)}, nil
+}
+
+// Run validates that the resource is provisioned by a recipe, starts the async operation detecting the drift and returns an async response.
+func (c *DetectDrift[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for the action has name of the operation as suffix which should be removed to get the resource id.
+	// The async operation is tracked on the resource itself.
+	serviceCtx.ResourceID = serviceCtx.ResourceID.Truncate()
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	if r, err := c.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
+	recipeDataModel, supportsRecipes := any(old).(datamodel.RecipeDataModel)
+	if !supportsRecipes || recipeDataModel.Recipe() == nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Resource %q is not provisioned by a recipe. Drift can only be detected for resources provisioned by a recipe.", serviceCtx.ResourceID.Name())), nil
+	}
+
+	if r, err := c.PrepareAsyncOperation(ctx, old, v1.ProvisioningStateAccepted, c.AsyncOperationTimeout(), &etag); r != nil || err != nil {
+		return r, err
+	}
+
+	versioned, err := c.ResponseConverter()(old, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewAsyncOperationResponse(versioned, serviceCtx.Location, http.StatusAccepted, serviceCtx.ResourceID, serviceCtx.OperationID, serviceCtx.APIVersion, "", ""), nil
+}
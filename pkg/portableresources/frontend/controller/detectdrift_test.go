@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/datastoresrp/api/v20231001preview"
+	ds_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_conv "github.com/radius-project/radius/pkg/datastoresrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+)
+
+const testHeaderfile = "detectdrift_requestheaders.json"
+
+func TestDetectDrift_Run(t *testing.T) {
+	cases := []struct {
+		desc         string
+		getErr       error
+		manual       bool
+		curState     v1.ProvisioningState
+		expectedCode int
+	}{
+		{"detect-drift-non-existing-resource", &store.ErrNotFound{}, false, v1.ProvisioningStateSucceeded, http.StatusNotFound},
+		{"detect-drift-manual-resource", nil, true, v1.ProvisioningStateSucceeded, http.StatusBadRequest},
+		{"detect-drift-resource-not-in-terminal-state", nil, false, v1.ProvisioningStateUpdating, http.StatusConflict},
+		{"detect-drift-existing-resource", nil, false, v1.ProvisioningStateSucceeded, http.StatusAccepted},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			msc := store.NewMockStorageClient(mctrl)
+			msm := statusmanager.NewMockStatusManager(mctrl)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, testHeaderfile, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			dataModel := &ds_dm.RedisCache{}
+			err = json.Unmarshal(testutil.ReadFixture("rediscache_datamodel.json"), dataModel)
+			require.NoError(t, err)
+			dataModel.InternalMetadata.AsyncProvisioningState = tt.curState
+			if tt.manual {
+				dataModel.Properties.ResourceProvisioning = portableresources.ResourceProvisioningManual
+			}
+
+			msc.EXPECT().
+				Get(gomock.Any(), dataModel.ID).
+				Return(&store.Object{
+					Metadata: store.Metadata{ID: dataModel.ID},
+					Data:     dataModel,
+				}, tt.getErr).
+				Times(1)
+
+			if tt.expectedCode == http.StatusAccepted {
+				msm.EXPECT().
+					QueueAsyncOperation(gomock.Any(), gomock.Any(), statusmanager.QueueOperationOptions{
+						OperationTimeout: AsyncDetectDriftTimeout,
+						RetryAfter:       v1.DefaultRetryAfterDuration,
+					}).
+					Return(nil).
+					Times(1)
+				msc.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				StorageClient: msc,
+				StatusManager: msm,
+			}
+
+			ctl, err := NewDetectDrift(opts, ctrl.ResourceOptions[ds_dm.RedisCache]{
+				RequestConverter:      ds_conv.RedisCacheDataModelFromVersioned,
+				ResponseConverter:     ds_conv.RedisCacheDataModelToVersioned,
+				AsyncOperationTimeout: AsyncDetectDriftTimeout,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCode, w.Result().StatusCode)
+
+			if tt.expectedCode == http.StatusAccepted {
+				actualOutput := &v20231001preview.RedisCacheResource{}
+				err = json.Unmarshal(w.Body.Bytes(), actualOutput)
+				require.NoError(t, err)
+				require.Equal(t, v20231001preview.ProvisioningStateAccepted, *actualOutput.Properties.ProvisioningState)
+				require.NotEmpty(t, w.Header().Get("Location"))
+				require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+			}
+		})
+	}
+}
+
+func TestDetectDrift_Run_GetError(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	msc := store.NewMockStorageClient(mctrl)
+
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, testHeaderfile, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	msc.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("failed to get the resource from data store")).
+		Times(1)
+
+	ctl, err := NewDetectDrift(ctrl.Options{StorageClient: msc}, ctrl.ResourceOptions[ds_dm.RedisCache]{
+		ResponseConverter: ds_conv.RedisCacheDataModelToVersioned,
+	})
+	require.NoError(t, err)
+
+	_, err = ctl.Run(ctx, httptest.NewRecorder(), req)
+	require.Error(t, err)
+}
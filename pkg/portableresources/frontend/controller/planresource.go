@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/portableresources/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// OperationPlan is the user defined operation to preview the changes a deployment of the recipe of a portable
+// resource would make to the infrastructure.
+const OperationPlan v1.OperationMethod = "PLAN"
+
+// PlanConverter is the function converting the version-agnostic recipe plan to the versioned recipe plan.
+type PlanConverter func(model *datamodel.RecipePlan, version string) (v1.VersionedModelInterface, error)
+
+// PlanResource is the controller implementation to preview the changes a deployment of the recipe of a portable
+// resource would make to the infrastructure, without deploying it.
+type PlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+	engine        engine.Engine
+	planConverter PlanConverter
+}
+
+// NewPlanResource creates a new PlanResource controller.
+func NewPlanResource[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T], engine engine.Engine, planConverter PlanConverter) (ctrl.Controller, error) {
+	return &PlanResource[P, T]{
+		Operation:     ctrl.NewOperation[P](opts, resourceOpts),
+		engine:        engine,
+		planConverter: planConverter,
+	}, nil
+}
+
+// Run validates the resource in the request body the same way as a create or update request, and returns the
+// resources its recipe would create, update and delete. Resources that are not provisioned by a recipe return an
+// empty plan.
+func (c *PlanResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// Request route for the action has name of the operation as suffix which should be removed to get the resource id.
+	serviceCtx.ResourceID = serviceCtx.ResourceID.Truncate()
+
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r, err := c.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	for _, filter := range c.UpdateFilters() {
+		if resp, err := filter(ctx, newResource, old, c.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	plan := &datamodel.RecipePlan{
+		RecipePlan: recipes.RecipePlan{
+			Resources: []recipes.PlannedResource{},
+		},
+		ResourceType: serviceCtx.ResourceID.Type(),
+	}
+
+	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
+	recipeDataModel, supportsRecipes := any(newResource).(datamodel.RecipeDataModel)
+	if supportsRecipes && recipeDataModel.Recipe() != nil {
+		prevState := []string{}
+		if old != nil {
+			for _, outputResource := range P(old).OutputResources() {
+				prevState = append(prevState, outputResource.ID.String())
+			}
+		}
+
+		data := P(newResource)
+		input := recipeDataModel.Recipe()
+		recipePlan, err := c.engine.Plan(ctx, engine.PlanOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          input.Name,
+					Parameters:    input.Parameters,
+					EnvironmentID: data.ResourceMetadata().Environment,
+					ApplicationID: data.ResourceMetadata().Application,
+					ResourceID:    data.GetBaseResource().ID,
+				},
+			},
+			PreviousState: prevState,
+		})
+		if err != nil {
+			recipeError := &recipes.RecipeError{}
+			if errors.As(err, &recipeError) {
+				return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: recipeError.ErrorDetails}), nil
+			}
+			return nil, err
+		}
+
+		plan.RecipePlan = *recipePlan
+	}
+
+	versioned, err := c.planConverter(plan, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(versioned), nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/datastoresrp/api/v20231001preview"
+	ds_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
+	ds_conv "github.com/radius-project/radius/pkg/datastoresrp/datamodel/converter"
+	pr_conv "github.com/radius-project/radius/pkg/portableresources/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+)
+
+const (
+	testPlanHeaderfile = "plan_requestheaders.json"
+	testPlanResourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/applications.datastores/rediscaches/redis0"
+)
+
+func TestPlanResource_Run(t *testing.T) {
+	createdResourceID := "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.MemoryDB/Cluster/redis"
+	existingResourceID := "/planes/test/local/providers/Test.Namespace/testResources/test-resource"
+
+	cases := []struct {
+		desc              string
+		existing          bool
+		manual            bool
+		curState          v1.ProvisioningState
+		planErr           error
+		expectedPrevState []string
+		expectedCode      int
+		expectedPlan      *v20231001preview.RecipePlan
+	}{
+		{
+			desc:              "plan-new-resource",
+			expectedPrevState: []string{},
+			expectedCode:      http.StatusOK,
+			expectedPlan: &v20231001preview.RecipePlan{
+				Resources: []*v20231001preview.PlannedResource{
+					{ID: to.Ptr(createdResourceID), Action: to.Ptr(v20231001preview.PlanActionCreate)},
+				},
+			},
+		},
+		{
+			desc:              "plan-existing-resource",
+			existing:          true,
+			curState:          v1.ProvisioningStateSucceeded,
+			expectedPrevState: []string{existingResourceID},
+			expectedCode:      http.StatusOK,
+			expectedPlan: &v20231001preview.RecipePlan{
+				Resources: []*v20231001preview.PlannedResource{
+					{ID: to.Ptr(createdResourceID), Action: to.Ptr(v20231001preview.PlanActionCreate)},
+				},
+			},
+		},
+		{
+			desc:         "plan-manual-resource",
+			manual:       true,
+			expectedCode: http.StatusOK,
+			expectedPlan: &v20231001preview.RecipePlan{
+				Resources: []*v20231001preview.PlannedResource{},
+			},
+		},
+		{
+			desc:         "plan-resource-not-in-terminal-state",
+			existing:     true,
+			curState:     v1.ProvisioningStateUpdating,
+			expectedCode: http.StatusConflict,
+		},
+		{
+			desc:              "plan-recipe-error",
+			planErr:           recipes.NewRecipeError(recipes.RecipePlanFailed, "failed to plan the recipe", util.ExecutionError),
+			expectedPrevState: []string{},
+			expectedCode:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			msc := store.NewMockStorageClient(mctrl)
+			mEngine := engine.NewMockEngine(mctrl)
+
+			input := &v20231001preview.RedisCacheResource{}
+			err := json.Unmarshal(testutil.ReadFixture("rediscache_plan_input.json"), input)
+			require.NoError(t, err)
+			if tt.manual {
+				input.Properties.ResourceProvisioning = to.Ptr(v20231001preview.ResourceProvisioningManual)
+				input.Properties.Recipe = nil
+				input.Properties.Host = to.Ptr("myrediscache.redis.cache.windows.net")
+				input.Properties.Port = to.Ptr(int32(10255))
+			}
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, testPlanHeaderfile, input)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			if tt.existing {
+				dataModel := &ds_dm.RedisCache{}
+				err = json.Unmarshal(testutil.ReadFixture("rediscache_datamodel.json"), dataModel)
+				require.NoError(t, err)
+				dataModel.InternalMetadata.AsyncProvisioningState = tt.curState
+
+				msc.EXPECT().
+					Get(gomock.Any(), testPlanResourceID).
+					Return(&store.Object{
+						Metadata: store.Metadata{ID: dataModel.ID},
+						Data:     dataModel,
+					}, nil).
+					Times(1)
+			} else {
+				msc.EXPECT().
+					Get(gomock.Any(), testPlanResourceID).
+					Return(nil, &store.ErrNotFound{ID: testPlanResourceID}).
+					Times(1)
+			}
+
+			if tt.expectedPrevState != nil {
+				mEngine.EXPECT().
+					Plan(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, opts engine.PlanOptions) (*recipes.RecipePlan, error) {
+						require.Equal(t, "redis", opts.Recipe.Name)
+						require.Equal(t, map[string]any{"sku": "Premium"}, opts.Recipe.Parameters)
+						require.Equal(t, testPlanResourceID, opts.Recipe.ResourceID)
+						require.Equal(t, tt.expectedPrevState, opts.PreviousState)
+						if tt.planErr != nil {
+							return nil, tt.planErr
+						}
+						return &recipes.RecipePlan{
+							Resources: []recipes.PlannedResource{
+								{ID: createdResourceID, Action: recipes.PlanActionCreate},
+							},
+						}, nil
+					}).
+					Times(1)
+			}
+
+			ctl, err := NewPlanResource(ctrl.Options{StorageClient: msc}, ctrl.ResourceOptions[ds_dm.RedisCache]{
+				RequestConverter:  ds_conv.RedisCacheDataModelFromVersioned,
+				ResponseConverter: ds_conv.RedisCacheDataModelToVersioned,
+			}, mEngine, pr_conv.RecipePlanDataModelToVersioned)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			err = resp.Apply(ctx, w, req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCode, w.Result().StatusCode)
+
+			if tt.expectedPlan != nil {
+				actualOutput := &v20231001preview.RecipePlan{}
+				err = json.Unmarshal(w.Body.Bytes(), actualOutput)
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPlan, actualOutput)
+			}
+
+			if tt.planErr != nil {
+				actualOutput := &v1.ErrorResponse{}
+				err = json.Unmarshal(w.Body.Bytes(), actualOutput)
+				require.NoError(t, err)
+				require.Equal(t, recipes.RecipePlanFailed, actualOutput.Error.Code)
+			}
+		})
+	}
+}
+
+func TestPlanResource_Run_EngineError(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	msc := store.NewMockStorageClient(mctrl)
+	mEngine := engine.NewMockEngine(mctrl)
+
+	input := &v20231001preview.RedisCacheResource{}
+	err := json.Unmarshal(testutil.ReadFixture("rediscache_plan_input.json"), input)
+	require.NoError(t, err)
+
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, testPlanHeaderfile, input)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	msc.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, &store.ErrNotFound{ID: testPlanResourceID}).
+		Times(1)
+	mEngine.EXPECT().
+		Plan(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("failed to connect to the deployment engine")).
+		Times(1)
+
+	ctl, err := NewPlanResource(ctrl.Options{StorageClient: msc}, ctrl.ResourceOptions[ds_dm.RedisCache]{
+		RequestConverter:  ds_conv.RedisCacheDataModelFromVersioned,
+		ResponseConverter: ds_conv.RedisCacheDataModelToVersioned,
+	}, mEngine, pr_conv.RecipePlanDataModelToVersioned)
+	require.NoError(t, err)
+
+	_, err = ctl.Run(ctx, httptest.NewRecorder(), req)
+	require.Error(t, err)
+}
@@ -26,6 +26,7 @@ import (
 	frontend_ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	rp_frontend "github.com/radius-project/radius/pkg/rp/frontend"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/swagger"
@@ -43,6 +44,8 @@ import (
 	msg_conv "github.com/radius-project/radius/pkg/messagingrp/datamodel/converter"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	rmq_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller/rabbitmqqueues"
+	pr_conv "github.com/radius-project/radius/pkg/portableresources/datamodel/converter"
+	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
 )
 
 const (
@@ -66,7 +69,7 @@ const (
 )
 
 // AddRoutes configures routes and handlers for Datastores, Messaging, Dapr Resource Providers.
-func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts frontend_ctrl.Options, recipeEngine engine.Engine) error {
 	rootScopePath := ctrlOpts.PathBase
 	rootScopePath += getRootScopePath(isARM)
 
@@ -77,17 +80,17 @@ func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts fron
 		rootScopePath,
 	}
 
-	err := AddMessagingRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts)
+	err := AddMessagingRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts, recipeEngine)
 	if err != nil {
 		return err
 	}
 
-	err = AddDaprRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts)
+	err = AddDaprRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts, recipeEngine)
 	if err != nil {
 		return err
 	}
 
-	err = AddDatastoresRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts)
+	err = AddDatastoresRoutes(ctx, router, rootScopePath, prefixes, isARM, ctrlOpts, recipeEngine)
 	if err != nil {
 		return err
 	}
@@ -97,7 +100,7 @@ func AddRoutes(ctx context.Context, router chi.Router, isARM bool, ctrlOpts fron
 
 // AddMessagingRoutes configures the default ARM handlers and registers handlers for the RabbitMQQueue resource type for
 // the List, Get, Put, Patch and Delete operations.
-func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options, recipeEngine engine.Engine) error {
 	// Configure the default ARM handlers.
 	err := server.ConfigureDefaultHandlers(ctx, r, rootScopePath, isARM, MessagingProviderNamespace, NewGetOperations, ctrlOpts)
 	if err != nil {
@@ -215,6 +218,57 @@ func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string,
 				)
 			},
 		},
+		{
+			ParentRouter: rmqResourceRouter,
+			Path:         "/detectdrift",
+			ResourceType: msg_ctrl.RabbitMQQueuesResourceType,
+			Method:       pr_ctrl.OperationDetectDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[msg_dm.RabbitMQQueue]{
+						RequestConverter:         msg_conv.RabbitMQQueueDataModelFromVersioned,
+						ResponseConverter:        msg_conv.RabbitMQQueueDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: rmqResourceRouter,
+			Path:         "/reconciledrift",
+			ResourceType: msg_ctrl.RabbitMQQueuesResourceType,
+			Method:       pr_ctrl.OperationReconcileDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[msg_dm.RabbitMQQueue]{
+						RequestConverter:         msg_conv.RabbitMQQueueDataModelFromVersioned,
+						ResponseConverter:        msg_conv.RabbitMQQueueDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: rmqResourceRouter,
+			Path:         "/plan",
+			ResourceType: msg_ctrl.RabbitMQQueuesResourceType,
+			Method:       pr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewPlanResource(opt,
+					frontend_ctrl.ResourceOptions[msg_dm.RabbitMQQueue]{
+						RequestConverter:  msg_conv.RabbitMQQueueDataModelFromVersioned,
+						ResponseConverter: msg_conv.RabbitMQQueueDataModelToVersioned,
+						UpdateFilters: []frontend_ctrl.UpdateFilter[msg_dm.RabbitMQQueue]{
+							rp_frontend.PrepareRadiusResource[*msg_dm.RabbitMQQueue],
+						},
+					},
+					recipeEngine,
+					pr_conv.RecipePlanDataModelToVersioned,
+				)
+			},
+		},
 		{
 			ParentRouter:      rmqResourceRouter,
 			Path:              "/listsecrets",
@@ -235,7 +289,7 @@ func AddMessagingRoutes(ctx context.Context, r chi.Router, rootScopePath string,
 
 // AddDaprRoutes configures the default ARM handlers and adds handlers for Dapr resources such as Dapr PubSubBroker,
 // SecretStore and StateStore. It registers handlers for various operations on these resources.
-func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options, recipeEngine engine.Engine) error {
 
 	// Dapr - Configure the default ARM handlers.
 	err := server.ConfigureDefaultHandlers(ctx, r, rootScopePath, isARM, DaprProviderNamespace, NewGetOperations, ctrlOpts)
@@ -354,6 +408,58 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter: pubsubResourceRouter,
+			Path:         "/detectdrift",
+			ResourceType: dapr_ctrl.DaprPubSubBrokersResourceType,
+			Method:       pr_ctrl.OperationDetectDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprPubSubBroker]{
+						RequestConverter:         dapr_conv.PubSubBrokerDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.PubSubBrokerDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: pubsubResourceRouter,
+			Path:         "/reconciledrift",
+			ResourceType: dapr_ctrl.DaprPubSubBrokersResourceType,
+			Method:       pr_ctrl.OperationReconcileDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprPubSubBroker]{
+						RequestConverter:         dapr_conv.PubSubBrokerDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.PubSubBrokerDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: pubsubResourceRouter,
+			Path:         "/plan",
+			ResourceType: dapr_ctrl.DaprPubSubBrokersResourceType,
+			Method:       pr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewPlanResource(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprPubSubBroker]{
+						RequestConverter:  dapr_conv.PubSubBrokerDataModelFromVersioned,
+						ResponseConverter: dapr_conv.PubSubBrokerDataModelToVersioned,
+						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprPubSubBroker]{
+							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprPubSubBroker],
+							rp_frontend.PrepareDaprResource[*dapr_dm.DaprPubSubBroker],
+						},
+					},
+					recipeEngine,
+					pr_conv.RecipePlanDataModelToVersioned,
+				)
+			},
+		},
 	}
 
 	secretStorePlaneRouter := server.NewSubrouter(r, rootScopePath+"/providers/applications.dapr/secretstores", validator)
@@ -451,6 +557,58 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter: secretStoreResourceRouter,
+			Path:         "/detectdrift",
+			ResourceType: dapr_ctrl.DaprSecretStoresResourceType,
+			Method:       pr_ctrl.OperationDetectDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprSecretStore]{
+						RequestConverter:         dapr_conv.SecretStoreDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.SecretStoreDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: secretStoreResourceRouter,
+			Path:         "/reconciledrift",
+			ResourceType: dapr_ctrl.DaprSecretStoresResourceType,
+			Method:       pr_ctrl.OperationReconcileDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprSecretStore]{
+						RequestConverter:         dapr_conv.SecretStoreDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.SecretStoreDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: secretStoreResourceRouter,
+			Path:         "/plan",
+			ResourceType: dapr_ctrl.DaprSecretStoresResourceType,
+			Method:       pr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewPlanResource(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprSecretStore]{
+						RequestConverter:  dapr_conv.SecretStoreDataModelFromVersioned,
+						ResponseConverter: dapr_conv.SecretStoreDataModelToVersioned,
+						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprSecretStore]{
+							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprSecretStore],
+							rp_frontend.PrepareDaprResource[*dapr_dm.DaprSecretStore],
+						},
+					},
+					recipeEngine,
+					pr_conv.RecipePlanDataModelToVersioned,
+				)
+			},
+		},
 	}...)
 
 	stateStorePlaneRouter := server.NewSubrouter(r, rootScopePath+"/providers/applications.dapr/statestores", validator)
@@ -548,6 +706,58 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 				)
 			},
 		},
+		{
+			ParentRouter: stateStoreResourceRouter,
+			Path:         "/detectdrift",
+			ResourceType: dapr_ctrl.DaprStateStoresResourceType,
+			Method:       pr_ctrl.OperationDetectDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprStateStore]{
+						RequestConverter:         dapr_conv.StateStoreDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.StateStoreDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: stateStoreResourceRouter,
+			Path:         "/reconciledrift",
+			ResourceType: dapr_ctrl.DaprStateStoresResourceType,
+			Method:       pr_ctrl.OperationReconcileDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprStateStore]{
+						RequestConverter:         dapr_conv.StateStoreDataModelFromVersioned,
+						ResponseConverter:        dapr_conv.StateStoreDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: stateStoreResourceRouter,
+			Path:         "/plan",
+			ResourceType: dapr_ctrl.DaprStateStoresResourceType,
+			Method:       pr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewPlanResource(opt,
+					frontend_ctrl.ResourceOptions[dapr_dm.DaprStateStore]{
+						RequestConverter:  dapr_conv.StateStoreDataModelFromVersioned,
+						ResponseConverter: dapr_conv.StateStoreDataModelToVersioned,
+						UpdateFilters: []frontend_ctrl.UpdateFilter[dapr_dm.DaprStateStore]{
+							rp_frontend.PrepareRadiusResource[*dapr_dm.DaprStateStore],
+							rp_frontend.PrepareDaprResource[*dapr_dm.DaprStateStore],
+						},
+					},
+					recipeEngine,
+					pr_conv.RecipePlanDataModelToVersioned,
+				)
+			},
+		},
 	}...)
 
 	for _, h := range handlerOptions {
@@ -561,7 +771,7 @@ func AddDaprRoutes(ctx context.Context, r chi.Router, rootScopePath string, pref
 
 // AddDatastoresRoutes configures the routes and handlers for  Datastores Resource Provider. It registers handlers for List, Get, Put,
 // Patch, and Delete operations for MongoDatabase, RedisCache, and SqlDatabase resources.
-func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options) error {
+func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string, prefixes []string, isARM bool, ctrlOpts frontend_ctrl.Options, recipeEngine engine.Engine) error {
 	// Datastores - Configure the default ARM handlers.
 	err := server.ConfigureDefaultHandlers(ctx, r, rootScopePath, isARM, DatastoresProviderNamespace, NewGetOperations, ctrlOpts)
 	if err != nil {
@@ -678,6 +888,57 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 				)
 			},
 		},
+		{
+			ParentRouter: mongoResourceRouter,
+			Path:         "/detectdrift",
+			ResourceType: ds_ctrl.MongoDatabasesResourceType,
+			Method:       pr_ctrl.OperationDetectDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.MongoDatabase]{
+						RequestConverter:         ds_conv.MongoDatabaseDataModelFromVersioned,
+						ResponseConverter:        ds_conv.MongoDatabaseDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: mongoResourceRouter,
+			Path:         "/reconciledrift",
+			ResourceType: ds_ctrl.MongoDatabasesResourceType,
+			Method:       pr_ctrl.OperationReconcileDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.MongoDatabase]{
+						RequestConverter:         ds_conv.MongoDatabaseDataModelFromVersioned,
+						ResponseConverter:        ds_conv.MongoDatabaseDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: mongoResourceRouter,
+			Path:         "/plan",
+			ResourceType: ds_ctrl.MongoDatabasesResourceType,
+			Method:       pr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewPlanResource(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.MongoDatabase]{
+						RequestConverter:  ds_conv.MongoDatabaseDataModelFromVersioned,
+						ResponseConverter: ds_conv.MongoDatabaseDataModelToVersioned,
+						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.MongoDatabase]{
+							rp_frontend.PrepareRadiusResource[*ds_dm.MongoDatabase],
+						},
+					},
+					recipeEngine,
+					pr_conv.RecipePlanDataModelToVersioned,
+				)
+			},
+		},
 		{
 			ParentRouter:      mongoResourceRouter,
 			Path:              "/listsecrets",
@@ -780,6 +1041,57 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 				)
 			},
 		},
+		{
+			ParentRouter: redisResourceRouter,
+			Path:         "/detectdrift",
+			ResourceType: ds_ctrl.RedisCachesResourceType,
+			Method:       pr_ctrl.OperationDetectDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.RedisCache]{
+						RequestConverter:         ds_conv.RedisCacheDataModelFromVersioned,
+						ResponseConverter:        ds_conv.RedisCacheDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: redisResourceRouter,
+			Path:         "/reconciledrift",
+			ResourceType: ds_ctrl.RedisCachesResourceType,
+			Method:       pr_ctrl.OperationReconcileDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.RedisCache]{
+						RequestConverter:         ds_conv.RedisCacheDataModelFromVersioned,
+						ResponseConverter:        ds_conv.RedisCacheDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: redisResourceRouter,
+			Path:         "/plan",
+			ResourceType: ds_ctrl.RedisCachesResourceType,
+			Method:       pr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewPlanResource(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.RedisCache]{
+						RequestConverter:  ds_conv.RedisCacheDataModelFromVersioned,
+						ResponseConverter: ds_conv.RedisCacheDataModelToVersioned,
+						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.RedisCache]{
+							rp_frontend.PrepareRadiusResource[*ds_dm.RedisCache],
+						},
+					},
+					recipeEngine,
+					pr_conv.RecipePlanDataModelToVersioned,
+				)
+			},
+		},
 		{
 			ParentRouter:      redisResourceRouter,
 			Path:              "/listsecrets",
@@ -882,6 +1194,57 @@ func AddDatastoresRoutes(ctx context.Context, r chi.Router, rootScopePath string
 				)
 			},
 		},
+		{
+			ParentRouter: sqlResourceRouter,
+			Path:         "/detectdrift",
+			ResourceType: ds_ctrl.SqlDatabasesResourceType,
+			Method:       pr_ctrl.OperationDetectDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.SqlDatabase]{
+						RequestConverter:         ds_conv.SqlDatabaseDataModelFromVersioned,
+						ResponseConverter:        ds_conv.SqlDatabaseDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: sqlResourceRouter,
+			Path:         "/reconciledrift",
+			ResourceType: ds_ctrl.SqlDatabasesResourceType,
+			Method:       pr_ctrl.OperationReconcileDrift,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewDetectDrift(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.SqlDatabase]{
+						RequestConverter:         ds_conv.SqlDatabaseDataModelFromVersioned,
+						ResponseConverter:        ds_conv.SqlDatabaseDataModelToVersioned,
+						AsyncOperationTimeout:    pr_ctrl.AsyncDetectDriftTimeout,
+						AsyncOperationRetryAfter: AsyncOperationRetryAfter,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: sqlResourceRouter,
+			Path:         "/plan",
+			ResourceType: ds_ctrl.SqlDatabasesResourceType,
+			Method:       pr_ctrl.OperationPlan,
+			ControllerFactory: func(opt frontend_ctrl.Options) (frontend_ctrl.Controller, error) {
+				return pr_ctrl.NewPlanResource(opt,
+					frontend_ctrl.ResourceOptions[ds_dm.SqlDatabase]{
+						RequestConverter:  ds_conv.SqlDatabaseDataModelFromVersioned,
+						ResponseConverter: ds_conv.SqlDatabaseDataModelToVersioned,
+						UpdateFilters: []frontend_ctrl.UpdateFilter[ds_dm.SqlDatabase]{
+							rp_frontend.PrepareRadiusResource[*ds_dm.SqlDatabase],
+						},
+					},
+					recipeEngine,
+					pr_conv.RecipePlanDataModelToVersioned,
+				)
+			},
+		},
 		{
 			ParentRouter:      sqlResourceRouter,
 			Path:              "/listsecrets",
@@ -29,6 +29,8 @@ import (
 	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	pr_ctrl "github.com/radius-project/radius/pkg/portableresources/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
@@ -58,6 +60,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: pr_ctrl.OperationDetectDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/detectdrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: pr_ctrl.OperationReconcileDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/reconciledrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: pr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: msg_ctrl.RabbitMQQueuesResourceType, Method: msg_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.messaging/rabbitmqqueues/rabbitmq/listsecrets",
@@ -86,6 +100,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: pr_ctrl.OperationDetectDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub/detectdrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: pr_ctrl.OperationReconcileDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub/reconciledrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprPubSubBrokersResourceType, Method: pr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/pubsubbrokers/daprpubsub/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationList},
 		Path:          "/providers/applications.dapr/secretstores",
@@ -110,6 +136,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: pr_ctrl.OperationDetectDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore/detectdrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: pr_ctrl.OperationReconcileDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore/reconciledrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprSecretStoresResourceType, Method: pr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/secretstores/daprsecretstore/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationList},
 		Path:          "/providers/applications.dapr/statestores",
@@ -134,6 +172,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: pr_ctrl.OperationDetectDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore/detectdrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: pr_ctrl.OperationReconcileDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore/reconciledrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: dapr_ctrl.DaprStateStoresResourceType, Method: pr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.dapr/statestores/daprstatestore/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: v1.OperationList},
 		Path:          "/providers/applications.datastores/mongodatabases",
@@ -158,6 +208,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: pr_ctrl.OperationDetectDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/detectdrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: pr_ctrl.OperationReconcileDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/reconciledrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: pr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.MongoDatabasesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/mongodatabases/mongo/listsecrets",
@@ -186,6 +248,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: pr_ctrl.OperationDetectDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/detectdrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: pr_ctrl.OperationReconcileDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/reconciledrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: pr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.RedisCachesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/rediscaches/redis/listsecrets",
@@ -214,6 +288,18 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: pr_ctrl.OperationDetectDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/detectdrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: pr_ctrl.OperationReconcileDrift},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/reconciledrift",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: pr_ctrl.OperationPlan},
+		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/plan",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ds_ctrl.SqlDatabasesResourceType, Method: ds_ctrl.OperationListSecret},
 		Path:          "/resourcegroups/testrg/providers/applications.datastores/sqldatabases/sql/listsecrets",
@@ -248,12 +334,13 @@ func TestHandlers(t *testing.T) {
 	mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.Object{}, nil).AnyTimes()
 	mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(mockSC), nil).AnyTimes()
+	mockEngine := engine.NewMockEngine(mctrl)
 
 	t.Run("UCP", func(t *testing.T) {
 		// Test handlers for UCP resources.
 		rpctest.AssertRouters(t, handlerTests, "/api.ucp.dev", "/planes/radius/local", func(ctx context.Context) (chi.Router, error) {
 			r := chi.NewRouter()
-			return r, AddRoutes(ctx, r, false, ctrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP})
+			return r, AddRoutes(ctx, r, false, ctrl.Options{PathBase: "/api.ucp.dev", DataProvider: mockSP}, mockEngine)
 		})
 	})
 
@@ -286,7 +373,7 @@ func TestHandlers(t *testing.T) {
 		// Test handlers for Azure resources
 		rpctest.AssertRouters(t, azureHandlerTests, "", "/subscriptions/00000000-0000-0000-0000-000000000000", func(ctx context.Context) (chi.Router, error) {
 			r := chi.NewRouter()
-			return r, AddRoutes(ctx, r, true, ctrl.Options{PathBase: "", DataProvider: mockSP})
+			return r, AddRoutes(ctx, r, true, ctrl.Options{PathBase: "", DataProvider: mockSP}, mockEngine)
 		})
 	})
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/portableresources/frontend/handler"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 )
 
 type Service struct {
@@ -52,6 +53,13 @@ func (s *Service) Run(ctx context.Context) error {
 		return err
 	}
 
+	// The recipe engine is used to preview the changes the recipe of a portable resource would make to the
+	// infrastructure.
+	recipeControllerConfig, err := controllerconfig.New(s.Options)
+	if err != nil {
+		return err
+	}
+
 	opts := ctrl.Options{
 		Address:       fmt.Sprintf("%s:%d", s.Options.Config.Server.Host, s.Options.Config.Server.Port),
 		PathBase:      s.Options.Config.Server.PathBase,
@@ -60,7 +68,7 @@ func (s *Service) Run(ctx context.Context) error {
 		StatusManager: s.OperationStatusManager,
 	}
 
-	err := s.Start(ctx, server.Options{
+	err = s.Start(ctx, server.Options{
 		Address:     opts.Address,
 		ServiceName: s.ProviderName,
 		Location:    s.Options.Config.Env.RoleLocation,
@@ -68,8 +76,10 @@ func (s *Service) Run(ctx context.Context) error {
 		// set the arm cert manager for managing client certificate
 		ArmCertMgr:    s.ARMCertManager,
 		EnableArmAuth: s.Options.Config.Server.EnableArmAuth, // when enabled the client cert validation will be done
+		Authenticator: s.Authenticator,
+		Authorizer:    s.Authorizer,
 		Configure: func(router chi.Router) error {
-			err := handler.AddRoutes(ctx, router, !hostoptions.IsSelfHosted(), opts)
+			err := handler.AddRoutes(ctx, router, !hostoptions.IsSelfHosted(), opts, recipeControllerConfig.Engine)
 			if err != nil {
 				return err
 			}
@@ -18,10 +18,13 @@ package processors
 
 import (
 	context "context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
@@ -30,11 +33,13 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/trace"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -56,11 +61,15 @@ type resourceClient struct {
 
 	// k8sDiscoveryClient is the Kubernetes client to used for API version lookups on Kubernetes resources. Override this for testing.
 	k8sDiscoveryClient discovery.ServerResourcesInterface
+
+	// clusters provides the clients of the registered clusters, which are used for the Kubernetes resources of
+	// their plane. It may be nil, in which case the clients of the local cluster are always used.
+	clusters ucp_kubernetes.ClusterProvider
 }
 
 // NewResourceClient creates a new resourceClient instance with the given parameters.
-func NewResourceClient(arm *armauth.ArmConfig, connection sdk.Connection, k8sClient runtime_client.Client, k8sDiscoveryClient discovery.ServerResourcesInterface) *resourceClient {
-	return &resourceClient{arm: arm, connection: connection, k8sClient: k8sClient, k8sDiscoveryClient: k8sDiscoveryClient}
+func NewResourceClient(arm *armauth.ArmConfig, connection sdk.Connection, k8sClient runtime_client.Client, k8sDiscoveryClient discovery.ServerResourcesInterface, clusters ucp_kubernetes.ClusterProvider) *resourceClient {
+	return &resourceClient{arm: arm, connection: connection, k8sClient: k8sClient, k8sDiscoveryClient: k8sDiscoveryClient, clusters: clusters}
 }
 
 // Delete attempts to delete a resource, either through UCP, Azure, or Kubernetes, depending on the resource type.
@@ -89,6 +98,91 @@ func (c *resourceClient) Delete(ctx context.Context, id string) error {
 	}
 }
 
+// Exists checks whether a resource exists, either through UCP, Azure, or Kubernetes, depending on the resource type.
+func (c *resourceClient) Exists(ctx context.Context, id string) (bool, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	ns := strings.ToLower(parsed.PlaneNamespace())
+	if !parsed.IsUCPQualfied() || strings.HasPrefix(ns, "azure/") {
+		exists, err = c.azureResourceExists(ctx, parsed)
+	} else if strings.HasPrefix(ns, "kubernetes/") {
+		exists, err = c.kubernetesResourceExists(ctx, parsed)
+	} else {
+		exists, err = c.ucpResourceExists(ctx, parsed)
+	}
+
+	return exists, c.wrapError(parsed, err)
+}
+
+// Redeploy re-deploys a Radius resource through UCP. The writable fields of the resource are sent back to its API with
+// the etag returned with its definition, so that the resource is not re-deployed if it was changed in the meantime. The API queues the
+// operation deploying the resource in its resource provider, and Redeploy waits for the operation to complete.
+func (c *resourceClient) Redeploy(ctx context.Context, id string) error {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return err
+	}
+
+	ns := strings.ToLower(parsed.PlaneNamespace())
+	if !parsed.IsUCPQualfied() || strings.HasPrefix(ns, "azure/") || strings.HasPrefix(ns, "kubernetes/") || strings.HasPrefix(ns, "aws/") {
+		return c.wrapError(parsed, errors.New("only Radius resources can be re-deployed"))
+	}
+
+	return c.wrapError(parsed, c.redeployUCPResource(ctx, parsed))
+}
+
+// readOnlyProperties are the properties of Radius resources which are set by their resource provider.
+var readOnlyProperties = []string{"provisioningState", "status"}
+
+// writableResource returns the fields of the resource which can be sent back to its API. The id, name, type and
+// system data of the resource and its read-only properties are omitted.
+func writableResource(resource generated.GenericResource) generated.GenericResource {
+	properties := map[string]any{}
+	for k, v := range resource.Properties {
+		properties[k] = v
+	}
+	for _, k := range readOnlyProperties {
+		delete(properties, k)
+	}
+
+	return generated.GenericResource{
+		Location:   resource.Location,
+		Tags:       resource.Tags,
+		Properties: properties,
+	}
+}
+
+func (c *resourceClient) redeployUCPResource(ctx context.Context, id resources.ID) error {
+	// NOTE: see deleteUCPResource for the API version used by the generated client.
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		return err
+	}
+
+	var response *http.Response
+	resource, err := client.Get(runtime.WithCaptureResponse(ctx, &response), id.Name(), nil)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{}
+	if etag := response.Header.Get("ETag"); etag != "" {
+		header.Set("If-Match", etag)
+	}
+
+	poller, err := client.BeginCreateOrUpdate(runtime.WithHTTPHeader(ctx, header), id.Name(), writableResource(resource.GenericResource), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
 func (c *resourceClient) wrapError(id resources.ID, err error) error {
 	if err != nil {
 		return &ResourceError{Inner: err, ID: id.String()}
@@ -139,6 +233,35 @@ func (c *resourceClient) deleteAzureResource(ctx context.Context, id resources.I
 	return nil
 }
 
+func (c *resourceClient) azureResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	var err error
+	if id.IsUCPQualfied() {
+		id, err = resources.ParseResource(resources.MakeRelativeID(id.ScopeSegments()[1:], id.TypeSegments(), id.ExtensionSegments()))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	apiVersion, err := c.lookupARMAPIVersion(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := clientv2.NewGenericResourceClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.GetByID(ctx, id.String(), apiVersion, &armresources.ClientGetByIDOptions{})
+	if clients.Is404Error(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (c *resourceClient) lookupARMAPIVersion(ctx context.Context, id resources.ID) (string, error) {
 	client, err := clientv2.NewProvidersClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
 	if err != nil {
@@ -207,12 +330,85 @@ func (c *resourceClient) deleteUCPResource(ctx context.Context, id resources.ID)
 	return nil
 }
 
+func (c *resourceClient) ucpResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	// NOTE: see deleteUCPResource for the API version used by the generated client.
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.Get(ctx, id.Name(), nil)
+	if clients.Is404Error(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resources.ID) error {
-	apiVersion, err := c.lookupKubernetesAPIVersion(ctx, id)
+	k8sClient, k8sDiscoveryClient, err := c.kubernetesClients(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := c.newKubernetesObject(ctx, id, k8sDiscoveryClient)
 	if err != nil {
 		return err
 	}
 
+	err = runtime_client.IgnoreNotFound(k8sClient.Delete(ctx, obj))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *resourceClient) kubernetesResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	k8sClient, k8sDiscoveryClient, err := c.kubernetesClients(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	obj, err := c.newKubernetesObject(ctx, id, k8sDiscoveryClient)
+	if err != nil {
+		return false, err
+	}
+
+	err = k8sClient.Get(ctx, runtime_client.ObjectKeyFromObject(obj), obj)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// kubernetesClients returns the clients of the cluster of the plane of the Kubernetes resource with the given id.
+func (c *resourceClient) kubernetesClients(ctx context.Context, id resources.ID) (runtime_client.Client, discovery.ServerResourcesInterface, error) {
+	planeName := resources_kubernetes.PlaneNameFromID(id)
+	if c.clusters == nil || ucp_kubernetes.IsLocalPlane(planeName) {
+		return c.k8sClient, c.k8sDiscoveryClient, nil
+	}
+
+	cluster, err := c.clusters.GetCluster(ctx, planeName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cluster.Clients.RuntimeClient, cluster.Clients.DiscoveryClient, nil
+}
+
+// newKubernetesObject creates an unstructured object referencing the Kubernetes resource with the given id.
+func (c *resourceClient) newKubernetesObject(ctx context.Context, id resources.ID, k8sDiscoveryClient discovery.ServerResourcesInterface) (*unstructured.Unstructured, error) {
+	apiVersion, err := c.lookupKubernetesAPIVersion(ctx, id, k8sDiscoveryClient)
+	if err != nil {
+		return nil, err
+	}
+
 	group, kind, namespace, name := resources_kubernetes.ToParts(id)
 
 	metadata := map[string]any{
@@ -226,33 +422,26 @@ func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resour
 		apiVersion = fmt.Sprintf("%s/%s", group, apiVersion)
 	}
 
-	obj := unstructured.Unstructured{
+	return &unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": apiVersion,
 			"kind":       kind,
 			"metadata":   metadata,
 		},
-	}
-
-	err = runtime_client.IgnoreNotFound(c.k8sClient.Delete(ctx, &obj))
-	if err != nil {
-		return err
-	}
-
-	return nil
+	}, nil
 }
 
-func (c *resourceClient) lookupKubernetesAPIVersion(ctx context.Context, id resources.ID) (string, error) {
+func (c *resourceClient) lookupKubernetesAPIVersion(ctx context.Context, id resources.ID, k8sDiscoveryClient discovery.ServerResourcesInterface) (string, error) {
 	group, kind, namespace, _ := resources_kubernetes.ToParts(id)
 	var resourceLists []*v1.APIResourceList
 	var err error
 	if namespace == "" {
-		resourceLists, err = c.k8sDiscoveryClient.ServerPreferredResources()
+		resourceLists, err = k8sDiscoveryClient.ServerPreferredResources()
 		if err != nil {
 			return "", fmt.Errorf("could not find API version for type %q: %w", id.Type(), err)
 		}
 	} else {
-		resourceLists, err = c.k8sDiscoveryClient.ServerPreferredNamespacedResources()
+		resourceLists, err = k8sDiscoveryClient.ServerPreferredNamespacedResources()
 		if err != nil {
 			return "", fmt.Errorf("could not find API version for type %q: %w", id.Type(), err)
 		}
@@ -33,6 +33,7 @@ import (
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -48,10 +49,12 @@ const (
 	AWSResourceID                    = "/planes/aws/aws/accounts/0000/regions/us-east-1/providers/AWS.Kinesis/Streams/test-stream"
 	KubernetesCoreGroupResourceID    = "/planes/kubernetes/local/namespaces/test-namespace/providers/core/Secret/test-name"
 	KubernetesNonCoreGroupResourceID = "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/test-name"
+	KubernetesRemoteResourceID       = "/planes/kubernetes/cluster1/namespaces/test-namespace/providers/core/Secret/test-name"
+	RadiusResourceID                 = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test-redis"
 )
 
 func Test_Delete_InvalidResourceID(t *testing.T) {
-	c := NewResourceClient(nil, nil, nil, nil)
+	c := NewResourceClient(nil, nil, nil, nil, nil)
 	err := c.Delete(context.Background(), "invalid")
 	require.Error(t, err)
 }
@@ -68,7 +71,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -96,7 +99,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -119,7 +122,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -146,7 +149,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -160,7 +163,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -178,7 +181,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -202,7 +205,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -238,7 +241,7 @@ func Test_Delete_Kubernetes(t *testing.T) {
 			},
 		}
 
-		c := NewResourceClient(nil, nil, client, dc)
+		c := NewResourceClient(nil, nil, client, dc, nil)
 
 		err := c.Delete(context.Background(), KubernetesCoreGroupResourceID)
 		require.NoError(t, err)
@@ -266,7 +269,7 @@ func Test_Delete_Kubernetes(t *testing.T) {
 			},
 		}
 
-		c := NewResourceClient(nil, nil, client, dc)
+		c := NewResourceClient(nil, nil, client, dc, nil)
 
 		err := c.Delete(context.Background(), KubernetesCoreGroupResourceID)
 		require.NoError(t, err)
@@ -284,12 +287,31 @@ func Test_Delete_Kubernetes(t *testing.T) {
 			Resources: []*metav1.APIResourceList{},
 		}
 
-		c := NewResourceClient(nil, nil, client, dc)
+		c := NewResourceClient(nil, nil, client, dc, nil)
 
 		err := c.Delete(context.Background(), KubernetesCoreGroupResourceID)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "could not find API version for type \"core/Secret\", type was not found")
 	})
+
+	t.Run("failure - cluster not registered", func(t *testing.T) {
+		clusters := &testClusterProvider{}
+		c := NewResourceClient(nil, nil, nil, nil, clusters)
+
+		err := c.Delete(context.Background(), KubernetesRemoteResourceID)
+		require.ErrorIs(t, err, &ucp_kubernetes.ErrClusterNotRegistered{})
+		require.Equal(t, []string{"cluster1"}, clusters.planeNames)
+	})
+}
+
+// testClusterProvider is a ClusterProvider which records the requested planes. Only the local plane is registered.
+type testClusterProvider struct {
+	planeNames []string
+}
+
+func (p *testClusterProvider) GetCluster(ctx context.Context, planeName string) (*ucp_kubernetes.Cluster, error) {
+	p.planeNames = append(p.planeNames, planeName)
+	return nil, &ucp_kubernetes.ErrClusterNotRegistered{PlaneName: planeName}
 }
 
 func Test_Delete_UCP(t *testing.T) {
@@ -303,7 +325,7 @@ func Test_Delete_UCP(t *testing.T) {
 		connection, err := sdk.NewDirectConnection(server.URL)
 		require.NoError(t, err)
 
-		c := NewResourceClient(nil, connection, nil, nil)
+		c := NewResourceClient(nil, connection, nil, nil, nil)
 
 		err = c.Delete(context.Background(), AWSResourceID)
 		require.NoError(t, err)
@@ -319,7 +341,7 @@ func Test_Delete_UCP(t *testing.T) {
 		connection, err := sdk.NewDirectConnection(server.URL)
 		require.NoError(t, err)
 
-		c := NewResourceClient(nil, connection, nil, nil)
+		c := NewResourceClient(nil, connection, nil, nil, nil)
 
 		err = c.Delete(context.Background(), AWSResourceID)
 		require.NoError(t, err)
@@ -339,7 +361,7 @@ func Test_Delete_UCP(t *testing.T) {
 		connection, err := sdk.NewDirectConnection(server.URL)
 		require.NoError(t, err)
 
-		c := NewResourceClient(nil, connection, nil, nil)
+		c := NewResourceClient(nil, connection, nil, nil, nil)
 
 		err = c.Delete(context.Background(), AWSResourceID)
 		require.Error(t, err)
@@ -347,6 +369,237 @@ func Test_Delete_UCP(t *testing.T) {
 	})
 }
 
+func Test_Exists_ARM(t *testing.T) {
+	provider := armresources.Provider{
+		Namespace: to.Ptr("Microsoft.Compute"),
+		ResourceTypes: []*armresources.ProviderResourceType{
+			{
+				ResourceType:      to.Ptr("virtualMachines"),
+				DefaultAPIVersion: to.Ptr(ARMAPIVersion),
+			},
+		},
+	}
+
+	t.Run("success - resource exists", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleJSONResponse(t, armresources.GenericResource{ID: to.Ptr(ARMResourceID)}, 200))
+		mux.HandleFunc(ARMProviderPath, handleJSONResponse(t, provider, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		exists, err := c.Exists(context.Background(), AzureUCPResourceID)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("success - resource not found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleNotFound(t))
+		mux.HandleFunc(ARMProviderPath, handleJSONResponse(t, provider, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		exists, err := c.Exists(context.Background(), ARMResourceID)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
+
+func Test_Exists_Kubernetes(t *testing.T) {
+	dc := &k8sutil.DiscoveryClient{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{
+						Name:    "api1",
+						Version: "v1",
+						Kind:    "Secret",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("success - resource exists", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-name",
+				Namespace: "test-namespace",
+			},
+		}).Build()
+
+		c := NewResourceClient(nil, nil, client, dc, nil)
+
+		exists, err := c.Exists(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("success - resource not found", func(t *testing.T) {
+		client := fake.NewClientBuilder().Build()
+
+		c := NewResourceClient(nil, nil, client, dc, nil)
+
+		exists, err := c.Exists(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
+
+func Test_Exists_UCP(t *testing.T) {
+	t.Run("success - resource exists", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleJSONResponse(t, map[string]any{"id": AWSResourceID}, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil, nil)
+
+		exists, err := c.Exists(context.Background(), AWSResourceID)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("success - resource not found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleNotFound(t))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil, nil)
+
+		exists, err := c.Exists(context.Background(), AWSResourceID)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("failure - get fails", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleJSONResponse(t, v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code: v1.CodeInvalid,
+			},
+		}, 400))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil, nil)
+
+		_, err = c.Exists(context.Background(), AWSResourceID)
+		require.Error(t, err)
+		require.IsType(t, &ResourceError{}, err)
+	})
+}
+
+func Test_Redeploy_UCP(t *testing.T) {
+	resource := map[string]any{
+		"id":       RadiusResourceID,
+		"name":     "test-resource",
+		"type":     "Applications.Test/testResources",
+		"location": "global",
+		"systemData": map[string]any{
+			"createdBy": "test-user",
+		},
+		"properties": map[string]any{
+			"environment":       "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env",
+			"provisioningState": "Succeeded",
+			"status": map[string]any{
+				"outputResources": []any{},
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(RadiusResourceID, func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			switch r.Method {
+			case http.MethodGet:
+				w.Header().Set("ETag", "test-etag")
+				handleJSONResponse(t, resource, 200)(w, r)
+			case http.MethodPut:
+				require.Equal(t, "test-etag", r.Header.Get("If-Match"))
+				body := map[string]any{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.Equal(t, map[string]any{
+					"location": "global",
+					"properties": map[string]any{
+						"environment": "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env",
+					},
+				}, body)
+				handleJSONResponse(t, resource, 200)(w, r)
+			default:
+				t.Fatalf("unexpected method %s", r.Method)
+			}
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil, nil)
+
+		err = c.Redeploy(context.Background(), RadiusResourceID)
+		require.NoError(t, err)
+	})
+
+	t.Run("failure - resource was changed", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(RadiusResourceID, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				w.Header().Set("ETag", "test-etag")
+				handleJSONResponse(t, resource, 200)(w, r)
+				return
+			}
+			handleJSONResponse(t, v1.ErrorResponse{Error: v1.ErrorDetails{Code: v1.CodePreconditionFailed}}, 412)(w, r)
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil, nil)
+
+		err = c.Redeploy(context.Background(), RadiusResourceID)
+		require.Error(t, err)
+		require.IsType(t, &ResourceError{}, err)
+	})
+
+	t.Run("failure - not a Radius resource", func(t *testing.T) {
+		c := NewResourceClient(nil, nil, nil, nil, nil)
+
+		for _, id := range []string{ARMResourceID, AzureUCPResourceID, AWSResourceID, KubernetesCoreGroupResourceID} {
+			err := c.Redeploy(context.Background(), id)
+			require.ErrorContains(t, err, "only Radius resources can be re-deployed")
+		}
+	})
+}
+
 func newArmOptions(url string) *armauth.ArmConfig {
 	return &armauth.ArmConfig{
 		ClientOptions: clientv2.Options{
@@ -66,6 +66,15 @@ type ResourceClient interface {
 	//
 	// If the API version is omitted, then an attempt will be made to look up the API version.
 	Delete(ctx context.Context, id string) error
+
+	// Exists checks whether a resource exists by id.
+	//
+	// The API version is looked up the same way as for Delete.
+	Exists(ctx context.Context, id string) (bool, error)
+
+	// Redeploy re-deploys a Radius resource by sending the writable fields of its current definition back to its API
+	// with the etag of the definition as precondition, and waits for the operation deploying the resource to complete.
+	Redeploy(ctx context.Context, id string) error
 }
 
 // ResourceError represents an error that occured while processing a resource.
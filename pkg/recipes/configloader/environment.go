@@ -36,6 +36,16 @@ var (
 	ErrUnsupportedComputeKind = errors.New("unsupported compute kind in environment resource")
 )
 
+const (
+	// Keys of the credentials in a secret store referenced by the registry or module source authentication of an environment.
+	registryUsernameKey = "username"
+	registryPasswordKey = "password"
+	registryTokenKey    = "token"
+)
+
+// secretsFetcher fetches the secret values of the secret store with the given ID.
+type secretsFetcher func(ctx context.Context, secretStoreID string) (map[string]string, error)
+
 //go:generate mockgen -destination=./mock_config_loader.go -package=configloader -self_package github.com/radius-project/radius/pkg/recipes/configloader github.com/radius-project/radius/pkg/recipes/configloader ConfigurationLoader
 
 var _ ConfigurationLoader = (*environmentLoader)(nil)
@@ -67,7 +77,29 @@ func (e *environmentLoader) LoadConfiguration(ctx context.Context, recipe recipe
 		}
 	}
 
-	return getConfiguration(environment, application)
+	config, err := getConfiguration(environment, application)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchSecrets := func(ctx context.Context, secretStoreID string) (map[string]string, error) {
+		return util.FetchSecretStoreSecrets(ctx, secretStoreID, e.ArmClientOptions)
+	}
+
+	config.Bicep.RegistryCredentials, err = getRegistryCredentials(ctx, environment, fetchSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = addTerraformCredentials(ctx, environment, config, fetchSecrets); err != nil {
+		return nil, err
+	}
+
+	if err = addTerraformBackendSecrets(ctx, environment, config, fetchSecrets); err != nil {
+		return nil, err
+	}
+
+	return config, nil
 }
 
 func getConfiguration(environment *v20231001preview.EnvironmentResource, application *v20231001preview.ApplicationResource) (*recipes.Configuration, error) {
@@ -87,6 +119,11 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 			return nil, err
 		}
 
+		config.Runtime.Kubernetes.PlaneName, err = kube.FetchPlaneNameFromEnvironmentResource(environment)
+		if err != nil {
+			return nil, err
+		}
+
 		if application != nil {
 			config.Runtime.Kubernetes.Namespace, err = kube.FetchNamespaceFromApplicationResource(application)
 			if err != nil {
@@ -109,15 +146,158 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		if providers.Azure != nil {
 			config.Providers.Azure.Scope = to.String(providers.Azure.Scope)
 		}
+		if providers.Gcp != nil {
+			config.Providers.GCP.Scope = to.String(providers.Gcp.Scope)
+		}
 	}
 
 	if environment.Properties.Simulated != nil && *environment.Properties.Simulated {
 		config.Simulated = true
 	}
 
+	if recipeConfig := environment.Properties.RecipeConfig; recipeConfig != nil && recipeConfig.Terraform != nil {
+		config.Terraform.Providers = recipeConfig.Terraform.Providers
+		if recipeConfig.Terraform.Env != nil {
+			config.Terraform.Env = map[string]string{}
+			for name, value := range recipeConfig.Terraform.Env {
+				config.Terraform.Env[name] = to.String(value)
+			}
+		}
+		if backend := recipeConfig.Terraform.Backend; backend != nil {
+			config.Terraform.Backend.Kind = to.String(backend.Kind)
+			if backend.Config != nil {
+				config.Terraform.Backend.Config = map[string]any{}
+				for key, value := range backend.Config {
+					config.Terraform.Backend.Config[key] = value
+				}
+			}
+		}
+	}
+
 	return &config, nil
 }
 
+// getRegistryCredentials returns the credentials of the private OCI registries configured for Bicep recipes in the
+// environment, keyed by registry host. Credentials are read from secret stores using fetchSecrets.
+func getRegistryCredentials(ctx context.Context, environment *v20231001preview.EnvironmentResource, fetchSecrets secretsFetcher) (map[string]recipes.RegistryCredentials, error) {
+	recipeConfig := environment.Properties.RecipeConfig
+	if recipeConfig == nil || recipeConfig.Bicep == nil || len(recipeConfig.Bicep.Authentication) == 0 {
+		return nil, nil
+	}
+
+	credentials := map[string]recipes.RegistryCredentials{}
+	for registry, auth := range recipeConfig.Bicep.Authentication {
+		if auth == nil || auth.Secret == nil {
+			continue
+		}
+
+		secrets, err := fetchSecrets(ctx, *auth.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch secrets from secret store %q for registry %q: %w", *auth.Secret, registry, err)
+		}
+
+		if secrets[registryTokenKey] == "" && (secrets[registryUsernameKey] == "" || secrets[registryPasswordKey] == "") {
+			return nil, fmt.Errorf("secret store %q for registry %q must contain either %q and %q keys, or a %q key", *auth.Secret, registry, registryUsernameKey, registryPasswordKey, registryTokenKey)
+		}
+
+		credentials[registry] = recipes.RegistryCredentials{
+			Username: secrets[registryUsernameKey],
+			Password: secrets[registryPasswordKey],
+			Token:    secrets[registryTokenKey],
+		}
+	}
+
+	return credentials, nil
+}
+
+// addTerraformCredentials adds the credentials of the private Terraform module sources configured in the environment
+// to the configuration. Credentials are read from secret stores using fetchSecrets.
+func addTerraformCredentials(ctx context.Context, environment *v20231001preview.EnvironmentResource, config *recipes.Configuration, fetchSecrets secretsFetcher) error {
+	recipeConfig := environment.Properties.RecipeConfig
+	if recipeConfig == nil || recipeConfig.Terraform == nil || recipeConfig.Terraform.Authentication == nil {
+		return nil
+	}
+
+	auth := recipeConfig.Terraform.Authentication
+	var err error
+	if config.Terraform.GitCredentials, err = getModuleSourceCredentials(ctx, auth.Git, false, fetchSecrets); err != nil {
+		return err
+	}
+	if config.Terraform.HTTPCredentials, err = getModuleSourceCredentials(ctx, auth.HTTP, false, fetchSecrets); err != nil {
+		return err
+	}
+	if config.Terraform.RegistryCredentials, err = getModuleSourceCredentials(ctx, auth.Registry, true, fetchSecrets); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addTerraformBackendSecrets adds the keys of the secret store configured for the Terraform backend of the environment
+// to the configuration of the backend. Secrets are read from the secret store using fetchSecrets.
+func addTerraformBackendSecrets(ctx context.Context, environment *v20231001preview.EnvironmentResource, config *recipes.Configuration, fetchSecrets secretsFetcher) error {
+	recipeConfig := environment.Properties.RecipeConfig
+	if recipeConfig == nil || recipeConfig.Terraform == nil || recipeConfig.Terraform.Backend == nil || recipeConfig.Terraform.Backend.Secret == nil {
+		return nil
+	}
+
+	secretStoreID := *recipeConfig.Terraform.Backend.Secret
+	secrets, err := fetchSecrets(ctx, secretStoreID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch secrets from secret store %q for the terraform backend: %w", secretStoreID, err)
+	}
+
+	if config.Terraform.Backend.Config == nil {
+		config.Terraform.Backend.Config = map[string]any{}
+	}
+	for key, value := range secrets {
+		config.Terraform.Backend.Config[key] = value
+	}
+
+	return nil
+}
+
+// getModuleSourceCredentials reads the credentials of the given Terraform module sources from their secret stores,
+// keyed by host. When tokenOnly is true the secret stores must contain a token, otherwise they can also contain
+// a username and a password.
+func getModuleSourceCredentials(ctx context.Context, sources map[string]*v20231001preview.ModuleSourceAuthentication, tokenOnly bool, fetchSecrets secretsFetcher) (map[string]recipes.RegistryCredentials, error) {
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	credentials := map[string]recipes.RegistryCredentials{}
+	for host, auth := range sources {
+		if auth == nil || auth.Secret == nil {
+			continue
+		}
+
+		secrets, err := fetchSecrets(ctx, *auth.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch secrets from secret store %q for module source %q: %w", *auth.Secret, host, err)
+		}
+
+		if tokenOnly {
+			if secrets[registryTokenKey] == "" {
+				return nil, fmt.Errorf("secret store %q for module source %q must contain a %q key", *auth.Secret, host, registryTokenKey)
+			}
+			credentials[host] = recipes.RegistryCredentials{Token: secrets[registryTokenKey]}
+			continue
+		}
+
+		if secrets[registryTokenKey] == "" && (secrets[registryUsernameKey] == "" || secrets[registryPasswordKey] == "") {
+			return nil, fmt.Errorf("secret store %q for module source %q must contain either %q and %q keys, or a %q key", *auth.Secret, host, registryUsernameKey, registryPasswordKey, registryTokenKey)
+		}
+
+		credentials[host] = recipes.RegistryCredentials{
+			Username: secrets[registryUsernameKey],
+			Password: secrets[registryPasswordKey],
+			Token:    secrets[registryTokenKey],
+		}
+	}
+
+	return credentials, nil
+}
+
 // LoadRecipe fetches the recipe information from the environment. It returns an error if the environment cannot be fetched.
 func (e *environmentLoader) LoadRecipe(ctx context.Context, recipe *recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, error) {
 	environment, err := util.FetchEnvironment(ctx, recipe.EnvironmentID, e.ArmClientOptions)
@@ -161,6 +341,8 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 	switch c := found.(type) {
 	case *v20231001preview.TerraformRecipeProperties:
 		definition.TemplateVersion = *c.TemplateVersion
+	case *v20231001preview.HelmRecipeProperties:
+		definition.TemplateVersion = to.String(c.TemplateVersion)
 	}
 
 	return definition, nil
@@ -17,12 +17,16 @@ limitations under the License.
 package configloader
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	model "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,6 +38,7 @@ const (
 	appResourceId   = "/subscriptions/test-sub/resourceGroups/test-group/providers/Applications.Core/applications/app0"
 	azureScope      = "/subscriptions/test-sub/resourceGroups/testRG"
 	awsScope        = "/planes/aws/aws/accounts/000/regions/cool-region"
+	gcpScope        = "/planes/gcp/gcp/projects/test-project/regions/cool-region"
 	mongoResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo-database-0"
 	redisID         = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis-0"
 
@@ -71,11 +76,35 @@ func TestGetConfiguration(t *testing.T) {
 					Kubernetes: &recipes.KubernetesRuntime{
 						Namespace:            envNamespace,
 						EnvironmentNamespace: envNamespace,
+						PlaneName:            "local",
 					},
 				},
 				Providers: createAzureProvider(),
 			},
 		},
+		{
+			name: "env resource with registered cluster",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr("/planes/kubernetes/cluster1"),
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+						PlaneName:            "cluster1",
+					},
+				},
+				Providers: datamodel.Providers{},
+			},
+		},
 		{
 			name: "aws provider with env resource",
 			envResource: &model.EnvironmentResource{
@@ -98,11 +127,53 @@ func TestGetConfiguration(t *testing.T) {
 					Kubernetes: &recipes.KubernetesRuntime{
 						Namespace:            envNamespace,
 						EnvironmentNamespace: envNamespace,
+						PlaneName:            "local",
 					},
 				},
 				Providers: createAWSProvider(),
 			},
 		},
+		{
+			name: "terraform recipe config with env resource",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					RecipeConfig: &model.RecipeConfigProperties{
+						Terraform: &model.TerraformConfigProperties{
+							Providers: map[string][]map[string]any{
+								"postgresql": {{"host": "postgres.example.com"}},
+							},
+							Env: map[string]*string{
+								"TF_REGISTRY_CLIENT_TIMEOUT": to.Ptr("30"),
+							},
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+						PlaneName:            "local",
+					},
+				},
+				Providers: datamodel.Providers{},
+				Terraform: recipes.TerraformConfiguration{
+					Providers: map[string][]map[string]any{
+						"postgresql": {{"host": "postgres.example.com"}},
+					},
+					Env: map[string]string{
+						"TF_REGISTRY_CLIENT_TIMEOUT": "30",
+					},
+				},
+			},
+		},
 		{
 			name: "aws provider with env and app resource",
 			envResource: &model.EnvironmentResource{
@@ -135,11 +206,87 @@ func TestGetConfiguration(t *testing.T) {
 					Kubernetes: &recipes.KubernetesRuntime{
 						Namespace:            "app-default",
 						EnvironmentNamespace: envNamespace,
+						PlaneName:            "local",
 					},
 				},
 				Providers: createAWSProvider(),
 			},
 		},
+		{
+			name: "gcp provider with env and app resource",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					Providers: &model.Providers{
+						Gcp: &model.ProvidersGcp{
+							Scope: to.Ptr(gcpScope),
+						},
+					},
+				},
+			},
+			appResource: &model.ApplicationResource{
+				Properties: &model.ApplicationProperties{
+					Status: &model.ResourceStatus{
+						Compute: &model.KubernetesCompute{
+							Kind:       to.Ptr(kind),
+							Namespace:  to.Ptr(appNamespace),
+							ResourceID: to.Ptr(appResourceId),
+						},
+					},
+				},
+			},
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            "app-default",
+						EnvironmentNamespace: envNamespace,
+						PlaneName:            "local",
+					},
+				},
+				Providers: createGCPProvider(),
+			},
+		},
+		{
+			name: "terraform backend",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					RecipeConfig: &model.RecipeConfigProperties{
+						Terraform: &model.TerraformConfigProperties{
+							Backend: &model.TerraformBackendConfig{
+								Kind:   to.Ptr("s3"),
+								Config: map[string]any{"bucket": "tfstate"},
+								Secret: to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/tfstate"),
+							},
+						},
+					},
+				},
+			},
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+						PlaneName:            "local",
+					},
+				},
+				Providers: datamodel.Providers{},
+				Terraform: recipes.TerraformConfiguration{
+					Backend: recipes.TerraformBackend{
+						Kind:   "s3",
+						Config: map[string]any{"bucket": "tfstate"},
+					},
+				},
+			},
+		},
 		{
 			name: "invalid app resource",
 			envResource: &model.EnvironmentResource{
@@ -207,6 +354,13 @@ func createAWSProvider() datamodel.Providers {
 		}}
 }
 
+func createGCPProvider() datamodel.Providers {
+	return datamodel.Providers{
+		GCP: datamodel.ProvidersGCP{
+			Scope: gcpScope,
+		}}
+}
+
 func TestGetRecipeDefinition(t *testing.T) {
 	envResource := model.EnvironmentResource{
 		Properties: &model.EnvironmentProperties{
@@ -295,3 +449,256 @@ func TestGetRecipeDefinition(t *testing.T) {
 		require.Contains(t, err.Error(), "could not find recipe")
 	})
 }
+
+func TestGetRegistryCredentials(t *testing.T) {
+	secretStoreID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/registry-creds"
+	newEnvironment := func(auth map[string]*model.RegistryAuthentication) *model.EnvironmentResource {
+		return &model.EnvironmentResource{
+			Properties: &model.EnvironmentProperties{
+				RecipeConfig: &model.RecipeConfigProperties{
+					Bicep: &model.BicepConfigProperties{
+						Authentication: auth,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		environment *model.EnvironmentResource
+		secrets     map[string]string
+		secretsErr  error
+		expected    map[string]recipes.RegistryCredentials
+		errString   string
+	}{
+		{
+			name:        "no recipe config",
+			environment: &model.EnvironmentResource{Properties: &model.EnvironmentProperties{}},
+		},
+		{
+			name: "secret store with basic credentials",
+			environment: newEnvironment(map[string]*model.RegistryAuthentication{
+				"private.example.com": {Secret: to.Ptr(secretStoreID)},
+			}),
+			secrets: map[string]string{"username": "admin", "password": "password"},
+			expected: map[string]recipes.RegistryCredentials{
+				"private.example.com": {Username: "admin", Password: "password"},
+			},
+		},
+		{
+			name: "secret store with token",
+			environment: newEnvironment(map[string]*model.RegistryAuthentication{
+				"private.example.com": {Secret: to.Ptr(secretStoreID)},
+			}),
+			secrets: map[string]string{"token": "token"},
+			expected: map[string]recipes.RegistryCredentials{
+				"private.example.com": {Token: "token"},
+			},
+		},
+		{
+			name: "secret store missing keys",
+			environment: newEnvironment(map[string]*model.RegistryAuthentication{
+				"private.example.com": {Secret: to.Ptr(secretStoreID)},
+			}),
+			secrets:   map[string]string{"username": "admin"},
+			errString: fmt.Sprintf("secret store %q for registry \"private.example.com\" must contain either \"username\" and \"password\" keys, or a \"token\" key", secretStoreID),
+		},
+		{
+			name: "secret store fetch failure",
+			environment: newEnvironment(map[string]*model.RegistryAuthentication{
+				"private.example.com": {Secret: to.Ptr(secretStoreID)},
+			}),
+			secretsErr: errors.New("secret store not found"),
+			errString:  fmt.Sprintf("failed to fetch secrets from secret store %q for registry \"private.example.com\": secret store not found", secretStoreID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetchSecrets := func(ctx context.Context, id string) (map[string]string, error) {
+				require.Equal(t, secretStoreID, id)
+				return tt.secrets, tt.secretsErr
+			}
+
+			credentials, err := getRegistryCredentials(testcontext.New(t), tt.environment, fetchSecrets)
+			if tt.errString != "" {
+				require.EqualError(t, err, tt.errString)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, credentials)
+		})
+	}
+}
+
+func TestAddTerraformCredentials(t *testing.T) {
+	secretStoreID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/module-creds"
+	newEnvironment := func(auth *model.TerraformAuthenticationConfig) *model.EnvironmentResource {
+		return &model.EnvironmentResource{
+			Properties: &model.EnvironmentProperties{
+				RecipeConfig: &model.RecipeConfigProperties{
+					Terraform: &model.TerraformConfigProperties{
+						Authentication: auth,
+					},
+				},
+			},
+		}
+	}
+	source := map[string]*model.ModuleSourceAuthentication{
+		"example.com": {Secret: to.Ptr(secretStoreID)},
+	}
+
+	tests := []struct {
+		name        string
+		environment *model.EnvironmentResource
+		secrets     map[string]string
+		secretsErr  error
+		expected    recipes.TerraformConfiguration
+		errString   string
+	}{
+		{
+			name:        "no recipe config",
+			environment: &model.EnvironmentResource{Properties: &model.EnvironmentProperties{}},
+		},
+		{
+			name:        "git with basic credentials",
+			environment: newEnvironment(&model.TerraformAuthenticationConfig{Git: source}),
+			secrets:     map[string]string{"username": "admin", "password": "password"},
+			expected: recipes.TerraformConfiguration{
+				GitCredentials: map[string]recipes.RegistryCredentials{
+					"example.com": {Username: "admin", Password: "password"},
+				},
+			},
+		},
+		{
+			name:        "http with token",
+			environment: newEnvironment(&model.TerraformAuthenticationConfig{HTTP: source}),
+			secrets:     map[string]string{"token": "token"},
+			expected: recipes.TerraformConfiguration{
+				HTTPCredentials: map[string]recipes.RegistryCredentials{
+					"example.com": {Token: "token"},
+				},
+			},
+		},
+		{
+			name:        "registry with token",
+			environment: newEnvironment(&model.TerraformAuthenticationConfig{Registry: source}),
+			secrets:     map[string]string{"token": "token", "username": "ignored"},
+			expected: recipes.TerraformConfiguration{
+				RegistryCredentials: map[string]recipes.RegistryCredentials{
+					"example.com": {Token: "token"},
+				},
+			},
+		},
+		{
+			name:        "registry without token",
+			environment: newEnvironment(&model.TerraformAuthenticationConfig{Registry: source}),
+			secrets:     map[string]string{"username": "admin", "password": "password"},
+			errString:   fmt.Sprintf("secret store %q for module source \"example.com\" must contain a \"token\" key", secretStoreID),
+		},
+		{
+			name:        "git missing keys",
+			environment: newEnvironment(&model.TerraformAuthenticationConfig{Git: source}),
+			secrets:     map[string]string{"username": "admin"},
+			errString:   fmt.Sprintf("secret store %q for module source \"example.com\" must contain either \"username\" and \"password\" keys, or a \"token\" key", secretStoreID),
+		},
+		{
+			name:        "secret store fetch failure",
+			environment: newEnvironment(&model.TerraformAuthenticationConfig{HTTP: source}),
+			secretsErr:  errors.New("secret store not found"),
+			errString:   fmt.Sprintf("failed to fetch secrets from secret store %q for module source \"example.com\": secret store not found", secretStoreID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetchSecrets := func(ctx context.Context, id string) (map[string]string, error) {
+				require.Equal(t, secretStoreID, id)
+				return tt.secrets, tt.secretsErr
+			}
+
+			config := &recipes.Configuration{}
+			err := addTerraformCredentials(testcontext.New(t), tt.environment, config, fetchSecrets)
+			if tt.errString != "" {
+				require.EqualError(t, err, tt.errString)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, config.Terraform)
+		})
+	}
+}
+
+func TestAddTerraformBackendSecrets(t *testing.T) {
+	secretStoreID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/tfstate"
+	environment := &model.EnvironmentResource{
+		Properties: &model.EnvironmentProperties{
+			RecipeConfig: &model.RecipeConfigProperties{
+				Terraform: &model.TerraformConfigProperties{
+					Backend: &model.TerraformBackendConfig{
+						Kind:   to.Ptr("s3"),
+						Config: map[string]any{"bucket": "tfstate"},
+						Secret: to.Ptr(secretStoreID),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		environment *model.EnvironmentResource
+		config      map[string]any
+		secrets     map[string]string
+		secretsErr  error
+		expected    map[string]any
+		errString   string
+	}{
+		{
+			name:        "no backend",
+			environment: &model.EnvironmentResource{Properties: &model.EnvironmentProperties{}},
+		},
+		{
+			name:        "secrets added to the configuration",
+			environment: environment,
+			config:      map[string]any{"bucket": "tfstate"},
+			secrets:     map[string]string{"access_key": "key", "secret_key": "secret"},
+			expected:    map[string]any{"bucket": "tfstate", "access_key": "key", "secret_key": "secret"},
+		},
+		{
+			name:        "secrets without configuration",
+			environment: environment,
+			secrets:     map[string]string{"access_key": "key"},
+			expected:    map[string]any{"access_key": "key"},
+		},
+		{
+			name:        "secret store fetch failure",
+			environment: environment,
+			secretsErr:  errors.New("secret store not found"),
+			errString:   fmt.Sprintf("failed to fetch secrets from secret store %q for the terraform backend: secret store not found", secretStoreID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetchSecrets := func(ctx context.Context, id string) (map[string]string, error) {
+				require.Equal(t, secretStoreID, id)
+				return tt.secrets, tt.secretsErr
+			}
+
+			config := &recipes.Configuration{}
+			config.Terraform.Backend.Config = tt.config
+			err := addTerraformBackendSecrets(testcontext.New(t), tt.environment, config, fetchSecrets)
+			if tt.errString != "" {
+				require.EqualError(t, err, tt.errString)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, config.Terraform.Backend.Config)
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
 )
 
@@ -37,6 +38,9 @@ type RecipeControllerConfig struct {
 	// K8sClients is the collections of Kubernetes clients.
 	K8sClients *kubeutil.Clients
 
+	// Clusters provides the connections to the Kubernetes clusters targeted by environments.
+	Clusters ucp_kubernetes.ClusterProvider
+
 	// ResourceClient is a client used by resource processors for interacting with UCP resources.
 	ResourceClient processors.ResourceClient
 
@@ -59,7 +63,13 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
-	cfg.ResourceClient = processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.K8sClients.RuntimeClient, cfg.K8sClients.DiscoveryClient)
+	secretProvider := provider.NewSecretProvider(options.Config.SecretProvider)
+	cfg.Clusters, err = ucp_kubernetes.NewUCPClusterProvider(&ucp_kubernetes.Cluster{Config: options.K8sConfig, Clients: cfg.K8sClients}, options.UCPConnection, secretProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.ResourceClient = processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.K8sClients.RuntimeClient, cfg.K8sClients.DiscoveryClient, cfg.Clusters)
 	clientOptions := sdk.NewClientOptions(options.UCPConnection)
 
 	cfg.DeploymentEngineClient, err = clients.NewResourceDeploymentsClient(&clients.Options{
@@ -94,10 +104,14 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 					DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
 				},
 			),
-			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, provider.NewSecretProvider(options.Config.SecretProvider),
+			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, secretProvider,
 				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
+					Path:      options.Config.Terraform.Path,
+					Version:   options.Config.Terraform.Version,
+					ExecPath:  options.Config.Terraform.ExecPath,
+					MirrorURL: options.Config.Terraform.MirrorURL,
 				}, cfg.K8sClients.ClientSet),
+			recipes.TemplateKindHelm: driver.NewHelmDriver(options.K8sConfig, cfg.K8sClients.RuntimeClient, cfg.Clusters),
 		},
 	})
 
@@ -18,8 +18,11 @@ package driver
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -45,14 +48,18 @@ import (
 	coredm "github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
-//go:generate mockgen -destination=./mock_driver.go -package=driver -self_package github.com/radius-project/radius/pkg/recipes/driver github.com/radius-project/radius/pkg/recipes/driver Driver
+//go:generate mockgen -destination=./mock_driver.go -package=driver -self_package github.com/radius-project/radius/pkg/recipes/driver github.com/radius-project/radius/pkg/recipes/driver Driver,StateDriver,DigestDriver
 const (
 	deploymentPrefix = "recipe"
 	pollFrequency    = time.Second * 5
 	recipeParameters = "parameters"
+
+	// cancelDeploymentTimeout is the timeout to cancel the deployment of a recipe after the recipe operation is canceled.
+	cancelDeploymentTimeout = time.Second * 30
 )
 
 var _ Driver = (*bicepDriver)(nil)
+var _ DigestDriver = (*bicepDriver)(nil)
 
 // NewBicepDriver creates a new bicep driver instance with the given ARM client options, deployment client, resource client, and options.
 func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient *clients.ResourceDeploymentsClient, client processors.ResourceClient, options BicepOptions) Driver {
@@ -83,69 +90,28 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
-	recipeData := make(map[string]any)
-	downloadStartTime := time.Now()
-	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData)
+	deployment, deploymentID, err := d.prepareDeployment(ctx, opts.BaseOptions, recipes.RecipeDeploymentFailed)
 	if err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
-	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
-
-	// create the context object to be passed to the recipe deployment
-	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
-	}
-
-	// get the parameters after resolving the conflict between developer and operator parameters
-	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
-	isContextParameterDefined := hasContextParameter(recipeData)
-	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
-
-	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
-	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+		return nil, err
 	}
 
-	// Provider config will specify the Azure and AWS scopes (if provided).
-	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
-
 	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
-	if providerConfig.AWS != nil {
-		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
-	}
-	if providerConfig.Az != nil {
-		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
-	}
 
 	if opts.Configuration.Simulated {
 		logger.Info("simulated environment enabled, skipping deployment")
 		return nil, nil
 	}
 
-	poller, err := d.DeploymentClient.CreateOrUpdate(
-		ctx,
-		clients.Deployment{
-			Properties: &clients.DeploymentProperties{
-				Mode:           armresources.DeploymentModeIncremental,
-				ProviderConfig: &providerConfig,
-				Parameters:     parameters,
-				Template:       recipeData,
-			},
-		},
-		deploymentID.String(),
-		clients.DeploymentsClientAPIVersion,
-	)
+	poller, err := d.DeploymentClient.CreateOrUpdate(ctx, deployment, deploymentID.String(), clients.DeploymentsClientAPIVersion)
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
 	}
 
 	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, d.cancelDeployment(ctx, deploymentID.String(), err)
+		}
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
 	}
 
@@ -154,6 +120,11 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
 	}
 
+	recipeResponse.Status, err = newBicepRecipeStatus(opts.BaseOptions, deployment.Properties.Template)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
 	// When a Radius portable resource consuming a recipe is redeployed, Garbage collection of the recipe resources that aren't included
 	// in the currently deployed resources compared to the list of resources from the previous deployment needs to be deleted
 	// as bicep does not take care of automatically deleting the unused resources.
@@ -178,6 +149,25 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	return recipeResponse, nil
 }
 
+// cancelDeployment cancels the deployment of the recipe when the recipe operation is canceled, so that the deployment
+// does not keep running in the deployment engine. The recipe is only reported as canceled if the deployment was canceled.
+func (d *bicepDriver) cancelDeployment(ctx context.Context, deploymentID string, err error) *recipes.RecipeError {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	// The context of the operation is done, so the deployment is canceled with a new deadline.
+	cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cancelDeploymentTimeout)
+	defer cancel()
+
+	if cancelErr := d.DeploymentClient.Cancel(cancelCtx, deploymentID, clients.DeploymentsClientAPIVersion); cancelErr != nil {
+		logger.Error(cancelErr, "failed to cancel the deployment of the recipe", "deploymentID", deploymentID)
+		message := fmt.Sprintf("recipe operation was canceled but the deployment %q could not be canceled and may still be running: %s", deploymentID, cancelErr.Error())
+		return recipes.NewRecipeError(recipes.RecipeDeploymentFailed, message, recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(cancelErr))
+	}
+
+	logger.Info("canceled the deployment of the recipe", "deploymentID", deploymentID)
+	return newCanceledError(err)
+}
+
 // Delete deletes all of the output resources that are marked as managed by Radius.
 // It will create a goroutine for each resource to be deleted and wait for them to finish,
 // retrying if necessary.
@@ -237,6 +227,73 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	return nil
 }
 
+// DetectDrift previews a deployment of the recipe using the what-if operation of the deployment engine, which compares the
+// properties of the deployed resources with the recipe template. The resources the deployment would create, modify or delete
+// are reported as drifted resources.
+func (d *bicepDriver) DetectDrift(ctx context.Context, opts DetectDriftOptions) ([]rpv1.DriftedResource, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Detecting drift of recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	deployment, deploymentID, err := d.prepareDeployment(ctx, opts.BaseOptions, recipes.RecipeDriftDetectionFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	poller, err := d.DeploymentClient.WhatIf(ctx, deployment, deploymentID.String(), clients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, newCanceledError(err)
+		}
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	var changes []*armresources.WhatIfChange
+	if resp.Properties != nil {
+		changes = resp.Properties.Changes
+	}
+
+	return newBicepDriftedResources(changes), nil
+}
+
+// Plan previews the changes a deployment of the recipe would make using the what-if operation of the deployment engine.
+// The output resources of the previous deployment which are no longer part of the recipe are reported as deleted, as they
+// are garbage collected after the deployment.
+func (d *bicepDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Previewing recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	deployment, deploymentID, err := d.prepareDeployment(ctx, opts.BaseOptions, recipes.RecipePlanFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("previewing bicep template for recipe", "deploymentID", deploymentID)
+	poller, err := d.DeploymentClient.WhatIf(ctx, deployment, deploymentID.String(), clients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, newCanceledError(err)
+		}
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	var changes []*armresources.WhatIfChange
+	if resp.Properties != nil {
+		changes = resp.Properties.Changes
+	}
+
+	return newBicepPlan(changes, opts.PrevState), nil
+}
+
 // GetRecipeMetadata gets the Bicep recipe parameters information from the container registry
 func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
 	// Recipe parameters can be found in the recipe data pulled from the registry in the following format:
@@ -257,7 +314,7 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (
 	//		}
 	//	}
 	recipeData := make(map[string]any)
-	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData)
+	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData, opts.Configuration.Bicep.RegistryCredentials)
 	if err != nil {
 		return nil, err
 	}
@@ -265,6 +322,99 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (
 	return recipeData, nil
 }
 
+// GetTemplateDigest fetches the recipe contents from the container registry and returns the digest of the template,
+// which is the digest recorded in the recipe status of the resources when the template is deployed.
+func (d *bicepDriver) GetTemplateDigest(ctx context.Context, opts BaseOptions) (string, error) {
+	recipeData := make(map[string]any)
+	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData, opts.Configuration.Bicep.RegistryCredentials)
+	if err != nil {
+		return "", recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return bicepTemplateDigest(recipeData)
+}
+
+// prepareDeployment fetches the recipe contents from the container registry and creates the deployment of the recipe
+// template with the recipe context parameter, the recipe parameters and the provider config. errorCode is the code of the
+// recipe errors returned when the deployment cannot be created from the recipe.
+func (d *bicepDriver) prepareDeployment(ctx context.Context, opts BaseOptions, errorCode string) (clients.Deployment, resources.ID, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	recipeData := make(map[string]any)
+	downloadStartTime := time.Now()
+	err := util.ReadFromRegistry(ctx, opts.Definition.TemplatePath, &recipeData, opts.Configuration.Bicep.RegistryCredentials)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	// create the context object to be passed to the recipe deployment
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	// get the parameters after resolving the conflict between developer and operator parameters
+	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return clients.Deployment{}, resources.ID{}, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	// Provider config will specify the Azure and AWS scopes (if provided).
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+	if providerConfig.AWS != nil {
+		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
+	}
+	if providerConfig.Az != nil {
+		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+	}
+
+	deployment := clients.Deployment{
+		Properties: &clients.DeploymentProperties{
+			Mode:           armresources.DeploymentModeIncremental,
+			ProviderConfig: &providerConfig,
+			Parameters:     parameters,
+			Template:       recipeData,
+		},
+	}
+
+	return deployment, deploymentID, nil
+}
+
+// newBicepRecipeStatus returns the recipe status of a Bicep recipe. The resolved version is the tag of the template
+// path and the digest is the SHA-256 hash of the compiled template which was deployed.
+func newBicepRecipeStatus(opts BaseOptions, template any) (*rpv1.RecipeStatus, error) {
+	tag, err := util.RegistryTag(opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := bicepTemplateDigest(template)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRecipeStatus(opts, tag, digest), nil
+}
+
+// bicepTemplateDigest returns the SHA-256 digest of the compiled template of a Bicep recipe.
+func bicepTemplateDigest(template any) (string, error) {
+	b, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(b)), nil
+}
+
 func hasContextParameter(recipeData map[string]any) bool {
 	parametersAny, ok := recipeData[recipeParameters]
 	if !ok {
@@ -399,3 +549,68 @@ func (d *bicepDriver) getGCOutputResources(current []string, previous []string)
 
 	return diff, nil
 }
+
+// newBicepDriftedResources returns the drifted resources from the changes returned by the what-if operation. Resources
+// which no longer exist are created again, resources whose properties differ from the template are modified, and
+// resources which would be deleted are no longer part of the recipe.
+func newBicepDriftedResources(changes []*armresources.WhatIfChange) []rpv1.DriftedResource {
+	driftedResources := []rpv1.DriftedResource{}
+	for _, change := range changes {
+		if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+			continue
+		}
+
+		var action rpv1.DriftAction
+		switch *change.ChangeType {
+		case armresources.ChangeTypeCreate:
+			action = rpv1.DriftActionCreate
+		case armresources.ChangeTypeModify:
+			action = rpv1.DriftActionUpdate
+		case armresources.ChangeTypeDelete:
+			action = rpv1.DriftActionDelete
+		default:
+			// Redeployed resources with no detected property changes have not drifted.
+			continue
+		}
+
+		driftedResources = append(driftedResources, rpv1.DriftedResource{ID: *change.ResourceID, Action: action})
+	}
+
+	return driftedResources
+}
+
+// newBicepPlan creates the recipe plan from the changes returned by the what-if operation. The resources of the previous
+// deployment which are not part of the changes are no longer deployed by the recipe, and are deleted by the garbage collection.
+func newBicepPlan(changes []*armresources.WhatIfChange, prevState []string) *recipes.RecipePlan {
+	plan := &recipes.RecipePlan{Resources: []recipes.PlannedResource{}}
+	current := map[string]bool{}
+	for _, change := range changes {
+		if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+			continue
+		}
+		current[strings.ToLower(*change.ResourceID)] = true
+
+		var action recipes.PlanAction
+		switch *change.ChangeType {
+		case armresources.ChangeTypeCreate:
+			action = recipes.PlanActionCreate
+		case armresources.ChangeTypeModify, armresources.ChangeTypeDeploy:
+			action = recipes.PlanActionUpdate
+		case armresources.ChangeTypeDelete:
+			action = recipes.PlanActionDelete
+		default:
+			// Ignored, unchanged and unsupported resources are not changed by the deployment.
+			continue
+		}
+
+		plan.Resources = append(plan.Resources, recipes.PlannedResource{ID: *change.ResourceID, Action: action})
+	}
+
+	for _, id := range prevState {
+		if !current[strings.ToLower(id)] {
+			plan.Resources = append(plan.Resources, recipes.PlannedResource{ID: id, Action: recipes.PlanActionDelete})
+		}
+	}
+
+	return plan
+}
@@ -0,0 +1,437 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	kubernetesresources "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/slices"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// HelmRecipeOutputAnnotation is the annotation marking the ConfigMaps and Secrets of the chart of a Helm recipe
+	// whose data are outputs of the recipe. The data of the ConfigMaps are values and the data of the Secrets are secrets.
+	HelmRecipeOutputAnnotation = "radapp.io/recipe-output"
+
+	// HelmRecipeResultBegin and HelmRecipeResultEnd delimit the result of a Helm recipe in the NOTES of its chart. The
+	// result is a JSON or YAML object with the values, secrets and resources of the recipe.
+	HelmRecipeResultBegin = "BEGIN RADIUS RECIPE RESULT"
+	HelmRecipeResultEnd   = "END RADIUS RECIPE RESULT"
+)
+
+var _ Driver = (*helmDriver)(nil)
+
+// NewHelmDriver creates a new instance of driver to execute a Helm recipe. The charts are installed to the cluster of
+// the given REST config, and the outputs of the recipes are read with the given client. The charts of the recipes of
+// environments targeting a registered cluster are installed to that cluster, whose connection is provided by clusters.
+func NewHelmDriver(restConfig *rest.Config, k8sClient client.Client, clusters ucp_kubernetes.ClusterProvider) Driver {
+	return &helmDriver{
+		helmExecutor: helm.NewExecutor(restConfig),
+		k8sClient:    k8sClient,
+		clusters:     clusters,
+		newExecutor: func(restConfig *rest.Config) helm.HelmExecutor {
+			return helm.NewExecutor(restConfig)
+		},
+	}
+}
+
+// helmDriver represents a driver to interact with Helm Recipe - install chart, uninstall chart, etc.
+type helmDriver struct {
+	// helmExecutor is used to execute Helm actions - install, upgrade, uninstall, etc.
+	helmExecutor helm.HelmExecutor
+
+	// k8sClient is the Kubernetes client used to read the outputs and the resources of the releases.
+	k8sClient client.Client
+
+	// clusters provides the connections to the registered clusters targeted by environments. It may be nil, in which
+	// case all the charts are installed to the local cluster.
+	clusters ucp_kubernetes.ClusterProvider
+
+	// newExecutor creates the Helm executor for a registered cluster.
+	newExecutor func(*rest.Config) helm.HelmExecutor
+}
+
+// helmTarget is the cluster a Helm recipe is installed to.
+type helmTarget struct {
+	// planeName is the name of the Kubernetes plane of the cluster.
+	planeName string
+
+	// helmExecutor executes the Helm actions against the cluster.
+	helmExecutor helm.HelmExecutor
+
+	// k8sClient is the Kubernetes client of the cluster.
+	k8sClient client.Client
+}
+
+// getTarget returns the cluster targeted by the environment of the recipe.
+func (d *helmDriver) getTarget(ctx context.Context, config recipes.Configuration) (*helmTarget, error) {
+	planeName := kubernetesPlaneName(config)
+	if d.clusters == nil || ucp_kubernetes.IsLocalPlane(planeName) {
+		return &helmTarget{planeName: planeName, helmExecutor: d.helmExecutor, k8sClient: d.k8sClient}, nil
+	}
+
+	cluster, err := d.clusters.GetCluster(ctx, planeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &helmTarget{planeName: planeName, helmExecutor: d.newExecutor(cluster.Config), k8sClient: cluster.Clients.RuntimeClient}, nil
+}
+
+// Execute installs the chart of the recipe to the Kubernetes namespace of the recipe, or upgrades its release, and
+// returns the outputs of the recipe read from the NOTES of the chart and from the ConfigMaps and Secrets annotated as
+// recipe outputs. All the resources of the release are returned as output resources.
+func (d *helmDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping deployment")
+		return nil, nil
+	}
+
+	target, err := d.getTarget(ctx, opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	logger.Info(fmt.Sprintf("Deploying helm recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+	rel, err := target.helmExecutor.Deploy(ctx, newHelmOptions(opts.BaseOptions))
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, newCanceledError(err)
+		}
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	recipeOutputs, err := prepareHelmRecipeResponse(ctx, target, rel)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the outputs of the release %q: %s", rel.Name, err.Error()), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	chartVersion := ""
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		chartVersion = rel.Chart.Metadata.Version
+	}
+	recipeOutputs.Status = newRecipeStatus(opts.BaseOptions, chartVersion, "")
+
+	return recipeOutputs, nil
+}
+
+// Delete uninstalls the release of the recipe, which deletes all the resources of the chart.
+func (d *helmDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	target, err := d.getTarget(ctx, opts.Configuration)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+	}
+
+	err = target.helmExecutor.Delete(ctx, newHelmOptions(opts.BaseOptions))
+	if err != nil {
+		if ctx.Err() != nil {
+			return newCanceledError(err)
+		}
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+	}
+
+	return nil
+}
+
+// GetRecipeMetadata returns the parameters of the Helm recipe, read from the values schema of the chart, along with the
+// values schema itself.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	recipeData, err := d.helmExecutor.GetRecipeMetadata(ctx, newHelmOptions(opts))
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
+	}
+
+	return recipeData, nil
+}
+
+// DetectDrift returns the resources of the release of the recipe which no longer exist in the cluster. All the output
+// resources of the recipe are reported as drifted if the release itself was uninstalled.
+func (d *helmDriver) DetectDrift(ctx context.Context, opts DetectDriftOptions) ([]rpv1.DriftedResource, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	target, err := d.getTarget(ctx, opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	rel, err := target.helmExecutor.GetRelease(ctx, newHelmOptions(opts.BaseOptions))
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	driftedResources := []rpv1.DriftedResource{}
+	if rel == nil || rel.Info == nil || rel.Info.Status == release.StatusUninstalled {
+		logger.Info("The release of the recipe no longer exists")
+		for _, outputResource := range opts.OutputResources {
+			driftedResources = append(driftedResources, rpv1.DriftedResource{ID: outputResource.ID.String(), Action: rpv1.DriftActionCreate})
+		}
+		return driftedResources, nil
+	}
+
+	objects, err := getHelmReleaseResources(target, rel)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	for _, id := range sortedKeys(objects) {
+		obj := objects[id]
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		err := target.k8sClient.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, live)
+		if apierrors.IsNotFound(err) {
+			logger.Info(fmt.Sprintf("Output resource %q deployed by the recipe no longer exists", id))
+			driftedResources = append(driftedResources, rpv1.DriftedResource{ID: id, Action: rpv1.DriftActionCreate})
+		} else if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+		}
+	}
+
+	return driftedResources, nil
+}
+
+// Plan runs a dry run of the installation or upgrade of the release of the recipe and compares the resources of the
+// rendered release with the resources of the installed release. Resources which are only in the rendered release are
+// created, resources which differ are updated and resources which are only in the installed release are deleted.
+func (d *helmDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	target, err := d.getTarget(ctx, opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	options := newHelmOptions(opts.BaseOptions)
+	current, err := target.helmExecutor.GetRelease(ctx, options)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	planned, err := target.helmExecutor.Plan(ctx, options)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, newCanceledError(err)
+		}
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	plannedObjects, err := getHelmReleaseResources(target, planned)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	currentObjects := map[string]*unstructured.Unstructured{}
+	if current != nil && current.Info != nil && current.Info.Status != release.StatusUninstalled {
+		currentObjects, err = getHelmReleaseResources(target, current)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+		}
+	}
+
+	plannedResources := []recipes.PlannedResource{}
+	for _, id := range sortedKeys(plannedObjects) {
+		currentObject, ok := currentObjects[id]
+		if !ok {
+			plannedResources = append(plannedResources, recipes.PlannedResource{ID: id, Action: recipes.PlanActionCreate})
+		} else if !reflect.DeepEqual(currentObject.Object, plannedObjects[id].Object) {
+			plannedResources = append(plannedResources, recipes.PlannedResource{ID: id, Action: recipes.PlanActionUpdate})
+		}
+	}
+	for _, id := range sortedKeys(currentObjects) {
+		if _, ok := plannedObjects[id]; !ok {
+			plannedResources = append(plannedResources, recipes.PlannedResource{ID: id, Action: recipes.PlanActionDelete})
+		}
+	}
+
+	return &recipes.RecipePlan{Resources: plannedResources}, nil
+}
+
+// prepareHelmRecipeResponse populates the recipe response from the result written to the NOTES of the chart, the data
+// of the ConfigMaps and Secrets of the release annotated as recipe outputs, and the resources of the release.
+func prepareHelmRecipeResponse(ctx context.Context, target *helmTarget, rel *release.Release) (*recipes.RecipeOutput, error) {
+	notes := ""
+	if rel.Info != nil {
+		notes = rel.Info.Notes
+	}
+	result, err := parseHelmRecipeResult(notes)
+	if err != nil {
+		return nil, err
+	}
+
+	recipeResponse := &recipes.RecipeOutput{}
+	if err := recipeResponse.PrepareRecipeResponse(result); err != nil {
+		return nil, err
+	}
+
+	objects, err := getHelmReleaseResources(target, rel)
+	if err != nil {
+		return nil, err
+	}
+
+	uniqueResourceIDs := []string{}
+	for _, val := range recipeResponse.Resources {
+		uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(val))
+	}
+
+	for _, id := range sortedKeys(objects) {
+		obj := objects[id]
+		if obj.GetAnnotations()[HelmRecipeOutputAnnotation] == "true" {
+			if err := addHelmOutputs(ctx, target, obj, recipeResponse); err != nil {
+				return nil, err
+			}
+		}
+
+		if !slices.Contains(uniqueResourceIDs, strings.ToLower(id)) {
+			recipeResponse.Resources = append(recipeResponse.Resources, id)
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// addHelmOutputs adds the data of the ConfigMap or Secret annotated as recipe output to the values or secrets of the
+// recipe response. The data are read from the cluster, as they can be generated when the chart is installed.
+func addHelmOutputs(ctx context.Context, target *helmTarget, obj *unstructured.Unstructured, recipeResponse *recipes.RecipeOutput) error {
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+
+	kind := obj.GroupVersionKind().GroupKind()
+	switch {
+	case kind == corev1.SchemeGroupVersion.WithKind("ConfigMap").GroupKind():
+		configMap := &corev1.ConfigMap{}
+		if err := target.k8sClient.Get(ctx, key, configMap); err != nil {
+			return fmt.Errorf("failed to read the recipe outputs from the ConfigMap %q: %w", key.String(), err)
+		}
+		for k, v := range configMap.Data {
+			recipeResponse.Values[k] = v
+		}
+	case kind == corev1.SchemeGroupVersion.WithKind("Secret").GroupKind():
+		secret := &corev1.Secret{}
+		if err := target.k8sClient.Get(ctx, key, secret); err != nil {
+			return fmt.Errorf("failed to read the recipe outputs from the Secret %q: %w", key.String(), err)
+		}
+		for k, v := range secret.Data {
+			recipeResponse.Secrets[k] = string(v)
+		}
+	default:
+		return fmt.Errorf("the %s %q is annotated with %q, but only ConfigMaps and Secrets can be recipe outputs", obj.GetKind(), key.String(), HelmRecipeOutputAnnotation)
+	}
+
+	return nil
+}
+
+// getHelmReleaseResources returns the resources of the manifest of the release keyed by their UCP resource ID in the
+// plane of the cluster. The namespace of the release is set on the resources which don't specify a namespace, unless
+// they are cluster-scoped.
+func getHelmReleaseResources(target *helmTarget, rel *release.Release) (map[string]*unstructured.Unstructured, error) {
+	manifests := releaseutil.SplitManifests(rel.Manifest)
+	keys := make([]string, 0, len(manifests))
+	for k := range manifests {
+		keys = append(keys, k)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	objects := map[string]*unstructured.Unstructured{}
+	for _, k := range keys {
+		obj := &unstructured.Unstructured{}
+		err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifests[k]), 4096).Decode(&obj.Object)
+		if errors.Is(err, io.EOF) || (err == nil && len(obj.Object) == 0) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to parse the manifest of the release %q: %w", rel.Name, err)
+		}
+
+		if obj.GetNamespace() == "" {
+			// Resources of kinds unknown to the cluster, such as the custom resources of CRDs installed by the chart,
+			// are assumed to be namespaced.
+			namespaced, err := target.k8sClient.IsObjectNamespaced(obj)
+			if err != nil || namespaced {
+				obj.SetNamespace(rel.Namespace)
+			}
+		}
+
+		id, err := kubernetesresources.ToUCPResourceID(target.planeName, obj.GetNamespace(), obj.GetKind(), obj.GetName(), obj.GroupVersionKind().Group)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the resource ID of a resource of the release %q: %w", rel.Name, err)
+		}
+		objects[id] = obj
+	}
+
+	return objects, nil
+}
+
+// parseHelmRecipeResult returns the result of a Helm recipe written to the NOTES of its chart between the result
+// markers. It returns an empty result if the NOTES don't contain a result.
+func parseHelmRecipeResult(notes string) (map[string]any, error) {
+	result := map[string]any{}
+
+	_, rest, found := strings.Cut(notes, HelmRecipeResultBegin)
+	if !found {
+		return result, nil
+	}
+
+	block, _, found := strings.Cut(rest, HelmRecipeResultEnd)
+	if !found {
+		return nil, fmt.Errorf("the recipe result in the NOTES of the chart is not terminated by %q", HelmRecipeResultEnd)
+	}
+
+	err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(block), 4096).Decode(&result)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse the recipe result in the NOTES of the chart: %w", err)
+	}
+
+	return result, nil
+}
+
+// newHelmOptions returns the options of the Helm executor for the given driver options.
+func newHelmOptions(opts BaseOptions) helm.Options {
+	return helm.Options{
+		EnvConfig:      &opts.Configuration,
+		EnvRecipe:      &opts.Definition,
+		ResourceRecipe: &opts.Recipe,
+	}
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
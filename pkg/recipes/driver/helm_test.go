@@ -0,0 +1,463 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	helmTestNamespace = "default-app1"
+
+	helmTestConfigMapID  = "/planes/kubernetes/local/namespaces/default-app1/providers/core/ConfigMap/redis-config"
+	helmTestSecretID     = "/planes/kubernetes/local/namespaces/default-app1/providers/core/Secret/redis-password"
+	helmTestDeploymentID = "/planes/kubernetes/local/namespaces/default-app1/providers/apps/Deployment/redis"
+	helmTestServiceID    = "/planes/kubernetes/local/namespaces/default-app1/providers/core/Service/redis"
+
+	helmTestManifest = `---
+# Source: redis/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-config
+  annotations:
+    radapp.io/recipe-output: "true"
+data:
+  port: "6379"
+---
+# Source: redis/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: redis-password
+  annotations:
+    radapp.io/recipe-output: "true"
+---
+# Source: redis/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+spec:
+  replicas: 1
+`
+)
+
+func setupHelm(t *testing.T, objects ...client.Object) (*helm.MockHelmExecutor, helmDriver) {
+	ctrl := gomock.NewController(t)
+	helmExecutor := helm.NewMockHelmExecutor(ctrl)
+
+	driver := helmDriver{
+		helmExecutor: helmExecutor,
+		k8sClient:    fake.NewClientBuilder().WithObjects(objects...).Build(),
+	}
+
+	return helmExecutor, driver
+}
+
+func buildHelmTestInputs() BaseOptions {
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	envRecipe.Driver = recipes.TemplateKindHelm
+	envRecipe.TemplatePath = "oci://ghcr.io/myorg/charts/redis"
+	envRecipe.TemplateVersion = "1.2.0"
+
+	return BaseOptions{
+		Configuration: envConfig,
+		Recipe:        recipeMetadata,
+		Definition:    envRecipe,
+	}
+}
+
+func newHelmTestRelease(manifest string, notes string) *release.Release {
+	return &release.Release{
+		Name:      "test-redis-recipe-1a2b3c4d",
+		Namespace: helmTestNamespace,
+		Manifest:  manifest,
+		Info:      &release.Info{Status: release.StatusDeployed, Notes: notes},
+		Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "redis", Version: "1.2.0"}},
+	}
+}
+
+func Test_Helm_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "redis-config", Namespace: helmTestNamespace},
+			Data:       map[string]string{"port": "6379"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "redis-password", Namespace: helmTestNamespace},
+			Data:       map[string][]byte{"password": []byte("generated")},
+		},
+	)
+	opts := buildHelmTestInputs()
+
+	notes := `Redis is installed.
+BEGIN RADIUS RECIPE RESULT
+values:
+  host: redis.default-app1.svc.cluster.local
+resources:
+  - ` + helmTestServiceID + `
+END RADIUS RECIPE RESULT
+`
+	helmExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, options helm.Options) (*release.Release, error) {
+		require.Equal(t, &opts.Configuration, options.EnvConfig)
+		require.Equal(t, &opts.Definition, options.EnvRecipe)
+		require.Equal(t, &opts.Recipe, options.ResourceRecipe)
+		return newHelmTestRelease(helmTestManifest, notes), nil
+	})
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Values: map[string]any{
+			"host": "redis.default-app1.svc.cluster.local",
+			"port": "6379",
+		},
+		Secrets: map[string]any{
+			"password": "generated",
+		},
+		Resources: []string{
+			helmTestServiceID,
+			helmTestDeploymentID,
+			helmTestConfigMapID,
+			helmTestSecretID,
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    "oci://ghcr.io/myorg/charts/redis",
+			TemplateVersion: "1.2.0",
+			ResolvedVersion: "1.2.0",
+			Parameters: map[string]any{
+				"redis_cache_name": "redis-test",
+			},
+		},
+	}
+	require.Equal(t, expected, recipeOutput)
+}
+
+func Test_Helm_Execute_RegisteredCluster(t *testing.T) {
+	ctx := testcontext.New(t)
+	localExecutor, driver := setupHelm(t)
+	remoteExecutor := helm.NewMockHelmExecutor(gomock.NewController(t))
+
+	cluster := &ucp_kubernetes.Cluster{
+		Config: &rest.Config{Host: "https://cluster1.example.com"},
+		Clients: &kubeutil.Clients{
+			RuntimeClient: fake.NewClientBuilder().WithObjects(
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "redis-config", Namespace: helmTestNamespace}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "redis-password", Namespace: helmTestNamespace}},
+			).Build(),
+		},
+	}
+	driver.clusters = &testHelmClusterProvider{planeName: "cluster1", cluster: cluster}
+	driver.newExecutor = func(restConfig *rest.Config) helm.HelmExecutor {
+		require.Same(t, cluster.Config, restConfig)
+		return remoteExecutor
+	}
+
+	opts := buildHelmTestInputs()
+	opts.Configuration.Runtime.Kubernetes = &recipes.KubernetesRuntime{Namespace: helmTestNamespace, PlaneName: "cluster1"}
+
+	localExecutor.EXPECT().Deploy(gomock.Any(), gomock.Any()).Times(0)
+	remoteExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(newHelmTestRelease(helmTestManifest, ""), nil)
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/planes/kubernetes/cluster1/namespaces/default-app1/providers/apps/Deployment/redis",
+		"/planes/kubernetes/cluster1/namespaces/default-app1/providers/core/ConfigMap/redis-config",
+		"/planes/kubernetes/cluster1/namespaces/default-app1/providers/core/Secret/redis-password",
+	}, recipeOutput.Resources)
+
+	opts.Configuration.Runtime.Kubernetes.PlaneName = "cluster2"
+	_, err = driver.Execute(ctx, ExecuteOptions{BaseOptions: opts})
+	var recipeError *recipes.RecipeError
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+}
+
+// testHelmClusterProvider is a ClusterProvider with a single registered cluster.
+type testHelmClusterProvider struct {
+	planeName string
+	cluster   *ucp_kubernetes.Cluster
+}
+
+func (p *testHelmClusterProvider) GetCluster(ctx context.Context, planeName string) (*ucp_kubernetes.Cluster, error) {
+	if planeName != p.planeName {
+		return nil, &ucp_kubernetes.ErrClusterNotRegistered{PlaneName: planeName}
+	}
+	return p.cluster, nil
+}
+
+func Test_Helm_Execute_SimulatedEnvironment(t *testing.T) {
+	ctx := testcontext.New(t)
+	_, driver := setupHelm(t)
+	opts := buildHelmTestInputs()
+	opts.Configuration.Simulated = true
+
+	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+	require.Nil(t, recipeOutput)
+}
+
+func Test_Helm_Execute_DeploymentFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+
+	helmExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(nil, errors.New("failed to install the chart"))
+
+	_, err := driver.Execute(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	recipeError := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeploymentFailed,
+			Message: "failed to install the chart",
+		},
+		DeploymentStatus: "executionError",
+	}
+	require.Equal(t, &recipeError, err)
+}
+
+func Test_Helm_Execute_OutputsFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+
+	// The ConfigMap annotated as recipe output doesn't exist in the cluster.
+	helmExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(newHelmTestRelease(helmTestManifest, ""), nil)
+
+	_, err := driver.Execute(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	var recipeError *recipes.RecipeError
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, `failed to read the outputs of the release "test-redis-recipe-1a2b3c4d": failed to read the recipe outputs from the ConfigMap "default-app1/redis-config"`)
+}
+
+func Test_Helm_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+
+	helmExecutor.EXPECT().Delete(ctx, gomock.Any()).Times(1).Return(nil)
+	err := driver.Delete(ctx, DeleteOptions{BaseOptions: buildHelmTestInputs()})
+	require.NoError(t, err)
+
+	helmExecutor.EXPECT().Delete(ctx, gomock.Any()).Times(1).Return(errors.New("failed to uninstall the release"))
+	err = driver.Delete(ctx, DeleteOptions{BaseOptions: buildHelmTestInputs()})
+	recipeError := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeletionFailed,
+			Message: "failed to uninstall the release",
+		},
+	}
+	require.Equal(t, &recipeError, err)
+}
+
+func Test_Helm_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+
+	metadata := map[string]any{
+		"parameters": map[string]any{
+			"replicas": map[string]any{"type": "int", "defaultValue": 1, "required": false},
+		},
+	}
+	helmExecutor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(metadata, nil)
+	result, err := driver.GetRecipeMetadata(ctx, buildHelmTestInputs())
+	require.NoError(t, err)
+	require.Equal(t, metadata, result)
+
+	helmExecutor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(nil, errors.New("failed to download the chart"))
+	_, err = driver.GetRecipeMetadata(ctx, buildHelmTestInputs())
+	recipeError := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeGetMetadataFailed,
+			Message: "failed to download the chart",
+		},
+	}
+	require.Equal(t, &recipeError, err)
+}
+
+func Test_Helm_DetectDrift(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	t.Run("missing resources", func(t *testing.T) {
+		helmExecutor, driver := setupHelm(t,
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "redis-config", Namespace: helmTestNamespace}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "redis-password", Namespace: helmTestNamespace}},
+		)
+		helmExecutor.EXPECT().GetRelease(ctx, gomock.Any()).Times(1).Return(newHelmTestRelease(helmTestManifest, ""), nil)
+
+		drifted, err := driver.DetectDrift(ctx, DetectDriftOptions{BaseOptions: buildHelmTestInputs()})
+		require.NoError(t, err)
+		require.Equal(t, []rpv1.DriftedResource{{ID: helmTestDeploymentID, Action: rpv1.DriftActionCreate}}, drifted)
+	})
+
+	t.Run("uninstalled release", func(t *testing.T) {
+		helmExecutor, driver := setupHelm(t)
+		helmExecutor.EXPECT().GetRelease(ctx, gomock.Any()).Times(1).Return(nil, nil)
+
+		opts := DetectDriftOptions{
+			BaseOptions: buildHelmTestInputs(),
+			OutputResources: []rpv1.OutputResource{
+				{ID: resources.MustParse(helmTestDeploymentID), RadiusManaged: to.Ptr(true)},
+			},
+		}
+		drifted, err := driver.DetectDrift(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, []rpv1.DriftedResource{{ID: helmTestDeploymentID, Action: rpv1.DriftActionCreate}}, drifted)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		helmExecutor, driver := setupHelm(t)
+		helmExecutor.EXPECT().GetRelease(ctx, gomock.Any()).Times(1).Return(nil, errors.New("failed to get the release"))
+
+		_, err := driver.DetectDrift(ctx, DetectDriftOptions{BaseOptions: buildHelmTestInputs()})
+		var recipeError *recipes.RecipeError
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDriftDetectionFailed, recipeError.ErrorDetails.Code)
+	})
+}
+
+func Test_Helm_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+
+	planned := `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-config
+  annotations:
+    radapp.io/recipe-output: "true"
+data:
+  port: "6380"
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: redis-password
+  annotations:
+    radapp.io/recipe-output: "true"
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+`
+	helmExecutor.EXPECT().GetRelease(ctx, gomock.Any()).Times(1).Return(newHelmTestRelease(helmTestManifest, ""), nil)
+	helmExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(newHelmTestRelease(planned, ""), nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Resources: []recipes.PlannedResource{
+			{ID: helmTestConfigMapID, Action: recipes.PlanActionUpdate},
+			{ID: helmTestServiceID, Action: recipes.PlanActionCreate},
+			{ID: helmTestDeploymentID, Action: recipes.PlanActionDelete},
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+func Test_Helm_Plan_NotInstalled(t *testing.T) {
+	ctx := testcontext.New(t)
+	helmExecutor, driver := setupHelm(t)
+
+	helmExecutor.EXPECT().GetRelease(ctx, gomock.Any()).Times(1).Return(nil, nil)
+	helmExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(newHelmTestRelease(helmTestManifest, ""), nil)
+
+	plan, err := driver.Plan(ctx, ExecuteOptions{BaseOptions: buildHelmTestInputs()})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipePlan{
+		Resources: []recipes.PlannedResource{
+			{ID: helmTestDeploymentID, Action: recipes.PlanActionCreate},
+			{ID: helmTestConfigMapID, Action: recipes.PlanActionCreate},
+			{ID: helmTestSecretID, Action: recipes.PlanActionCreate},
+		},
+	}
+	require.Equal(t, expected, plan)
+}
+
+func Test_ParseHelmRecipeResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		notes    string
+		expected map[string]any
+		err      string
+	}{
+		{
+			name:     "no result",
+			notes:    "Redis is installed.",
+			expected: map[string]any{},
+		},
+		{
+			name:     "JSON result",
+			notes:    "Redis is installed.\nBEGIN RADIUS RECIPE RESULT\n{\"values\": {\"port\": 6379}}\nEND RADIUS RECIPE RESULT",
+			expected: map[string]any{"values": map[string]any{"port": float64(6379)}},
+		},
+		{
+			name:     "YAML result",
+			notes:    "BEGIN RADIUS RECIPE RESULT\nsecrets:\n  password: secret\nEND RADIUS RECIPE RESULT\nSee the docs.",
+			expected: map[string]any{"secrets": map[string]any{"password": "secret"}},
+		},
+		{
+			name:     "empty result",
+			notes:    "BEGIN RADIUS RECIPE RESULT\nEND RADIUS RECIPE RESULT",
+			expected: map[string]any{},
+		},
+		{
+			name:  "unterminated result",
+			notes: "BEGIN RADIUS RECIPE RESULT\nvalues: {}",
+			err:   `the recipe result in the NOTES of the chart is not terminated by "END RADIUS RECIPE RESULT"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseHelmRecipeResult(tt.notes)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/radius-project/radius/pkg/recipes/driver (interfaces: Driver)
+// Source: github.com/radius-project/radius/pkg/recipes/driver (interfaces: Driver,StateDriver,DigestDriver)
 
 // Package driver is a generated GoMock package.
 package driver
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	recipes "github.com/radius-project/radius/pkg/recipes"
+	v1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
 // MockDriver is a mock of Driver interface.
@@ -49,6 +50,21 @@ func (mr *MockDriverMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDriver)(nil).Delete), arg0, arg1)
 }
 
+// DetectDrift mocks base method.
+func (m *MockDriver) DetectDrift(arg0 context.Context, arg1 DetectDriftOptions) ([]v1.DriftedResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", arg0, arg1)
+	ret0, _ := ret[0].([]v1.DriftedResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockDriverMockRecorder) DetectDrift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockDriver)(nil).DetectDrift), arg0, arg1)
+}
+
 // Execute mocks base method.
 func (m *MockDriver) Execute(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipeOutput, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +94,108 @@ func (mr *MockDriverMockRecorder) GetRecipeMetadata(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockDriver)(nil).GetRecipeMetadata), arg0, arg1)
 }
+
+// Plan mocks base method.
+func (m *MockDriver) Plan(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*recipes.RecipePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockDriverMockRecorder) Plan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockDriver)(nil).Plan), arg0, arg1)
+}
+
+// MockStateDriver is a mock of StateDriver interface.
+type MockStateDriver struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateDriverMockRecorder
+}
+
+// MockStateDriverMockRecorder is the mock recorder for MockStateDriver.
+type MockStateDriverMockRecorder struct {
+	mock *MockStateDriver
+}
+
+// NewMockStateDriver creates a new mock instance.
+func NewMockStateDriver(ctrl *gomock.Controller) *MockStateDriver {
+	mock := &MockStateDriver{ctrl: ctrl}
+	mock.recorder = &MockStateDriverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateDriver) EXPECT() *MockStateDriverMockRecorder {
+	return m.recorder
+}
+
+// ExportState mocks base method.
+func (m *MockStateDriver) ExportState(arg0 context.Context, arg1 BaseOptions) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportState", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportState indicates an expected call of ExportState.
+func (mr *MockStateDriverMockRecorder) ExportState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportState", reflect.TypeOf((*MockStateDriver)(nil).ExportState), arg0, arg1)
+}
+
+// UnlockState mocks base method.
+func (m *MockStateDriver) UnlockState(arg0 context.Context, arg1 BaseOptions, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockState indicates an expected call of UnlockState.
+func (mr *MockStateDriverMockRecorder) UnlockState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockState", reflect.TypeOf((*MockStateDriver)(nil).UnlockState), arg0, arg1, arg2)
+}
+
+// MockDigestDriver is a mock of DigestDriver interface.
+type MockDigestDriver struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestDriverMockRecorder
+}
+
+// MockDigestDriverMockRecorder is the mock recorder for MockDigestDriver.
+type MockDigestDriverMockRecorder struct {
+	mock *MockDigestDriver
+}
+
+// NewMockDigestDriver creates a new mock instance.
+func NewMockDigestDriver(ctrl *gomock.Controller) *MockDigestDriver {
+	mock := &MockDigestDriver{ctrl: ctrl}
+	mock.recorder = &MockDigestDriverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestDriver) EXPECT() *MockDigestDriverMockRecorder {
+	return m.recorder
+}
+
+// GetTemplateDigest mocks base method.
+func (m *MockDigestDriver) GetTemplateDigest(arg0 context.Context, arg1 BaseOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateDigest", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateDigest indicates an expected call of GetTemplateDigest.
+func (mr *MockDigestDriverMockRecorder) GetTemplateDigest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateDigest", reflect.TypeOf((*MockDigestDriver)(nil).GetTemplateDigest), arg0, arg1)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/recipes/terraform"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/sdk"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
 	awsresources "github.com/radius-project/radius/pkg/ucp/resources/aws"
@@ -45,12 +46,18 @@ import (
 )
 
 var _ Driver = (*terraformDriver)(nil)
+var _ StateDriver = (*terraformDriver)(nil)
 
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
 func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
 	return &terraformDriver{
-		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet),
-		options:           options,
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet, terraform.NewInstaller(terraform.InstallOptions{
+			RootDir:   options.Path,
+			Version:   options.Version,
+			ExecPath:  options.ExecPath,
+			MirrorURL: options.MirrorURL,
+		})),
+		options: options,
 	}
 }
 
@@ -58,6 +65,15 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.Sec
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// Version is the version of Terraform to install. The latest version is installed if empty.
+	Version string
+
+	// ExecPath is the path to a pre-provisioned Terraform binary used instead of downloading Terraform.
+	ExecPath string
+
+	// MirrorURL is the URL of a mirror of https://releases.hashicorp.com, or a local directory with the same layout, to download Terraform from.
+	MirrorURL string
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -89,20 +105,24 @@ func (d *terraformDriver) Execute(ctx context.Context, opts ExecuteOptions) (*re
 		return nil, nil
 	}
 
-	tfState, err := d.terraformExecutor.Deploy(ctx, terraform.Options{
+	result, err := d.terraformExecutor.Deploy(ctx, terraform.Options{
 		RootDir:        requestDirPath,
 		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
 	})
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, newCanceledError(err)
+		}
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
 	}
 
-	recipeOutputs, err := d.prepareRecipeResponse(ctx, tfState)
+	recipeOutputs, err := d.prepareRecipeResponse(ctx, result.State, kubernetesPlaneName(opts.Configuration))
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
 	}
+	recipeOutputs.Status = newRecipeStatus(opts.BaseOptions, result.ModuleVersion, result.ModuleDigest)
 
 	return recipeOutputs, nil
 }
@@ -128,6 +148,9 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 		EnvRecipe:      &opts.Definition,
 	})
 	if err != nil {
+		if ctx.Err() != nil {
+			return newCanceledError(err)
+		}
 		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetRecipeErrorDetails(err))
 	}
 
@@ -136,7 +159,8 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
-func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
+// The Kubernetes resources are identified in the given Kubernetes plane.
+func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, tfState *tfjson.State, planeName string) (*recipes.RecipeOutput, error) {
 	if tfState == nil || (*tfState == tfjson.State{}) {
 		return &recipes.RecipeOutput{}, errors.New("terraform state is empty")
 	}
@@ -153,7 +177,7 @@ func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, tfState *tf
 		}
 	}
 
-	deployedResources, err := d.getDeployedOutputResources(ctx, tfState.Values.RootModule)
+	deployedResources, err := d.getDeployedOutputResources(ctx, tfState.Values.RootModule, planeName)
 	if err != nil {
 		return &recipes.RecipeOutput{}, err
 	}
@@ -228,9 +252,254 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts BaseOption
 	return recipeData, nil
 }
 
+// ExportState returns the Terraform state file of the resource from the backend configured for the environment.
+func (d *terraformDriver) ExportState(ctx context.Context, opts BaseOptions) ([]byte, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeStateFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	state, err := d.terraformExecutor.ExportState(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeStateFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return state, nil
+}
+
+// UnlockState releases the lock on the Terraform state file of the resource in the backend configured for the environment.
+func (d *terraformDriver) UnlockState(ctx context.Context, opts BaseOptions, lockID string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeStateFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	err = d.terraformExecutor.UnlockState(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+	}, lockID)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeStateFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return nil
+}
+
+// DetectDrift runs Terraform plan on the recipe and returns the resources which need to be changed to match the recipe,
+// including the resources changed or deleted outside of Terraform.
+func (d *terraformDriver) DetectDrift(ctx context.Context, opts DetectDriftOptions) ([]rpv1.DriftedResource, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	plan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, newCanceledError(err)
+		}
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	driftedResources, err := d.getDriftedResources(ctx, plan, kubernetesPlaneName(opts.Configuration))
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return driftedResources, nil
+}
+
+// Plan runs Terraform plan on the recipe and returns the resources which would be created, updated or deleted by a
+// deployment of the recipe. Resources replaced by the plan are reported as deleted and created again.
+func (d *terraformDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	plan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, newCanceledError(err)
+		}
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	plannedResources, err := d.getPlannedResources(ctx, plan, kubernetesPlaneName(opts.Configuration))
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return &recipes.RecipePlan{Resources: plannedResources}, nil
+}
+
+// getDriftedResources returns the resources which are changed by the Terraform plan. The resources are identified by
+// their UCP resource ID computed from the state before the plan when possible, or by their Terraform address otherwise.
+func (d *terraformDriver) getDriftedResources(ctx context.Context, plan *tfjson.Plan, planeName string) ([]rpv1.DriftedResource, error) {
+	resourceIDs, err := d.getPriorResourceIDs(ctx, plan, planeName)
+	if err != nil {
+		return nil, err
+	}
+
+	driftedResources := []rpv1.DriftedResource{}
+	for _, change := range plan.ResourceChanges {
+		if change.Change == nil || change.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		var action rpv1.DriftAction
+		switch {
+		case change.Change.Actions.Replace():
+			action = rpv1.DriftActionReplace
+		case change.Change.Actions.Create():
+			action = rpv1.DriftActionCreate
+		case change.Change.Actions.Update():
+			action = rpv1.DriftActionUpdate
+		case change.Change.Actions.Delete():
+			action = rpv1.DriftActionDelete
+		default:
+			// No-op and read actions don't change the deployed resources.
+			continue
+		}
+
+		id, ok := resourceIDs[change.Address]
+		if !ok {
+			id = change.Address
+		}
+		driftedResources = append(driftedResources, rpv1.DriftedResource{ID: id, Action: action})
+	}
+
+	return driftedResources, nil
+}
+
+// getPlannedResources returns the resources which are created, updated or deleted by the Terraform plan. The resources
+// are identified by their UCP resource ID computed from the state before the plan when possible, or by their Terraform
+// address otherwise.
+func (d *terraformDriver) getPlannedResources(ctx context.Context, plan *tfjson.Plan, planeName string) ([]recipes.PlannedResource, error) {
+	resourceIDs, err := d.getPriorResourceIDs(ctx, plan, planeName)
+	if err != nil {
+		return nil, err
+	}
+
+	plannedResources := []recipes.PlannedResource{}
+	for _, change := range plan.ResourceChanges {
+		if change.Change == nil || change.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		var actions []recipes.PlanAction
+		switch {
+		case change.Change.Actions.Replace():
+			actions = []recipes.PlanAction{recipes.PlanActionDelete, recipes.PlanActionCreate}
+		case change.Change.Actions.Create():
+			actions = []recipes.PlanAction{recipes.PlanActionCreate}
+		case change.Change.Actions.Update():
+			actions = []recipes.PlanAction{recipes.PlanActionUpdate}
+		case change.Change.Actions.Delete():
+			actions = []recipes.PlanAction{recipes.PlanActionDelete}
+		default:
+			// No-op and read actions don't change the deployed resources.
+			continue
+		}
+
+		id, ok := resourceIDs[change.Address]
+		if !ok {
+			id = change.Address
+		}
+		for _, action := range actions {
+			plannedResources = append(plannedResources, recipes.PlannedResource{ID: id, Action: action})
+		}
+	}
+
+	return plannedResources, nil
+}
+
+// getPriorResourceIDs returns the UCP resource IDs of the resources in the state before the Terraform plan, keyed by
+// the Terraform address of the resources.
+func (d *terraformDriver) getPriorResourceIDs(ctx context.Context, plan *tfjson.Plan, planeName string) (map[string]string, error) {
+	resourceIDs := map[string]string{}
+	if plan.PriorState != nil && plan.PriorState.Values != nil {
+		if err := d.addResourceIDs(ctx, plan.PriorState.Values.RootModule, resourceIDs, planeName); err != nil {
+			return nil, err
+		}
+	}
+
+	return resourceIDs, nil
+}
+
+// addResourceIDs adds the UCP resource IDs of the resources in the Terraform state module and its child modules to ids,
+// keyed by the Terraform address of the resources.
+func (d *terraformDriver) addResourceIDs(ctx context.Context, module *tfjson.StateModule, ids map[string]string, planeName string) error {
+	if module == nil {
+		return nil
+	}
+
+	for _, resource := range module.Resources {
+		resourceIDs, err := d.getDeployedOutputResources(ctx, &tfjson.StateModule{Resources: []*tfjson.StateResource{resource}}, planeName)
+		if err != nil {
+			return err
+		}
+		if len(resourceIDs) > 0 {
+			ids[resource.Address] = resourceIDs[0]
+		}
+	}
+
+	for _, childModule := range module.ChildModules {
+		if err := d.addResourceIDs(ctx, childModule, ids, planeName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // getDeployedOutputResources is used to the get the resource IDs by parsing the terraform state for resource information and using it to create UCP qualified IDs.
-// Currently only Azure, AWS and Kubernetes providers are supported by output resources.
-func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module *tfjson.StateModule) ([]string, error) {
+// Currently only Azure, AWS and Kubernetes providers are supported by output resources. The Kubernetes resources are
+// identified in the given Kubernetes plane, which is the plane of the cluster targeted by the environment.
+func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module *tfjson.StateModule, planeName string) ([]string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	recipeResources := []string{}
 	if module == nil {
@@ -289,7 +558,7 @@ func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module
 
 				}
 			}
-			kubernetesResourceID, err := kubernetesresources.ToUCPResourceID(namespace, resourceType, resourceName, provider)
+			kubernetesResourceID, err := kubernetesresources.ToUCPResourceID(planeName, namespace, resourceType, resourceName, provider)
 			if err != nil {
 				return []string{}, err
 			}
@@ -322,7 +591,7 @@ func (d *terraformDriver) getDeployedOutputResources(ctx context.Context, module
 	}
 
 	for _, childModule := range module.ChildModules {
-		modResources, err := d.getDeployedOutputResources(ctx, childModule)
+		modResources, err := d.getDeployedOutputResources(ctx, childModule, planeName)
 		if err != nil {
 			return []string{}, err
 		}
@@ -33,6 +33,7 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 
 	"github.com/radius-project/radius/pkg/recipes/terraform"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -102,6 +103,13 @@ func Test_Terraform_Execute_Success(t *testing.T) {
 		},
 		Secrets:   map[string]any{},
 		Resources: []string{},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindBicep,
+			TemplatePath:    "Azure/redis/azurerm",
+			ResolvedVersion: "1.0.0",
+			TemplateDigest:  "sha256:digest",
+			Parameters:      map[string]any{"redis_cache_name": "redis-test"},
+		},
 	}
 
 	expectedTFState := &tfjson.State{
@@ -119,7 +127,7 @@ func Test_Terraform_Execute_Success(t *testing.T) {
 		},
 	}
 
-	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(expectedTFState, nil)
+	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(&terraform.DeployResult{State: expectedTFState, ModuleVersion: "1.0.0", ModuleDigest: "sha256:digest"}, nil)
 
 	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
 		BaseOptions: BaseOptions{
@@ -163,6 +171,39 @@ func Test_Terraform_Execute_DeploymentFailure(t *testing.T) {
 	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Execute_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(testcontext.New(t))
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	recipeError := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeDeploymentCanceled,
+			Message: "recipe operation was canceled: context canceled",
+		},
+		DeploymentStatus: "executionError",
+	}
+	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, options terraform.Options) (*terraform.DeployResult, error) {
+		cancel()
+		return nil, ctx.Err()
+	})
+
+	_, err := driver.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, err, &recipeError)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_Execute_OutputsFailure(t *testing.T) {
 	ctx := testcontext.New(t)
 	armCtx := &v1.ARMRequestContext{
@@ -195,7 +236,7 @@ func Test_Terraform_Execute_OutputsFailure(t *testing.T) {
 		},
 		DeploymentStatus: "executionError",
 	}
-	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(expectedTFState, nil)
+	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(&terraform.DeployResult{State: expectedTFState, ModuleVersion: "1.0.0", ModuleDigest: "sha256:digest"}, nil)
 
 	_, err := driver.Execute(ctx, ExecuteOptions{
 		BaseOptions: BaseOptions{
@@ -245,6 +286,11 @@ func Test_Terraform_Execute_EmptyOperationID_Success(t *testing.T) {
 		},
 		Secrets:   map[string]any{},
 		Resources: []string{},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "Azure/redis/azurerm",
+			Parameters:   map[string]any{"redis_cache_name": "redis-test"},
+		},
 	}
 
 	expectedTFState := &tfjson.State{
@@ -265,7 +311,7 @@ func Test_Terraform_Execute_EmptyOperationID_Success(t *testing.T) {
 	tfExecutor.EXPECT().
 		Deploy(ctx, gomock.Any()).
 		Times(1).
-		Return(expectedTFState, nil)
+		Return(&terraform.DeployResult{State: expectedTFState}, nil)
 
 	recipeOutput, err := driver.Execute(ctx, ExecuteOptions{
 		BaseOptions: BaseOptions{
@@ -391,6 +437,280 @@ func TestTerraformDriver_GetRecipeMetadata_Failure(t *testing.T) {
 	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
 }
 
+func TestTerraformDriver_ExportState(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, _ := buildTestInputs()
+
+	tfExecutor.EXPECT().ExportState(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, opts terraform.Options) ([]byte, error) {
+		require.Equal(t, &envConfig, opts.EnvConfig)
+		require.Equal(t, &recipeMetadata, opts.ResourceRecipe)
+		return []byte("{}"), nil
+	})
+
+	state, err := driver.ExportState(ctx, BaseOptions{
+		Configuration: envConfig,
+		Recipe:        recipeMetadata,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("{}"), state)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func TestTerraformDriver_ExportState_Failure(t *testing.T) {
+	ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{OperationID: uuid.New()})
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, _ := buildTestInputs()
+
+	tfExecutor.EXPECT().ExportState(ctx, gomock.Any()).Times(1).Return(nil, errors.New("terraform init failure"))
+
+	_, err := driver.ExportState(ctx, BaseOptions{
+		Configuration: envConfig,
+		Recipe:        recipeMetadata,
+	})
+	require.Equal(t, recipes.NewRecipeError(recipes.RecipeStateFailed, "terraform init failure", recipes_util.ExecutionError, nil), err)
+}
+
+func TestTerraformDriver_UnlockState(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, _ := buildTestInputs()
+
+	tfExecutor.EXPECT().UnlockState(ctx, gomock.Any(), "lock-id").Times(1).Return(nil)
+
+	err := driver.UnlockState(ctx, BaseOptions{
+		Configuration: envConfig,
+		Recipe:        recipeMetadata,
+	}, "lock-id")
+	require.NoError(t, err)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func TestTerraformDriver_UnlockState_EmptyPath(t *testing.T) {
+	_, driver := setup(t)
+	driver.options.Path = ""
+	envConfig, recipeMetadata, _ := buildTestInputs()
+
+	err := driver.UnlockState(testcontext.New(t), BaseOptions{
+		Configuration: envConfig,
+		Recipe:        recipeMetadata,
+	}, "")
+	require.Equal(t, recipes.NewRecipeError(recipes.RecipeStateFailed, "path is a required option for Terraform driver", recipes_util.RecipeSetupError, nil), err)
+}
+
+func TestTerraformDriver_DetectDrift(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	plan := &tfjson.Plan{
+		PriorState: &tfjson.State{
+			Values: &tfjson.StateValues{
+				RootModule: &tfjson.StateModule{
+					ChildModules: []*tfjson.StateModule{
+						{
+							Resources: []*tfjson.StateResource{
+								{
+									Address:      "module.redis-azure.aws_subnet.subnet",
+									ProviderName: "registry.terraform.io/hashicorp/aws",
+									AttributeValues: map[string]any{
+										"arn": "arn:aws:ec2:us-east-2:179022619019:subnet/subnet-0ddfaa93733f98002",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "module.redis-azure.aws_subnet.subnet",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			},
+			{
+				Address: "module.redis-azure.aws_vpc.vpc",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.redis-azure.aws_route_table.table",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.redis-azure.aws_security_group.group",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+			},
+			{
+				Address: "module.redis-azure.data.aws_region.current",
+				Mode:    tfjson.DataResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}},
+			},
+		},
+	}
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, opts terraform.Options) (*tfjson.Plan, error) {
+		require.Equal(t, &envConfig, opts.EnvConfig)
+		require.Equal(t, &recipeMetadata, opts.ResourceRecipe)
+		require.Equal(t, &envRecipe, opts.EnvRecipe)
+		return plan, nil
+	})
+
+	driftedResources, err := driver.DetectDrift(ctx, DetectDriftOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []rpv1.DriftedResource{
+		{ID: "/planes/aws/aws/accounts/179022619019/regions/us-east-2/providers/AWS.ec2/subnet/subnet-0ddfaa93733f98002", Action: rpv1.DriftActionUpdate},
+		{ID: "module.redis-azure.aws_vpc.vpc", Action: rpv1.DriftActionCreate},
+		{ID: "module.redis-azure.aws_route_table.table", Action: rpv1.DriftActionReplace},
+	}, driftedResources)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func TestTerraformDriver_DetectDrift_Failure(t *testing.T) {
+	ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{OperationID: uuid.New()})
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, errors.New("terraform plan failure"))
+
+	_, err := driver.DetectDrift(ctx, DetectDriftOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, "terraform plan failure", recipes_util.ExecutionError, nil), err)
+}
+
+func TestTerraformDriver_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	plan := &tfjson.Plan{
+		PriorState: &tfjson.State{
+			Values: &tfjson.StateValues{
+				RootModule: &tfjson.StateModule{
+					Resources: []*tfjson.StateResource{
+						{
+							Address:      "aws_subnet.subnet",
+							ProviderName: "registry.terraform.io/hashicorp/aws",
+							AttributeValues: map[string]any{
+								"arn": "arn:aws:ec2:us-east-2:179022619019:subnet/subnet-0ddfaa93733f98002",
+							},
+						},
+					},
+				},
+			},
+		},
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "aws_subnet.subnet",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+			},
+			{
+				Address: "aws_vpc.vpc",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			},
+			{
+				Address: "aws_route_table.table",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate, tfjson.ActionDelete}},
+			},
+			{
+				Address: "aws_security_group.group",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			},
+			{
+				Address: "aws_internet_gateway.gateway",
+				Mode:    tfjson.ManagedResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+			},
+			{
+				Address: "data.aws_region.current",
+				Mode:    tfjson.DataResourceMode,
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}},
+			},
+		},
+	}
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, opts terraform.Options) (*tfjson.Plan, error) {
+		require.Equal(t, &envConfig, opts.EnvConfig)
+		require.Equal(t, &recipeMetadata, opts.ResourceRecipe)
+		require.Equal(t, &envRecipe, opts.EnvRecipe)
+		return plan, nil
+	})
+
+	result, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		Resources: []recipes.PlannedResource{
+			{ID: "/planes/aws/aws/accounts/179022619019/regions/us-east-2/providers/AWS.ec2/subnet/subnet-0ddfaa93733f98002", Action: recipes.PlanActionDelete},
+			{ID: "aws_vpc.vpc", Action: recipes.PlanActionCreate},
+			{ID: "aws_route_table.table", Action: recipes.PlanActionDelete},
+			{ID: "aws_route_table.table", Action: recipes.PlanActionCreate},
+			{ID: "aws_security_group.group", Action: recipes.PlanActionUpdate},
+		},
+	}, result)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func TestTerraformDriver_Plan_Failure(t *testing.T) {
+	ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{OperationID: uuid.New()})
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, errors.New("terraform plan failure"))
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, recipes.NewRecipeError(recipes.RecipePlanFailed, "terraform plan failure", recipes_util.ExecutionError, nil), err)
+}
+
 func Test_Terraform_Delete_Success(t *testing.T) {
 	ctx := testcontext.New(t)
 	armCtx := &v1.ARMRequestContext{
@@ -477,9 +797,37 @@ func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 	tests := []struct {
 		desc             string
 		state            *tfjson.State
+		planeName        string
 		expectedResponse *recipes.RecipeOutput
 		expectedErr      error
 	}{
+		{
+			desc: "kubernetes resource in registered cluster",
+			state: &tfjson.State{
+				Values: &tfjson.StateValues{
+					RootModule: &tfjson.StateModule{
+						Resources: []*tfjson.StateResource{
+							{
+								Type:         "kubernetes_deployment",
+								ProviderName: "registry.terraform.io/hashicorp/kubernetes",
+								AttributeValues: map[string]any{
+									"metadata": []any{
+										map[string]any{
+											"name":      "test-redis",
+											"namespace": "default",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			planeName: "cluster1",
+			expectedResponse: &recipes.RecipeOutput{
+				Resources: []string{"/planes/kubernetes/cluster1/namespaces/default/providers/apps/Deployment/test-redis"},
+			},
+		},
 		{
 			desc: "valid state",
 			state: &tfjson.State{
@@ -743,7 +1091,11 @@ func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			recipeResponse, err := d.prepareRecipeResponse(context.Background(), tt.state)
+			planeName := tt.planeName
+			if planeName == "" {
+				planeName = "local"
+			}
+			recipeResponse, err := d.prepareRecipeResponse(context.Background(), tt.state, planeName)
 			require.Equal(t, tt.expectedErr, err)
 			require.Equal(t, tt.expectedResponse, recipeResponse)
 		})
@@ -20,7 +20,9 @@ import (
 	"context"
 
 	"github.com/radius-project/radius/pkg/recipes"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 )
 
 const (
@@ -39,6 +41,30 @@ type Driver interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
+
+	// DetectDrift compares the deployed resources with the recipe and returns the resources which drifted from the recipe.
+	DetectDrift(ctx context.Context, opts DetectDriftOptions) ([]rpv1.DriftedResource, error)
+
+	// Plan previews the changes a deployment of the recipe would make to the infrastructure without deploying the recipe.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
+}
+
+// StateDriver is implemented by the drivers that store the state of the deployed recipes, such as the Terraform driver.
+type StateDriver interface {
+	// ExportState returns the state of the recipe deployed for the resource.
+	ExportState(ctx context.Context, opts BaseOptions) ([]byte, error)
+
+	// UnlockState releases the lock on the state of the recipe deployed for the resource. lockID is the ID of the lock,
+	// which is required by the backends that cannot release a lock without its ID.
+	UnlockState(ctx context.Context, opts BaseOptions, lockID string) error
+}
+
+// DigestDriver is implemented by the drivers that can resolve the digest of the content of a recipe template without
+// deploying the recipe, such as the Bicep driver.
+type DigestDriver interface {
+	// GetTemplateDigest returns the digest of the content of the recipe template. The digest has the same format as the
+	// template digest recorded in the recipe status of the resources deployed by the recipe.
+	GetTemplateDigest(ctx context.Context, opts BaseOptions) (string, error)
 }
 
 // BaseOptions is the base options for the driver operations.
@@ -67,3 +93,49 @@ type DeleteOptions struct {
 	// OutputResources is the list of output resources for the recipe.
 	OutputResources []rpv1.OutputResource
 }
+
+// DetectDriftOptions is the options for the DetectDrift method.
+type DetectDriftOptions struct {
+	BaseOptions
+
+	// OutputResources is the list of output resources deployed by the recipe.
+	OutputResources []rpv1.OutputResource
+}
+
+// newCanceledError returns the recipe error for a recipe deployment or deletion which was stopped because the context
+// of the operation was canceled.
+func newCanceledError(err error) *recipes.RecipeError {
+	return recipes.NewRecipeError(recipes.RecipeDeploymentCanceled, "recipe operation was canceled: "+err.Error(), recipes_util.ExecutionError, recipes.GetRecipeErrorDetails(err))
+}
+
+// newRecipeStatus returns the recipe status recorded for a resource deployed by the recipe. The parameters are the
+// operator parameters of the environment overridden by the developer parameters of the resource, which are the
+// parameters the recipe was executed with.
+func newRecipeStatus(opts BaseOptions, resolvedVersion string, templateDigest string) *rpv1.RecipeStatus {
+	parameters := map[string]any{}
+	for k, v := range opts.Definition.Parameters {
+		parameters[k] = v
+	}
+	for k, v := range opts.Recipe.Parameters {
+		parameters[k] = v
+	}
+
+	return &rpv1.RecipeStatus{
+		TemplateKind:    opts.Definition.Driver,
+		TemplatePath:    opts.Definition.TemplatePath,
+		TemplateVersion: opts.Definition.TemplateVersion,
+		ResolvedVersion: resolvedVersion,
+		TemplateDigest:  templateDigest,
+		Parameters:      parameters,
+	}
+}
+
+// kubernetesPlaneName returns the name of the Kubernetes plane of the cluster targeted by the environment of the
+// recipe, which is the local plane unless the environment targets a registered cluster.
+func kubernetesPlaneName(config recipes.Configuration) string {
+	if config.Runtime.Kubernetes == nil || config.Runtime.Kubernetes.PlaneName == "" {
+		return resources_kubernetes.PlaneNameLocal
+	}
+
+	return config.Runtime.Kubernetes.PlaneName
+}
@@ -47,8 +47,8 @@ type engine struct {
 }
 
 // Execute loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
-// configuration associated with the recipe, and then executes the recipe using the driver. It returns a RecipeOutput and
-// an error if one occurs.
+// configuration associated with the recipe, and then executes the recipe using the driver. The recipe output is validated
+// against the outputs expected for the resource type of the recipe. It returns a RecipeOutput and an error if one occurs.
 func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	executionStart := time.Now()
 	result := metrics.SuccessfulOperationState
@@ -93,6 +93,14 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, definition, err
 	}
 
+	// The output is nil when the recipe deployment is skipped, for example in simulated environments.
+	if res != nil {
+		err = res.ValidateOutputContract(definition.ResourceType)
+		if err != nil {
+			return nil, definition, err
+		}
+	}
+
 	return res, definition, nil
 }
 
@@ -145,8 +153,8 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 }
 
 // Gets the Recipe metadata and parameters from Recipe's template path.
-func (e *engine) GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error) {
-	recipeData, err := e.getRecipeMetadataCore(ctx, recipeDefinition)
+func (e *engine) GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error) {
+	recipeData, err := e.getRecipeMetadataCore(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -156,19 +164,156 @@ func (e *engine) GetRecipeMetadata(ctx context.Context, recipeDefinition recipes
 
 // getRecipeMetadataCore function is the core logic of the GetRecipeMetadata function.
 // Any changes to the core logic of the GetRecipeMetadata function should be made here.
-func (e *engine) getRecipeMetadataCore(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error) {
+func (e *engine) getRecipeMetadataCore(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error) {
 	// Determine Recipe driver type
-	driver, ok := e.options.Drivers[recipeDefinition.Driver]
+	driver, ok := e.options.Drivers[opts.RecipeDefinition.Driver]
 	if !ok {
-		return nil, fmt.Errorf("could not find driver %s", recipeDefinition.Driver)
+		return nil, fmt.Errorf("could not find driver %s", opts.RecipeDefinition.Driver)
+	}
+
+	configuration, err := e.loadRegistryConfiguration(ctx, opts.EnvironmentID)
+	if err != nil {
+		return nil, err
 	}
 
 	return driver.GetRecipeMetadata(ctx, recipedriver.BaseOptions{
-		Recipe:     recipes.ResourceMetadata{},
-		Definition: recipeDefinition,
+		Configuration: *configuration,
+		Recipe:        recipes.ResourceMetadata{},
+		Definition:    opts.RecipeDefinition,
+	})
+}
+
+// GetTemplateDigest returns the digest of the content of the recipe template, or an empty string if the driver of the
+// recipe cannot resolve the digest without deploying the recipe.
+func (e *engine) GetTemplateDigest(ctx context.Context, opts GetRecipeMetadataOptions) (string, error) {
+	driver, ok := e.options.Drivers[opts.RecipeDefinition.Driver]
+	if !ok {
+		return "", fmt.Errorf("could not find driver %s", opts.RecipeDefinition.Driver)
+	}
+
+	digestDriver, ok := driver.(recipedriver.DigestDriver)
+	if !ok {
+		return "", nil
+	}
+
+	configuration, err := e.loadRegistryConfiguration(ctx, opts.EnvironmentID)
+	if err != nil {
+		return "", err
+	}
+
+	return digestDriver.GetTemplateDigest(ctx, recipedriver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        recipes.ResourceMetadata{},
+		Definition:    opts.RecipeDefinition,
+	})
+}
+
+// loadRegistryConfiguration loads the configuration of the environment, which contains the credentials used to fetch
+// recipes from private registries. An empty configuration is returned if no environment is specified.
+func (e *engine) loadRegistryConfiguration(ctx context.Context, environmentID string) (*recipes.Configuration, error) {
+	if environmentID == "" {
+		return &recipes.Configuration{}, nil
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipes.ResourceMetadata{EnvironmentID: environmentID})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return configuration, nil
+}
+
+// ExportRecipeState returns the Terraform state of the recipe deployed for the resource, from the backend configured
+// for the environment of the resource.
+func (e *engine) ExportRecipeState(ctx context.Context, opts RecipeStateOptions) ([]byte, error) {
+	driver, configuration, err := e.getStateDriver(ctx, opts.Recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	return driver.ExportState(ctx, recipedriver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        opts.Recipe,
+	})
+}
+
+// UnlockRecipeState releases the lock on the Terraform state of the recipe deployed for the resource, in the backend
+// configured for the environment of the resource.
+func (e *engine) UnlockRecipeState(ctx context.Context, opts RecipeStateOptions) error {
+	driver, configuration, err := e.getStateDriver(ctx, opts.Recipe)
+	if err != nil {
+		return err
+	}
+
+	return driver.UnlockState(ctx, recipedriver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        opts.Recipe,
+	}, opts.LockID)
+}
+
+// DetectDrift loads the recipe of the resource and calls the driver of the recipe to compare the resources deployed by
+// the recipe with the recipe.
+func (e *engine) DetectDrift(ctx context.Context, opts DetectDriftOptions) ([]rpv1.DriftedResource, error) {
+	definition, driver, err := e.getDriver(ctx, opts.Recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, opts.Recipe)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return driver.DetectDrift(ctx, recipedriver.DetectDriftOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        opts.Recipe,
+			Definition:    *definition,
+		},
+		OutputResources: opts.OutputResources,
+	})
+}
+
+// Plan loads the recipe of the resource and calls the driver of the recipe to preview the changes a deployment of the
+// recipe would make.
+func (e *engine) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	definition, driver, err := e.getDriver(ctx, opts.Recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, opts.Recipe)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        opts.Recipe,
+			Definition:    *definition,
+		},
+		PrevState: opts.PreviousState,
 	})
 }
 
+// getStateDriver returns the Terraform driver, which stores the state of the deployed recipes, and the configuration
+// of the environment of the recipe.
+func (e *engine) getStateDriver(ctx context.Context, recipe recipes.ResourceMetadata) (recipedriver.StateDriver, *recipes.Configuration, error) {
+	driver, ok := e.options.Drivers[recipes.TemplateKindTerraform].(recipedriver.StateDriver)
+	if !ok {
+		err := fmt.Errorf("could not find driver %s", recipes.TemplateKindTerraform)
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeDriverNotFoundFailure, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetRecipeErrorDetails(err))
+	}
+
+	return driver, configuration, nil
+}
+
 func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
 	// Load Recipe Definition from the environment.
 	definition, err := e.options.ConfigurationLoader.LoadRecipe(ctx, &recipeMetadata)
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	recipes "github.com/radius-project/radius/pkg/recipes"
+	v1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
 // MockEngine is a mock of Engine interface.
@@ -49,6 +50,21 @@ func (mr *MockEngineMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEngine)(nil).Delete), arg0, arg1)
 }
 
+// DetectDrift mocks base method.
+func (m *MockEngine) DetectDrift(arg0 context.Context, arg1 DetectDriftOptions) ([]v1.DriftedResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", arg0, arg1)
+	ret0, _ := ret[0].([]v1.DriftedResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockEngineMockRecorder) DetectDrift(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockEngine)(nil).DetectDrift), arg0, arg1)
+}
+
 // Execute mocks base method.
 func (m *MockEngine) Execute(arg0 context.Context, arg1 ExecuteOptions) (*recipes.RecipeOutput, error) {
 	m.ctrl.T.Helper()
@@ -64,8 +80,23 @@ func (mr *MockEngineMockRecorder) Execute(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEngine)(nil).Execute), arg0, arg1)
 }
 
+// ExportRecipeState mocks base method.
+func (m *MockEngine) ExportRecipeState(arg0 context.Context, arg1 RecipeStateOptions) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportRecipeState", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportRecipeState indicates an expected call of ExportRecipeState.
+func (mr *MockEngineMockRecorder) ExportRecipeState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRecipeState", reflect.TypeOf((*MockEngine)(nil).ExportRecipeState), arg0, arg1)
+}
+
 // GetRecipeMetadata mocks base method.
-func (m *MockEngine) GetRecipeMetadata(arg0 context.Context, arg1 recipes.EnvironmentDefinition) (map[string]interface{}, error) {
+func (m *MockEngine) GetRecipeMetadata(arg0 context.Context, arg1 GetRecipeMetadataOptions) (map[string]interface{}, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRecipeMetadata", arg0, arg1)
 	ret0, _ := ret[0].(map[string]interface{})
@@ -78,3 +109,47 @@ func (mr *MockEngineMockRecorder) GetRecipeMetadata(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockEngine)(nil).GetRecipeMetadata), arg0, arg1)
 }
+
+// GetTemplateDigest mocks base method.
+func (m *MockEngine) GetTemplateDigest(arg0 context.Context, arg1 GetRecipeMetadataOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateDigest", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateDigest indicates an expected call of GetTemplateDigest.
+func (mr *MockEngineMockRecorder) GetTemplateDigest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateDigest", reflect.TypeOf((*MockEngine)(nil).GetTemplateDigest), arg0, arg1)
+}
+
+// Plan mocks base method.
+func (m *MockEngine) Plan(arg0 context.Context, arg1 PlanOptions) (*recipes.RecipePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*recipes.RecipePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockEngineMockRecorder) Plan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockEngine)(nil).Plan), arg0, arg1)
+}
+
+// UnlockRecipeState mocks base method.
+func (m *MockEngine) UnlockRecipeState(arg0 context.Context, arg1 RecipeStateOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockRecipeState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockRecipeState indicates an expected call of UnlockRecipeState.
+func (mr *MockEngineMockRecorder) UnlockRecipeState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockRecipeState", reflect.TypeOf((*MockEngine)(nil).UnlockRecipeState), arg0, arg1)
+}
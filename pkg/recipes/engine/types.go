@@ -34,7 +34,24 @@ type Engine interface {
 	Delete(ctx context.Context, opts DeleteOptions) error
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
-	GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error)
+	GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error)
+
+	// GetTemplateDigest returns the digest of the content of the recipe template, or an empty string if the driver of
+	// the recipe cannot resolve the digest without deploying the recipe.
+	GetTemplateDigest(ctx context.Context, opts GetRecipeMetadataOptions) (string, error)
+
+	// ExportRecipeState returns the Terraform state of the recipe deployed for the resource.
+	ExportRecipeState(ctx context.Context, opts RecipeStateOptions) ([]byte, error)
+
+	// UnlockRecipeState releases the lock on the Terraform state of the recipe deployed for the resource.
+	UnlockRecipeState(ctx context.Context, opts RecipeStateOptions) error
+
+	// DetectDrift compares the resources deployed by the recipe with the recipe, and returns the resources which drifted from the recipe.
+	DetectDrift(ctx context.Context, opts DetectDriftOptions) ([]rpv1.DriftedResource, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to preview the changes a deployment
+	// of the recipe would make, without deploying the recipe.
+	Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -59,3 +76,37 @@ type DeleteOptions struct {
 	// OutputResources is the list of output resources for the recipe.
 	OutputResources []rpv1.OutputResource
 }
+
+// GetRecipeMetadataOptions is the options for the GetRecipeMetadata method.
+type GetRecipeMetadataOptions struct {
+	// EnvironmentID is the ID of the environment the recipe is registered to. The configuration of the environment,
+	// such as registry credentials, is used to fetch the recipe.
+	EnvironmentID string
+
+	// RecipeDefinition is the environment definition of the recipe.
+	RecipeDefinition recipes.EnvironmentDefinition
+}
+
+// RecipeStateOptions is the options for the ExportRecipeState and UnlockRecipeState methods.
+type RecipeStateOptions struct {
+	BaseOptions
+
+	// LockID is the ID of the lock to release. The lock is released without its ID by the backends supporting it if empty.
+	LockID string
+}
+
+// DetectDriftOptions is the options for the DetectDrift method.
+type DetectDriftOptions struct {
+	BaseOptions
+
+	// OutputResources is the list of output resources deployed by the recipe.
+	OutputResources []rpv1.OutputResource
+}
+
+// PlanOptions is the options for the Plan method.
+type PlanOptions struct {
+	BaseOptions
+
+	// PreviousState represents previously deployed state of output resource IDs.
+	PreviousState []string
+}
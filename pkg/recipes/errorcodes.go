@@ -20,6 +20,9 @@ const (
 	// Used for recipe deployment failures.
 	RecipeDeploymentFailed = "RecipeDeploymentFailed"
 
+	// Used for recipe deployments which were stopped because the operation was canceled.
+	RecipeDeploymentCanceled = "RecipeDeploymentCanceled"
+
 	// Used for recipe validation failures.
 	RecipeValidationFailed = "RecipeValidationFailed"
 
@@ -46,4 +49,13 @@ const (
 
 	// Used for errors with recipe configuration
 	RecipeConfigurationFailure = "RecipeConfigurationFailure"
+
+	// Used for errors encountered when exporting or unlocking the state of a recipe.
+	RecipeStateFailed = "RecipeStateFailed"
+
+	// Used for errors encountered when detecting the drift of the resources deployed by a recipe.
+	RecipeDriftDetectionFailed = "RecipeDriftDetectionFailed"
+
+	// Used for errors encountered when previewing the changes of a recipe deployment.
+	RecipePlanFailed = "RecipePlanFailed"
 )
@@ -0,0 +1,251 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
+)
+
+// chartReference is the reference of the chart of a recipe.
+type chartReference struct {
+	// RepoURL is the URL of the chart repository. It is empty for charts stored in an OCI registry.
+	RepoURL string
+
+	// Chart is the name of the chart in the chart repository, or the reference of the chart in the OCI registry.
+	Chart string
+}
+
+// parseChartReference parses the template path of a Helm recipe, which is either the reference of a chart in an OCI
+// registry, such as 'oci://ghcr.io/myorg/charts/redis', or the URL of a chart repository followed by the name of the
+// chart, such as 'https://charts.example.com/stable/redis'.
+func parseChartReference(templatePath string) (chartReference, error) {
+	if registry.IsOCI(templatePath) {
+		return chartReference{Chart: templatePath}, nil
+	}
+
+	u, err := url.Parse(templatePath)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return chartReference{}, fmt.Errorf("invalid template path %q: expected the reference of a chart in an OCI registry or the URL of a chart repository followed by the name of the chart", templatePath)
+	}
+
+	if strings.Trim(u.Path, "/") == "" || strings.HasSuffix(u.Path, "/") {
+		return chartReference{}, fmt.Errorf("invalid template path %q: the name of the chart must follow the URL of the chart repository", templatePath)
+	}
+
+	i := strings.LastIndex(templatePath, "/")
+	return chartReference{RepoURL: templatePath[:i], Chart: templatePath[i+1:]}, nil
+}
+
+// downloadChart downloads the chart referenced by the recipe to a temporary directory and loads it. Charts stored in
+// an OCI registry are pulled with the given registry client, which must be logged in to the registry if it is private.
+func downloadChart(client *registry.Client, definition *recipes.EnvironmentDefinition) (*chart.Chart, error) {
+	ref, err := parseChartReference(definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	pull := action.NewPullWithOpts(action.WithConfig(&action.Configuration{RegistryClient: client}))
+	pull.Settings = &cli.EnvSettings{}
+	pull.RepoURL = ref.RepoURL
+	// The latest version of the chart is pulled if the version isn't set.
+	pull.Version = definition.TemplateVersion
+
+	dir, err := os.MkdirTemp("", "helm-recipe-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a directory to download the chart: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	pull.DestDir = dir
+
+	if _, err := pull.Run(ref.Chart); err != nil {
+		return nil, fmt.Errorf("failed to download the chart %q: %w", definition.TemplatePath, err)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) != 1 {
+		return nil, fmt.Errorf("failed to download the chart %q: expected a single chart archive, found %d files", definition.TemplatePath, len(files))
+	}
+
+	ch, err := loader.Load(filepath.Join(dir, files[0].Name()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the chart %q: %w", definition.TemplatePath, err)
+	}
+
+	return ch, nil
+}
+
+// loginToRegistry logs the registry client in to the OCI registry of the chart of the recipe if credentials are
+// configured for the registry in the environment.
+func loginToRegistry(client *registry.Client, templatePath string, credentials map[string]recipes.RegistryCredentials) error {
+	if !registry.IsOCI(templatePath) {
+		return nil
+	}
+
+	host, _, _ := strings.Cut(strings.TrimPrefix(templatePath, fmt.Sprintf("%s://", registry.OCIScheme)), "/")
+	cred, ok := credentials[host]
+	if !ok {
+		return nil
+	}
+
+	if cred.Username == "" {
+		return fmt.Errorf("failed to login to the registry %q: only username and password credentials are supported for Helm charts", host)
+	}
+
+	if err := client.Login(host, registry.LoginOptBasicAuth(cred.Username, cred.Password)); err != nil {
+		return fmt.Errorf("failed to login to the registry %q: %w", host, err)
+	}
+
+	return nil
+}
+
+// getChartParameters returns the parameters of a recipe from the top-level properties of the values schema of the
+// chart, with the default values of the chart. The top-level default values of the chart are returned as the parameters
+// if the chart has no values schema.
+func getChartParameters(ch *chart.Chart) (map[string]any, error) {
+	parameters := map[string]any{}
+
+	if len(ch.Schema) == 0 {
+		for name, value := range ch.Values {
+			parameters[name] = map[string]any{
+				"type":         valueType(value),
+				"defaultValue": value,
+			}
+		}
+		return parameters, nil
+	}
+
+	schema, err := parseValuesSchema(ch)
+	if err != nil {
+		return nil, err
+	}
+
+	required := map[string]bool{}
+	if names, ok := schema["required"].([]any); ok {
+		for _, name := range names {
+			if s, ok := name.(string); ok {
+				required[s] = true
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	for name, value := range properties {
+		property, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse the values schema of the chart %q: property %q is not an object", ch.Name(), name)
+		}
+
+		details := map[string]any{
+			"required": required[name],
+		}
+		if t, ok := property["type"].(string); ok {
+			details["type"] = parameterType(t)
+		}
+		if description, ok := property["description"].(string); ok {
+			details["description"] = description
+		}
+		if allowed, ok := property["enum"].([]any); ok {
+			details["allowedValues"] = allowed
+		}
+		if defaultValue, ok := ch.Values[name]; ok {
+			details["defaultValue"] = defaultValue
+		} else if defaultValue, ok := property["default"]; ok {
+			details["defaultValue"] = defaultValue
+		}
+
+		parameters[name] = details
+	}
+
+	return parameters, nil
+}
+
+// parseValuesSchema parses the values schema of the chart. It returns nil if the chart has no values schema.
+func parseValuesSchema(ch *chart.Chart) (map[string]any, error) {
+	if len(ch.Schema) == 0 {
+		return nil, nil
+	}
+
+	schema := map[string]any{}
+	if err := json.Unmarshal(ch.Schema, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse the values schema of the chart %q: %w", ch.Name(), err)
+	}
+
+	return schema, nil
+}
+
+// acceptsContextValue returns true unless the values schema of the chart rejects the recipe context value, which is
+// the case when the schema doesn't declare it and disallows additional properties.
+func acceptsContextValue(ch *chart.Chart) (bool, error) {
+	schema, err := parseValuesSchema(ch)
+	if err != nil || schema == nil {
+		return err == nil, err
+	}
+
+	if properties, ok := schema["properties"].(map[string]any); ok {
+		if _, ok := properties[recipecontext.RecipeContextParamKey]; ok {
+			return true, nil
+		}
+	}
+
+	additionalProperties, ok := schema["additionalProperties"].(bool)
+	return !ok || additionalProperties, nil
+}
+
+// parameterType returns the type of a recipe parameter from the type of a property of a JSON schema, using the names
+// of the types of Bicep parameters for the types which have one.
+func parameterType(schemaType string) string {
+	switch schemaType {
+	case "integer":
+		return "int"
+	case "boolean":
+		return "bool"
+	default:
+		return schemaType
+	}
+}
+
+// valueType returns the type of a recipe parameter from the default value of the chart.
+func valueType(value any) string {
+	switch value.(type) {
+	case bool:
+		return "bool"
+	case int, int64, float64:
+		return "int"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+func Test_ParseChartReference(t *testing.T) {
+	tests := []struct {
+		templatePath string
+		expected     chartReference
+		err          string
+	}{
+		{
+			templatePath: "oci://ghcr.io/myorg/charts/redis",
+			expected:     chartReference{Chart: "oci://ghcr.io/myorg/charts/redis"},
+		},
+		{
+			templatePath: "https://charts.example.com/stable/redis",
+			expected:     chartReference{RepoURL: "https://charts.example.com/stable", Chart: "redis"},
+		},
+		{
+			templatePath: "http://charts.example.com/redis",
+			expected:     chartReference{RepoURL: "http://charts.example.com", Chart: "redis"},
+		},
+		{
+			templatePath: "https://charts.example.com/stable/",
+			err:          `invalid template path "https://charts.example.com/stable/": the name of the chart must follow the URL of the chart repository`,
+		},
+		{
+			templatePath: "https://charts.example.com",
+			err:          `invalid template path "https://charts.example.com": the name of the chart must follow the URL of the chart repository`,
+		},
+		{
+			templatePath: "ghcr.io/myorg/charts/redis",
+			err:          `invalid template path "ghcr.io/myorg/charts/redis": expected the reference of a chart in an OCI registry or the URL of a chart repository followed by the name of the chart`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.templatePath, func(t *testing.T) {
+			ref, err := parseChartReference(tt.templatePath)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ref)
+		})
+	}
+}
+
+func Test_GetChartParameters_NoSchema(t *testing.T) {
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "redis"},
+		Values: map[string]any{
+			"replicas": float64(1),
+			"image":    "redis:7",
+			"auth":     map[string]any{"enabled": true},
+		},
+	}
+
+	parameters, err := getChartParameters(ch)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"replicas": map[string]any{"type": "int", "defaultValue": float64(1)},
+		"image":    map[string]any{"type": "string", "defaultValue": "redis:7"},
+		"auth":     map[string]any{"type": "object", "defaultValue": map[string]any{"enabled": true}},
+	}
+	require.Equal(t, expected, parameters)
+}
+
+func Test_GetChartParameters_Schema(t *testing.T) {
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "redis"},
+		Values: map[string]any{
+			"replicas": float64(1),
+		},
+		Schema: []byte(`{
+			"properties": {
+				"replicas": {"type": "integer"},
+				"tls": {"type": "boolean", "default": false},
+				"sku": {"type": "string", "enum": ["Basic", "Standard"]}
+			}
+		}`),
+	}
+
+	parameters, err := getChartParameters(ch)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"replicas": map[string]any{"type": "int", "defaultValue": float64(1), "required": false},
+		"tls":      map[string]any{"type": "bool", "defaultValue": false, "required": false},
+		"sku":      map[string]any{"type": "string", "allowedValues": []any{"Basic", "Standard"}, "required": false},
+	}
+	require.Equal(t, expected, parameters)
+
+	ch.Schema = []byte("{")
+	_, err = getChartParameters(ch)
+	require.ErrorContains(t, err, `failed to parse the values schema of the chart "redis"`)
+}
+
+func Test_AcceptsContextValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		expected bool
+	}{
+		{
+			name:     "no schema",
+			expected: true,
+		},
+		{
+			name:     "additional properties allowed",
+			schema:   `{"properties": {"replicas": {"type": "integer"}}}`,
+			expected: true,
+		},
+		{
+			name:     "context declared",
+			schema:   `{"additionalProperties": false, "properties": {"context": {"type": "object"}}}`,
+			expected: true,
+		},
+		{
+			name:     "additional properties not allowed",
+			schema:   `{"additionalProperties": false, "properties": {"replicas": {"type": "integer"}}}`,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := acceptsContextValue(&chart.Chart{Metadata: &chart.Metadata{Name: "redis"}, Schema: []byte(tt.schema)})
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ok)
+		})
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// helmDriverSecret is the Helm storage driver storing the releases of the recipes in Kubernetes secrets.
+	helmDriverSecret = "secret"
+
+	// releaseTimeout is the time Helm waits for the resources of a release to be ready or deleted.
+	releaseTimeout = 10 * time.Minute
+)
+
+var _ HelmExecutor = (*executor)(nil)
+
+// NewExecutor creates a new Executor to install the Helm charts of recipes to the cluster with the given REST config.
+func NewExecutor(restConfig *rest.Config) *executor {
+	e := &executor{restConfig: restConfig}
+	e.actionConfig = e.newActionConfig
+	e.loadChart = e.pullChart
+	return e
+}
+
+type executor struct {
+	// restConfig is the REST config of the cluster the charts are installed to.
+	restConfig *rest.Config
+
+	// actionConfig returns the configuration of the Helm actions on the releases of the given namespace.
+	actionConfig func(ctx context.Context, namespace string) (*action.Configuration, error)
+
+	// loadChart downloads and loads the chart referenced by the recipe.
+	loadChart func(ctx context.Context, options Options) (*chart.Chart, error)
+}
+
+// Deploy downloads the chart of the recipe and installs it to the Kubernetes namespace of the recipe, or upgrades the
+// release of the recipe if it is already installed. It waits for the resources of the release to be ready.
+func (e *executor) Deploy(ctx context.Context, options Options) (*release.Release, error) {
+	return e.installOrUpgrade(ctx, options, false)
+}
+
+// Plan downloads the chart of the recipe and runs a dry run of the installation or upgrade of the release of the
+// recipe, returning the release which would be deployed.
+func (e *executor) Plan(ctx context.Context, options Options) (*release.Release, error) {
+	return e.installOrUpgrade(ctx, options, true)
+}
+
+// Delete uninstalls the release of the recipe and waits for its resources to be deleted. It doesn't return an error
+// if the release is not installed.
+func (e *executor) Delete(ctx context.Context, options Options) error {
+	name, namespace, err := getRelease(options)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := e.actionConfig(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	uninstall := action.NewUninstall(cfg)
+	uninstall.Wait = true
+	uninstall.Timeout = releaseTimeout
+	if _, err := uninstall.Run(name); err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return fmt.Errorf("failed to uninstall the release %q from namespace %q: %w", name, namespace, err)
+	}
+
+	return nil
+}
+
+// GetRelease returns the latest release of the recipe, or nil if the chart of the recipe is not installed.
+func (e *executor) GetRelease(ctx context.Context, options Options) (*release.Release, error) {
+	name, namespace, err := getRelease(options)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := e.actionConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := action.NewGet(cfg).Run(name)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get the release %q from namespace %q: %w", name, namespace, err)
+	}
+
+	return rel, nil
+}
+
+// GetRecipeMetadata downloads the chart of the recipe and returns the parameters of the recipe, read from the values
+// schema of the chart, and the values schema itself.
+func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
+	ch, err := e.loadChart(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, err := getChartParameters(ch)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := parseValuesSchema(ch)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]any{
+		"parameters": parameters,
+	}
+	if schema != nil {
+		metadata["valuesSchema"] = schema
+	}
+
+	return metadata, nil
+}
+
+// installOrUpgrade installs the chart of the recipe as a new release if the release of the recipe doesn't exist, or
+// upgrades it otherwise. Nothing is changed in the cluster when dryRun is true.
+func (e *executor) installOrUpgrade(ctx context.Context, options Options, dryRun bool) (*release.Release, error) {
+	recipeContext, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := ReleaseName(options.ResourceRecipe.ResourceID)
+	namespace := recipeContext.Runtime.Kubernetes.Namespace
+
+	ch, err := e.loadChart(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := getValues(ch, options, recipeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := e.actionConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	history := action.NewHistory(cfg)
+	history.Max = 1
+	if _, err := history.Run(name); errors.Is(err, driver.ErrReleaseNotFound) {
+		install := action.NewInstall(cfg)
+		install.ReleaseName = name
+		install.Namespace = namespace
+		install.CreateNamespace = true
+		install.Wait = true
+		install.Timeout = releaseTimeout
+		install.DryRun = dryRun
+
+		rel, err := install.RunWithContext(ctx, ch, values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to install the chart %q as release %q to namespace %q: %w", options.EnvRecipe.TemplatePath, name, namespace, err)
+		}
+		return rel, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read the history of the release %q from namespace %q: %w", name, namespace, err)
+	}
+
+	upgrade := action.NewUpgrade(cfg)
+	upgrade.Namespace = namespace
+	upgrade.Wait = true
+	upgrade.Timeout = releaseTimeout
+	upgrade.DryRun = dryRun
+
+	rel, err := upgrade.RunWithContext(ctx, name, ch, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upgrade the release %q in namespace %q to the chart %q: %w", name, namespace, options.EnvRecipe.TemplatePath, err)
+	}
+
+	return rel, nil
+}
+
+// newActionConfig returns the configuration of the Helm actions on the releases of the given namespace, which are
+// stored in Kubernetes secrets of the namespace.
+func (e *executor) newActionConfig(ctx context.Context, namespace string) (*action.Configuration, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg := &action.Configuration{}
+	err := cfg.Init(newRESTClientGetter(e.restConfig, namespace), namespace, helmDriverSecret, func(format string, v ...any) {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, v...))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Helm: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// pullChart downloads the chart of the recipe, logging in to its OCI registry with the registry credentials of the
+// environment. The credentials are stored in a temporary file which is removed once the chart is downloaded.
+func (e *executor) pullChart(ctx context.Context, options Options) (*chart.Chart, error) {
+	dir, err := os.MkdirTemp("", "helm-registry-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a directory for the registry credentials: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	client, err := registry.NewClient(registry.ClientOptCredentialsFile(filepath.Join(dir, "config.json")), registry.ClientOptWriter(io.Discard))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the registry client: %w", err)
+	}
+
+	var credentials map[string]recipes.RegistryCredentials
+	if options.EnvConfig != nil {
+		credentials = options.EnvConfig.Bicep.RegistryCredentials
+	}
+	if err := loginToRegistry(client, options.EnvRecipe.TemplatePath, credentials); err != nil {
+		return nil, err
+	}
+
+	return downloadChart(client, options.EnvRecipe)
+}
+
+// getRelease returns the name and the namespace of the release of the recipe.
+func getRelease(options Options) (string, string, error) {
+	recipeContext, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
+	if err != nil {
+		return "", "", err
+	}
+
+	return ReleaseName(options.ResourceRecipe.ResourceID), recipeContext.Runtime.Kubernetes.Namespace, nil
+}
+
+// getValues returns the values the chart of the recipe is rendered with. They are the parameters of the recipe set in
+// the environment, overridden by the parameters set in the resource, and the recipe context set as the "context" value
+// unless the values schema of the chart rejects it.
+func getValues(ch *chart.Chart, options Options, recipeContext *recipecontext.Context) (map[string]any, error) {
+	values := map[string]any{}
+	for k, v := range options.EnvRecipe.Parameters {
+		values[k] = v
+	}
+	for k, v := range options.ResourceRecipe.Parameters {
+		values[k] = v
+	}
+
+	ok, err := acceptsContextValue(ch)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		// Round-trip the recipe context through JSON so that the chart gets the same field names as other recipes.
+		b, err := json.Marshal(recipeContext)
+		if err != nil {
+			return nil, err
+		}
+		contextValue := map[string]any{}
+		if err := json.Unmarshal(b, &contextValue); err != nil {
+			return nil, err
+		}
+		values[recipecontext.RecipeContextParamKey] = contextValue
+	}
+
+	return values, nil
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+const (
+	testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+)
+
+func newTestChart(schema string) *chart.Chart {
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{APIVersion: chart.APIVersionV2, Name: "redis", Version: "1.2.0"},
+		Values: map[string]any{
+			"replicas": 1,
+		},
+		Templates: []*chart.File{
+			{
+				Name: "templates/configmap.yaml",
+				Data: []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+data:
+  replicas: {{ .Values.replicas | quote }}
+  resource: {{ .Values.context.resource.name | quote }}
+`),
+			},
+			{
+				Name: "templates/NOTES.txt",
+				Data: []byte("replicas={{ .Values.replicas }}"),
+			},
+		},
+	}
+	if schema != "" {
+		ch.Schema = []byte(schema)
+	}
+
+	return ch
+}
+
+func newTestExecutor(t *testing.T, ch *chart.Chart) (*executor, *action.Configuration) {
+	cfg := &action.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(format string, v ...any) {},
+	}
+
+	e := &executor{
+		actionConfig: func(ctx context.Context, namespace string) (*action.Configuration, error) {
+			require.Equal(t, "default-app", namespace)
+			return cfg, nil
+		},
+		loadChart: func(ctx context.Context, options Options) (*chart.Chart, error) {
+			return ch, nil
+		},
+	}
+
+	return e, cfg
+}
+
+func newTestOptions(parameters map[string]any) Options {
+	return Options{
+		EnvConfig: &recipes.Configuration{
+			Runtime: recipes.RuntimeConfiguration{
+				Kubernetes: &recipes.KubernetesRuntime{
+					Namespace:            "default-app",
+					EnvironmentNamespace: "default",
+				},
+			},
+		},
+		EnvRecipe: &recipes.EnvironmentDefinition{
+			Name:         "redis",
+			Driver:       recipes.TemplateKindHelm,
+			TemplatePath: "oci://ghcr.io/myorg/charts/redis",
+		},
+		ResourceRecipe: &recipes.ResourceMetadata{
+			Name:          "redis",
+			EnvironmentID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env",
+			ApplicationID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app",
+			ResourceID:    testResourceID,
+			Parameters:    parameters,
+		},
+	}
+}
+
+func Test_Executor_Deploy(t *testing.T) {
+	ctx := testcontext.New(t)
+	e, cfg := newTestExecutor(t, newTestChart(""))
+
+	rel, err := e.Deploy(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+	require.Equal(t, ReleaseName(testResourceID), rel.Name)
+	require.Equal(t, "default-app", rel.Namespace)
+	require.Equal(t, 1, rel.Version)
+	require.Equal(t, release.StatusDeployed, rel.Info.Status)
+	require.Equal(t, "replicas=1", rel.Info.Notes)
+	require.Contains(t, rel.Manifest, `replicas: "1"`)
+	require.Contains(t, rel.Manifest, `resource: "redis"`)
+
+	// A second deployment upgrades the release with the new parameters.
+	rel, err = e.Deploy(ctx, newTestOptions(map[string]any{"replicas": 3}))
+	require.NoError(t, err)
+	require.Equal(t, 2, rel.Version)
+	require.Equal(t, "replicas=3", rel.Info.Notes)
+
+	history, err := cfg.Releases.History(ReleaseName(testResourceID))
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+}
+
+func Test_Executor_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	e, cfg := newTestExecutor(t, newTestChart(""))
+
+	rel, err := e.Plan(ctx, newTestOptions(map[string]any{"replicas": 2}))
+	require.NoError(t, err)
+	require.Contains(t, rel.Manifest, `replicas: "2"`)
+
+	// The dry run doesn't install the release.
+	_, err = cfg.Releases.History(ReleaseName(testResourceID))
+	require.ErrorIs(t, err, driver.ErrReleaseNotFound)
+}
+
+func Test_Executor_GetRelease_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	e, _ := newTestExecutor(t, newTestChart(""))
+
+	rel, err := e.GetRelease(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+	require.Nil(t, rel)
+
+	// Deleting a release which is not installed succeeds.
+	err = e.Delete(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+
+	_, err = e.Deploy(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+
+	rel, err = e.GetRelease(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+	require.Equal(t, release.StatusDeployed, rel.Info.Status)
+
+	err = e.Delete(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+
+	rel, err = e.GetRelease(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+	require.Nil(t, rel)
+}
+
+func Test_Executor_StrictSchema(t *testing.T) {
+	ctx := testcontext.New(t)
+	schema := `{
+		"type": "object",
+		"additionalProperties": false,
+		"properties": {
+			"replicas": {"type": "integer"}
+		}
+	}`
+	ch := newTestChart(schema)
+	ch.Templates = ch.Templates[1:]
+	e, _ := newTestExecutor(t, ch)
+
+	// The recipe context is not passed to charts whose values schema doesn't allow it.
+	rel, err := e.Deploy(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+	require.NotContains(t, rel.Config, "context")
+}
+
+func Test_Executor_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	schema := `{
+		"type": "object",
+		"required": ["replicas"],
+		"properties": {
+			"replicas": {"type": "integer", "description": "The number of replicas."}
+		}
+	}`
+	e, _ := newTestExecutor(t, newTestChart(schema))
+
+	metadata, err := e.GetRecipeMetadata(ctx, newTestOptions(nil))
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"parameters": map[string]any{
+			"replicas": map[string]any{
+				"type":         "int",
+				"description":  "The number of replicas.",
+				"defaultValue": 1,
+				"required":     true,
+			},
+		},
+		"valuesSchema": map[string]any{
+			"type":     "object",
+			"required": []any{"replicas"},
+			"properties": map[string]any{
+				"replicas": map[string]any{"type": "integer", "description": "The number of replicas."},
+			},
+		},
+	}
+	require.Equal(t, expected, metadata)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+const (
+	// maxReleaseNameLength is the maximum length of the name of a Helm release.
+	maxReleaseNameLength = 53
+
+	// releaseNameHashLength is the length of the hash of the resource ID appended to the name of a Helm release.
+	releaseNameHashLength = 8
+)
+
+var invalidReleaseNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+//go:generate mockgen -destination=./mock_executor.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm HelmExecutor
+
+type HelmExecutor interface {
+	// Deploy downloads the chart referenced by the recipe and installs it as a new release, or upgrades the release
+	// of the recipe if the chart is already installed.
+	Deploy(ctx context.Context, options Options) (*release.Release, error)
+
+	// Delete uninstalls the release of the recipe. It doesn't return an error if the release is not installed.
+	Delete(ctx context.Context, options Options) error
+
+	// GetRelease returns the deployed release of the recipe, or nil if the chart of the recipe is not installed.
+	GetRelease(ctx context.Context, options Options) (*release.Release, error)
+
+	// Plan downloads the chart referenced by the recipe and renders the release a deployment of the recipe would
+	// install or upgrade to, without changing the cluster.
+	Plan(ctx context.Context, options Options) (*release.Release, error)
+
+	// GetRecipeMetadata downloads the chart referenced by the recipe and retrieves the parameters of the recipe from
+	// the values schema of the chart.
+	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+}
+
+// Options represents the options required to install and uninstall the Helm chart of a recipe.
+type Options struct {
+	// EnvConfig is the kubernetes runtime and cloud provider configuration for the Radius Environment in which the application consuming the helm recipe will be deployed.
+	EnvConfig *recipes.Configuration
+
+	// EnvRecipe is the recipe metadata associated with the Radius Environment in which the application consuming the helm recipe will be deployed.
+	EnvRecipe *recipes.EnvironmentDefinition
+
+	// ResourceRecipe is recipe metadata associated with the Radius resource deploying the Helm recipe.
+	ResourceRecipe *recipes.ResourceMetadata
+}
+
+// ReleaseName returns the name of the Helm release of the recipe deployed for the given resource. The name is the
+// name of the resource followed by a hash of the resource ID, so that resources of different applications and
+// environments with the same name don't share a release.
+func ReleaseName(resourceID string) string {
+	name := resourceID
+	if id, err := resources.ParseResource(resourceID); err == nil {
+		name = id.Name()
+	}
+	name = strings.Trim(invalidReleaseNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+
+	hash := sha256.Sum256([]byte(strings.ToLower(resourceID)))
+	suffix := hex.EncodeToString(hash[:])[:releaseNameHashLength]
+
+	if maxLength := maxReleaseNameLength - releaseNameHashLength - 1; len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+	if name == "" {
+		return suffix
+	}
+
+	return name + "-" + suffix
+}
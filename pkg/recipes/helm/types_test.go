@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chartutil"
+)
+
+func Test_ReleaseName(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+		prefix     string
+	}{
+		{
+			name:       "resource name",
+			resourceID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/Redis_Cache",
+			prefix:     "redis-cache-",
+		},
+		{
+			name:       "long resource name",
+			resourceID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/" + strings.Repeat("a", 100),
+			prefix:     strings.Repeat("a", 44) + "-",
+		},
+		{
+			name:       "invalid resource ID",
+			resourceID: "invalid",
+			prefix:     "invalid-",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := ReleaseName(tt.resourceID)
+			require.True(t, strings.HasPrefix(name, tt.prefix), name)
+			require.Len(t, name, len(tt.prefix)+releaseNameHashLength)
+			require.NoError(t, chartutil.ValidateReleaseName(name))
+			require.Equal(t, name, ReleaseName(tt.resourceID))
+		})
+	}
+
+	// Resources with the same name in different resource groups get different releases.
+	require.NotEqual(t,
+		ReleaseName("/planes/radius/local/resourceGroups/rg1/providers/Applications.Datastores/redisCaches/redis"),
+		ReleaseName("/planes/radius/local/resourceGroups/rg2/providers/Applications.Datastores/redisCaches/redis"))
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+// OutputType is the type of a value returned by a recipe.
+type OutputType string
+
+const (
+	// OutputTypeString represents a string value.
+	OutputTypeString OutputType = "string"
+	// OutputTypeInteger represents an integer value.
+	OutputTypeInteger OutputType = "integer"
+	// OutputTypeBoolean represents a boolean value.
+	OutputTypeBoolean OutputType = "boolean"
+)
+
+// OutputDefinition declares a value a recipe is expected to return for a portable resource type.
+type OutputDefinition struct {
+	// Name is the key of the value in the recipe output.
+	Name string `json:"name"`
+	// Type is the type of the value.
+	Type OutputType `json:"type"`
+	// Required is true when the recipe must return the value.
+	Required bool `json:"required"`
+	// Secret is true when the value must be returned in the secrets of the recipe output instead of its values.
+	Secret bool `json:"secret"`
+	// Description describes the value.
+	Description string `json:"description,omitempty"`
+}
+
+// outputContracts are the outputs expected from the recipes of each portable resource type, keyed by the lowercased
+// resource type. Types without an entry, such as Applications.Core/extenders, accept any output.
+var outputContracts = map[string][]OutputDefinition{
+	"applications.datastores/rediscaches": {
+		{Name: "host", Type: OutputTypeString, Required: true, Description: "The host name of the Redis cache."},
+		{Name: "port", Type: OutputTypeInteger, Required: true, Description: "The port of the Redis cache."},
+		{Name: "username", Type: OutputTypeString, Description: "The username used to connect to the Redis cache."},
+		{Name: "tls", Type: OutputTypeBoolean, Description: "Whether TLS is used to connect to the Redis cache. Computed from the port when not set."},
+		{Name: "password", Type: OutputTypeString, Secret: true, Description: "The password used to connect to the Redis cache."},
+		{Name: "connectionString", Type: OutputTypeString, Secret: true, Description: "The connection string of the Redis cache. Computed when not set."},
+		{Name: "url", Type: OutputTypeString, Secret: true, Description: "The connection URL of the Redis cache. Computed when not set."},
+	},
+	"applications.datastores/mongodatabases": {
+		{Name: "host", Type: OutputTypeString, Required: true, Description: "The host name of the MongoDB server."},
+		{Name: "port", Type: OutputTypeInteger, Required: true, Description: "The port of the MongoDB server."},
+		{Name: "database", Type: OutputTypeString, Required: true, Description: "The name of the MongoDB database."},
+		{Name: "username", Type: OutputTypeString, Description: "The username used to connect to the MongoDB database."},
+		{Name: "password", Type: OutputTypeString, Secret: true, Description: "The password used to connect to the MongoDB database."},
+		{Name: "connectionString", Type: OutputTypeString, Secret: true, Description: "The connection string of the MongoDB database. Computed when not set."},
+	},
+	"applications.datastores/sqldatabases": {
+		{Name: "server", Type: OutputTypeString, Required: true, Description: "The fully qualified domain name of the SQL server."},
+		{Name: "port", Type: OutputTypeInteger, Required: true, Description: "The port of the SQL server."},
+		{Name: "database", Type: OutputTypeString, Required: true, Description: "The name of the SQL database."},
+		{Name: "username", Type: OutputTypeString, Description: "The username used to connect to the SQL database."},
+		{Name: "password", Type: OutputTypeString, Secret: true, Description: "The password used to connect to the SQL database."},
+		{Name: "connectionString", Type: OutputTypeString, Secret: true, Description: "The connection string of the SQL database. Computed when not set."},
+	},
+	"applications.messaging/rabbitmqqueues": {
+		{Name: "queue", Type: OutputTypeString, Required: true, Description: "The name of the RabbitMQ queue."},
+		{Name: "host", Type: OutputTypeString, Required: true, Description: "The host name of the RabbitMQ server."},
+		{Name: "port", Type: OutputTypeInteger, Required: true, Description: "The port of the RabbitMQ server."},
+		{Name: "vHost", Type: OutputTypeString, Description: "The virtual host of the RabbitMQ server."},
+		{Name: "username", Type: OutputTypeString, Description: "The username used to connect to the RabbitMQ server."},
+		{Name: "tls", Type: OutputTypeBoolean, Description: "Whether TLS is used to connect to the RabbitMQ server."},
+		{Name: "password", Type: OutputTypeString, Secret: true, Description: "The password used to connect to the RabbitMQ server."},
+		{Name: "uri", Type: OutputTypeString, Secret: true, Description: "The connection URI of the RabbitMQ queue. Computed when not set."},
+	},
+	"applications.dapr/pubsubbrokers": {
+		{Name: "componentName", Type: OutputTypeString, Description: "The name of the Dapr component. Defaults to the name of the resource."},
+	},
+	"applications.dapr/secretstores": {
+		{Name: "componentName", Type: OutputTypeString, Description: "The name of the Dapr component. Defaults to the name of the resource."},
+	},
+	"applications.dapr/statestores": {
+		{Name: "componentName", Type: OutputTypeString, Description: "The name of the Dapr component. Defaults to the name of the resource."},
+	},
+}
+
+// GetOutputContract returns the outputs expected from the recipes of the portable resource type, or nil when the
+// outputs of the recipes of the resource type are not validated. The resource type is case-insensitive.
+func GetOutputContract(resourceType string) []OutputDefinition {
+	return outputContracts[strings.ToLower(resourceType)]
+}
+
+// ValidateOutputContract validates the recipe output against the outputs expected from the recipes of the portable
+// resource type. It returns a RecipeError describing every output which is missing, has an unexpected type or is
+// returned as a value instead of a secret, or as a secret instead of a value.
+func (ro *RecipeOutput) ValidateOutputContract(resourceType string) error {
+	msgs := []string{}
+	for _, definition := range GetOutputContract(resourceType) {
+		msg := ro.validateOutput(definition)
+		if msg != "" {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if len(msgs) == 1 {
+		return NewRecipeError(InvalidRecipeOutputs, msgs[0], util.ExecutionError)
+	}
+
+	details := []*v1.ErrorDetails{}
+	for _, msg := range msgs {
+		details = append(details, &v1.ErrorDetails{Code: InvalidRecipeOutputs, Message: msg})
+	}
+
+	msg := fmt.Sprintf("recipe returned invalid outputs for resource type %q:\n\n%s", resourceType, strings.Join(msgs, "\n"))
+	return NewRecipeError(InvalidRecipeOutputs, msg, util.ExecutionError, details...)
+}
+
+// validateOutput validates a single output of the recipe output and returns a message describing the mismatch, or
+// an empty string when the output matches its definition.
+func (ro *RecipeOutput) validateOutput(definition OutputDefinition) string {
+	expected, other := ro.Values, ro.Secrets
+	expectedKind, otherKind := "value", "secret"
+	if definition.Secret {
+		expected, other = ro.Secrets, ro.Values
+		expectedKind, otherKind = "secret", "value"
+	}
+
+	value, ok := expected[definition.Name]
+	if !ok {
+		if _, ok := other[definition.Name]; ok {
+			return fmt.Sprintf("recipe returned %s as a %s, expected a %s", definition.Name, otherKind, expectedKind)
+		}
+
+		if definition.Required {
+			return fmt.Sprintf("recipe did not return %s, expected a required %s %s", definition.Name, definition.Type, expectedKind)
+		}
+
+		return ""
+	}
+
+	actual := outputTypeOf(value)
+	if actual != string(definition.Type) {
+		return fmt.Sprintf("recipe returned %s as %s, expected %s", definition.Name, actual, definition.Type)
+	}
+
+	return ""
+}
+
+// outputTypeOf returns the name of the type of a value decoded from a recipe output.
+func outputTypeOf(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return string(OutputTypeString)
+	case bool:
+		return string(OutputTypeBoolean)
+	case int, int32, int64:
+		return string(OutputTypeInteger)
+	case float64:
+		if v == math.Trunc(v) {
+			return string(OutputTypeInteger)
+		}
+		return "number"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOutputContract(t *testing.T) {
+	contract := GetOutputContract("applications.datastores/REDISCACHES")
+	require.NotEmpty(t, contract)
+	require.Equal(t, OutputDefinition{Name: "host", Type: OutputTypeString, Required: true, Description: "The host name of the Redis cache."}, contract[0])
+
+	require.Nil(t, GetOutputContract("Applications.Core/extenders"))
+}
+
+func TestRecipeOutput_ValidateOutputContract(t *testing.T) {
+	tests := []struct {
+		desc         string
+		resourceType string
+		output       RecipeOutput
+		expectedErr  error
+	}{
+		{
+			desc:         "valid outputs",
+			resourceType: "Applications.Datastores/redisCaches",
+			output: RecipeOutput{
+				Values:  map[string]any{"host": "myredis", "port": float64(6379), "tls": false, "extra": "ignored"},
+				Secrets: map[string]any{"password": "secret"},
+			},
+		},
+		{
+			desc:         "resource type without contract",
+			resourceType: "Applications.Core/extenders",
+			output: RecipeOutput{
+				Values: map[string]any{"port": "6379"},
+			},
+		},
+		{
+			desc:         "invalid type",
+			resourceType: "Applications.Datastores/redisCaches",
+			output: RecipeOutput{
+				Values: map[string]any{"host": "myredis", "port": "6379"},
+			},
+			expectedErr: NewRecipeError(InvalidRecipeOutputs, "recipe returned port as string, expected integer", util.ExecutionError),
+		},
+		{
+			desc:         "non-integer number",
+			resourceType: "Applications.Datastores/redisCaches",
+			output: RecipeOutput{
+				Values: map[string]any{"host": "myredis", "port": float64(6379.5)},
+			},
+			expectedErr: NewRecipeError(InvalidRecipeOutputs, "recipe returned port as number, expected integer", util.ExecutionError),
+		},
+		{
+			desc:         "secret returned as value",
+			resourceType: "Applications.Messaging/rabbitMQQueues",
+			output: RecipeOutput{
+				Values: map[string]any{"queue": "queue", "host": "myrabbit", "port": 5672, "password": "secret"},
+			},
+			expectedErr: NewRecipeError(InvalidRecipeOutputs, "recipe returned password as a value, expected a secret", util.ExecutionError),
+		},
+		{
+			desc:         "multiple errors",
+			resourceType: "Applications.Datastores/sqlDatabases",
+			output: RecipeOutput{
+				Values:  map[string]any{"port": 1433, "database": true},
+				Secrets: map[string]any{"username": "admin"},
+			},
+			expectedErr: NewRecipeError(InvalidRecipeOutputs,
+				"recipe returned invalid outputs for resource type \"Applications.Datastores/sqlDatabases\":\n\n"+
+					"recipe did not return server, expected a required string value\n"+
+					"recipe returned database as boolean, expected string\n"+
+					"recipe returned username as a secret, expected a value",
+				util.ExecutionError,
+				&v1.ErrorDetails{Code: InvalidRecipeOutputs, Message: "recipe did not return server, expected a required string value"},
+				&v1.ErrorDetails{Code: InvalidRecipeOutputs, Message: "recipe returned database as boolean, expected string"},
+				&v1.ErrorDetails{Code: InvalidRecipeOutputs, Message: "recipe returned username as a secret, expected a value"},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.output.ValidateOutputContract(tt.resourceType)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -41,6 +41,8 @@ type Context struct {
 	Azure *ProviderAzure `json:"azure,omitempty"`
 	// AWS represents AWS provider scope.
 	AWS *ProviderAWS `json:"aws,omitempty"`
+	// GCP represents GCP provider scope.
+	GCP *ProviderGCP `json:"gcp,omitempty"`
 }
 
 // Resource contains the information needed to deploy a recipe.
@@ -91,3 +93,11 @@ type ProviderAWS struct {
 	// Account represents the account id of the AWS account.
 	Account string `json:"account"`
 }
+
+// ProviderGCP contains GCP Project provider scope for recipe context.
+type ProviderGCP struct {
+	// Project represents the id of the GCP project.
+	Project string `json:"project"`
+	// Region represents the region of the GCP project.
+	Region string `json:"region"`
+}
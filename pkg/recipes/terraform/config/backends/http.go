@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	httpAddressKey       = "address"
+	httpLockAddressKey   = "lock_address"
+	httpUnlockAddressKey = "unlock_address"
+	httpUsernameKey      = "username"
+	httpPasswordKey      = "password"
+	httpSkipCertKey      = "skip_cert_verification"
+)
+
+var _ Backend = (*httpBackend)(nil)
+
+type httpBackend struct {
+	config map[string]any
+	client *http.Client
+}
+
+// NewHTTPBackend creates a backend storing Terraform state files with a REST client. The state file of each resource
+// is stored at a sub-path of the configured "address", and is locked at sub-paths of the lock and unlock addresses.
+func NewHTTPBackend(config map[string]any) Backend {
+	client := http.DefaultClient
+	if skip, ok := config[httpSkipCertKey].(bool); ok && skip {
+		client = &http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		}
+	}
+
+	return &httpBackend{config: config, client: client}
+}
+
+// Kind returns the kind of the HTTP backend.
+func (p *httpBackend) Kind() string {
+	return BackendHTTP
+}
+
+// BuildBackend generates the Terraform backend configuration, setting the addresses of the state file of the resource.
+func (p *httpBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	name, err := p.stateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := copyConfig(p.config)
+	for _, key := range []string{httpAddressKey, httpLockAddressKey, httpUnlockAddressKey} {
+		if address := getString(p.config, key); address != "" {
+			config[key] = stateAddress(address, name)
+		}
+	}
+
+	return map[string]any{BackendHTTP: config}, nil
+}
+
+// ValidateBackendExists checks if the REST endpoint returns a state file for the resource.
+func (p *httpBackend) ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
+	resp, err := p.do(ctx, http.MethodGet, resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent:
+		return false, nil
+	case resp.StatusCode != http.StatusOK:
+		return false, fmt.Errorf("failed to get terraform state: unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+
+	return len(body) > 0, nil
+}
+
+// DeleteState deletes the state file of the resource from the REST endpoint. Endpoints that don't support deleting
+// state files are left with the empty state written by Terraform destroy.
+func (p *httpBackend) DeleteState(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	resp, err := p.do(ctx, http.MethodDelete, resourceRecipe)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 && resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusMethodNotAllowed {
+		return fmt.Errorf("error deleting terraform state: unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// ReleaseLock returns ErrLockIDRequired, since the REST endpoint is unlocked with the information of the lock.
+func (p *httpBackend) ReleaseLock(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	return ErrLockIDRequired
+}
+
+// do sends a request with the given method to the address of the state file of the resource.
+func (p *httpBackend) do(ctx context.Context, method string, resourceRecipe *recipes.ResourceMetadata) (*http.Response, error) {
+	name, err := p.stateName(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, stateAddress(getString(p.config, httpAddressKey), name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if username := getString(p.config, httpUsernameKey); username != "" {
+		req.SetBasicAuth(username, getString(p.config, httpPasswordKey))
+	}
+
+	return p.client.Do(req)
+}
+
+// stateName returns the name of the state file of the resource.
+func (p *httpBackend) stateName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	if err := requireKeys(BackendHTTP, p.config, httpAddressKey); err != nil {
+		return "", err
+	}
+
+	return generateSecretSuffix(resourceRecipe)
+}
+
+// stateAddress returns the address of the state file with the given name under the base address.
+func stateAddress(address, name string) string {
+	return strings.TrimSuffix(address, "/") + "/" + name
+}
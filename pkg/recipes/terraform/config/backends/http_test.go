@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testStateServer is a minimal implementation of the REST endpoint of the Terraform http backend.
+type testStateServer struct {
+	mu     sync.Mutex
+	states map[string]string
+}
+
+func (s *testStateServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "password" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		state, ok := s.states[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(state))
+	case http.MethodPost:
+		body, _ := io.ReadAll(r.Body)
+		s.states[r.URL.Path] = string(body)
+	case http.MethodDelete:
+		delete(s.states, r.URL.Path)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func Test_HTTPBackend(t *testing.T) {
+	ctx := context.Background()
+	_, resourceRecipe := getTestInputs()
+	name, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	stateServer := &testStateServer{states: map[string]string{}}
+	server := httptest.NewServer(stateServer)
+	defer server.Close()
+
+	config := map[string]any{
+		"address":        server.URL + "/state/",
+		"lock_address":   server.URL + "/lock",
+		"unlock_address": server.URL + "/lock",
+		"username":       "user",
+		"password":       "password",
+	}
+	backend := NewHTTPBackend(config)
+
+	actual, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	expected := map[string]any{
+		BackendHTTP: map[string]any{
+			"address":        server.URL + "/state/" + name,
+			"lock_address":   server.URL + "/lock/" + name,
+			"unlock_address": server.URL + "/lock/" + name,
+			"username":       "user",
+			"password":       "password",
+		},
+	}
+	require.Equal(t, expected, actual)
+
+	exists, err := backend.ValidateBackendExists(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	stateServer.states["/state/"+name] = "{}"
+	exists, err = backend.ValidateBackendExists(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, backend.DeleteState(ctx, &resourceRecipe))
+	require.Empty(t, stateServer.states)
+
+	require.ErrorIs(t, backend.ReleaseLock(ctx, &resourceRecipe), ErrLockIDRequired)
+
+	// Requests without credentials are rejected.
+	_, err = NewHTTPBackend(map[string]any{"address": server.URL}).ValidateBackendExists(ctx, &resourceRecipe)
+	require.EqualError(t, err, "failed to get terraform state: unexpected status 401 Unauthorized")
+
+	_, err = NewHTTPBackend(map[string]any{}).BuildBackend(&resourceRecipe)
+	require.EqualError(t, err, "\"address\" is required in the configuration of the http terraform backend")
+}
@@ -45,6 +45,10 @@ const (
 	// https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
 	// https://developer.hashicorp.com/terraform/language/state/workspaces
 	KubernetesBackendNamePrefix = "tfstate-default-"
+
+	// KubernetesBackendLockPrefix is the prefix added by Terraform to the name of the Kubernetes secret for the state
+	// to generate the name of the Kubernetes lease that is used to lock the state.
+	KubernetesBackendLockPrefix = "lock-"
 )
 
 var _ Backend = (*kubernetesBackend)(nil)
@@ -70,13 +74,22 @@ func (p *kubernetesBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadat
 	return generateKubernetesBackendConfig(secretSuffix)
 }
 
-// ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists.
-// name is the name of the backend Kubernetes secret resource that is created as a part of terraform apply
-// during recipe deployment.
-func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+// Kind returns the kind of the Kubernetes backend.
+func (p *kubernetesBackend) Kind() string {
+	return BackendKubernetes
+}
+
+// ValidateBackendExists checks if the Kubernetes secret for Terraform state file of the resource exists. The secret
+// is created as a part of terraform apply during recipe deployment.
+func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	_, err := p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Get(ctx, name, metav1.GetOptions{})
+	name, err := secretName(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		if k8s_errors.IsNotFound(err) {
 			logger.Info(fmt.Sprintf("Kubernetes secret %q does not exist: %s", name, err.Error()))
@@ -89,8 +102,50 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// DeleteState deletes the Kubernetes secret created for the Terraform state file of the resource.
+func (p *kubernetesBackend) DeleteState(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	name, err := secretName(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	err = p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseLock deletes the Kubernetes lease that Terraform uses to lock the state stored in the secret of the resource.
+func (p *kubernetesBackend) ReleaseLock(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	name, err := secretName(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	leaseName := KubernetesBackendLockPrefix + name
+	err = p.k8sClientSet.CoordinationV1().Leases(RadiusNamespace).Delete(ctx, leaseName, metav1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete the lease %q locking the terraform state: %w", leaseName, err)
+	}
+
+	return nil
+}
+
+// secretName returns the name of the Kubernetes secret Terraform creates for the state file of the resource.
+func secretName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	secretSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return KubernetesBackendNamePrefix + secretSuffix, nil
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
-// which is used as key for kubernetes secret in defining terraform backend.
+// which is used as key for kubernetes secret in defining terraform backend, and as the name of the state file
+// of the resource in the other backends.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
 	parsedResourceID, err := resources.Parse(resourceRecipe.ResourceID)
 	if err != nil {
@@ -117,8 +172,8 @@ func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, err
 	return fmt.Sprintf("%x", hash), nil
 }
 
-// generateKubernetesBackendConfig returns Terraform backend configuration to store Terraform state file for the deployment.
-// Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
+// generateKubernetesBackendConfig returns Terraform backend configuration to store Terraform state file for the deployment
+// in a Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
 func generateKubernetesBackendConfig(secretSuffix string) (map[string]interface{}, error) {
 	backend := map[string]interface{}{
 		BackendKubernetes: map[string]interface{}{
@@ -25,6 +25,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	v1 "k8s.io/api/core/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -124,26 +125,32 @@ func Test_GenerateSecretSuffix_invalid_appid(t *testing.T) {
 }
 
 func Test_ValidateBackendExists(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := secretName(&resourceRecipe)
+	require.NoError(t, err)
+
 	clientset := fake.NewSimpleClientset()
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-secret",
+			Name:      name,
 			Namespace: RadiusNamespace,
 		},
 		Data: map[string][]byte{
 			"key": []byte("value"),
 		},
 	}
-	_, err := clientset.CoreV1().Secrets(RadiusNamespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	_, err = clientset.CoreV1().Secrets(RadiusNamespace).Create(context.Background(), secret, metav1.CreateOptions{})
 	require.NoError(t, err)
 
 	b := NewKubernetesBackend(clientset)
-	exists, err := b.ValidateBackendExists(context.Background(), "test-secret")
+	exists, err := b.ValidateBackendExists(context.Background(), &resourceRecipe)
 	require.NoError(t, err)
 	require.True(t, exists)
 
 	// Validate that the function returns false for a non-existent secret.
-	exists, err = b.ValidateBackendExists(context.Background(), "invalid-secret")
+	otherRecipe := resourceRecipe
+	otherRecipe.ResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/other"
+	exists, err = b.ValidateBackendExists(context.Background(), &otherRecipe)
 	require.NoError(t, err)
 	require.False(t, exists)
 
@@ -151,8 +158,53 @@ func Test_ValidateBackendExists(t *testing.T) {
 	clientset.Fake.PrependReactor("get", "secrets", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, nil, k8s_errors.NewServerTimeout(schema.GroupResource{Resource: "test-secret"}, "get", 1)
 	})
-	exists, err = b.ValidateBackendExists(context.Background(), "test-secret")
+	exists, err = b.ValidateBackendExists(context.Background(), &resourceRecipe)
 	require.Error(t, err)
 	require.True(t, k8s_errors.IsServerTimeout(err))
 	require.False(t, exists)
 }
+
+func Test_KubernetesBackend_DeleteState(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := secretName(&resourceRecipe)
+	require.NoError(t, err)
+
+	clientset := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: RadiusNamespace,
+		},
+	})
+
+	b := NewKubernetesBackend(clientset)
+	require.NoError(t, b.DeleteState(context.Background(), &resourceRecipe))
+
+	_, err = clientset.CoreV1().Secrets(RadiusNamespace).Get(context.Background(), name, metav1.GetOptions{})
+	require.True(t, k8s_errors.IsNotFound(err))
+
+	// Deleting a state which does not exist is a no-op.
+	require.NoError(t, b.DeleteState(context.Background(), &resourceRecipe))
+}
+
+func Test_KubernetesBackend_ReleaseLock(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := secretName(&resourceRecipe)
+	require.NoError(t, err)
+
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      KubernetesBackendLockPrefix + name,
+			Namespace: RadiusNamespace,
+		},
+	}
+	clientset := fake.NewSimpleClientset(lease)
+
+	b := NewKubernetesBackend(clientset)
+	require.NoError(t, b.ReleaseLock(context.Background(), &resourceRecipe))
+
+	_, err = clientset.CoordinationV1().Leases(RadiusNamespace).Get(context.Background(), lease.Name, metav1.GetOptions{})
+	require.True(t, k8s_errors.IsNotFound(err))
+
+	// Releasing a lock which does not exist is a no-op.
+	require.NoError(t, b.ReleaseLock(context.Background(), &resourceRecipe))
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+const (
+	// localPathKey is the key of the configuration of the local backend holding the directory of the state files.
+	localPathKey = "path"
+)
+
+var _ Backend = (*localBackend)(nil)
+
+type localBackend struct {
+	config map[string]any
+}
+
+// NewLocalBackend creates a backend storing Terraform state files in the directory configured with the "path" key.
+// The directory must be persisted across restarts of the process running Terraform, for example on a persistent volume.
+func NewLocalBackend(config map[string]any) Backend {
+	return &localBackend{config: config}
+}
+
+// Kind returns the kind of the local backend.
+func (p *localBackend) Kind() string {
+	return BackendLocal
+}
+
+// BuildBackend generates the Terraform backend configuration, setting the path of the state file of the resource.
+func (p *localBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	path, err := p.statePath(resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := copyConfig(p.config)
+	config[localPathKey] = path
+
+	return map[string]any{BackendLocal: config}, nil
+}
+
+// ValidateBackendExists checks if the state file of the resource exists.
+func (p *localBackend) ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
+	path, err := p.statePath(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteState deletes the state file of the resource and its backup.
+func (p *localBackend) DeleteState(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	path, err := p.statePath(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range []string{path, path + ".backup"} {
+		if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error deleting terraform state file %q: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseLock deletes the lock info file Terraform writes next to the state file of the resource. The filesystem lock
+// itself is released by the operating system when the Terraform process exits.
+func (p *localBackend) ReleaseLock(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	path, err := p.statePath(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	dir, name := filepath.Split(path)
+	lockInfoPath := filepath.Join(dir, fmt.Sprintf(".%s.lock.info", name))
+	if err := os.Remove(lockInfoPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting terraform lock info file %q: %w", lockInfoPath, err)
+	}
+
+	return nil
+}
+
+// statePath returns the path of the state file of the resource.
+func (p *localBackend) statePath(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	if err := requireKeys(BackendLocal, p.config, localPathKey); err != nil {
+		return "", err
+	}
+
+	name, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(getString(p.config, localPathKey), name+stateFileExtension), nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalBackend(t *testing.T) {
+	ctx := context.Background()
+	_, resourceRecipe := getTestInputs()
+	name, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, name+".tfstate")
+	backend := NewLocalBackend(map[string]any{"path": dir})
+
+	actual, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{BackendLocal: map[string]any{"path": statePath}}, actual)
+
+	exists, err := backend.ValidateBackendExists(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, os.WriteFile(statePath, []byte("{}"), 0600))
+	require.NoError(t, os.WriteFile(statePath+".backup", []byte("{}"), 0600))
+	exists, err = backend.ValidateBackendExists(ctx, &resourceRecipe)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	lockInfoPath := filepath.Join(dir, "."+name+".tfstate.lock.info")
+	require.NoError(t, os.WriteFile(lockInfoPath, []byte("{}"), 0600))
+	require.NoError(t, backend.ReleaseLock(ctx, &resourceRecipe))
+	require.NoFileExists(t, lockInfoPath)
+
+	require.NoError(t, backend.DeleteState(ctx, &resourceRecipe))
+	require.NoFileExists(t, statePath)
+	require.NoFileExists(t, statePath+".backup")
+
+	// Deleting a state which does not exist is a no-op.
+	require.NoError(t, backend.DeleteState(ctx, &resourceRecipe))
+
+	_, err = NewLocalBackend(map[string]any{}).BuildBackend(&resourceRecipe)
+	require.EqualError(t, err, "\"path\" is required in the configuration of the local terraform backend")
+}
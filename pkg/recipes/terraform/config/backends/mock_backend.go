@@ -50,8 +50,50 @@ func (mr *MockBackendMockRecorder) BuildBackend(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), arg0)
 }
 
+// DeleteState mocks base method.
+func (m *MockBackend) DeleteState(arg0 context.Context, arg1 *recipes.ResourceMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteState indicates an expected call of DeleteState.
+func (mr *MockBackendMockRecorder) DeleteState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteState", reflect.TypeOf((*MockBackend)(nil).DeleteState), arg0, arg1)
+}
+
+// Kind mocks base method.
+func (m *MockBackend) Kind() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Kind")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Kind indicates an expected call of Kind.
+func (mr *MockBackendMockRecorder) Kind() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Kind", reflect.TypeOf((*MockBackend)(nil).Kind))
+}
+
+// ReleaseLock mocks base method.
+func (m *MockBackend) ReleaseLock(arg0 context.Context, arg1 *recipes.ResourceMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLock indicates an expected call of ReleaseLock.
+func (mr *MockBackendMockRecorder) ReleaseLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLock", reflect.TypeOf((*MockBackend)(nil).ReleaseLock), arg0, arg1)
+}
+
 // ValidateBackendExists mocks base method.
-func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
+func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 *recipes.ResourceMetadata) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidateBackendExists", arg0, arg1)
 	ret0, _ := ret[0].(bool)
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/recipes"
+)
+
+var _ Backend = (*objectStoreBackend)(nil)
+
+// objectStoreBackend is a backend storing the state file of each resource as an object of a remote object store,
+// such as an S3 bucket or an Azure storage container. The objects are only accessed through Terraform.
+type objectStoreBackend struct {
+	kind         string
+	requiredKeys []string
+	config       map[string]any
+}
+
+// NewS3Backend creates a backend storing Terraform state files in an Amazon S3 compatible bucket. The "key" of the
+// configuration is used as the prefix of the keys of the state files.
+func NewS3Backend(config map[string]any) Backend {
+	return &objectStoreBackend{kind: BackendS3, requiredKeys: []string{"bucket"}, config: config}
+}
+
+// NewAzureRMBackend creates a backend storing Terraform state files as blobs of an Azure storage container. The "key"
+// of the configuration is used as the prefix of the names of the blobs.
+func NewAzureRMBackend(config map[string]any) Backend {
+	return &objectStoreBackend{kind: BackendAzureRM, requiredKeys: []string{"storage_account_name", "container_name"}, config: config}
+}
+
+// Kind returns the kind of the backend.
+func (p *objectStoreBackend) Kind() string {
+	return p.kind
+}
+
+// BuildBackend generates the Terraform backend configuration, setting the key of the state file of the resource.
+func (p *objectStoreBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	if err := requireKeys(p.kind, p.config, p.requiredKeys...); err != nil {
+		return nil, err
+	}
+
+	key, err := stateKey(p.config, resourceRecipe)
+	if err != nil {
+		return nil, err
+	}
+
+	config := copyConfig(p.config)
+	config["key"] = key
+
+	return map[string]any{p.kind: config}, nil
+}
+
+// ValidateBackendExists returns true, since the object store is only accessed through Terraform, which handles a
+// missing state file as an empty state.
+func (p *objectStoreBackend) ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
+	return true, nil
+}
+
+// DeleteState is a no-op. The state file of the resource is left empty by Terraform destroy.
+func (p *objectStoreBackend) DeleteState(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	return nil
+}
+
+// ReleaseLock returns ErrLockIDRequired, since Terraform requires the ID of the lock to unlock the state.
+func (p *objectStoreBackend) ReleaseLock(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error {
+	return ErrLockIDRequired
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_S3Backend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	config := map[string]any{
+		"bucket":     "tfstate",
+		"region":     "us-west-2",
+		"key":        "radius",
+		"access_key": "access-key",
+	}
+	backend := NewS3Backend(config)
+
+	actual, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	expected := map[string]any{
+		BackendS3: map[string]any{
+			"bucket":     "tfstate",
+			"region":     "us-west-2",
+			"key":        "radius/" + name + ".tfstate",
+			"access_key": "access-key",
+		},
+	}
+	require.Equal(t, expected, actual)
+
+	// The configuration of the environment is not modified.
+	require.Equal(t, "radius", config["key"])
+
+	_, err = NewS3Backend(map[string]any{"region": "us-west-2"}).BuildBackend(&resourceRecipe)
+	require.EqualError(t, err, "\"bucket\" is required in the configuration of the s3 terraform backend")
+}
+
+func Test_AzureRMBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	backend := NewAzureRMBackend(map[string]any{
+		"storage_account_name": "account",
+		"container_name":       "tfstate",
+	})
+
+	actual, err := backend.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	expected := map[string]any{
+		BackendAzureRM: map[string]any{
+			"storage_account_name": "account",
+			"container_name":       "tfstate",
+			"key":                  name + ".tfstate",
+		},
+	}
+	require.Equal(t, expected, actual)
+
+	_, err = NewAzureRMBackend(map[string]any{"storage_account_name": "account"}).BuildBackend(&resourceRecipe)
+	require.EqualError(t, err, "\"container_name\" is required in the configuration of the azurerm terraform backend")
+}
+
+func Test_ObjectStoreBackend_State(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	backend := NewS3Backend(map[string]any{"bucket": "tfstate"})
+
+	// The existence of the state is left to Terraform.
+	exists, err := backend.ValidateBackendExists(context.Background(), &resourceRecipe)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, backend.DeleteState(context.Background(), &resourceRecipe))
+	require.ErrorIs(t, backend.ReleaseLock(context.Background(), &resourceRecipe), ErrLockIDRequired)
+}
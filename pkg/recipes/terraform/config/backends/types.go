@@ -18,21 +18,120 @@ package backends
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/recipes"
+	"k8s.io/client-go/kubernetes"
 )
 
 //go:generate mockgen -destination=./mock_backend.go -package=backends -self_package github.com/radius-project/radius/pkg/recipes/terraform/config/backends github.com/radius-project/radius/pkg/recipes/terraform/config/backends Backend
 
+const (
+	// BackendS3 is the kind of the backend storing Terraform state in an Amazon S3 compatible bucket.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/s3
+	BackendS3 = "s3"
+
+	// BackendAzureRM is the kind of the backend storing Terraform state in an Azure storage account.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/azurerm
+	BackendAzureRM = "azurerm"
+
+	// BackendHTTP is the kind of the backend storing Terraform state with a REST client.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/http
+	BackendHTTP = "http"
+
+	// BackendLocal is the kind of the backend storing Terraform state on the local filesystem.
+	// https://developer.hashicorp.com/terraform/language/settings/backends/local
+	BackendLocal = "local"
+
+	// stateFileExtension is the extension of the state files stored by the backends addressing the state with a key or a path.
+	stateFileExtension = ".tfstate"
+)
+
+var (
+	// ErrLockIDRequired is the error returned when the lock on the state can only be released with the ID of the lock.
+	ErrLockIDRequired = errors.New("the ID of the lock is required to unlock the state stored in this backend")
+)
+
 // Backend is an interface for generating Terraform backend configurations.
 type Backend interface {
+	// Kind returns the kind of the backend, for example "kubernetes".
+	Kind() string
+
 	// BuildBackend generates the Terraform backend configuration for the backend.
 	// Returns a map of Terraform backend name to values representing the backend configuration.
 	// Returns an error if the backend configuration cannot be generated.
 	BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error)
 
-	// ValidateBackendExists checks if the Terraform state file backend source exists.
+	// ValidateBackendExists checks if the Terraform state file of the resource exists in the backend.
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
+	// Backends that cannot be queried without Terraform return true, and leave it to Terraform to handle a missing state.
 	// returns true if backend is found, false otherwise.
-	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+	ValidateBackendExists(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) (bool, error)
+
+	// DeleteState deletes the Terraform state file of the resource once the resources deployed by the recipe are destroyed.
+	DeleteState(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error
+
+	// ReleaseLock releases the lock Terraform holds on the state file of the resource, without the ID of the lock.
+	// Returns ErrLockIDRequired if the backend can only be unlocked by Terraform with the ID of the lock.
+	ReleaseLock(ctx context.Context, resourceRecipe *recipes.ResourceMetadata) error
+}
+
+// NewBackend returns the backend of the given configuration. The Kubernetes secret backend is used if the kind of
+// the backend is not set.
+func NewBackend(config recipes.TerraformBackend, k8sClientSet kubernetes.Interface) (Backend, error) {
+	switch config.Kind {
+	case "", BackendKubernetes:
+		return NewKubernetesBackend(k8sClientSet), nil
+	case BackendS3:
+		return NewS3Backend(config.Config), nil
+	case BackendAzureRM:
+		return NewAzureRMBackend(config.Config), nil
+	case BackendHTTP:
+		return NewHTTPBackend(config.Config), nil
+	case BackendLocal:
+		return NewLocalBackend(config.Config), nil
+	default:
+		return nil, fmt.Errorf("unsupported terraform backend kind %q", config.Kind)
+	}
+}
+
+// copyConfig returns a shallow copy of the backend configuration.
+func copyConfig(config map[string]any) map[string]any {
+	copied := map[string]any{}
+	for k, v := range config {
+		copied[k] = v
+	}
+	return copied
+}
+
+// getString returns the string value of the given key of the backend configuration.
+func getString(config map[string]any, key string) string {
+	value, _ := config[key].(string)
+	return value
+}
+
+// requireKeys returns an error if any of the given keys is not set in the configuration of the backend.
+func requireKeys(kind string, config map[string]any, keys ...string) error {
+	for _, key := range keys {
+		if getString(config, key) == "" {
+			return fmt.Errorf("%q is required in the configuration of the %s terraform backend", key, kind)
+		}
+	}
+	return nil
+}
+
+// stateKey returns the key of the state file of the resource, under the prefix configured with the key of the backend.
+func stateKey(config map[string]any, resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	name, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := strings.Trim(getString(config, "key"), "/")
+	if prefix == "" {
+		return name + stateFileExtension, nil
+	}
+	return prefix + "/" + name + stateFileExtension, nil
 }
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_NewBackend(t *testing.T) {
+	tests := []struct {
+		kind     string
+		expected string
+	}{
+		{kind: "", expected: BackendKubernetes},
+		{kind: BackendKubernetes, expected: BackendKubernetes},
+		{kind: BackendS3, expected: BackendS3},
+		{kind: BackendAzureRM, expected: BackendAzureRM},
+		{kind: BackendHTTP, expected: BackendHTTP},
+		{kind: BackendLocal, expected: BackendLocal},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expected, func(t *testing.T) {
+			backend, err := NewBackend(recipes.TerraformBackend{Kind: tc.kind}, fake.NewSimpleClientset())
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, backend.Kind())
+		})
+	}
+
+	_, err := NewBackend(recipes.TerraformBackend{Kind: "gcs"}, nil)
+	require.EqualError(t, err, "unsupported terraform backend kind \"gcs\"")
+}
+
+func Test_StateKey(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	name, err := generateSecretSuffix(&resourceRecipe)
+	require.NoError(t, err)
+
+	key, err := stateKey(map[string]any{}, &resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, name+".tfstate", key)
+
+	key, err = stateKey(map[string]any{"key": "/radius/recipes/"}, &resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, "radius/recipes/"+name+".tfstate", key)
+}
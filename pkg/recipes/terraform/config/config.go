@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
@@ -136,20 +137,29 @@ func newModuleConfig(moduleSource string, moduleVersion string, params ...Recipe
 }
 
 // getProviderConfigs generates the Terraform provider configurations for the required providers.
+// Providers configured in the environment are only added if they are required by the module, since Terraform
+// installs every provider that has a configuration.
 func getProviderConfigs(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) (map[string]any, error) {
 	providerConfigs := make(map[string]any)
 	for _, provider := range requiredProviders {
-		builder, ok := supportedProviders[provider]
-		if !ok {
-			// No-op: For any other provider, Radius doesn't generate any custom configuration.
-			continue
+		var config map[string]any
+		if builder, ok := supportedProviders[provider]; ok {
+			var err error
+			config, err = builder.BuildConfig(ctx, envConfig)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		config, err := builder.BuildConfig(ctx, envConfig)
-		if err != nil {
-			return nil, err
+		// For any other provider, Radius doesn't generate any custom configuration.
+		var envProviderConfigs []map[string]any
+		if envConfig != nil {
+			envProviderConfigs = envConfig.Terraform.Providers[provider]
 		}
-		if len(config) > 0 {
+
+		if len(envProviderConfigs) > 0 {
+			providerConfigs[provider] = mergeProviderConfigs(config, envProviderConfigs)
+		} else if len(config) > 0 {
 			providerConfigs[provider] = config
 		}
 	}
@@ -157,6 +167,96 @@ func getProviderConfigs(ctx context.Context, requiredProviders []string, support
 	return providerConfigs, nil
 }
 
+// mergeProviderConfigs merges the provider configuration generated by Radius into the default configuration of the
+// provider configured in the environment, which is the configuration without an alias. Settings configured in the
+// environment take precedence. The Radius generated configuration is used as the default configuration if the
+// environment only configures aliased configurations.
+func mergeProviderConfigs(generated map[string]any, envConfigs []map[string]any) []map[string]any {
+	merged := []map[string]any{}
+	hasDefault := false
+	for _, envConfig := range envConfigs {
+		config := map[string]any{}
+		if _, ok := envConfig[providerAliasKey]; !ok {
+			hasDefault = true
+			for k, v := range generated {
+				config[k] = v
+			}
+		}
+		for k, v := range envConfig {
+			config[k] = v
+		}
+		merged = append(merged, config)
+	}
+
+	if !hasDefault && len(generated) > 0 {
+		merged = append([]map[string]any{generated}, merged...)
+	}
+
+	return merged
+}
+
+// AddRequiredProviders declares the source addresses of the providers required by the module in the root module,
+// so that the provider configurations of the root module apply to the same providers as the module. It also passes
+// the alternate provider configurations expected by the module through the "providers" argument of the module when
+// they are configured. providerSources is a map of provider name to source address and configurationAliases contains
+// the alternate provider configurations expected by the module, for example: "helm.east".
+// Save() must be called to save the updated config.
+func (cfg *TerraformConfig) AddRequiredProviders(moduleName string, providerSources map[string]string, configurationAliases []string) error {
+	mod, ok := cfg.Module[moduleName]
+	if !ok {
+		// must not happen because module key is set when the config is initialized in New().
+		return fmt.Errorf("module %q not found in the initialized terraform config", moduleName)
+	}
+
+	requiredProviders := map[string]any{}
+	for name, source := range providerSources {
+		if source != "" {
+			requiredProviders[name] = map[string]any{moduleSourceKey: source}
+		}
+	}
+	if len(requiredProviders) > 0 {
+		if cfg.Terraform == nil {
+			cfg.Terraform = &TerraformDefinition{}
+		}
+		cfg.Terraform.RequiredProviders = requiredProviders
+	}
+
+	moduleProviders := map[string]string{}
+	for _, ref := range configurationAliases {
+		name, alias, ok := strings.Cut(ref, ".")
+		if ok && cfg.hasProviderAlias(name, alias) {
+			moduleProviders[ref] = ref
+		}
+	}
+
+	// Passing providers explicitly to a module disables the inheritance of the default provider configurations,
+	// so the default configurations of all required providers must be passed as well.
+	if len(moduleProviders) > 0 {
+		for name := range providerSources {
+			moduleProviders[name] = name
+		}
+		mod[moduleProvidersKey] = moduleProviders
+	}
+
+	return nil
+}
+
+// hasProviderAlias returns true if the config has an alternate configuration with the given alias for the provider.
+func (cfg *TerraformConfig) hasProviderAlias(provider, alias string) bool {
+	configs, ok := cfg.Provider[provider].([]map[string]any)
+	if !ok {
+		return false
+	}
+
+	for _, config := range configs {
+		if config[providerAliasKey] == alias {
+			return true
+		}
+	}
+
+	return false
+}
+
 // AddTerraformBackend adds backend configurations to store Terraform state file for the deployment.
 // Save() must be called to save the generated backend config.
 // Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
@@ -165,9 +265,10 @@ func (cfg *TerraformConfig) AddTerraformBackend(resourceRecipe *recipes.Resource
 	if err != nil {
 		return nil, err
 	}
-	cfg.Terraform = &TerraformDefinition{
-		Backend: backendConfig,
+	if cfg.Terraform == nil {
+		cfg.Terraform = &TerraformDefinition{}
 	}
+	cfg.Terraform.Backend = backendConfig
 
 	return backendConfig, nil
 }
@@ -347,6 +347,40 @@ func Test_AddProviders(t *testing.T) {
 
 			expectedConfigFile: "testdata/providers-valid.tf.json",
 		},
+		{
+			desc: "providers configured in the environment",
+			expectedProviders: []map[string]any{
+				{
+					"region": "test-region",
+				},
+			},
+			Err: nil,
+			envConfig: recipes.Configuration{
+				Providers: datamodel.Providers{
+					AWS: datamodel.ProvidersAWS{
+						Scope: "/planes/aws/aws/accounts/0000/regions/test-region",
+					},
+				},
+				Terraform: recipes.TerraformConfiguration{
+					Providers: map[string][]map[string]any{
+						providers.AWSProviderName: {
+							{"alias": "west", "region": "us-west-2"},
+						},
+						"postgresql": {
+							{"host": "postgres.example.com", "port": 5432},
+						},
+						"random": {
+							{},
+						},
+					},
+				},
+			},
+			requiredProviders: []string{
+				providers.AWSProviderName,
+				"postgresql",
+			},
+			expectedConfigFile: "testdata/providers-envconfig.tf.json",
+		},
 		{
 			desc:              "invalid aws scope",
 			expectedProviders: nil,
@@ -445,6 +479,89 @@ func Test_AddProviders(t *testing.T) {
 	}
 }
 
+func Test_MergeProviderConfigs(t *testing.T) {
+	generated := map[string]any{"region": "test-region", "access_key": "key"}
+
+	tests := []struct {
+		desc       string
+		envConfigs []map[string]any
+		expected   []map[string]any
+	}{
+		{
+			desc:       "environment settings take precedence",
+			envConfigs: []map[string]any{{"region": "us-west-2"}},
+			expected:   []map[string]any{{"region": "us-west-2", "access_key": "key"}},
+		},
+		{
+			desc:       "aliased configurations only",
+			envConfigs: []map[string]any{{"alias": "west", "region": "us-west-2"}},
+			expected: []map[string]any{
+				{"region": "test-region", "access_key": "key"},
+				{"alias": "west", "region": "us-west-2"},
+			},
+		},
+		{
+			desc:       "default and aliased configurations",
+			envConfigs: []map[string]any{{"alias": "west", "region": "us-west-2"}, {"profile": "default"}},
+			expected: []map[string]any{
+				{"alias": "west", "region": "us-west-2"},
+				{"region": "test-region", "access_key": "key", "profile": "default"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, mergeProviderConfigs(generated, tc.envConfigs))
+		})
+	}
+
+	require.Equal(t, []map[string]any{{"host": "localhost"}}, mergeProviderConfigs(nil, []map[string]any{{"host": "localhost"}}))
+}
+
+func Test_AddRequiredProviders(t *testing.T) {
+	envRecipe, resourceRecipe := getTestInputs()
+	sources := map[string]string{
+		"helm":       "hashicorp/helm",
+		"postgresql": "cyrilgdn/postgresql",
+	}
+
+	t.Run("configured aliases are passed to the module", func(t *testing.T) {
+		tfconfig := New(testRecipeName, &envRecipe, &resourceRecipe)
+		tfconfig.Provider = map[string]any{
+			"helm": []map[string]any{{"alias": "east"}, {"alias": "west"}},
+		}
+
+		err := tfconfig.AddRequiredProviders(testRecipeName, sources, []string{"helm.east", "helm.central"})
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"helm":       map[string]any{"source": "hashicorp/helm"},
+			"postgresql": map[string]any{"source": "cyrilgdn/postgresql"},
+		}, tfconfig.Terraform.RequiredProviders)
+		require.Equal(t, map[string]string{
+			"helm":       "helm",
+			"helm.east":  "helm.east",
+			"postgresql": "postgresql",
+		}, tfconfig.Module[testRecipeName]["providers"])
+	})
+
+	t.Run("providers are inherited without aliases", func(t *testing.T) {
+		tfconfig := New(testRecipeName, &envRecipe, &resourceRecipe)
+
+		err := tfconfig.AddRequiredProviders(testRecipeName, sources, []string{"helm.east"})
+		require.NoError(t, err)
+		require.Len(t, tfconfig.Terraform.RequiredProviders, 2)
+		require.NotContains(t, tfconfig.Module[testRecipeName], "providers")
+	})
+
+	t.Run("module not found", func(t *testing.T) {
+		tfconfig := New(testRecipeName, &envRecipe, &resourceRecipe)
+
+		err := tfconfig.AddRequiredProviders("invalid", sources, nil)
+		require.EqualError(t, err, "module \"invalid\" not found in the initialized terraform config")
+	})
+}
+
 func Test_AddOutputs(t *testing.T) {
 	envRecipe, resourceRecipe := getTestInputs()
 	tests := []struct {
@@ -41,6 +41,10 @@ const (
 	awsRegionParam    = "region"
 	awsAccessKeyParam = "access_key"
 	awsSecretKeyParam = "secret_key"
+
+	awsAssumeRoleWithWebIdentityParam = "assume_role_with_web_identity"
+	awsRoleARNParam                   = "role_arn"
+	awsWebIdentityTokenFileParam      = "web_identity_token_file"
 )
 
 var _ Provider = (*awsProvider)(nil)
@@ -118,7 +122,17 @@ func fetchAWSCredentials(ctx context.Context, awsCredentialsProvider credentials
 		return nil, err
 	}
 
-	if credentials == nil || credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+	if credentials == nil {
+		logger.Info("AWS credentials are not registered, skipping credentials configuration.")
+		return nil, nil
+	}
+
+	if credentials.IsIRSA() {
+		if credentials.RoleARN == "" {
+			logger.Info("AWS IRSA role is not registered, skipping credentials configuration.")
+			return nil, nil
+		}
+	} else if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
 		logger.Info("AWS credentials are not registered, skipping credentials configuration.")
 		return nil, nil
 	}
@@ -126,15 +140,23 @@ func fetchAWSCredentials(ctx context.Context, awsCredentialsProvider credentials
 	return credentials, nil
 }
 
-func (p *awsProvider) generateProviderConfigMap(credentials *credentials.AWSCredential, region string) map[string]any {
+func (p *awsProvider) generateProviderConfigMap(creds *credentials.AWSCredential, region string) map[string]any {
 	config := make(map[string]any)
 	if region != "" {
 		config[awsRegionParam] = region
 	}
 
-	if credentials != nil && credentials.AccessKeyID != "" && credentials.SecretAccessKey != "" {
-		config[awsAccessKeyParam] = credentials.AccessKeyID
-		config[awsSecretKeyParam] = credentials.SecretAccessKey
+	switch {
+	case creds == nil:
+	case creds.IsIRSA() && creds.RoleARN != "":
+		// The provider assumes the IRSA role with the web identity token projected into the pod.
+		config[awsAssumeRoleWithWebIdentityParam] = map[string]any{
+			awsRoleARNParam:              creds.RoleARN,
+			awsWebIdentityTokenFileParam: credentials.AWSWebIdentityTokenFile(),
+		}
+	case creds.AccessKeyID != "" && creds.SecretAccessKey != "":
+		config[awsAccessKeyParam] = creds.AccessKeyID
+		config[awsSecretKeyParam] = creds.SecretAccessKey
 	}
 
 	return config
@@ -36,6 +36,10 @@ var (
 		AccessKeyID:     "testAccessKey",
 		SecretAccessKey: "testSecretKey",
 	}
+	testAWSIRSACredentials = ucp_credentials.AWSCredential{
+		Kind:    "IRSA",
+		RoleARN: "arn:aws:iam::000000000000:role/radius",
+	}
 )
 
 type mockAWSCredentialsProvider struct {
@@ -190,6 +194,24 @@ func TestAWSProvider_FetchCredentials(t *testing.T) {
 			expectedCreds:       &testAWSCredentials,
 			expectedErr:         false,
 		},
+		{
+			desc: "valid IRSA credentials",
+			credentialsProvider: &mockAWSCredentialsProvider{
+				&testAWSIRSACredentials,
+			},
+			expectedCreds: &testAWSIRSACredentials,
+			expectedErr:   false,
+		},
+		{
+			desc: "IRSA credentials without role - no error",
+			credentialsProvider: &mockAWSCredentialsProvider{
+				&ucp_credentials.AWSCredential{
+					Kind: "IRSA",
+				},
+			},
+			expectedCreds: nil,
+			expectedErr:   false,
+		},
 		{
 			desc: "credentials not found - no error",
 			credentialsProvider: &mockAWSCredentialsProvider{
@@ -263,6 +285,18 @@ func TestAWSProvider_generateProviderConfigMap(t *testing.T) {
 				awsSecretKeyParam: testAWSCredentials.SecretAccessKey,
 			},
 		},
+		{
+			desc:        "IRSA credentials",
+			region:      testRegion,
+			credentials: testAWSIRSACredentials,
+			expectedConfig: map[string]any{
+				awsRegionParam: testRegion,
+				awsAssumeRoleWithWebIdentityParam: map[string]any{
+					awsRoleARNParam:              testAWSIRSACredentials.RoleARN,
+					awsWebIdentityTokenFileParam: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+				},
+			},
+		},
 		{
 			desc:   "missing credentials",
 			region: testRegion,
@@ -288,6 +322,7 @@ func TestAWSProvider_generateProviderConfigMap(t *testing.T) {
 			require.Equal(t, tt.expectedConfig[awsRegionParam], config[awsRegionParam])
 			require.Equal(t, tt.expectedConfig[awsAccessKeyParam], config[awsAccessKeyParam])
 			require.Equal(t, tt.expectedConfig[awsSecretKeyParam], config[awsSecretKeyParam])
+			require.Equal(t, tt.expectedConfig[awsAssumeRoleWithWebIdentityParam], config[awsAssumeRoleWithWebIdentityParam])
 		})
 	}
 }
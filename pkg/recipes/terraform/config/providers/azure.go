@@ -38,11 +38,13 @@ import (
 const (
 	AzureProviderName = "azurerm"
 
-	azureFeaturesParam     = "features"
-	azureSubIDParam        = "subscription_id"
-	azureClientIDParam     = "client_id"
-	azureClientSecretParam = "client_secret"
-	azureTenantIDParam     = "tenant_id"
+	azureFeaturesParam          = "features"
+	azureSubIDParam             = "subscription_id"
+	azureClientIDParam          = "client_id"
+	azureClientSecretParam      = "client_secret"
+	azureTenantIDParam          = "tenant_id"
+	azureUseOIDCParam           = "use_oidc"
+	azureOIDCTokenFilePathParam = "oidc_token_file_path"
 )
 
 var _ Provider = (*azureProvider)(nil)
@@ -126,7 +128,7 @@ func fetchAzureCredentials(ctx context.Context, azureCredentialsProvider credent
 		return nil, err
 	}
 
-	if credentials == nil || credentials.ClientID == "" || credentials.TenantID == "" || credentials.ClientSecret == "" {
+	if credentials == nil || credentials.ClientID == "" || credentials.TenantID == "" || (!credentials.IsWorkloadIdentity() && credentials.ClientSecret == "") {
 		logger.Info("Azure credentials are not registered, skipping credentials configuration.")
 		return nil, nil
 	}
@@ -134,15 +136,23 @@ func fetchAzureCredentials(ctx context.Context, azureCredentialsProvider credent
 	return credentials, nil
 }
 
-func (p *azureProvider) generateProviderConfigMap(configMap map[string]any, credentials *credentials.AzureCredential, subscriptionID string) map[string]any {
+func (p *azureProvider) generateProviderConfigMap(configMap map[string]any, creds *credentials.AzureCredential, subscriptionID string) map[string]any {
 	if subscriptionID != "" {
 		configMap[azureSubIDParam] = subscriptionID
 	}
 
-	if credentials != nil && credentials.ClientID != "" && credentials.TenantID != "" && credentials.ClientSecret != "" {
-		configMap[azureClientIDParam] = credentials.ClientID
-		configMap[azureClientSecretParam] = credentials.ClientSecret
-		configMap[azureTenantIDParam] = credentials.TenantID
+	switch {
+	case creds == nil || creds.ClientID == "" || creds.TenantID == "":
+	case creds.IsWorkloadIdentity():
+		// The provider exchanges the federated token projected into the pod for access tokens.
+		configMap[azureClientIDParam] = creds.ClientID
+		configMap[azureTenantIDParam] = creds.TenantID
+		configMap[azureUseOIDCParam] = true
+		configMap[azureOIDCTokenFilePathParam] = credentials.AzureFederatedTokenFile()
+	case creds.ClientSecret != "":
+		configMap[azureClientIDParam] = creds.ClientID
+		configMap[azureClientSecretParam] = creds.ClientSecret
+		configMap[azureTenantIDParam] = creds.TenantID
 	}
 
 	return configMap
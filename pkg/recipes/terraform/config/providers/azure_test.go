@@ -37,6 +37,11 @@ var (
 		ClientSecret: "testClientSecret",
 		ClientID:     "testClientID",
 	}
+	testAzureWorkloadIdentityCredentials = ucp_credentials.AzureCredential{
+		Kind:     "WorkloadIdentity",
+		TenantID: "testTenantID",
+		ClientID: "testClientID",
+	}
 )
 
 type mockAzureCredentialsProvider struct {
@@ -192,6 +197,14 @@ func TestAzureProvider_FetchCredentials(t *testing.T) {
 			expectedCreds:       &testAzureCredentials,
 			expectedErr:         false,
 		},
+		{
+			desc: "valid workload identity credentials",
+			credentialsProvider: &mockAzureCredentialsProvider{
+				&testAzureWorkloadIdentityCredentials,
+			},
+			expectedCreds: &testAzureWorkloadIdentityCredentials,
+			expectedErr:   false,
+		},
 		{
 			desc: "credentials not found - no error",
 			credentialsProvider: &mockAzureCredentialsProvider{
@@ -271,6 +284,19 @@ func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
 				azureClientSecretParam: testAzureCredentials.ClientSecret,
 			},
 		},
+		{
+			desc:         "workload identity credentials",
+			subscription: testSubscription,
+			credentials:  testAzureWorkloadIdentityCredentials,
+			expectedConfig: map[string]any{
+				azureFeaturesParam:          map[string]any{},
+				azureSubIDParam:             testSubscription,
+				azureTenantIDParam:          testAzureWorkloadIdentityCredentials.TenantID,
+				azureClientIDParam:          testAzureWorkloadIdentityCredentials.ClientID,
+				azureUseOIDCParam:           true,
+				azureOIDCTokenFilePathParam: "/var/run/secrets/azure/tokens/azure-identity-token",
+			},
+		},
 		{
 			desc:         "missing credentials",
 			subscription: testSubscription,
@@ -305,6 +331,8 @@ func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
 			require.Equal(t, tt.expectedConfig[azureClientIDParam], config[azureClientIDParam])
 			require.Equal(t, tt.expectedConfig[azureClientSecretParam], config[azureClientSecretParam])
 			require.Equal(t, tt.expectedConfig[azureTenantIDParam], config[azureTenantIDParam])
+			require.Equal(t, tt.expectedConfig[azureUseOIDCParam], config[azureUseOIDCParam])
+			require.Equal(t, tt.expectedConfig[azureOIDCTokenFilePathParam], config[azureOIDCTokenFilePathParam])
 		})
 	}
 }
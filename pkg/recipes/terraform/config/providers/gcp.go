@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_gcp "github.com/radius-project/radius/pkg/ucp/resources/gcp"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Provider's config parameters need to match the values expected by Terraform
+// https://registry.terraform.io/providers/hashicorp/google/latest/docs/guides/provider_reference
+const (
+	GCPProviderName = "google"
+
+	gcpProjectParam     = "project"
+	gcpRegionParam      = "region"
+	gcpCredentialsParam = "credentials"
+
+	// Values of the external account credential configuration used for workload identity federation.
+	// https://google.aip.dev/auth/4117
+	gcpExternalAccountType            = "external_account"
+	gcpSubjectTokenTypeJWT            = "urn:ietf:params:oauth:token-type:jwt"
+	gcpSTSTokenURL                    = "https://sts.googleapis.com/v1/token"
+	gcpServiceAccountImpersonationURL = "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken"
+)
+
+var _ Provider = (*gcpProvider)(nil)
+
+type gcpProvider struct {
+	ucpConn        sdk.Connection
+	secretProvider *ucp_provider.SecretProvider
+}
+
+// gcpScope is the project and region parsed from the GCP provider scope of the Environment.
+type gcpScope struct {
+	project string
+	region  string
+}
+
+// gcpExternalAccount is the external account credential configuration consumed by the Google Terraform provider
+// for workload identity federation.
+type gcpExternalAccount struct {
+	Type                           string                   `json:"type"`
+	Audience                       string                   `json:"audience"`
+	SubjectTokenType               string                   `json:"subject_token_type"`
+	TokenURL                       string                   `json:"token_url"`
+	CredentialSource               gcpExternalAccountSource `json:"credential_source"`
+	ServiceAccountImpersonationURL string                   `json:"service_account_impersonation_url,omitempty"`
+}
+
+// gcpExternalAccountSource is the file source of the subject token of the external account credential.
+type gcpExternalAccountSource struct {
+	File string `json:"file"`
+}
+
+// NewGCPProvider creates a new GCPProvider instance.
+func NewGCPProvider(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider) Provider {
+	return &gcpProvider{ucpConn: ucpConn, secretProvider: secretProvider}
+}
+
+// BuildConfig generates the Terraform provider configuration for Google provider. It checks if the GCP provider/scope
+// is configured on the Environment and if so, parses the scope to get the project and region, and adds the GCP
+// credentials registered with UCP to the configuration. If the scope is invalid, an error is returned.
+// https://registry.terraform.io/providers/hashicorp/google/latest/docs/guides/provider_reference
+func (p *gcpProvider) BuildConfig(ctx context.Context, envConfig *recipes.Configuration) (map[string]any, error) {
+	scope, err := p.parseScope(ctx, envConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsProvider, err := p.getCredentialsProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := fetchGCPCredentials(ctx, credentialsProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.generateProviderConfigMap(credentials, scope)
+}
+
+// parseScope parses a GCP provider scope and returns the associated project and region.
+// Example scope: /planes/gcp/gcp/projects/my-project/regions/us-central1
+func (p *gcpProvider) parseScope(ctx context.Context, envConfig *recipes.Configuration) (gcpScope, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if (envConfig == nil) || (envConfig.Providers == datamodel.Providers{}) || (envConfig.Providers.GCP == datamodel.ProvidersGCP{}) || envConfig.Providers.GCP.Scope == "" {
+		logger.Info("GCP provider/scope is not configured on the Environment, skipping GCP project and region configuration.")
+		return gcpScope{}, nil
+	}
+
+	scope := envConfig.Providers.GCP.Scope
+	parsedScope, err := resources.Parse(scope)
+	if err != nil {
+		return gcpScope{}, fmt.Errorf("invalid GCP provider scope %q is configured on the Environment, error parsing: %s", scope, err.Error())
+	}
+
+	project := parsedScope.FindScope(resources_gcp.ScopeProjects)
+	if project == "" {
+		return gcpScope{}, fmt.Errorf("invalid GCP provider scope %q is configured on the Environment, project is required in the scope", scope)
+	}
+
+	return gcpScope{project: project, region: parsedScope.FindScope(resources_gcp.ScopeRegions)}, nil
+}
+
+func (p *gcpProvider) getCredentialsProvider() (*credentials.GCPCredentialProvider, error) {
+	return credentials.NewGCPCredentialProvider(p.secretProvider, p.ucpConn, &tokencredentials.AnonymousCredential{})
+}
+
+// fetchGCPCredentials fetches GCP credentials from UCP. Returns nil if credentials not found error is received or the credentials are empty.
+func fetchGCPCredentials(ctx context.Context, gcpCredentialsProvider credentials.CredentialProvider[credentials.GCPCredential]) (*credentials.GCPCredential, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	credentials, err := gcpCredentialsProvider.Fetch(ctx, credentials.GCPPublic, "default")
+	if err != nil {
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			logger.Info("GCP credentials are not registered, skipping credentials configuration.")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if credentials == nil {
+		logger.Info("GCP credentials are not registered, skipping credentials configuration.")
+		return nil, nil
+	}
+
+	if credentials.IsWorkloadIdentityFederation() {
+		if credentials.Audience == "" {
+			logger.Info("GCP workload identity pool provider is not registered, skipping credentials configuration.")
+			return nil, nil
+		}
+	} else if credentials.ServiceAccountKey == "" {
+		logger.Info("GCP credentials are not registered, skipping credentials configuration.")
+		return nil, nil
+	}
+
+	return credentials, nil
+}
+
+func (p *gcpProvider) generateProviderConfigMap(creds *credentials.GCPCredential, scope gcpScope) (map[string]any, error) {
+	config := make(map[string]any)
+	if scope.project != "" {
+		config[gcpProjectParam] = scope.project
+	}
+	if scope.region != "" {
+		config[gcpRegionParam] = scope.region
+	}
+
+	switch {
+	case creds == nil:
+	case creds.IsWorkloadIdentityFederation() && creds.Audience != "":
+		// The provider exchanges the service account token projected into the pod for GCP access tokens.
+		account := gcpExternalAccount{
+			Type:             gcpExternalAccountType,
+			Audience:         creds.Audience,
+			SubjectTokenType: gcpSubjectTokenTypeJWT,
+			TokenURL:         gcpSTSTokenURL,
+			CredentialSource: gcpExternalAccountSource{File: credentials.GCPWorkloadIdentityTokenFile()},
+		}
+		if creds.ServiceAccountEmail != "" {
+			account.ServiceAccountImpersonationURL = fmt.Sprintf(gcpServiceAccountImpersonationURL, creds.ServiceAccountEmail)
+		}
+
+		b, err := json.Marshal(account)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate GCP external account credentials: %w", err)
+		}
+		config[gcpCredentialsParam] = string(b)
+	case creds.ServiceAccountKey != "":
+		config[gcpCredentialsParam] = creds.ServiceAccountKey
+	}
+
+	return config, nil
+}
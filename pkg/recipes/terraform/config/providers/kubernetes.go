@@ -19,31 +19,71 @@ package providers
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
+// Provider's config parameters need to match the values expected by Terraform
+// https://registry.terraform.io/providers/hashicorp/kubernetes/latest/docs
 const (
 	KubernetesProviderName = "kubernetes"
+
+	kubernetesConfigPathParam           = "config_path"
+	kubernetesHostParam                 = "host"
+	kubernetesClusterCACertificateParam = "cluster_ca_certificate"
+	kubernetesClientCertificateParam    = "client_certificate"
+	kubernetesClientKeyParam            = "client_key"
+	kubernetesTokenParam                = "token"
+	kubernetesInsecureParam             = "insecure"
 )
 
 var _ Provider = (*kubernetesProvider)(nil)
 
-type kubernetesProvider struct{}
+type kubernetesProvider struct {
+	ucpConn        sdk.Connection
+	secretProvider *ucp_provider.SecretProvider
+}
+
+// NewKubernetesProvider creates a new KubernetesProvider instance.
+func NewKubernetesProvider(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider) Provider {
+	return &kubernetesProvider{ucpConn: ucpConn, secretProvider: secretProvider}
+}
 
-// BuildKubernetesProviderConfig generates the Terraform provider configuration for Kubernetes provider.
-// It returns an error if the in cluster config cannot be retrieved, and uses default kubeconfig file if
-// in-cluster config is not present.
-// https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs
+// BuildConfig generates the Terraform provider configuration for Kubernetes provider. If the Environment targets a
+// cluster registered as a Kubernetes plane, the configuration connects to that cluster with the kubeconfig stored in
+// its UCP credential. Otherwise it returns an error if the in cluster config cannot be retrieved, and uses default
+// kubeconfig file if in-cluster config is not present.
+// https://registry.terraform.io/providers/hashicorp/kubernetes/latest/docs
 func (p *kubernetesProvider) BuildConfig(ctx context.Context, envConfig *recipes.Configuration) (map[string]any, error) {
+	planeName := ""
+	if envConfig != nil && envConfig.Runtime.Kubernetes != nil {
+		planeName = envConfig.Runtime.Kubernetes.PlaneName
+	}
+
+	if !ucp_kubernetes.IsLocalPlane(planeName) {
+		credentialsProvider, err := p.getCredentialsProvider()
+		if err != nil {
+			return nil, err
+		}
+
+		return buildKubernetesClusterConfig(ctx, credentialsProvider, planeName)
+	}
+
 	_, err := rest.InClusterConfig()
 	if err != nil {
 		// If in cluster config is not present, then use default kubeconfig file.
 		if errors.Is(err, rest.ErrNotInCluster) {
 			return map[string]any{
-				"config_path": clientcmd.RecommendedHomeFile,
+				kubernetesConfigPathParam: clientcmd.RecommendedHomeFile,
 			}, nil
 		}
 
@@ -54,3 +94,55 @@ func (p *kubernetesProvider) BuildConfig(ctx context.Context, envConfig *recipes
 	// https://registry.terraform.io/providers/hashicorp/kubernetes/latest/docs#in-cluster-config
 	return nil, nil
 }
+
+func (p *kubernetesProvider) getCredentialsProvider() (*credentials.KubernetesCredentialProvider, error) {
+	return credentials.NewKubernetesCredentialProvider(p.secretProvider, p.ucpConn, &tokencredentials.AnonymousCredential{})
+}
+
+// buildKubernetesClusterConfig fetches the kubeconfig of the cluster of the Kubernetes plane from UCP and returns the
+// provider configuration connecting to the cluster. It returns an error if the plane has no registered kubeconfig.
+func buildKubernetesClusterConfig(ctx context.Context, credentialsProvider credentials.CredentialProvider[credentials.KubernetesCredential], planeName string) (map[string]any, error) {
+	credential, err := credentialsProvider.Fetch(ctx, planeName, ucp_kubernetes.DefaultCredentialName)
+	if errors.Is(err, &secret.ErrNotFound{}) || (err == nil && (credential == nil || credential.KubeConfig == "")) {
+		return nil, &ucp_kubernetes.ErrClusterNotRegistered{PlaneName: planeName}
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Loading the kubeconfig reads the files it references, which would copy files of the UCP host into the
+	// provider configuration.
+	if err := ucp_kubernetes.ValidateKubeConfig(credential.KubeConfig); err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig for the Kubernetes plane %q: %w", planeName, err)
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(credential.KubeConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the kubeconfig of the Kubernetes plane %q: %w", planeName, err)
+	}
+
+	return generateKubernetesProviderConfigMap(restConfig), nil
+}
+
+func generateKubernetesProviderConfigMap(restConfig *rest.Config) map[string]any {
+	config := map[string]any{
+		kubernetesHostParam: restConfig.Host,
+	}
+
+	if len(restConfig.CAData) > 0 {
+		config[kubernetesClusterCACertificateParam] = string(restConfig.CAData)
+	}
+	if len(restConfig.CertData) > 0 {
+		config[kubernetesClientCertificateParam] = string(restConfig.CertData)
+	}
+	if len(restConfig.KeyData) > 0 {
+		config[kubernetesClientKeyParam] = string(restConfig.KeyData)
+	}
+	if restConfig.BearerToken != "" {
+		config[kubernetesTokenParam] = restConfig.BearerToken
+	}
+	if restConfig.Insecure {
+		config[kubernetesInsecureParam] = true
+	}
+
+	return config
+}
@@ -17,13 +17,59 @@ limitations under the License.
 package providers
 
 import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/recipes"
+	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
+const (
+	testClusterCACertificate = "-----BEGIN CERTIFICATE-----\nfake-ca\n-----END CERTIFICATE-----\n"
+
+	testClusterKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster1
+  cluster:
+    server: https://cluster1.example.com
+    certificate-authority-data: %s
+contexts:
+- name: cluster1
+  context:
+    cluster: cluster1
+    user: user1
+current-context: cluster1
+users:
+- name: user1
+  user:
+    token: fake-token
+`
+)
+
+type mockKubernetesCredentialsProvider struct {
+	testCredential *ucp_credentials.KubernetesCredential
+	err            error
+	planeName      string
+}
+
+// Fetch returns the mock Kubernetes credential or the configured error, and records the requested plane.
+func (p *mockKubernetesCredentialsProvider) Fetch(ctx context.Context, planeName, name string) (*ucp_credentials.KubernetesCredential, error) {
+	p.planeName = planeName
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.testCredential, nil
+}
+
 func TestKubernetesProvider_BuildConfig(t *testing.T) {
 	expectedConfig := map[string]any{
 		"config_path": clientcmd.RecommendedHomeFile,
@@ -35,6 +81,23 @@ func TestKubernetesProvider_BuildConfig(t *testing.T) {
 	require.Equal(t, expectedConfig, config)
 }
 
+func TestKubernetesProvider_BuildConfig_LocalPlane(t *testing.T) {
+	expectedConfig := map[string]any{
+		"config_path": clientcmd.RecommendedHomeFile,
+	}
+
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{PlaneName: "local"},
+		},
+	}
+
+	p := &kubernetesProvider{}
+	config, err := p.BuildConfig(testcontext.New(t), envConfig)
+	require.NoError(t, err)
+	require.Equal(t, expectedConfig, config)
+}
+
 func TestKubernetesProvider_BuildConfig_Error(t *testing.T) {
 	t.Setenv("KUBERNETES_SERVICE_HOST", "testvalue")
 	t.Setenv("KUBERNETES_SERVICE_PORT", "1111")
@@ -44,3 +107,66 @@ func TestKubernetesProvider_BuildConfig_Error(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, config)
 }
+
+func TestBuildKubernetesClusterConfig(t *testing.T) {
+	kubeConfig := fmt.Sprintf(testClusterKubeConfig, base64.StdEncoding.EncodeToString([]byte(testClusterCACertificate)))
+
+	tests := []struct {
+		desc                string
+		credentialsProvider *mockKubernetesCredentialsProvider
+		expectedConfig      map[string]any
+		expectedErr         error
+		expectedErrMsg      string
+	}{
+		{
+			desc: "registered cluster",
+			credentialsProvider: &mockKubernetesCredentialsProvider{
+				testCredential: &ucp_credentials.KubernetesCredential{Kind: "KubeConfig", KubeConfig: kubeConfig},
+			},
+			expectedConfig: map[string]any{
+				"host":                   "https://cluster1.example.com",
+				"cluster_ca_certificate": testClusterCACertificate,
+				"token":                  "fake-token",
+			},
+		},
+		{
+			desc:                "credential not found",
+			credentialsProvider: &mockKubernetesCredentialsProvider{err: &secret.ErrNotFound{}},
+			expectedErr:         &ucp_kubernetes.ErrClusterNotRegistered{},
+		},
+		{
+			desc: "empty kubeconfig",
+			credentialsProvider: &mockKubernetesCredentialsProvider{
+				testCredential: &ucp_credentials.KubernetesCredential{Kind: "KubeConfig"},
+			},
+			expectedErr: &ucp_kubernetes.ErrClusterNotRegistered{},
+		},
+		{
+			desc: "kubeconfig referencing a token file",
+			credentialsProvider: &mockKubernetesCredentialsProvider{
+				testCredential: &ucp_credentials.KubernetesCredential{
+					Kind:       "KubeConfig",
+					KubeConfig: strings.Replace(kubeConfig, "token: fake-token", "tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token", 1),
+				},
+			},
+			expectedErrMsg: `invalid kubeconfig for the Kubernetes plane "cluster1": user "user1": tokenFile is not supported`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			config, err := buildKubernetesClusterConfig(testcontext.New(t), tt.credentialsProvider, "cluster1")
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				require.Nil(t, config)
+			} else if tt.expectedErrMsg != "" {
+				require.ErrorContains(t, err, tt.expectedErrMsg)
+				require.Nil(t, config)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedConfig, config)
+			}
+			require.Equal(t, "cluster1", tt.credentialsProvider.planeName)
+		})
+	}
+}
@@ -41,6 +41,7 @@ func GetSupportedTerraformProviders(ucpConn sdk.Connection, secretProvider *ucp_
 	return map[string]Provider{
 		AWSProviderName:        NewAWSProvider(ucpConn, secretProvider),
 		AzureProviderName:      NewAzureProvider(ucpConn, secretProvider),
-		KubernetesProviderName: &kubernetesProvider{},
+		GCPProviderName:        NewGCPProvider(ucpConn, secretProvider),
+		KubernetesProviderName: NewKubernetesProvider(ucpConn, secretProvider),
 	}
 }
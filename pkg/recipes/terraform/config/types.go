@@ -21,6 +21,11 @@ const (
 	moduleSourceKey = "source"
 	// moduleVersionKey represents the key for the module version parameter.
 	moduleVersionKey = "version"
+	// moduleProvidersKey represents the key for the providers passed to the module.
+	moduleProvidersKey = "providers"
+
+	// providerAliasKey represents the key for the alias of an alternate provider configuration.
+	providerAliasKey = "alias"
 
 	mainConfigFileName = "main.tf.json"
 )
@@ -49,7 +54,7 @@ type TerraformConfig struct {
 
 	// Module is the Terraform module configuration.
 	// https://developer.hashicorp.com/terraform/language/modules/syntax
-	Module map[string]TFModuleConfig `json:"module"`
+	Module map[string]TFModuleConfig `json:"module,omitempty"`
 
 	// Output is the Terraform output configuration.
 	// https://developer.hashicorp.com/terraform/language/values/outputs
@@ -60,4 +65,8 @@ type TerraformDefinition struct {
 	// Backend defines where Terraform stores its state.
 	// https://developer.hashicorp.com/terraform/language/state
 	Backend map[string]interface{} `json:"backend"`
+
+	// RequiredProviders declares the source addresses of the providers used by the configuration.
+	// https://developer.hashicorp.com/terraform/language/providers/requirements
+	RequiredProviders map[string]any `json:"required_providers,omitempty"`
 }
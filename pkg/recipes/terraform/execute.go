@@ -23,9 +23,10 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
-	install "github.com/hashicorp/hc-install"
+	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
@@ -38,25 +39,30 @@ import (
 	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 const (
 	executionSubDir                = "deploy"
+	planFileName                   = "tfplan"
 	workingDirFileMode fs.FileMode = 0700
 )
 
 var (
 	// ErrRecipeNameEmpty is the error when the recipe name is empty.
 	ErrRecipeNameEmpty = errors.New("recipe name cannot be empty")
+
+	// initLock serializes Terraform init across executions. Terraform init installs providers into the plugin cache
+	// shared across executions, which is not safe for concurrent use.
+	initLock sync.Mutex
 )
 
 var _ TerraformExecutor = (*executor)(nil)
 
 // NewExecutor creates a new Executor with the given UCP connection and secret provider, to execute a Terraform recipe.
-func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface) *executor {
-	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet}
+// Terraform is installed by the given installer.
+func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface, installer *Installer) *executor {
+	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet, installer: installer}
 }
 
 type executor struct {
@@ -68,23 +74,22 @@ type executor struct {
 
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+
+	// installer installs Terraform once and shares the installation across executions.
+	installer *Installer
 }
 
 // Deploy installs Terraform, creates a working directory, generates a config, and runs Terraform init and
-// apply in the working directory, returning an error if any of these steps fail.
-func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State, error) {
-	logger := ucplog.FromContextOrDiscard(ctx)
+// apply in the working directory, returning an error if any of these steps fail. The result includes the resolved
+// version and the digest of the module which was applied.
+func (e *executor) Deploy(ctx context.Context, options Options) (*DeployResult, error) {
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return nil, err
+	}
 
-	// Install Terraform
-	i := install.NewInstaller()
-	execPath, err := Install(ctx, i, options.RootDir)
-	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
-	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
-	defer func() {
-		if err := i.Remove(ctx); err != nil {
-			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
-		}
-	}()
+	// Install Terraform, or reuse the installation shared across executions.
+	execPath, err := e.installer.Install(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -96,27 +101,47 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 	}
 
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, workingDir, execPath, options)
+	if err := e.generateConfig(ctx, workingDir, execPath, backend, options); err != nil {
+		return nil, err
+	}
+
+	// Record the module which is applied so that the resource can be traced back to the version of the recipe.
+	moduleVersion, err := getModuleVersion(workingDir, options.EnvRecipe.Name)
+	if err != nil {
+		return nil, err
+	}
+	moduleDigest, err := getModuleDigest(workingDir, options.EnvRecipe.Name)
 	if err != nil {
 		return nil, err
 	}
 
 	// Run TF Init and Apply in the working directory
-	state, err := initAndApply(ctx, workingDir, execPath)
+	env, err := getTerraformEnv(workingDir, options.EnvConfig, e.installer.PluginCacheDir())
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := initAndApply(ctx, workingDir, execPath, env)
 	if err != nil {
+		if ctx.Err() != nil {
+			releaseStateLock(ctx, backend, options.ResourceRecipe)
+		}
 		return nil, err
 	}
 
-	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	// Validate that the terraform state file exists in the backend, where it is created as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, options.ResourceRecipe)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return nil, fmt.Errorf("error retrieving terraform state from the %s backend: %w", backend.Kind(), err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, fmt.Errorf("expected terraform state is not found in the %s backend", backend.Kind())
 	}
 
-	return state, nil
+	return &DeployResult{
+		State:         state,
+		ModuleVersion: moduleVersion,
+		ModuleDigest:  moduleDigest,
+	}, nil
 }
 
 // Delete installs Terraform, creates a working directory, generates a config, and runs Terraform destroy
@@ -124,16 +149,13 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 func (e *executor) Delete(ctx context.Context, options Options) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Install Terraform
-	i := install.NewInstaller()
-	execPath, err := Install(ctx, i, options.RootDir)
-	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
-	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
-	defer func() {
-		if err := i.Remove(ctx); err != nil {
-			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
-		}
-	}()
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return err
+	}
+
+	// Install Terraform, or reuse the installation shared across executions.
+	execPath, err := e.installer.Install(ctx)
 	if err != nil {
 		return err
 	}
@@ -145,15 +167,14 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	}
 
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, workingDir, execPath, options)
-	if err != nil {
+	if err := e.generateConfig(ctx, workingDir, execPath, backend, options); err != nil {
 		return err
 	}
 
 	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
 	// If the state file source has been deleted or wasn't created due to a failure during apply then
 	// terraform initialization will fail due to missing backend source.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	backendExists, err := backend.ValidateBackendExists(ctx, options.ResourceRecipe)
 	if err != nil {
 		// Continue with the delete flow for all errors other than backend not found.
 		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
@@ -165,35 +186,188 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	}
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
-	err = initAndDestroy(ctx, workingDir, execPath)
+	env, err := getTerraformEnv(workingDir, options.EnvConfig, e.installer.PluginCacheDir())
 	if err != nil {
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = e.k8sClientSet.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
+	err = initAndDestroy(ctx, workingDir, execPath, env)
 	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+		if ctx.Err() != nil {
+			releaseStateLock(ctx, backend, options.ResourceRecipe)
+		}
+		return err
+	}
+
+	// Delete the terraform state file from the backend.
+	return backend.DeleteState(ctx, options.ResourceRecipe)
+}
+
+// ExportState installs Terraform, initializes the backend configured for the environment and returns the Terraform
+// state file of the resource.
+func (e *executor) ExportState(ctx context.Context, options Options) ([]byte, error) {
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := e.initBackend(ctx, backend, options)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := tf.StatePull(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("terraform state pull failure: %w", err)
+	}
+
+	return []byte(state), nil
+}
+
+// UnlockState releases the lock on the Terraform state file of the resource. When lockID is empty, the lock is
+// released directly in the backend, which is only supported by some backends. Otherwise, Terraform force-unlocks the
+// state with the given lock ID.
+func (e *executor) UnlockState(ctx context.Context, options Options, lockID string) error {
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return err
+	}
+
+	if lockID == "" {
+		return backend.ReleaseLock(ctx, options.ResourceRecipe)
+	}
+
+	tf, err := e.initBackend(ctx, backend, options)
+	if err != nil {
+		return err
+	}
+
+	if err := tf.ForceUnlock(ctx, lockID); err != nil {
+		return fmt.Errorf("terraform force-unlock failure: %w", err)
 	}
 
 	return nil
 }
 
-func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
-	logger := ucplog.FromContextOrDiscard(ctx)
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and plan in the
+// working directory to compare the resources deployed by the recipe with the recipe. The plan is returned without
+// applying it.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Install Terraform, or reuse the installation shared across executions.
+	execPath, err := e.installer.Install(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// Install Terraform
-	i := install.NewInstaller()
-	execPath, err := Install(ctx, i, options.RootDir)
-	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
-	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
-	defer func() {
-		if err := i.Remove(ctx); err != nil {
-			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+	// Create Working Directory
+	workingDir, err := createWorkingDir(ctx, options.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory
+	if err := e.generateConfig(ctx, workingDir, execPath, backend, options); err != nil {
+		return nil, err
+	}
+
+	// The deployed resources can't be compared with the recipe without the Terraform state created by Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, options.ResourceRecipe)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving terraform state from the %s backend: %w", backend.Kind(), err)
+	} else if !backendExists {
+		return nil, fmt.Errorf("expected terraform state is not found in the %s backend", backend.Kind())
+	}
+
+	// Run TF Init and Plan in the working directory
+	env, err := getTerraformEnv(workingDir, options.EnvConfig, e.installer.PluginCacheDir())
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := initAndPlan(ctx, workingDir, execPath, env)
+	if err != nil {
+		if ctx.Err() != nil {
+			releaseStateLock(ctx, backend, options.ResourceRecipe)
 		}
-	}()
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// getBackend returns the backend storing the Terraform state files of the environment.
+func (e *executor) getBackend(options Options) (backends.Backend, error) {
+	var backendConfig recipes.TerraformBackend
+	if options.EnvConfig != nil {
+		backendConfig = options.EnvConfig.Terraform.Backend
+	}
+
+	return backends.NewBackend(backendConfig, e.k8sClientSet)
+}
+
+// initBackend generates a Terraform configuration with only the backend of the resource in a new working directory,
+// and runs Terraform init to access the state file of the resource.
+func (e *executor) initBackend(ctx context.Context, backend backends.Backend, options Options) (*tfexec.Terraform, error) {
+	execPath, err := e.installer.Install(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workingDir, err := createWorkingDir(ctx, options.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tfConfig := &config.TerraformConfig{}
+	if _, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backend); err != nil {
+		return nil, err
+	}
+	if err := tfConfig.Save(ctx, workingDir); err != nil {
+		return nil, err
+	}
+
+	env, err := getTerraformEnv(workingDir, options.EnvConfig, e.installer.PluginCacheDir())
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
+	if err != nil {
+		return nil, err
+	}
+
+	initLock.Lock()
+	err = tf.Init(ctx)
+	initLock.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+
+	return tf, nil
+}
+
+// releaseStateLock releases the lock Terraform holds on the state file of the resource. Terraform is killed without
+// releasing the lock when the context of the operation is canceled, which would block the following deployments of
+// the recipe. The lock is released with a context that is not canceled.
+func releaseStateLock(ctx context.Context, backend backends.Backend, resourceRecipe *recipes.ResourceMetadata) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	err := backend.ReleaseLock(context.WithoutCancel(ctx), resourceRecipe)
+	if errors.Is(err, backends.ErrLockIDRequired) {
+		logger.Info(fmt.Sprintf("The lock of the Terraform state in the %s backend must be released with the ID of the lock: %s", backend.Kind(), err.Error()))
+	} else if err != nil {
+		logger.Info(fmt.Sprintf("Failed to release the lock of the Terraform state: %s", err.Error()))
+	}
+}
+
+func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
+	// Install Terraform, or reuse the installation shared across executions.
+	execPath, err := e.installer.Install(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +383,12 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 		return nil, err
 	}
 
-	result, err := downloadAndInspect(ctx, workingDir, execPath, options)
+	env, err := getTerraformEnv(workingDir, options.EnvConfig, "")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := downloadAndInspect(ctx, workingDir, execPath, env, options)
 	if err != nil {
 		return nil, err
 	}
@@ -232,38 +411,39 @@ func createWorkingDir(ctx context.Context, tfDir string) (string, error) {
 }
 
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, workingDir, execPath string, options Options) (string, error) {
+func (e *executor) generateConfig(ctx context.Context, workingDir, execPath string, backend backends.Backend, options Options) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	tfConfig, err := getTerraformConfig(ctx, workingDir, options)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	loadedModule, err := downloadAndInspect(ctx, workingDir, execPath, options)
+	env, err := getTerraformEnv(workingDir, options.EnvConfig, "")
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	loadedModule, err := downloadAndInspect(ctx, workingDir, execPath, env, options)
+	if err != nil {
+		return err
 	}
 
 	// Generate Terraform providers configuration for required providers and add it to the Terraform configuration.
 	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", loadedModule.RequiredProviders))
 	if err := tfConfig.AddProviders(ctx, loadedModule.RequiredProviders, providers.GetSupportedTerraformProviders(e.ucpConn, e.secretProvider),
 		options.EnvConfig); err != nil {
-		return "", err
+		return err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(e.k8sClientSet))
-	if err != nil {
-		return "", err
+	if _, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backend); err != nil {
+		return err
 	}
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
-		}
+
+	// Declare the providers required by the module so that the provider configurations apply to them, and pass
+	// the alternate provider configurations expected by the module.
+	if err := tfConfig.AddRequiredProviders(options.EnvRecipe.Name, loadedModule.ProviderSources, loadedModule.ConfigurationAliases); err != nil {
+		return err
 	}
 
 	// Add recipe context parameter to the generated Terraform config's module parameters.
@@ -274,16 +454,16 @@ func (e *executor) generateConfig(ctx context.Context, workingDir, execPath stri
 		// Create the recipe context object to be passed to the recipe deployment
 		recipectx, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
 		if err != nil {
-			return "", err
+			return err
 		}
 
 		if err = tfConfig.AddRecipeContext(ctx, options.EnvRecipe.Name, recipectx); err != nil {
-			return "", err
+			return err
 		}
 	}
 	if loadedModule.ResultOutputExists {
 		if err = tfConfig.AddOutputs(options.EnvRecipe.Name); err != nil {
-			return "", err
+			return err
 		}
 	}
 
@@ -291,20 +471,21 @@ func (e *executor) generateConfig(ctx context.Context, workingDir, execPath stri
 
 	// Ensure that we need to save the configuration after adding providers and recipecontext.
 	if err := tfConfig.Save(ctx, workingDir); err != nil {
-		return "", err
+		return err
 	}
 
-	return secretSuffix, nil
+	return nil
 }
 
-// downloadAndInspect handles downloading the TF module and retrieving the necessary information
-func downloadAndInspect(ctx context.Context, workingDir string, execPath string, options Options) (*moduleInspectResult, error) {
+// downloadAndInspect handles downloading the TF module and retrieving the necessary information. Downloading modules
+// doesn't install providers, so env doesn't need to include the plugin cache.
+func downloadAndInspect(ctx context.Context, workingDir string, execPath string, env map[string]string, options Options) (*moduleInspectResult, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Download the Terraform module to the working directory.
 	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
 	downloadStartTime := time.Now()
-	if err := downloadModule(ctx, workingDir, execPath, options.EnvRecipe.TemplatePath); err != nil {
+	if err := downloadModule(ctx, workingDir, execPath, options.EnvRecipe.TemplatePath, env); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
 				options.EnvRecipe, recipes.RecipeDownloadFailed))
@@ -350,10 +531,10 @@ func getTerraformConfig(ctx context.Context, workingDir string, options Options)
 }
 
 // initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, workingDir, execPath string) (*tfjson.State, error) {
+func initAndApply(ctx context.Context, workingDir, execPath string, env map[string]string) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	tf, err := NewTerraform(ctx, workingDir, execPath)
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
 	if err != nil {
 		return nil, err
 	}
@@ -361,7 +542,10 @@ func initAndApply(ctx context.Context, workingDir, execPath string) (*tfjson.Sta
 	logger.Info("Initializing Terraform")
 
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	initLock.Lock()
+	err = tf.Init(ctx)
+	initLock.Unlock()
+	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -381,11 +565,45 @@ func initAndApply(ctx context.Context, workingDir, execPath string) (*tfjson.Sta
 	return tf.Show(ctx)
 }
 
+// initAndPlan runs Terraform init and plan in the provided working directory and returns the plan. Terraform refreshes
+// the state of the deployed resources as a part of the plan, so the plan includes the changes made outside of Terraform.
+func initAndPlan(ctx context.Context, workingDir, execPath string, env map[string]string) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	initLock.Lock()
+	err = tf.Init(ctx)
+	initLock.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+
+	// Plan Terraform configuration
+	logger.Info("Running Terraform plan")
+	planFile := filepath.Join(workingDir, planFileName)
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	plan, err := tf.ShowPlanFile(ctx, planFile)
+	if err != nil {
+		return nil, fmt.Errorf("terraform show failure: %w", err)
+	}
+
+	return plan, nil
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, workingDir, execPath string) error {
+func initAndDestroy(ctx context.Context, workingDir, execPath string, env map[string]string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	tf, err := NewTerraform(ctx, workingDir, execPath)
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
 	if err != nil {
 		return err
 	}
@@ -394,7 +612,10 @@ func initAndDestroy(ctx context.Context, workingDir, execPath string) error {
 	logger.Info("Initializing Terraform")
 
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	initLock.Lock()
+	err = tf.Init(ctx)
+	initLock.Unlock()
+	if err != nil {
 		return fmt.Errorf("terraform init failure: %w", err)
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime, nil)
@@ -17,14 +17,23 @@ limitations under the License.
 package terraform
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestCreateWorkingDir_Created(t *testing.T) {
@@ -62,7 +71,7 @@ func TestInitAndApply_EmptyWorkingDirPath(t *testing.T) {
 	testDir := t.TempDir()
 	execPath := filepath.Join(testDir, "terraform")
 
-	_, err := initAndApply(testcontext.New(t), "", execPath)
+	_, err := initAndApply(testcontext.New(t), "", execPath, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Terraform cannot be initialised with empty workdir")
 }
@@ -114,13 +123,68 @@ func TestGeneratedConfig(t *testing.T) {
 			}
 			execPath := filepath.Join(tc.workingDir, "terraform")
 			e := executor{}
-			_, err := e.generateConfig(ctx, tc.workingDir, execPath, tc.opts)
+			err := e.generateConfig(ctx, tc.workingDir, execPath, backends.NewKubernetesBackend(nil), tc.opts)
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
 	}
 }
 
+func TestGeneratedConfig_DownloadFailureDoesNotReturnCredentials(t *testing.T) {
+	ctx := testcontext.New(t)
+	workingDir := t.TempDir()
+
+	// The fake Terraform fails to download the module and prints the configuration, like Terraform prints the
+	// source of the module which failed to download.
+	execPath := filepath.Join(t.TempDir(), "terraform")
+	script := `#!/bin/sh
+if [ "$1" = "version" ]; then
+  echo '{"terraform_version":"1.6.0","provider_selections":{}}'
+  exit 0
+fi
+echo "Error: Failed to download module" >&2
+cat main.tf.json >&2
+exit 1
+`
+	require.NoError(t, os.WriteFile(execPath, []byte(script), 0700))
+
+	options := Options{
+		EnvConfig: &recipes.Configuration{
+			Terraform: recipes.TerraformConfiguration{
+				GitCredentials: map[string]recipes.RegistryCredentials{
+					"github.com": {Token: "git-secret-token"},
+				},
+				HTTPCredentials: map[string]recipes.RegistryCredentials{
+					"artifacts.example.com": {Username: "admin", Password: "http-secret-password"},
+				},
+			},
+		},
+		EnvRecipe: &recipes.EnvironmentDefinition{
+			Name:         "test-recipe",
+			TemplatePath: "git::https://github.com/org/modules.git//redis",
+		},
+		ResourceRecipe: &recipes.ResourceMetadata{},
+	}
+
+	e := executor{}
+	err := e.generateConfig(ctx, workingDir, execPath, backends.NewKubernetesBackend(nil), options)
+	require.ErrorContains(t, err, "Failed to download module")
+
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	details, err := json.Marshal(recipeError)
+	require.NoError(t, err)
+	require.Contains(t, string(details), "git::https://github.com/org/modules.git//redis")
+
+	for _, secret := range []string{"git-secret-token", "http-secret-password", base64.StdEncoding.EncodeToString([]byte("oauth2:git-secret-token"))} {
+		require.NotContains(t, string(details), secret)
+	}
+
+	b, err := os.ReadFile(filepath.Join(workingDir, "main.tf.json"))
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "git-secret-token")
+}
+
 func Test_GetTerraformConfig(t *testing.T) {
 	// Create a temporary directory for testing.
 	testDir := t.TempDir()
@@ -178,3 +242,83 @@ func Test_GetTerraformConfig_InvalidDirectory(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "error creating file: open invalid directory/main.tf.json: no such file or directory")
 }
+
+func Test_ReleaseStateLock(t *testing.T) {
+	resourceRecipe := &recipes.ResourceMetadata{
+		ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+	}
+
+	t.Run("lock released with an uncanceled context", func(t *testing.T) {
+		mBackend := backends.NewMockBackend(gomock.NewController(t))
+		mBackend.EXPECT().ReleaseLock(gomock.Any(), resourceRecipe).DoAndReturn(func(ctx context.Context, _ *recipes.ResourceMetadata) error {
+			require.NoError(t, ctx.Err())
+			return nil
+		})
+
+		// The lock is released even though the context of the operation is canceled.
+		ctx, cancel := context.WithCancel(testcontext.New(t))
+		cancel()
+		releaseStateLock(ctx, mBackend, resourceRecipe)
+	})
+
+	t.Run("lock ID required", func(t *testing.T) {
+		mBackend := backends.NewMockBackend(gomock.NewController(t))
+		mBackend.EXPECT().ReleaseLock(gomock.Any(), resourceRecipe).Return(backends.ErrLockIDRequired)
+		mBackend.EXPECT().Kind().Return(backends.BackendS3)
+
+		releaseStateLock(testcontext.New(t), mBackend, resourceRecipe)
+	})
+}
+
+func Test_UnlockState_WithoutLockID(t *testing.T) {
+	resourceRecipe := &recipes.ResourceMetadata{
+		EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+		ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+	}
+
+	t.Run("kubernetes backend", func(t *testing.T) {
+		backendConfig, err := backends.NewKubernetesBackend(nil).BuildBackend(resourceRecipe)
+		require.NoError(t, err)
+		secretSuffix := backendConfig[backends.BackendKubernetes].(map[string]any)["secret_suffix"].(string)
+
+		lease := &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      backends.KubernetesBackendLockPrefix + backends.KubernetesBackendNamePrefix + secretSuffix,
+				Namespace: backends.RadiusNamespace,
+			},
+		}
+		clientSet := fake.NewSimpleClientset(lease)
+		e := NewExecutor(nil, nil, clientSet, nil)
+
+		err = e.UnlockState(testcontext.New(t), Options{EnvConfig: &recipes.Configuration{}, ResourceRecipe: resourceRecipe}, "")
+		require.NoError(t, err)
+
+		_, err = clientSet.CoordinationV1().Leases(backends.RadiusNamespace).Get(testcontext.New(t), lease.Name, metav1.GetOptions{})
+		require.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("lock ID required", func(t *testing.T) {
+		e := NewExecutor(nil, nil, nil, nil)
+		envConfig := &recipes.Configuration{
+			Terraform: recipes.TerraformConfiguration{
+				Backend: recipes.TerraformBackend{Kind: backends.BackendS3, Config: map[string]any{"bucket": "tfstate"}},
+			},
+		}
+
+		err := e.UnlockState(testcontext.New(t), Options{EnvConfig: envConfig, ResourceRecipe: resourceRecipe}, "")
+		require.ErrorIs(t, err, backends.ErrLockIDRequired)
+	})
+}
+
+func Test_ExportState_UnsupportedBackend(t *testing.T) {
+	e := NewExecutor(nil, nil, nil, nil)
+	envConfig := &recipes.Configuration{
+		Terraform: recipes.TerraformConfiguration{
+			Backend: recipes.TerraformBackend{Kind: "gcs"},
+		},
+	}
+
+	_, err := e.ExportState(testcontext.New(t), Options{EnvConfig: envConfig, ResourceRecipe: &recipes.ResourceMetadata{}})
+	require.EqualError(t, err, "unsupported terraform backend kind \"gcs\"")
+}
@@ -17,12 +17,25 @@ limitations under the License.
 package terraform
 
 import (
+	"archive/zip"
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	install "github.com/hashicorp/hc-install"
 	"github.com/hashicorp/hc-install/product"
 	"github.com/hashicorp/hc-install/releases"
@@ -33,36 +46,123 @@ import (
 )
 
 const (
-	installSubDir = "install"
+	// installSubDir is the directory under the root directory where Terraform versions are installed and cached.
+	installSubDir = ".terraform-install"
+	// pluginCacheSubDir is the directory under the root directory where Terraform caches provider plugins.
+	pluginCacheSubDir = ".terraform-plugin-cache"
+	// checksumFileName is the name of the file storing the SHA256 checksum of an installed Terraform binary.
+	checksumFileName = "terraform.sha256"
+	// DefaultVersion is the version of Terraform installed when no version is configured. Recipes are always
+	// executed with a pinned version of Terraform so that upgrades of Terraform are explicit.
+	DefaultVersion = "1.6.4"
+
+	installDirFileMode os.FileMode = 0755
+	execFileMode       os.FileMode = 0755
 )
 
-// Install installs Terraform under /install in the provided Terraform root directory for the resource. It installs
-// the latest version of Terraform and returns the path to the installed Terraform executable. It returns an error
-// if the directory creation or Terraform installation fails.
-func Install(ctx context.Context, installer *install.Installer, tfDir string) (string, error) {
+// InstallOptions represents the options to install Terraform.
+type InstallOptions struct {
+	// RootDir is the directory where Terraform installations and the provider plugin cache are shared across executions.
+	RootDir string
+
+	// Version is the version of Terraform to install, for example: "1.6.4". DefaultVersion is installed if empty.
+	Version string
+
+	// ExecPath is the path to a pre-provisioned Terraform binary. Terraform is never downloaded when it is set.
+	ExecPath string
+
+	// MirrorURL is the URL of a mirror of https://releases.hashicorp.com, or the path to a local directory with the
+	// same layout, used to download Terraform. The mirror must provide the configured version, or DefaultVersion.
+	MirrorURL string
+}
+
+// Installer installs Terraform once for each version in a directory shared by all the executions of Terraform.
+// Installed binaries are verified against their recorded checksums before they are reused.
+type Installer struct {
+	options InstallOptions
+
+	// mu serializes installations of Terraform.
+	mu sync.Mutex
+	// execPath is the path to the Terraform executable once installed.
+	execPath string
+	// checksum is the SHA256 checksum of the Terraform executable once installed.
+	checksum string
+}
+
+// NewInstaller creates a new Installer with the given options.
+func NewInstaller(options InstallOptions) *Installer {
+	return &Installer{options: options}
+}
+
+// PluginCacheDir returns the directory where Terraform caches provider plugins across executions.
+func (i *Installer) PluginCacheDir() string {
+	if i.options.RootDir == "" {
+		return ""
+	}
+	return filepath.Join(i.options.RootDir, pluginCacheSubDir)
+}
+
+// Install returns the path to the Terraform executable. Terraform is installed the first time it is called for the
+// configured version, and the installation is reused as long as its checksum matches. When a pre-provisioned binary
+// is configured, its path is returned without downloading Terraform.
+func (i *Installer) Install(ctx context.Context) (string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Create Terraform installation directory
-	installDir := filepath.Join(tfDir, installSubDir)
-	if err := os.MkdirAll(installDir, 0755); err != nil {
-		return "", fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	// Terraform ignores the plugin cache directory unless it already exists.
+	if cacheDir := i.PluginCacheDir(); cacheDir != "" {
+		if err := os.MkdirAll(cacheDir, installDirFileMode); err != nil {
+			return "", fmt.Errorf("failed to create Terraform plugin cache directory: %w", err)
+		}
 	}
 
-	logger.Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
+	if i.options.ExecPath != "" {
+		if err := validateExecutable(i.options.ExecPath); err != nil {
+			return "", fmt.Errorf("failed to use the pre-provisioned Terraform binary: %w", err)
+		}
+		return i.options.ExecPath, nil
+	}
+
+	// Fast path: Terraform is already installed by this process and hasn't been modified.
+	if i.execPath != "" {
+		if checksum, err := fileChecksum(i.execPath); err == nil && checksum == i.checksum {
+			return i.execPath, nil
+		}
+		logger.Info(fmt.Sprintf("Terraform installation %q is missing or modified, reinstalling", i.execPath))
+		i.execPath, i.checksum = "", ""
+	}
+
+	if i.options.RootDir == "" {
+		return "", errors.New("root directory is required to install Terraform")
+	}
+
+	tfVersion := DefaultVersion
+	if i.options.Version != "" {
+		v, err := version.NewVersion(i.options.Version)
+		if err != nil {
+			return "", fmt.Errorf("invalid Terraform version %q: %w", i.options.Version, err)
+		}
+		tfVersion = v.String()
+	}
+
+	versionDir := filepath.Join(i.options.RootDir, installSubDir, tfVersion)
+	execPath := filepath.Join(versionDir, product.Terraform.BinaryName())
+
+	// Reuse an installation from a previous process if it is intact.
+	if checksum, ok := verifyInstallation(versionDir, execPath); ok {
+		logger.Info(fmt.Sprintf("Using cached Terraform %s installation: %q", tfVersion, execPath))
+		i.execPath, i.checksum = execPath, checksum
+		return execPath, nil
+	}
 
 	installStartTime := time.Now()
-	// Re-visit this: consider checking if an existing installation of same version of Terraform is available.
-	// For initial iteration we will always install Terraform for every execution of the recipe driver.
-	execPath, err := installer.Ensure(ctx, []src.Source{
-		&releases.LatestVersion{
-			Product:    product.Terraform,
-			InstallDir: installDir,
-		},
-	})
+	checksum, err := i.installVersion(ctx, tfVersion, versionDir)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 			[]attribute.KeyValue{
-				metrics.TerraformVersionAttrKey.String("latest"),
+				metrics.TerraformVersionAttrKey.String(tfVersion),
 				metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 			},
 		)
@@ -71,12 +171,245 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (s
 
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 		[]attribute.KeyValue{
-			metrics.TerraformVersionAttrKey.String("latest"),
+			metrics.TerraformVersionAttrKey.String(tfVersion),
 			metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 		},
 	)
 
-	logger.Info(fmt.Sprintf("Terraform latest version installed to: %q", execPath))
+	logger.Info(fmt.Sprintf("Terraform %s installed to: %q", tfVersion, execPath))
 
+	i.execPath, i.checksum = execPath, checksum
 	return execPath, nil
 }
+
+// installVersion installs the given version of Terraform into versionDir and records the checksum of the binary.
+// Terraform is installed into a temporary directory first, so that an interrupted installation is never reused.
+func (i *Installer) installVersion(ctx context.Context, tfVersion, versionDir string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	installRoot := filepath.Dir(versionDir)
+	if err := os.MkdirAll(installRoot, installDirFileMode); err != nil {
+		return "", fmt.Errorf("failed to create directory for terraform installation: %w", err)
+	}
+
+	tmpDir, err := os.MkdirTemp(installRoot, tfVersion+"-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create directory for terraform installation: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	logger.Info(fmt.Sprintf("Installing Terraform %s in the directory: %q", tfVersion, versionDir))
+	if i.options.MirrorURL != "" {
+		err = installFromMirror(ctx, i.options.MirrorURL, tfVersion, tmpDir)
+	} else {
+		err = installFromReleases(ctx, tfVersion, tmpDir)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	checksum, err := fileChecksum(filepath.Join(tmpDir, product.Terraform.BinaryName()))
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, checksumFileName), []byte(checksum), 0644); err != nil {
+		return "", fmt.Errorf("failed to record the checksum of the terraform installation: %w", err)
+	}
+
+	// Replace a corrupted installation, if any.
+	if err := os.RemoveAll(versionDir); err != nil {
+		return "", fmt.Errorf("failed to remove the previous terraform installation: %w", err)
+	}
+	if err := os.Rename(tmpDir, versionDir); err != nil {
+		return "", fmt.Errorf("failed to move the terraform installation to %q: %w", versionDir, err)
+	}
+
+	return checksum, nil
+}
+
+// installFromReleases installs Terraform from https://releases.hashicorp.com. The signature and the checksum of
+// the downloaded archive are verified by the installer.
+func installFromReleases(ctx context.Context, tfVersion, installDir string) error {
+	source := &releases.ExactVersion{
+		Product:    product.Terraform,
+		Version:    version.Must(version.NewVersion(tfVersion)),
+		InstallDir: installDir,
+	}
+
+	installer := install.NewInstaller()
+	execPath, err := installer.Ensure(ctx, []src.Source{source})
+	if err != nil {
+		removeInstallerFiles(ctx, installer)
+		return fmt.Errorf("failed to install terraform %s: %w", tfVersion, err)
+	}
+
+	// The installer removes the installed binary together with the downloaded archive, which is stored outside of the
+	// install directory. Keep a hard link to the binary so that the archive can be removed.
+	keepPath := execPath + ".keep"
+	if err := os.Link(execPath, keepPath); err != nil {
+		removeInstallerFiles(ctx, installer)
+		return fmt.Errorf("failed to keep the installed terraform binary: %w", err)
+	}
+	removeInstallerFiles(ctx, installer)
+
+	return os.Rename(keepPath, execPath)
+}
+
+// removeInstallerFiles removes the files downloaded and installed by the installer.
+func removeInstallerFiles(ctx context.Context, installer *install.Installer) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if err := installer.Remove(ctx); err != nil {
+		logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+	}
+}
+
+// installFromMirror downloads the Terraform archive for the current platform from a mirror of
+// https://releases.hashicorp.com, verifies it against the SHA256SUMS file of the release and extracts the binary to
+// installDir. mirrorURL is either an HTTP(S) URL or the path to a local directory.
+func installFromMirror(ctx context.Context, mirrorURL, tfVersion, installDir string) error {
+	archiveName := fmt.Sprintf("terraform_%s_%s_%s.zip", tfVersion, runtime.GOOS, runtime.GOARCH)
+	sumsName := fmt.Sprintf("terraform_%s_SHA256SUMS", tfVersion)
+
+	sums, err := readFromMirror(ctx, mirrorURL, tfVersion, sumsName)
+	if err != nil {
+		return err
+	}
+
+	expected, err := findChecksum(sums, archiveName)
+	if err != nil {
+		return err
+	}
+
+	archive, err := readFromMirror(ctx, mirrorURL, tfVersion, archiveName)
+	if err != nil {
+		return err
+	}
+
+	actual := sha256.Sum256(archive)
+	if hex.EncodeToString(actual[:]) != expected {
+		return fmt.Errorf("checksum mismatch for %q: expected %s, got %s", archiveName, expected, hex.EncodeToString(actual[:]))
+	}
+
+	return extractBinary(archive, filepath.Join(installDir, product.Terraform.BinaryName()))
+}
+
+// readFromMirror reads the file with the given name of a Terraform release from the mirror.
+func readFromMirror(ctx context.Context, mirrorURL, tfVersion, name string) ([]byte, error) {
+	u, err := url.Parse(mirrorURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		data, err := os.ReadFile(filepath.Join(mirrorURL, product.Terraform.Name, tfVersion, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %q from the terraform mirror: %w", name, err)
+		}
+		return data, nil
+	}
+
+	fileURL := u.JoinPath(product.Terraform.Name, tfVersion, name).String()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %q from the terraform mirror: %w", fileURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %q from the terraform mirror: unexpected status %s", fileURL, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// findChecksum returns the SHA256 checksum of the file with the given name from the content of a SHA256SUMS file.
+func findChecksum(sums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+
+	return "", fmt.Errorf("checksum for %q not found", name)
+}
+
+// extractBinary extracts the Terraform binary from the zip archive to execPath.
+func extractBinary(archive []byte, execPath string) error {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return fmt.Errorf("failed to read terraform archive: %w", err)
+	}
+
+	for _, f := range reader.File {
+		if f.Name != product.Terraform.BinaryName() {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("failed to extract terraform binary: %w", err)
+		}
+		defer rc.Close()
+
+		out, err := os.OpenFile(execPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, execFileMode)
+		if err != nil {
+			return fmt.Errorf("failed to extract terraform binary: %w", err)
+		}
+		defer out.Close()
+
+		if _, err := io.Copy(out, rc); err != nil {
+			return fmt.Errorf("failed to extract terraform binary: %w", err)
+		}
+
+		return out.Close()
+	}
+
+	return fmt.Errorf("terraform binary not found in the archive")
+}
+
+// verifyInstallation returns the checksum of the Terraform binary at execPath and true if it matches the checksum
+// recorded in versionDir when Terraform was installed.
+func verifyInstallation(versionDir, execPath string) (string, bool) {
+	recorded, err := os.ReadFile(filepath.Join(versionDir, checksumFileName))
+	if err != nil {
+		return "", false
+	}
+
+	checksum, err := fileChecksum(execPath)
+	if err != nil || checksum != strings.TrimSpace(string(recorded)) {
+		return "", false
+	}
+
+	return checksum, true
+}
+
+// fileChecksum returns the hex encoded SHA256 checksum of the file.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// validateExecutable returns an error if the file at path doesn't exist or isn't an executable file.
+func validateExecutable(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() || info.Mode()&0111 == 0 {
+		return fmt.Errorf("%q is not an executable file", path)
+	}
+	return nil
+}
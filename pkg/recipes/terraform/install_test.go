@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/hc-install/product"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const testVersion = DefaultVersion
+
+// createMirror creates a local mirror of the Terraform release with the given binary content and returns its path.
+func createMirror(t *testing.T, content []byte, corruptChecksum bool) string {
+	mirrorDir := t.TempDir()
+	releaseDir := filepath.Join(mirrorDir, product.Terraform.Name, testVersion)
+	require.NoError(t, os.MkdirAll(releaseDir, 0755))
+
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	f, err := w.Create(product.Terraform.BinaryName())
+	require.NoError(t, err)
+	_, err = f.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	archiveName := fmt.Sprintf("terraform_%s_%s_%s.zip", testVersion, runtime.GOOS, runtime.GOARCH)
+	require.NoError(t, os.WriteFile(filepath.Join(releaseDir, archiveName), buf.Bytes(), 0644))
+
+	sum := sha256.Sum256(buf.Bytes())
+	if corruptChecksum {
+		sum = sha256.Sum256([]byte("corrupted"))
+	}
+	sums := fmt.Sprintf("%s  terraform_%s_other_arch.zip\n%s  %s\n", hex.EncodeToString(sum[:]), testVersion, hex.EncodeToString(sum[:]), archiveName)
+	require.NoError(t, os.WriteFile(filepath.Join(releaseDir, fmt.Sprintf("terraform_%s_SHA256SUMS", testVersion)), []byte(sums), 0644))
+
+	return mirrorDir
+}
+
+func Test_Install_Mirror(t *testing.T) {
+	ctx := testcontext.New(t)
+	rootDir := t.TempDir()
+	mirrorDir := createMirror(t, []byte("terraform-binary"), false)
+
+	installer := NewInstaller(InstallOptions{RootDir: rootDir, Version: testVersion, MirrorURL: mirrorDir})
+	execPath, err := installer.Install(ctx)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(rootDir, installSubDir, testVersion, product.Terraform.BinaryName()), execPath)
+
+	content, err := os.ReadFile(execPath)
+	require.NoError(t, err)
+	require.Equal(t, "terraform-binary", string(content))
+	require.DirExists(t, installer.PluginCacheDir())
+
+	// The installation is reused without downloading Terraform again.
+	require.NoError(t, os.RemoveAll(mirrorDir))
+	execPath2, err := installer.Install(ctx)
+	require.NoError(t, err)
+	require.Equal(t, execPath, execPath2)
+
+	// The installation is shared with other installers using the same root directory.
+	execPath3, err := NewInstaller(InstallOptions{RootDir: rootDir, Version: testVersion, MirrorURL: mirrorDir}).Install(ctx)
+	require.NoError(t, err)
+	require.Equal(t, execPath, execPath3)
+}
+
+func Test_Install_Mirror_HTTP(t *testing.T) {
+	mirrorDir := createMirror(t, []byte("terraform-binary"), false)
+	server := httptest.NewServer(http.FileServer(http.Dir(mirrorDir)))
+	defer server.Close()
+
+	installer := NewInstaller(InstallOptions{RootDir: t.TempDir(), Version: testVersion, MirrorURL: server.URL})
+	execPath, err := installer.Install(testcontext.New(t))
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(execPath)
+	require.NoError(t, err)
+	require.Equal(t, "terraform-binary", string(content))
+}
+
+func Test_Install_Mirror_ChecksumMismatch(t *testing.T) {
+	rootDir := t.TempDir()
+	mirrorDir := createMirror(t, []byte("terraform-binary"), true)
+
+	installer := NewInstaller(InstallOptions{RootDir: rootDir, Version: testVersion, MirrorURL: mirrorDir})
+	_, err := installer.Install(testcontext.New(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "checksum mismatch")
+	require.NoDirExists(t, filepath.Join(rootDir, installSubDir, testVersion))
+}
+
+func Test_Install_Mirror_DefaultVersion(t *testing.T) {
+	mirrorDir := createMirror(t, []byte("terraform-binary"), false)
+	rootDir := t.TempDir()
+
+	installer := NewInstaller(InstallOptions{RootDir: rootDir, MirrorURL: mirrorDir})
+	execPath, err := installer.Install(testcontext.New(t))
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(rootDir, installSubDir, DefaultVersion, product.Terraform.BinaryName()), execPath)
+}
+
+func Test_Install_InvalidVersion(t *testing.T) {
+	installer := NewInstaller(InstallOptions{RootDir: t.TempDir(), Version: "invalid"})
+	_, err := installer.Install(testcontext.New(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid Terraform version \"invalid\"")
+}
+
+func Test_Install_ModifiedBinary(t *testing.T) {
+	ctx := testcontext.New(t)
+	mirrorDir := createMirror(t, []byte("terraform-binary"), false)
+
+	installer := NewInstaller(InstallOptions{RootDir: t.TempDir(), Version: testVersion, MirrorURL: mirrorDir})
+	execPath, err := installer.Install(ctx)
+	require.NoError(t, err)
+
+	// A modified binary is never reused.
+	require.NoError(t, os.WriteFile(execPath, []byte("tampered"), execFileMode))
+	execPath2, err := installer.Install(ctx)
+	require.NoError(t, err)
+	require.Equal(t, execPath, execPath2)
+
+	content, err := os.ReadFile(execPath)
+	require.NoError(t, err)
+	require.Equal(t, "terraform-binary", string(content))
+
+	// Installations modified by another process are not reused either.
+	require.NoError(t, os.WriteFile(execPath, []byte("tampered"), execFileMode))
+	execPath3, err := NewInstaller(InstallOptions{RootDir: installer.options.RootDir, Version: testVersion, MirrorURL: mirrorDir}).Install(ctx)
+	require.NoError(t, err)
+
+	content, err = os.ReadFile(execPath3)
+	require.NoError(t, err)
+	require.Equal(t, "terraform-binary", string(content))
+}
+
+func Test_Install_ExecPath(t *testing.T) {
+	ctx := testcontext.New(t)
+	execPath := filepath.Join(t.TempDir(), product.Terraform.BinaryName())
+	require.NoError(t, os.WriteFile(execPath, []byte("terraform-binary"), execFileMode))
+
+	t.Run("pre-provisioned binary", func(t *testing.T) {
+		rootDir := t.TempDir()
+		installer := NewInstaller(InstallOptions{RootDir: rootDir, ExecPath: execPath, MirrorURL: "https://unreachable.example.com"})
+		actual, err := installer.Install(ctx)
+		require.NoError(t, err)
+		require.Equal(t, execPath, actual)
+		require.NoDirExists(t, filepath.Join(rootDir, installSubDir))
+	})
+
+	t.Run("missing binary", func(t *testing.T) {
+		installer := NewInstaller(InstallOptions{ExecPath: filepath.Join(t.TempDir(), "missing")})
+		_, err := installer.Install(ctx)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to use the pre-provisioned Terraform binary")
+	})
+
+	t.Run("not executable", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), product.Terraform.BinaryName())
+		require.NoError(t, os.WriteFile(path, []byte("terraform-binary"), 0644))
+
+		installer := NewInstaller(InstallOptions{ExecPath: path})
+		_, err := installer.Install(ctx)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not an executable file")
+	})
+}
+
+func Test_PluginCacheDir(t *testing.T) {
+	require.Equal(t, "", NewInstaller(InstallOptions{}).PluginCacheDir())
+	require.Equal(t, filepath.Join("/terraform", pluginCacheSubDir), NewInstaller(InstallOptions{RootDir: "/terraform"}).PluginCacheDir())
+}
+
+func Test_FindChecksum(t *testing.T) {
+	sums := []byte("ABC123  terraform_1.6.4_linux_amd64.zip\ndef456  terraform_1.6.4_darwin_arm64.zip\n")
+
+	checksum, err := findChecksum(sums, "terraform_1.6.4_linux_amd64.zip")
+	require.NoError(t, err)
+	require.Equal(t, "abc123", checksum)
+
+	_, err = findChecksum(sums, "terraform_1.6.4_windows_amd64.zip")
+	require.EqualError(t, err, "checksum for \"terraform_1.6.4_windows_amd64.zip\" not found")
+}
@@ -50,10 +50,10 @@ func (mr *MockTerraformExecutorMockRecorder) Delete(arg0, arg1 interface{}) *gom
 }
 
 // Deploy mocks base method.
-func (m *MockTerraformExecutor) Deploy(arg0 context.Context, arg1 Options) (*terraform_json.State, error) {
+func (m *MockTerraformExecutor) Deploy(arg0 context.Context, arg1 Options) (*DeployResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Deploy", arg0, arg1)
-	ret0, _ := ret[0].(*terraform_json.State)
+	ret0, _ := ret[0].(*DeployResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -64,6 +64,21 @@ func (mr *MockTerraformExecutorMockRecorder) Deploy(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deploy", reflect.TypeOf((*MockTerraformExecutor)(nil).Deploy), arg0, arg1)
 }
 
+// ExportState mocks base method.
+func (m *MockTerraformExecutor) ExportState(arg0 context.Context, arg1 Options) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportState", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportState indicates an expected call of ExportState.
+func (mr *MockTerraformExecutorMockRecorder) ExportState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportState", reflect.TypeOf((*MockTerraformExecutor)(nil).ExportState), arg0, arg1)
+}
+
 // GetRecipeMetadata mocks base method.
 func (m *MockTerraformExecutor) GetRecipeMetadata(arg0 context.Context, arg1 Options) (map[string]interface{}, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +93,32 @@ func (mr *MockTerraformExecutorMockRecorder) GetRecipeMetadata(arg0, arg1 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockTerraformExecutor)(nil).GetRecipeMetadata), arg0, arg1)
 }
+
+// Plan mocks base method.
+func (m *MockTerraformExecutor) Plan(arg0 context.Context, arg1 Options) (*terraform_json.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1)
+	ret0, _ := ret[0].(*terraform_json.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockTerraformExecutorMockRecorder) Plan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockTerraformExecutor)(nil).Plan), arg0, arg1)
+}
+
+// UnlockState mocks base method.
+func (m *MockTerraformExecutor) UnlockState(arg0 context.Context, arg1 Options, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockState indicates an expected call of UnlockState.
+func (mr *MockTerraformExecutorMockRecorder) UnlockState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockState", reflect.TypeOf((*MockTerraformExecutor)(nil).UnlockState), arg0, arg1, arg2)
+}
@@ -18,7 +18,11 @@ package terraform
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"io/fs"
+	"os"
 	"path/filepath"
 
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
@@ -28,8 +32,32 @@ import (
 
 const (
 	moduleRootDir = ".terraform/modules"
+
+	// moduleManifestFile is the file in the module root directory where Terraform records the modules it downloaded.
+	moduleManifestFile = "modules.json"
 )
 
+// moduleManifest is the content of the manifest of the modules downloaded by Terraform.
+type moduleManifest struct {
+	Modules []moduleManifestRecord `json:"Modules"`
+}
+
+// moduleManifestRecord is the record of a single module downloaded by Terraform.
+type moduleManifestRecord struct {
+	// Key is the local name of the module.
+	Key string `json:"Key"`
+
+	// Source is the source address of the module.
+	Source string `json:"Source"`
+
+	// Version is the version of the module resolved from the version constraint. It is only set for modules
+	// downloaded from a module registry.
+	Version string `json:"Version,omitempty"`
+
+	// Dir is the directory where the module was downloaded, relative to the working directory.
+	Dir string `json:"Dir"`
+}
+
 // moduleInspectResult contains the result of inspecting a Terraform module config.
 type moduleInspectResult struct {
 	// ContextVarExists is true if the module has a variable defined for recipe context.
@@ -38,6 +66,12 @@ type moduleInspectResult struct {
 	// RequiredProviders is a list of names of required providers for the module.
 	RequiredProviders []string
 
+	// ProviderSources is a map of names of required providers to their source addresses, for example: "hashicorp/aws".
+	ProviderSources map[string]string
+
+	// ConfigurationAliases is a list of alternate provider configurations expected by the module, for example: "helm.east".
+	ConfigurationAliases []string
+
 	// ResultOutputExists is true if the module contains an output named "result".
 	ResultOutputExists bool
 
@@ -53,7 +87,14 @@ type moduleInspectResult struct {
 // It uses terraform-config-inspect to load the module from the directory. An error is returned if the module
 // could not be loaded.
 func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, error) {
-	result := &moduleInspectResult{ContextVarExists: false, RequiredProviders: []string{}, ResultOutputExists: false, Parameters: map[string]any{}}
+	result := &moduleInspectResult{
+		ContextVarExists:     false,
+		RequiredProviders:    []string{},
+		ProviderSources:      map[string]string{},
+		ConfigurationAliases: []string{},
+		ResultOutputExists:   false,
+		Parameters:           map[string]any{},
+	}
 
 	// Modules are downloaded in a subdirectory in the working directory.
 	// Name of the module specified in the configuration is used as subdirectory name.
@@ -68,9 +109,13 @@ func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, er
 		result.ContextVarExists = true
 	}
 
-	// Extract the list of required providers.
-	for providerName := range mod.RequiredProviders {
+	// Extract the list of required providers with their sources and configuration aliases.
+	for providerName, requirement := range mod.RequiredProviders {
 		result.RequiredProviders = append(result.RequiredProviders, providerName)
+		result.ProviderSources[providerName] = requirement.Source
+		for _, ref := range requirement.ConfigurationAliases {
+			result.ConfigurationAliases = append(result.ConfigurationAliases, ref.Name+"."+ref.Alias)
+		}
 	}
 
 	// Check if an output named "result" is defined in the module.
@@ -97,9 +142,10 @@ func inspectModule(workingDir, localModuleName string) (*moduleInspectResult, er
 
 // downloadModule downloads the module to the workingDir from the module source specified in the Terraform configuration.
 // It uses Terraform's Get command to download the module using the Terraform executable available at execPath.
+// env contains the additional environment variables set for the Terraform process.
 // An error is returned if the module could not be downloaded.
-func downloadModule(ctx context.Context, workingDir, execPath, templatePath string) error {
-	tf, err := NewTerraform(ctx, workingDir, execPath)
+func downloadModule(ctx context.Context, workingDir, execPath, templatePath string, env map[string]string) error {
+	tf, err := NewTerraform(ctx, workingDir, execPath, env)
 	if err != nil {
 		return err
 	}
@@ -110,3 +156,68 @@ func downloadModule(ctx context.Context, workingDir, execPath, templatePath stri
 
 	return nil
 }
+
+// getModuleVersion returns the version of the module present at workingDir/.terraform/modules/<localModuleName> resolved
+// by Terraform from the version constraint of the recipe. An empty string is returned when the module was not downloaded
+// from a module registry, as only registry modules are versioned.
+func getModuleVersion(workingDir, localModuleName string) (string, error) {
+	b, err := os.ReadFile(filepath.Join(workingDir, moduleRootDir, moduleManifestFile))
+	if err != nil {
+		return "", fmt.Errorf("error reading the module manifest: %w", err)
+	}
+
+	manifest := moduleManifest{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return "", fmt.Errorf("error reading the module manifest: %w", err)
+	}
+
+	for _, record := range manifest.Modules {
+		if record.Key == localModuleName {
+			return record.Version, nil
+		}
+	}
+
+	return "", fmt.Errorf("module %q is not found in the module manifest", localModuleName)
+}
+
+// getModuleDigest returns the SHA-256 digest of the content of the module present at
+// workingDir/.terraform/modules/<localModuleName>. The digest covers the path and content of every file of the module,
+// in lexical order, so it changes whenever the content of the module changes even if its version does not.
+func getModuleDigest(workingDir, localModuleName string) (string, error) {
+	moduleDir := filepath.Join(workingDir, moduleRootDir, localModuleName)
+	hash := sha256.New()
+	err := filepath.WalkDir(moduleDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			// Skip the version control metadata of modules downloaded from git.
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(moduleDir, path)
+		if err != nil {
+			return err
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		// Separate the path from the content so that moving content between files changes the digest.
+		hash.Write([]byte(filepath.ToSlash(rel)))
+		hash.Write([]byte{0})
+		hash.Write(b)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("error computing the digest of the module: %w", err)
+	}
+
+	return fmt.Sprintf("sha256:%x", hash.Sum(nil)), nil
+}
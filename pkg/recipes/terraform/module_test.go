@@ -17,7 +17,10 @@ limitations under the License.
 package terraform
 
 import (
+	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
@@ -38,10 +41,28 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 			workingDir: "testdata",
 			moduleName: "test-module-provideronly",
 			result: &moduleInspectResult{
-				ContextVarExists:   false,
-				RequiredProviders:  []string{"aws"},
-				ResultOutputExists: false,
-				Parameters:         map[string]any{},
+				ContextVarExists:     false,
+				RequiredProviders:    []string{"aws"},
+				ProviderSources:      map[string]string{"aws": "hashicorp/aws"},
+				ConfigurationAliases: []string{},
+				ResultOutputExists:   false,
+				Parameters:           map[string]any{},
+			},
+		},
+		{
+			name:       "providers with configuration aliases",
+			workingDir: "testdata",
+			moduleName: "test-module-provider-aliases",
+			result: &moduleInspectResult{
+				ContextVarExists:  false,
+				RequiredProviders: []string{"helm", "postgresql"},
+				ProviderSources: map[string]string{
+					"helm":       "hashicorp/helm",
+					"postgresql": "cyrilgdn/postgresql",
+				},
+				ConfigurationAliases: []string{"helm.east", "helm.west"},
+				ResultOutputExists:   false,
+				Parameters:           map[string]any{},
 			},
 		},
 		{
@@ -49,9 +70,11 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 			workingDir: "testdata",
 			moduleName: "test-module-recipe-context-outputs",
 			result: &moduleInspectResult{
-				ContextVarExists:   true,
-				RequiredProviders:  []string{"aws"},
-				ResultOutputExists: true,
+				ContextVarExists:     true,
+				RequiredProviders:    []string{"aws"},
+				ProviderSources:      map[string]string{"aws": "hashicorp/aws"},
+				ConfigurationAliases: []string{},
+				ResultOutputExists:   true,
 				Parameters: map[string]any{
 					"context": map[string]any{
 						"name":         "context",
@@ -85,6 +108,7 @@ func Test_InspectTFModuleConfig(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			sort.Strings(result.RequiredProviders)
 			require.Equal(t, tc.result, result)
 		})
 	}
@@ -95,7 +119,49 @@ func Test_DownloadModule_EmptyWorkingDirPath_Error(t *testing.T) {
 	testDir := t.TempDir()
 	execPath := filepath.Join(testDir, "terraform")
 
-	err := downloadModule(testcontext.New(t), "", execPath, "test/module/source")
+	err := downloadModule(testcontext.New(t), "", execPath, "test/module/source", nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Terraform cannot be initialised with empty workdir")
 }
+
+func Test_GetModuleVersion(t *testing.T) {
+	version, err := getModuleVersion("testdata", "test-module-provideronly")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.2", version)
+
+	// Modules which are not downloaded from a module registry are not versioned.
+	version, err = getModuleVersion("testdata", "test-module-provider-aliases")
+	require.NoError(t, err)
+	require.Empty(t, version)
+
+	_, err = getModuleVersion("testdata", "invalid-module")
+	require.EqualError(t, err, "module \"invalid-module\" is not found in the module manifest")
+
+	_, err = getModuleVersion(t.TempDir(), "test-module-provideronly")
+	require.ErrorContains(t, err, "error reading the module manifest")
+}
+
+func Test_GetModuleDigest(t *testing.T) {
+	workingDir := t.TempDir()
+	moduleDir := filepath.Join(workingDir, moduleRootDir, "test-module")
+	require.NoError(t, os.MkdirAll(filepath.Join(moduleDir, ".git"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "main.tf"), []byte("resource \"null_resource\" \"test\" {}"), 0644))
+
+	digest, err := getModuleDigest(workingDir, "test-module")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(digest, "sha256:"))
+
+	// Version control metadata doesn't change the digest.
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, ".git", "HEAD"), []byte("ref: refs/heads/main"), 0644))
+	unchanged, err := getModuleDigest(workingDir, "test-module")
+	require.NoError(t, err)
+	require.Equal(t, digest, unchanged)
+
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "outputs.tf"), []byte("output \"result\" {}"), 0644))
+	changed, err := getModuleDigest(workingDir, "test-module")
+	require.NoError(t, err)
+	require.NotEqual(t, digest, changed)
+
+	_, err = getModuleDigest(workingDir, "invalid-module")
+	require.ErrorContains(t, err, "error computing the digest of the module")
+}
@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    helm = {
+      source = "hashicorp/helm"
+      configuration_aliases = [ helm.east, helm.west ]
+    }
+    postgresql = {
+      source = "cyrilgdn/postgresql"
+      version = ">=1.0"
+    }
+  }
+}
\ No newline at end of file
@@ -18,7 +18,14 @@ package terraform
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/terraform-exec/tfexec"
@@ -27,11 +34,36 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
+const (
+	// pluginCacheDirEnvVar is the environment variable that configures the Terraform provider plugin cache directory.
+	pluginCacheDirEnvVar = "TF_PLUGIN_CACHE_DIR"
+
+	// pluginCacheBreakLockFileEnvVar allows Terraform to use the plugin cache without a dependency lock file.
+	pluginCacheBreakLockFileEnvVar = "TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE"
+
+	// gitConfigCountEnvVar is the number of the Git configuration entries set by the GIT_CONFIG_KEY_<n> and
+	// GIT_CONFIG_VALUE_<n> environment variables. https://git-scm.com/docs/git-config#ENVIRONMENT
+	gitConfigCountEnvVar = "GIT_CONFIG_COUNT"
+
+	// netrcEnvVar is the environment variable that configures the netrc file Terraform reads the credentials of
+	// HTTP module sources from.
+	netrcEnvVar = "NETRC"
+
+	// netrcFileName is the name of the netrc file written to the working directory.
+	netrcFileName = ".netrc"
+
+	// modeNetrcFile is read/write mode only for the owner of the netrc file.
+	modeNetrcFile os.FileMode = 0600
+
+	// tokenUsername is the username used to authenticate to Git and HTTP module sources with a token.
+	tokenUsername = "oauth2"
+)
+
 //go:generate mockgen -destination=./mock_executor.go -package=terraform -self_package github.com/radius-project/radius/pkg/recipes/terraform github.com/radius-project/radius/pkg/recipes/terraform TerraformExecutor
 
 type TerraformExecutor interface {
 	// Deploy installs terraform and runs terraform init and apply on the terraform module referenced by the recipe using terraform-exec.
-	Deploy(ctx context.Context, options Options) (*tfjson.State, error)
+	Deploy(ctx context.Context, options Options) (*DeployResult, error)
 
 	// Delete installs terraform and runs terraform destroy on the terraform module referenced by the recipe using terraform-exec,
 	// and deletes the Kubernetes secret created for terraform state store.
@@ -39,6 +71,31 @@ type TerraformExecutor interface {
 
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+
+	// ExportState installs terraform and runs terraform state pull to retrieve the state file of the resource from
+	// the backend configured for the environment.
+	ExportState(ctx context.Context, options Options) ([]byte, error)
+
+	// UnlockState releases the lock on the state file of the resource in the backend configured for the environment.
+	// The lock is force-unlocked by terraform when lockID is set.
+	UnlockState(ctx context.Context, options Options, lockID string) error
+
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe to
+	// compare the deployed resources with the recipe.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
+}
+
+// DeployResult represents the result of deploying a Terraform recipe.
+type DeployResult struct {
+	// State is the Terraform state after the module was applied.
+	State *tfjson.State
+
+	// ModuleVersion is the version of the module resolved from the version constraint of the recipe. It is empty
+	// when the module was not downloaded from a module registry.
+	ModuleVersion string
+
+	// ModuleDigest is the SHA-256 digest of the content of the module which was applied.
+	ModuleDigest string
 }
 
 // Options represents the options required to build inputs to interact with Terraform.
@@ -56,18 +113,157 @@ type Options struct {
 	ResourceRecipe *recipes.ResourceMetadata
 }
 
-// NewTerraform creates a new Terraform executor with Terraform logs enabled.
-func NewTerraform(ctx context.Context, workingDir, execPath string) (*tfexec.Terraform, error) {
+// NewTerraform creates a new Terraform executor with Terraform logs enabled. env contains the environment variables
+// set for the Terraform process in addition to the environment variables of the current process.
+func NewTerraform(ctx context.Context, workingDir, execPath string, env map[string]string) (*tfexec.Terraform, error) {
 	tf, err := tfexec.NewTerraform(workingDir, execPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Terraform: %w", err)
 	}
 
+	if len(env) > 0 {
+		// Setting the environment replaces the environment inherited from the current process.
+		merged := tfexec.CleanEnv(currentEnv())
+		for k, v := range env {
+			merged[k] = v
+		}
+		if err := tf.SetEnv(merged); err != nil {
+			return nil, fmt.Errorf("failed to set environment variables for Terraform: %w", err)
+		}
+	}
+
 	configureTerraformLogs(ctx, tf)
 
 	return tf, nil
 }
 
+// currentEnv returns the environment variables of the current process.
+func currentEnv() map[string]string {
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			env[k] = v
+		}
+	}
+	return env
+}
+
+// getTerraformEnv returns the environment variables set for the Terraform process, which include the shared provider
+// plugin cache, the environment variables configured in the environment and the credentials of private module
+// registries and module sources. The credentials are passed through the environment rather than in the module source
+// so that they are neither written to the Terraform configuration nor included in the errors of Terraform.
+// https://developer.hashicorp.com/terraform/cli/config/config-file#environment-variable-credentials
+func getTerraformEnv(workingDir string, envConfig *recipes.Configuration, pluginCacheDir string) (map[string]string, error) {
+	env := map[string]string{}
+	if pluginCacheDir != "" {
+		env[pluginCacheDirEnvVar] = pluginCacheDir
+		// Recipe modules are initialized without a dependency lock file, in which case Terraform 1.4+ ignores
+		// the plugin cache unless explicitly allowed to use it.
+		env[pluginCacheBreakLockFileEnvVar] = "true"
+	}
+
+	if envConfig != nil {
+		for host, cred := range envConfig.Terraform.RegistryCredentials {
+			env[registryTokenEnvVar(host)] = cred.Token
+		}
+		for k, v := range envConfig.Terraform.Env {
+			env[k] = v
+		}
+
+		if err := addGitCredentials(env, envConfig.Terraform.GitCredentials); err != nil {
+			return nil, err
+		}
+
+		if len(envConfig.Terraform.HTTPCredentials) > 0 {
+			netrcFile, err := writeNetrcFile(workingDir, envConfig.Terraform.HTTPCredentials)
+			if err != nil {
+				return nil, err
+			}
+			env[netrcEnvVar] = netrcFile
+		}
+	}
+
+	if len(env) == 0 {
+		return nil, nil
+	}
+
+	return env, nil
+}
+
+// addGitCredentials adds the Git configuration which sends the credentials of Git module sources in the Authorization
+// header of the requests to their hosts. The entries are added after the Git configuration entries already in env.
+func addGitCredentials(env map[string]string, credentials map[string]recipes.RegistryCredentials) error {
+	if len(credentials) == 0 {
+		return nil
+	}
+
+	count := 0
+	if value, ok := env[gitConfigCountEnvVar]; ok {
+		var err error
+		if count, err = strconv.Atoi(value); err != nil {
+			return fmt.Errorf("the value of the environment variable %s must be a number: %q", gitConfigCountEnvVar, value)
+		}
+	}
+
+	for _, host := range sortedHosts(credentials) {
+		username, password := basicAuth(credentials[host])
+		header := "Authorization: Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+		for _, scheme := range []string{"https", "http"} {
+			env[fmt.Sprintf("GIT_CONFIG_KEY_%d", count)] = fmt.Sprintf("http.%s://%s/.extraHeader", scheme, host)
+			env[fmt.Sprintf("GIT_CONFIG_VALUE_%d", count)] = header
+			count++
+		}
+	}
+	env[gitConfigCountEnvVar] = strconv.Itoa(count)
+
+	return nil
+}
+
+// writeNetrcFile writes the credentials of HTTP module sources to a netrc file in the working directory and returns
+// the path of the file.
+func writeNetrcFile(workingDir string, credentials map[string]recipes.RegistryCredentials) (string, error) {
+	b := strings.Builder{}
+	for _, host := range sortedHosts(credentials) {
+		username, password := basicAuth(credentials[host])
+		// The netrc format doesn't support quoting, so the tokens of an entry can't contain whitespace.
+		if strings.ContainsFunc(host+username+password, unicode.IsSpace) {
+			return "", fmt.Errorf("the credentials of the HTTP module sources at %q can't contain whitespace", host)
+		}
+		fmt.Fprintf(&b, "machine %s login %s password %s\n", host, username, password)
+	}
+
+	netrcFile := filepath.Join(workingDir, netrcFileName)
+	if err := os.WriteFile(netrcFile, []byte(b.String()), modeNetrcFile); err != nil {
+		return "", fmt.Errorf("failed to write the credentials of the HTTP module sources: %w", err)
+	}
+
+	return netrcFile, nil
+}
+
+// basicAuth returns the username and password used to authenticate to a module source with the credentials.
+func basicAuth(cred recipes.RegistryCredentials) (string, string) {
+	if cred.Token != "" {
+		return tokenUsername, cred.Token
+	}
+	return cred.Username, cred.Password
+}
+
+// sortedHosts returns the hosts of the credentials in order, so that the generated configuration is stable.
+func sortedHosts(credentials map[string]recipes.RegistryCredentials) []string {
+	hosts := make([]string, 0, len(credentials))
+	for host := range credentials {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+// registryTokenEnvVar returns the name of the environment variable Terraform reads the token of the module registry
+// at the given host from. Periods are encoded as underscores and hyphens as double underscores.
+func registryTokenEnvVar(host string) string {
+	return "TF_TOKEN_" + strings.NewReplacer(".", "_", "-", "__").Replace(host)
+}
+
 // tfLogWrapper is a wrapper around the Terraform logger to stream the logs to the Radius logger.
 type tfLogWrapper struct {
 	logger   logr.Logger
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetTerraformEnv(t *testing.T) {
+	workingDir := t.TempDir()
+
+	env, err := getTerraformEnv(workingDir, nil, "")
+	require.NoError(t, err)
+	require.Nil(t, env)
+
+	env, err = getTerraformEnv(workingDir, nil, "/terraform/.terraform-plugin-cache")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"TF_PLUGIN_CACHE_DIR":                            "/terraform/.terraform-plugin-cache",
+		"TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE": "true",
+	}, env)
+
+	envConfig := &recipes.Configuration{
+		Terraform: recipes.TerraformConfiguration{
+			Env: map[string]string{
+				"TF_REGISTRY_CLIENT_TIMEOUT": "30",
+			},
+			RegistryCredentials: map[string]recipes.RegistryCredentials{
+				"app.terraform.io":        {Token: "tfc-token"},
+				"my-registry.example.com": {Token: "registry-token"},
+			},
+		},
+	}
+
+	expected := map[string]string{
+		"TF_REGISTRY_CLIENT_TIMEOUT":        "30",
+		"TF_TOKEN_app_terraform_io":         "tfc-token",
+		"TF_TOKEN_my__registry_example_com": "registry-token",
+	}
+	env, err = getTerraformEnv(workingDir, envConfig, "")
+	require.NoError(t, err)
+	require.Equal(t, expected, env)
+
+	// Environment variables configured in the environment take precedence.
+	envConfig.Terraform.Env["TF_PLUGIN_CACHE_DIR"] = "/custom-cache"
+	expected["TF_PLUGIN_CACHE_DIR"] = "/custom-cache"
+	expected["TF_PLUGIN_CACHE_MAY_BREAK_DEPENDENCY_LOCK_FILE"] = "true"
+	env, err = getTerraformEnv(workingDir, envConfig, "/terraform/.terraform-plugin-cache")
+	require.NoError(t, err)
+	require.Equal(t, expected, env)
+}
+
+func Test_GetTerraformEnv_ModuleSourceCredentials(t *testing.T) {
+	workingDir := t.TempDir()
+	envConfig := &recipes.Configuration{
+		Terraform: recipes.TerraformConfiguration{
+			GitCredentials: map[string]recipes.RegistryCredentials{
+				"github.com":      {Token: "ghp_token"},
+				"git.example.com": {Username: "admin", Password: "p@ss"},
+			},
+			HTTPCredentials: map[string]recipes.RegistryCredentials{
+				"artifacts.example.com": {Username: "admin", Password: "password"},
+				"files.example.com":     {Token: "files-token"},
+			},
+			Env: map[string]string{
+				// Git configuration entries set in the environment are kept.
+				"GIT_CONFIG_COUNT":   "1",
+				"GIT_CONFIG_KEY_0":   "http.sslVerify",
+				"GIT_CONFIG_VALUE_0": "true",
+			},
+		},
+	}
+
+	env, err := getTerraformEnv(workingDir, envConfig, "")
+	require.NoError(t, err)
+
+	adminHeader := "Authorization: Basic " + base64.StdEncoding.EncodeToString([]byte("admin:p@ss"))
+	tokenHeader := "Authorization: Basic " + base64.StdEncoding.EncodeToString([]byte("oauth2:ghp_token"))
+	require.Equal(t, map[string]string{
+		"GIT_CONFIG_COUNT":   "5",
+		"GIT_CONFIG_KEY_0":   "http.sslVerify",
+		"GIT_CONFIG_VALUE_0": "true",
+		"GIT_CONFIG_KEY_1":   "http.https://git.example.com/.extraHeader",
+		"GIT_CONFIG_VALUE_1": adminHeader,
+		"GIT_CONFIG_KEY_2":   "http.http://git.example.com/.extraHeader",
+		"GIT_CONFIG_VALUE_2": adminHeader,
+		"GIT_CONFIG_KEY_3":   "http.https://github.com/.extraHeader",
+		"GIT_CONFIG_VALUE_3": tokenHeader,
+		"GIT_CONFIG_KEY_4":   "http.http://github.com/.extraHeader",
+		"GIT_CONFIG_VALUE_4": tokenHeader,
+		"NETRC":              filepath.Join(workingDir, ".netrc"),
+	}, env)
+
+	b, err := os.ReadFile(env["NETRC"])
+	require.NoError(t, err)
+	require.Equal(t, "machine artifacts.example.com login admin password password\nmachine files.example.com login oauth2 password files-token\n", string(b))
+
+	info, err := os.Stat(env["NETRC"])
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	t.Run("invalid Git configuration count", func(t *testing.T) {
+		envConfig.Terraform.Env["GIT_CONFIG_COUNT"] = "one"
+		defer func() { envConfig.Terraform.Env["GIT_CONFIG_COUNT"] = "1" }()
+
+		_, err := getTerraformEnv(workingDir, envConfig, "")
+		require.EqualError(t, err, "the value of the environment variable GIT_CONFIG_COUNT must be a number: \"one\"")
+	})
+
+	t.Run("whitespace in HTTP credentials", func(t *testing.T) {
+		_, err := getTerraformEnv(workingDir, &recipes.Configuration{
+			Terraform: recipes.TerraformConfiguration{
+				HTTPCredentials: map[string]recipes.RegistryCredentials{
+					"artifacts.example.com": {Username: "admin", Password: "pass word"},
+				},
+			},
+		}, "")
+		require.EqualError(t, err, "the credentials of the HTTP module sources at \"artifacts.example.com\" can't contain whitespace")
+	})
+}
+
+func Test_NewTerraform_Env(t *testing.T) {
+	execPath := filepath.Join(t.TempDir(), "terraform")
+
+	_, err := NewTerraform(testcontext.New(t), t.TempDir(), execPath, map[string]string{"TF_REGISTRY_CLIENT_TIMEOUT": "30"})
+	require.NoError(t, err)
+
+	// Environment variables managed by terraform-exec can't be overridden.
+	_, err = NewTerraform(testcontext.New(t), t.TempDir(), execPath, map[string]string{"TF_LOG": "DEBUG"})
+	require.ErrorContains(t, err, "failed to set environment variables for Terraform")
+}
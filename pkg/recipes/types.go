@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
 // Configuration represents kubernetes runtime and cloud provider configuration, which is used by the driver while deploying recipes.
@@ -31,6 +32,52 @@ type Configuration struct {
 	Providers datamodel.Providers
 	// Simulated represents whether the environment is simulated or not.
 	Simulated bool
+	// Bicep is the configuration for Bicep recipes.
+	Bicep BicepConfiguration
+	// Terraform is the configuration for Terraform recipes.
+	Terraform TerraformConfiguration
+}
+
+// BicepConfiguration represents the configuration used by the driver to fetch Bicep recipes.
+type BicepConfiguration struct {
+	// RegistryCredentials are the credentials used to authenticate to private OCI registries, keyed by registry host.
+	RegistryCredentials map[string]RegistryCredentials
+}
+
+// TerraformConfiguration represents the configuration used by the driver to download and run Terraform recipes.
+type TerraformConfiguration struct {
+	// GitCredentials are the credentials used to download modules from Git module sources, keyed by host.
+	GitCredentials map[string]RegistryCredentials
+	// HTTPCredentials are the credentials used to download modules from HTTP module sources, keyed by host.
+	HTTPCredentials map[string]RegistryCredentials
+	// RegistryCredentials are the credentials used to download modules from private module registries, keyed by host.
+	// Only Token is set for module registries.
+	RegistryCredentials map[string]RegistryCredentials
+	// Providers is the configuration of Terraform providers keyed by provider name.
+	Providers map[string][]map[string]any
+	// Env is the environment variables set for the Terraform process.
+	Env map[string]string
+	// Backend is the Terraform backend storing the state of the recipes deployed in the environment.
+	Backend TerraformBackend
+}
+
+// TerraformBackend represents the Terraform backend storing the state of Terraform recipes.
+type TerraformBackend struct {
+	// Kind is the kind of the backend, for example "s3". The Kubernetes secret backend is used if empty.
+	Kind string
+	// Config is the configuration of the backend, including the values of the secrets of the backend.
+	Config map[string]any
+}
+
+// RegistryCredentials represents the credentials used to authenticate to a private OCI registry or to a private
+// Terraform module source. Either Username and Password, or Token is set.
+type RegistryCredentials struct {
+	// Username is the username used for basic authentication.
+	Username string
+	// Password is the password used for basic authentication.
+	Password string
+	// Token is the bearer token used to authenticate to the registry.
+	Token string
 }
 
 // RuntimeConfiguration represents Kubernetes Runtime configuration for the environment.
@@ -44,6 +91,8 @@ type KubernetesRuntime struct {
 	Namespace string `json:"namespace,omitempty"`
 	// EnvironmentNamespace is set to environment namespace.
 	EnvironmentNamespace string `json:"environmentNamespace"`
+	// PlaneName is the name of the Kubernetes plane of the cluster targeted by the environment.
+	PlaneName string `json:"planeName,omitempty"`
 }
 
 // EnvironmentDefinition represents the recipe configuration details.
@@ -79,13 +128,14 @@ type ResourceMetadata struct {
 const (
 	TemplateKindBicep     = "bicep"
 	TemplateKindTerraform = "terraform"
+	TemplateKindHelm      = "helm"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -98,6 +148,37 @@ type RecipeOutput struct {
 
 	// Values represents the key/value pairs of properties of the deployed resource.
 	Values map[string]any
+
+	// Status records the recipe template, version and parameters which produced the output. It is set by the driver
+	// and is never read from the output of the recipe itself.
+	Status *rpv1.RecipeStatus `json:"-"`
+}
+
+// PlanAction is the change a recipe deployment would make to a resource.
+type PlanAction string
+
+const (
+	// PlanActionCreate represents a resource which would be created by the recipe deployment.
+	PlanActionCreate PlanAction = "Create"
+	// PlanActionUpdate represents a resource which would be updated in-place by the recipe deployment.
+	PlanActionUpdate PlanAction = "Update"
+	// PlanActionDelete represents a resource which would be deleted by the recipe deployment.
+	PlanActionDelete PlanAction = "Delete"
+)
+
+// RecipePlan represents the preview of the changes a recipe deployment would make to the infrastructure.
+type RecipePlan struct {
+	// Resources represents the list of resources which would be created, updated or deleted by the recipe deployment.
+	Resources []PlannedResource
+}
+
+// PlannedResource represents a resource which would be changed by a recipe deployment.
+type PlannedResource struct {
+	// ID represents the UCP resource ID of the resource, or the recipe address of the resource when it has no resource ID yet.
+	ID string
+
+	// Action represents the change the recipe deployment would make to the resource.
+	Action PlanAction
 }
 
 // PrepareRecipeOutput populates the recipe output from the recipe deployment output stored in the "result" object.
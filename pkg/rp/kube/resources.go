@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	cdm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
@@ -83,6 +84,20 @@ func FetchNamespaceFromEnvironmentResource(environment *v20231001preview.Environ
 
 }
 
+// FetchPlaneNameFromEnvironmentResource finds the name of the Kubernetes plane of the cluster targeted by
+// EnvironmentResource. If no compute is found, an error is returned.
+func FetchPlaneNameFromEnvironmentResource(environment *v20231001preview.EnvironmentResource) (string, error) {
+	if environment.Properties.Compute != nil {
+		kubernetes, ok := environment.Properties.Compute.(*v20231001preview.KubernetesCompute)
+		if !ok {
+			return "", v1.ErrInvalidModelConversion
+		}
+		compute := rpv1.KubernetesComputeProperties{ResourceID: to.String(kubernetes.ResourceID)}
+		return compute.PlaneName(), nil
+	}
+	return "", errors.New("unable to fetch plane information")
+}
+
 // FetchNamespaceFromApplicationResource finds the application-scope Kubernetes namespace from ApplicationResource.
 // If no namespace is found, an error is returned.
 func FetchNamespaceFromApplicationResource(application *v20231001preview.ApplicationResource) (string, error) {
@@ -123,6 +123,38 @@ func TestFetchNameSpaceFromEnvironmentResource(t *testing.T) {
 	require.Equal(t, err.Error(), "unable to fetch namespace information")
 }
 
+func TestFetchPlaneNameFromEnvironmentResource(t *testing.T) {
+	envResource := model.EnvironmentResource{
+		Properties: &model.EnvironmentProperties{
+			Compute: &model.KubernetesCompute{
+				Namespace: to.Ptr(namespace),
+			},
+		},
+	}
+
+	planeName, err := FetchPlaneNameFromEnvironmentResource(&envResource)
+	require.NoError(t, err)
+	require.Equal(t, "local", planeName)
+	// Registered cluster
+	envResource.Properties.Compute = &model.KubernetesCompute{
+		Namespace:  to.Ptr(namespace),
+		ResourceID: to.Ptr("/planes/kubernetes/cluster1"),
+	}
+	planeName, err = FetchPlaneNameFromEnvironmentResource(&envResource)
+	require.NoError(t, err)
+	require.Equal(t, "cluster1", planeName)
+	// Invalid env model
+	envResource.Properties.Compute = &model.EnvironmentCompute{}
+	_, err = FetchPlaneNameFromEnvironmentResource(&envResource)
+	require.Error(t, err)
+	require.Equal(t, err.Error(), "invalid model conversion")
+	// Invalid compute fields
+	envResource.Properties.Compute = nil
+	_, err = FetchPlaneNameFromEnvironmentResource(&envResource)
+	require.Error(t, err)
+	require.Equal(t, err.Error(), "unable to fetch plane information")
+}
+
 func TestFetchNameSpaceFromApplicationResource(t *testing.T) {
 	appResource := model.ApplicationResource{
 		Properties: &model.ApplicationProperties{
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	dockerParser "github.com/novln/docker-parser"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -27,12 +28,31 @@ import (
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
 )
 
-// ReadFromRegistry reads data from an OCI compliant registry and stores it in a map. It returns an error if the path is invalid,
-// if the client to the registry fails to be created, if the manifest fails to be fetched, if the bytes fail to be fetched, or if
-// the data fails to be unmarshalled.
-func ReadFromRegistry(ctx context.Context, path string, data *map[string]any) error {
+// ReadFromRegistry reads data from an OCI compliant registry and stores it in a map. If credentials contains an entry for the
+// host of the registry, it is used to authenticate to the registry, otherwise the registry is accessed anonymously. It returns
+// an error if the path is invalid, if the client to the registry fails to be created, if the manifest fails to be fetched, if
+// the bytes fail to be fetched, or if the data fails to be unmarshalled.
+func ReadFromRegistry(ctx context.Context, path string, data *map[string]any, credentials map[string]recipes.RegistryCredentials) error {
+	return readFromRegistry(ctx, path, data, credentials, retry.DefaultClient)
+}
+
+// RegistryCredential converts the registry credentials of an environment to the credential used by the OCI client.
+func RegistryCredential(credentials recipes.RegistryCredentials) auth.Credential {
+	if credentials.Token != "" {
+		return auth.Credential{AccessToken: credentials.Token}
+	}
+
+	return auth.Credential{
+		Username: credentials.Username,
+		Password: credentials.Password,
+	}
+}
+
+func readFromRegistry(ctx context.Context, path string, data *map[string]any, credentials map[string]recipes.RegistryCredentials, httpClient *http.Client) error {
 	registryRepo, tag, err := parsePath(path)
 	if err != nil {
 		return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
@@ -43,6 +63,16 @@ func ReadFromRegistry(ctx context.Context, path string, data *map[string]any) er
 		return fmt.Errorf("failed to create client to registry %s", err.Error())
 	}
 
+	// Each read uses its own cache so that the credentials of one environment are never used for another.
+	client := &auth.Client{
+		Client: httpClient,
+		Cache:  auth.NewCache(),
+	}
+	if cred, ok := credentials[repo.Reference.Registry]; ok {
+		client.Credential = auth.StaticCredential(repo.Reference.Registry, RegistryCredential(cred))
+	}
+	repo.Client = client
+
 	digest, err := getDigestFromManifest(ctx, repo, tag)
 	if err != nil {
 		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", path, err.Error()), recipes_util.RecipeSetupError, nil)
@@ -116,6 +146,17 @@ func getBytes(ctx context.Context, repo *remote.Repository, layerDigest string)
 	return pulledBlob, nil
 }
 
+// RegistryTag returns the tag of a path in the form of registry/repository:tag. The tag defaults to "latest" when the
+// path does not include one.
+func RegistryTag(path string) (string, error) {
+	_, tag, err := parsePath(path)
+	if err != nil {
+		return "", err
+	}
+
+	return tag, nil
+}
+
 // parsePath parses a path in the form of registry/repository:tag
 func parsePath(path string) (repository string, tag string, err error) {
 	reference, err := dockerParser.Parse(path)
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	resources "github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// FetchSecretStoreSecrets lists the secrets of the secret store with the given ID using the provided ClientOptions and
+// returns the decoded secret values keyed by secret name.
+func FetchSecretStoreSecrets(ctx context.Context, secretStoreID string, ucpOptions *arm.ClientOptions) (map[string]string, error) {
+	id, err := resources.ParseResource(secretStoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := v20231001preview.NewSecretStoresClient(id.RootScope(), &aztoken.AnonymousCredential{}, ucpOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.ListSecrets(ctx, id.Name(), map[string]any{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSecretValues(response.Data)
+}
+
+// decodeSecretValues returns the values of the secrets, decoding the base64-encoded ones.
+func decodeSecretValues(data map[string]*v20231001preview.SecretValueProperties) (map[string]string, error) {
+	secrets := map[string]string{}
+	for key, secret := range data {
+		if secret == nil {
+			continue
+		}
+
+		value := to.String(secret.Value)
+		if secret.Encoding != nil && *secret.Encoding == v20231001preview.SecretValueEncodingBase64 {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("secret %s is not a valid base64-encoded value: %w", key, err)
+			}
+			value = string(decoded)
+		}
+
+		secrets[key] = value
+	}
+
+	return secrets, nil
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DecodeSecretValues(t *testing.T) {
+	secrets, err := decodeSecretValues(map[string]*v20231001preview.SecretValueProperties{
+		"username": {Value: to.Ptr("admin")},
+		"password": {Value: to.Ptr("cGFzc3dvcmQ="), Encoding: to.Ptr(v20231001preview.SecretValueEncodingBase64)},
+		"token":    {Value: to.Ptr("token"), Encoding: to.Ptr(v20231001preview.SecretValueEncodingRaw)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"username": "admin", "password": "password", "token": "token"}, secrets)
+}
+
+func Test_DecodeSecretValues_InvalidBase64(t *testing.T) {
+	_, err := decodeSecretValues(map[string]*v20231001preview.SecretValueProperties{
+		"password": {Value: to.Ptr("not base64!"), Encoding: to.Ptr(v20231001preview.SecretValueEncodingBase64)},
+	})
+	require.ErrorContains(t, err, "secret password is not a valid base64-encoded value")
+}
@@ -54,6 +54,7 @@ const (
 	LocalIDDeployment                   = "Deployment"
 	LocalIDGateway                      = "Gateway"
 	LocalIDHttpRoute                    = "HttpRoute"
+	LocalIDHorizontalPodAutoscaler      = "HorizontalPodAutoscaler"
 	LocalIDKeyVault                     = "KeyVault"
 	LocalIDSecret                       = "Secret"
 	LocalIDConfigMap                    = "ConfigMap"
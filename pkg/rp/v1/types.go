@@ -18,8 +18,10 @@ package v1
 
 import (
 	"strings"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 )
 
 // EnvironmentComputeKind is the type of compute resource.
@@ -62,12 +64,91 @@ type ResourceStatus struct {
 
 	// OutputResources represents the output resources associated with the radius resource.
 	OutputResources []OutputResource `json:"outputResources,omitempty"`
+
+	// RecipeDrift represents the result of the last drift detection of the recipe deployed for the radius resource.
+	RecipeDrift *RecipeDriftStatus `json:"recipeDrift,omitempty"`
+
+	// Recipe represents the recipe which deployed the infrastructure of the radius resource.
+	Recipe *RecipeStatus `json:"recipe,omitempty"`
 }
 
 // DeepCopy copies the contents of the ResourceStatus struct from in to out.
 func (in *ResourceStatus) DeepCopy(out *ResourceStatus) {
 	in.Compute = out.Compute
 	in.OutputResources = out.OutputResources
+	in.RecipeDrift = out.RecipeDrift
+	in.Recipe = out.Recipe
+}
+
+// RecipeStatus represents the recipe which deployed the infrastructure of a radius resource.
+type RecipeStatus struct {
+	// TemplateKind is the kind of the template provided by the recipe, for example "bicep", "terraform" or "helm".
+	TemplateKind string `json:"templateKind"`
+
+	// TemplatePath is the path to the template provided by the recipe.
+	TemplatePath string `json:"templatePath"`
+
+	// TemplateVersion is the version of the template requested by the recipe. It is only set for Terraform recipes.
+	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// ResolvedVersion is the version of the template resolved during the deployment. It is the tag of the template
+	// path for Bicep recipes, and the module version selected by Terraform for Terraform recipes.
+	ResolvedVersion string `json:"resolvedVersion,omitempty"`
+
+	// TemplateDigest is the digest of the content of the deployed template.
+	TemplateDigest string `json:"templateDigest,omitempty"`
+
+	// Parameters are the parameters the recipe was deployed with, after merging the parameters of the environment
+	// and of the resource.
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// RecipeDriftState is the state of the infrastructure deployed by a recipe compared to the recipe.
+type RecipeDriftState string
+
+const (
+	// RecipeDriftStateInSync represents infrastructure matching the recipe.
+	RecipeDriftStateInSync RecipeDriftState = "InSync"
+	// RecipeDriftStateDrifted represents infrastructure which was changed outside of the recipe.
+	RecipeDriftStateDrifted RecipeDriftState = "Drifted"
+	// RecipeDriftStateReconciled represents drifted infrastructure which was reconciled by re-applying the recipe.
+	RecipeDriftStateReconciled RecipeDriftState = "Reconciled"
+)
+
+// DriftAction is the change required to reconcile a drifted resource with the recipe.
+type DriftAction string
+
+const (
+	// DriftActionCreate represents a resource which no longer exists and needs to be created.
+	DriftActionCreate DriftAction = "Create"
+	// DriftActionUpdate represents a resource which needs to be updated in-place.
+	DriftActionUpdate DriftAction = "Update"
+	// DriftActionReplace represents a resource which needs to be deleted and created again.
+	DriftActionReplace DriftAction = "Replace"
+	// DriftActionDelete represents a resource which is not part of the recipe anymore and needs to be deleted.
+	DriftActionDelete DriftAction = "Delete"
+)
+
+// RecipeDriftStatus represents the result of the drift detection of a recipe deployment.
+type RecipeDriftStatus struct {
+	// State is the state of the infrastructure compared to the recipe.
+	State RecipeDriftState `json:"state"`
+
+	// LastCheckedTime is the time the drift was last detected.
+	LastCheckedTime time.Time `json:"lastCheckedTime"`
+
+	// DriftedResources is the list of resources which differ from the recipe.
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+}
+
+// DriftedResource represents a resource deployed by a recipe which differs from the recipe.
+type DriftedResource struct {
+	// ID is the resource ID of the drifted resource. Resources which cannot be represented as a UCP resource ID,
+	// such as the resources which no longer exist, are identified by their address in the recipe.
+	ID string `json:"id"`
+
+	// Action is the change required to reconcile the resource with the recipe.
+	Action DriftAction `json:"action"`
 }
 
 // EnvironmentCompute represents the compute resource of Environment.
@@ -89,6 +170,18 @@ type KubernetesComputeProperties struct {
 	Namespace string `json:"namespace"`
 }
 
+// PlaneName returns the name of the Kubernetes plane of the cluster targeted by the compute. A cluster registered
+// with UCP is targeted when ResourceID is the ID of its Kubernetes plane, such as /planes/kubernetes/cluster1.
+// Otherwise the cluster hosting Radius is targeted.
+func (k KubernetesComputeProperties) PlaneName() string {
+	planeName, err := resources_kubernetes.ParsePlaneID(k.ResourceID)
+	if err != nil {
+		return resources_kubernetes.PlaneNameLocal
+	}
+
+	return planeName
+}
+
 // RadiusResourceModel represents the interface of radius resource type.
 // TODO: Replace DeploymentDataModel with RadiusResourceModel later when link rp leverages generic.
 type RadiusResourceModel interface {
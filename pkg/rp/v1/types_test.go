@@ -96,3 +96,22 @@ func TestEqualLinkedResource(t *testing.T) {
 		require.Equal(t, tt.propA.EqualLinkedResource(&tt.propB), tt.eq)
 	}
 }
+
+func TestKubernetesComputePlaneName(t *testing.T) {
+	tests := []struct {
+		resourceID string
+		expected   string
+	}{
+		{resourceID: "", expected: "local"},
+		{resourceID: "/planes/kubernetes/local", expected: "local"},
+		{resourceID: "/planes/kubernetes/cluster1", expected: "cluster1"},
+		{resourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster", expected: "local"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceID, func(t *testing.T) {
+			compute := KubernetesComputeProperties{ResourceID: tt.resourceID, Namespace: "default"}
+			require.Equal(t, tt.expected, compute.PlaneName())
+		})
+	}
+}
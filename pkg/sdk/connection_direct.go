@@ -30,6 +30,9 @@ var _ Connection = (*directConnection)(nil)
 // or intermediate systems. This is mostly used for test scenarios.
 type directConnection struct {
 	endpoint string
+
+	// roundTripper is the http.roundTripper used to send requests.
+	roundTripper http.RoundTripper
 }
 
 // NewDirectConnection parses the given endpoint string and returns a direct connection if the endpoint uses the http or
@@ -45,15 +48,33 @@ func NewDirectConnection(endpoint string) (Connection, error) {
 	}
 
 	return &directConnection{
-		endpoint: endpoint,
+		endpoint:     endpoint,
+		roundTripper: otelhttp.NewTransport(http.DefaultTransport),
 	}, nil
 }
 
+// NewDirectConnectionWithCredentials creates a direct connection to the given endpoint which authenticates its
+// requests with the given credentials. This is used to connect to a Radius control plane which requires authentication.
+func NewDirectConnectionWithCredentials(endpoint string, credentials Credentials) (Connection, error) {
+	connection, err := NewDirectConnection(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	roundTripper, err := credentials.WrapTransport(http.DefaultTransport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure the credentials of the connection: %w", err)
+	}
+
+	connection.(*directConnection).roundTripper = otelhttp.NewTransport(roundTripper)
+	return connection, nil
+}
+
 // Client returns an http.Client for communicating with Radius. This satisfies both the
 // autorest.Sender interface (autorest Track1 Go SDK) and policy.Transporter interface
 // (autorest Track2 Go SDK).
 func (c *directConnection) Client() *http.Client {
-	return &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	return &http.Client{Transport: c.roundTripper}
 }
 
 // Endpoint returns the endpoint (aka. base URL) of the Radius API. This definitely includes
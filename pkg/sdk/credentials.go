@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
+)
+
+// Credentials authenticate the requests sent to a Radius control plane which requires authentication.
+type Credentials interface {
+	// WrapTransport returns a http.RoundTripper which authenticates the requests before sending them with
+	// the given http.RoundTripper.
+	WrapTransport(rt http.RoundTripper) (http.RoundTripper, error)
+}
+
+var _ Credentials = (*kubernetesCredentials)(nil)
+
+// kubernetesCredentials authenticate requests with the credentials of a Kubernetes client configuration.
+type kubernetesCredentials struct {
+	config *rest.Config
+}
+
+// NewKubernetesCredentials creates credentials which authenticate requests with the bearer token of the user of the
+// given Kubernetes client configuration, including the tokens issued by exec plugins. The control plane validates
+// the token with the Kubernetes TokenReview API.
+func NewKubernetesCredentials(config *rest.Config) Credentials {
+	return &kubernetesCredentials{config: config}
+}
+
+// WrapTransport returns a http.RoundTripper which adds the Kubernetes credentials to the requests.
+func (c *kubernetesCredentials) WrapTransport(rt http.RoundTripper) (http.RoundTripper, error) {
+	return rest.HTTPWrappersForConfig(c.config, rt)
+}
+
+var _ Credentials = (*tokenSourceCredentials)(nil)
+
+// tokenSourceCredentials authenticate requests with the bearer tokens of an oauth2.TokenSource.
+type tokenSourceCredentials struct {
+	source oauth2.TokenSource
+}
+
+// NewTokenSourceCredentials creates credentials which authenticate requests with the bearer tokens returned
+// by the given token source.
+func NewTokenSourceCredentials(source oauth2.TokenSource) Credentials {
+	return &tokenSourceCredentials{source: source}
+}
+
+// NewTokenFileCredentials creates credentials which authenticate requests with the bearer token stored in the file
+// at the given path, such as a projected Kubernetes service account token. The file is read again periodically
+// so that rotated tokens are used.
+func NewTokenFileCredentials(path string) Credentials {
+	return &tokenSourceCredentials{source: transport.NewCachedFileTokenSource(path)}
+}
+
+// NewOIDCClientCredentials creates credentials which authenticate requests with the access tokens issued by
+// an OpenID Connect provider with the OAuth2 client credentials flow. The token endpoint is discovered from
+// the OpenID configuration of the issuer when the first token is requested.
+func NewOIDCClientCredentials(issuer string, clientID string, clientSecret string, scopes []string) Credentials {
+	source := &oidcClientCredentialsTokenSource{
+		issuer: issuer,
+		config: clientcredentials.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Scopes:       scopes,
+		},
+	}
+
+	return &tokenSourceCredentials{source: oauth2.ReuseTokenSource(nil, source)}
+}
+
+// WrapTransport returns a http.RoundTripper which adds the bearer token of the token source to the requests.
+func (c *tokenSourceCredentials) WrapTransport(rt http.RoundTripper) (http.RoundTripper, error) {
+	return &oauth2.Transport{Source: c.source, Base: rt}, nil
+}
+
+var _ oauth2.TokenSource = (*oidcClientCredentialsTokenSource)(nil)
+
+// oidcClientCredentialsTokenSource requests access tokens from the token endpoint of an OpenID Connect provider.
+type oidcClientCredentialsTokenSource struct {
+	issuer string
+
+	mu     sync.Mutex
+	config clientcredentials.Config
+}
+
+// Token requests a new access token with the client credentials flow.
+func (s *oidcClientCredentialsTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := context.Background()
+	if s.config.TokenURL == "" {
+		tokenURL, err := discoverTokenEndpoint(ctx, s.issuer)
+		if err != nil {
+			return nil, err
+		}
+		s.config.TokenURL = tokenURL
+	}
+
+	return s.config.Token(ctx)
+}
+
+// discoverTokenEndpoint returns the token endpoint advertised in the OpenID configuration of the issuer.
+func discoverTokenEndpoint(ctx context.Context, issuer string) (string, error) {
+	url := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the OpenID configuration of %q: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get the OpenID configuration of %q: unexpected status code %d", issuer, resp.StatusCode)
+	}
+
+	discovery := struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&discovery)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the OpenID configuration of %q: %w", issuer, err)
+	}
+
+	if discovery.TokenEndpoint == "" {
+		return "", fmt.Errorf("the OpenID configuration of %q doesn't have a token_endpoint", issuer)
+	}
+
+	return discovery.TokenEndpoint, nil
+}
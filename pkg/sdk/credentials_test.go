@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func Test_NewDirectConnectionWithCredentials_AddsBearerToken(t *testing.T) {
+	authorization := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	credentials := NewTokenSourceCredentials(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "test-token"}))
+	connection, err := NewDirectConnectionWithCredentials(server.URL, credentials)
+	require.NoError(t, err)
+	require.Equal(t, server.URL, connection.Endpoint())
+
+	resp, err := connection.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, "Bearer test-token", authorization)
+}
+
+func Test_NewTokenFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	err := os.WriteFile(path, []byte("file-token\n"), 0600)
+	require.NoError(t, err)
+
+	authorization := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	connection, err := NewDirectConnectionWithCredentials(server.URL, NewTokenFileCredentials(path))
+	require.NoError(t, err)
+
+	resp, err := connection.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, "Bearer file-token", authorization)
+}
+
+func Test_NewOIDCClientCredentials(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"token_endpoint": server.URL + "/token"})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "rad" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "oidc-token", "token_type": "Bearer", "expires_in": 3600})
+	})
+
+	authorization := ""
+	mux.HandleFunc("/apis", func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	credentials := NewOIDCClientCredentials(server.URL, "rad", "secret", nil)
+	connection, err := NewDirectConnectionWithCredentials(server.URL, credentials)
+	require.NoError(t, err)
+
+	resp, err := connection.Client().Get(server.URL + "/apis")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, "Bearer oidc-token", authorization)
+}
+
+func Test_NewOIDCClientCredentials_DiscoveryFailure(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	credentials := NewOIDCClientCredentials(server.URL, "rad", "secret", nil)
+	connection, err := NewDirectConnectionWithCredentials(server.URL, credentials)
+	require.NoError(t, err)
+
+	_, err = connection.Client().Get(server.URL + "/apis")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected status code 404")
+}
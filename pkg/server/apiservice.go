@@ -85,5 +85,7 @@ func (s *APIService) Run(ctx context.Context) error {
 		// set the arm cert manager for managing client certificate
 		ArmCertMgr:    s.ARMCertManager,
 		EnableArmAuth: s.Options.Config.Server.EnableArmAuth, // when enabled the client cert validation will be done
+		Authenticator: s.Authenticator,
+		Authorizer:    s.Authorizer,
 	})
 }
@@ -27,6 +27,8 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/corerp/model"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
 )
 
 // AsyncWorker is a service to run AsyncReqeustProcessWorker.
@@ -63,7 +65,12 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize kubernetes clients: %w", err)
 	}
 
-	appModel, err := model.NewApplicationModel(w.Options.Arm, k8s.RuntimeClient, k8s.ClientSet, k8s.DiscoveryClient, k8s.DynamicClient)
+	clusters, err := ucp_kubernetes.NewUCPClusterProvider(&ucp_kubernetes.Cluster{Config: w.Options.K8sConfig, Clients: k8s}, w.Options.UCPConnection, provider.NewSecretProvider(w.Options.Config.SecretProvider))
+	if err != nil {
+		return fmt.Errorf("failed to initialize kubernetes cluster provider: %w", err)
+	}
+
+	appModel, err := model.NewApplicationModel(w.Options.Arm, clusters)
 	if err != nil {
 		return fmt.Errorf("failed to initialize application model: %w", err)
 	}
@@ -73,7 +80,7 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 			DataProvider: w.StorageProvider,
 			KubeClient:   k8s.RuntimeClient,
 			GetDeploymentProcessor: func() deployment.DeploymentProcessor {
-				return deployment.NewDeploymentProcessor(appModel, w.StorageProvider, k8s.RuntimeClient, k8s.ClientSet)
+				return deployment.NewDeploymentProcessor(appModel, w.StorageProvider, k8s.RuntimeClient, k8s.ClientSet, clusters)
 			},
 		}
 
@@ -83,7 +90,9 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		}
 	}
 
-	workerOpts := worker.Options{}
+	workerOpts := worker.Options{
+		DeadLetterScope: worker.DeadLetterScope("Applications.Core", w.Options.Config.Env.RoleLocation),
+	}
 	if w.Options.Config.WorkerServer != nil {
 		if w.Options.Config.WorkerServer.MaxOperationConcurrency != nil {
 			workerOpts.MaxOperationConcurrency = *w.Options.Config.WorkerServer.MaxOperationConcurrency
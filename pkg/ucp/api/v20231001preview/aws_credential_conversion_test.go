@@ -55,6 +55,7 @@ func TestAWSCredentialConvertVersionedToDataModel(t *testing.T) {
 				Properties: &datamodel.AWSCredentialResourceProperties{
 					Kind: "AccessKey",
 					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:            "AccessKey",
 						AccessKeyID:     "00000000-0000-0000-0000-000000000000",
 						SecretAccessKey: "00000000-0000-0000-0000-000000000000",
 					},
@@ -65,6 +66,40 @@ func TestAWSCredentialConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresource-aws-irsa.json",
+			expected: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/aws/aws/providers/System.AWS/credentials/default",
+						Name:     "default",
+						Type:     "System.AWS/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: "IRSA",
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:    "IRSA",
+						RoleARN: "arn:aws:iam::000000000000:role/radius",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-aws-irsa-empty-rolearn.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.roleARN", ValidValue: "not empty"},
+		},
 		{
 			filename: "credentialresource-other.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -132,6 +167,26 @@ func TestAWSCredentialConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "credentialresourcedatamodel-aws-irsa.json",
+			expected: &AwsCredentialResource{
+				ID:       to.Ptr("/planes/aws/aws/providers/System.AWS/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.AWS/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AwsIRSACredentialProperties{
+					Kind:    to.Ptr(AWSCredentialKindIRSA),
+					RoleARN: to.Ptr("arn:aws:iam::000000000000:role/radius"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("aws-awscloud-default"),
+					},
+				},
+			},
+		},
 		{
 			filename: "credentialresourcedatamodel-default.json",
 			err:      v1.ErrInvalidModelConversion,
@@ -17,8 +17,6 @@ limitations under the License.
 package v20231001preview
 
 import (
-	"fmt"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -62,34 +60,36 @@ func (cr *AzureCredentialResource) getDataModelCredentialProperties() (*datamode
 
 	switch p := cr.Properties.(type) {
 	case *AzureServicePrincipalProperties:
-		var storage *datamodel.CredentialStorageProperties
-
-		switch c := p.Storage.(type) {
-		case *InternalCredentialStorageProperties:
-			if c.Kind == nil {
-				return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
-			}
-			storage = &datamodel.CredentialStorageProperties{
-				Kind: datamodel.InternalStorageKind,
-				InternalCredential: &datamodel.InternalCredentialStorageProperties{
-					SecretName: to.String(c.SecretName),
-				},
-			}
-		case nil:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
-		default:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
 		}
 
 		return &datamodel.AzureCredentialResourceProperties{
 			Kind: datamodel.AzureCredentialKind,
 			AzureCredential: &datamodel.AzureCredentialProperties{
+				Kind:         datamodel.AzureCredentialKind,
 				TenantID:     to.String(p.TenantID),
 				ClientID:     to.String(p.ClientID),
 				ClientSecret: to.String(p.ClientSecret),
 			},
 			Storage: storage,
 		}, nil
+	case *AzureWorkloadIdentityProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.AzureCredentialResourceProperties{
+			Kind: datamodel.AzureWorkloadIdentityCredentialKind,
+			AzureCredential: &datamodel.AzureCredentialProperties{
+				Kind:     datamodel.AzureWorkloadIdentityCredentialKind,
+				TenantID: to.String(p.TenantID),
+				ClientID: to.String(p.ClientID),
+			},
+			Storage: storage,
+		}, nil
 	default:
 		return nil, v1.ErrInvalidModelConversion
 	}
@@ -108,15 +108,9 @@ func (dst *AzureCredentialResource) ConvertFrom(src v1.DataModelInterface) error
 	dst.Location = &dm.Location
 	dst.Tags = *to.StringMapPtr(dm.Tags)
 
-	var storage CredentialStoragePropertiesClassification
-	switch dm.Properties.Storage.Kind {
-	case datamodel.InternalStorageKind:
-		storage = &InternalCredentialStorageProperties{
-			Kind:       to.Ptr(CredentialStorageKindInternal),
-			SecretName: to.Ptr(dm.Properties.Storage.InternalCredential.SecretName),
-		}
-	default:
-		return v1.ErrInvalidModelConversion
+	storage, err := fromCredentialStorageDataModel(dm.Properties.Storage)
+	if err != nil {
+		return err
 	}
 
 	// DO NOT convert any secret values to versioned model.
@@ -128,6 +122,13 @@ func (dst *AzureCredentialResource) ConvertFrom(src v1.DataModelInterface) error
 			TenantID: to.Ptr(dm.Properties.AzureCredential.TenantID),
 			Storage:  storage,
 		}
+	case datamodel.AzureWorkloadIdentityCredentialKind:
+		dst.Properties = &AzureWorkloadIdentityProperties{
+			Kind:     to.Ptr(AzureCredentialKind(dm.Properties.Kind)),
+			ClientID: to.Ptr(dm.Properties.AzureCredential.ClientID),
+			TenantID: to.Ptr(dm.Properties.AzureCredential.TenantID),
+			Storage:  storage,
+		}
 	default:
 		return v1.ErrInvalidModelConversion
 	}
@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// toCredentialStorageDataModel converts the versioned credential storage properties to the version-agnostic datamodel.
+func toCredentialStorageDataModel(s CredentialStoragePropertiesClassification) (*datamodel.CredentialStorageProperties, error) {
+	switch c := s.(type) {
+	case *InternalCredentialStorageProperties:
+		if c.Kind == nil {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+		}
+		return &datamodel.CredentialStorageProperties{
+			Kind: datamodel.InternalStorageKind,
+			InternalCredential: &datamodel.InternalCredentialStorageProperties{
+				SecretName: to.String(c.SecretName),
+			},
+		}, nil
+	case nil:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+	}
+}
+
+// fromCredentialStorageDataModel converts the version-agnostic credential storage datamodel to the versioned model.
+func fromCredentialStorageDataModel(s *datamodel.CredentialStorageProperties) (CredentialStoragePropertiesClassification, error) {
+	switch s.Kind {
+	case datamodel.InternalStorageKind:
+		return &InternalCredentialStorageProperties{
+			Kind:       to.Ptr(CredentialStorageKindInternal),
+			SecretName: to.Ptr(s.InternalCredential.SecretName),
+		}, nil
+	default:
+		return nil, v1.ErrInvalidModelConversion
+	}
+}
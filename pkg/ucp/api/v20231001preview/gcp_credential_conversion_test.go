@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGCPServiceAccountKey   = `{"type":"service_account","project_id":"radius-project"}`
+	testGCPAudience            = "//iam.googleapis.com/projects/000000000000/locations/global/workloadIdentityPools/radius/providers/kubernetes"
+	testGCPServiceAccountEmail = "radius@radius-project.iam.gserviceaccount.com"
+)
+
+func TestGCPCredentialConvertVersionedToDataModel(t *testing.T) {
+	trackedResource := v1.TrackedResource{
+		ID:       "/planes/gcp/gcp/providers/System.GCP/credentials/default",
+		Name:     "default",
+		Type:     "System.GCP/credentials",
+		Location: "west-us-2",
+		Tags: map[string]string{
+			"env": "dev",
+		},
+	}
+
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.GCPCredential
+		err      error
+	}{
+		{
+			filename: "credentialresource-gcp.json",
+			expected: &datamodel.GCPCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: trackedResource,
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.GCPCredentialResourceProperties{
+					Kind: "ServiceAccountKey",
+					GCPCredential: &datamodel.GCPCredentialProperties{
+						Kind:              "ServiceAccountKey",
+						ServiceAccountKey: testGCPServiceAccountKey,
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-gcp-wif.json",
+			expected: &datamodel.GCPCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: trackedResource,
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.GCPCredentialResourceProperties{
+					Kind: "WorkloadIdentityFederation",
+					GCPCredential: &datamodel.GCPCredentialProperties{
+						Kind:                "WorkloadIdentityFederation",
+						Audience:            testGCPAudience,
+						ServiceAccountEmail: testGCPServiceAccountEmail,
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-gcp-wif-empty-audience.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.audience", ValidValue: "not empty"},
+		},
+		{
+			filename: "credentialresource-other.json",
+			err:      v1.ErrInvalidModelConversion,
+		},
+		{
+			filename: "credentialresource-empty-properties.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"},
+		},
+		{
+			filename: "credentialresource-empty-storage-gcp.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"},
+		},
+	}
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &GcpCredentialResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				ct := dm.(*datamodel.GCPCredential)
+				require.Equal(t, tt.expected, ct)
+			}
+		})
+	}
+}
+
+func TestGCPCredentialConvertDataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *GcpCredentialResource
+		err      error
+	}{
+		{
+			filename: "credentialresourcedatamodel-gcp.json",
+			expected: &GcpCredentialResource{
+				ID:       to.Ptr("/planes/gcp/gcp/providers/System.GCP/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.GCP/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &GcpServiceAccountKeyCredentialProperties{
+					Kind: to.Ptr(GCPCredentialKindServiceAccountKey),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("gcp-gcp-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-gcp-wif.json",
+			expected: &GcpCredentialResource{
+				ID:       to.Ptr("/planes/gcp/gcp/providers/System.GCP/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.GCP/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &GcpWorkloadIdentityFederationCredentialProperties{
+					Kind:                to.Ptr(GCPCredentialKindWorkloadIdentityFederation),
+					Audience:            to.Ptr(testGCPAudience),
+					ServiceAccountEmail: to.Ptr(testGCPServiceAccountEmail),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("gcp-gcp-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-default.json",
+			err:      v1.ErrInvalidModelConversion,
+		},
+	}
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &datamodel.GCPCredential{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			versioned := &GcpCredentialResource{}
+			err = versioned.ConvertFrom(r)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// KubernetesCredentialType represents the ucp kubernetes credential type value.
+	KubernetesCredentialType = "System.Kubernetes/credentials"
+)
+
+// ConvertTo converts from the versioned Credential resource to version-agnostic datamodel.
+func (cr *KubernetesCredentialResource) ConvertTo() (v1.DataModelInterface, error) {
+	prop, err := cr.getDataModelCredentialProperties()
+	if err != nil {
+		return nil, err
+	}
+
+	converted := &datamodel.KubernetesCredential{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(cr.ID),
+				Name:     to.String(cr.Name),
+				Type:     to.String(cr.Type),
+				Location: to.String(cr.Location),
+				Tags:     to.StringMap(cr.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: prop,
+	}
+
+	return converted, nil
+}
+
+func (cr *KubernetesCredentialResource) getDataModelCredentialProperties() (*datamodel.KubernetesCredentialResourceProperties, error) {
+	if cr.Properties == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+	}
+
+	switch p := cr.Properties.(type) {
+	case *KubernetesKubeConfigCredentialProperties:
+		if to.String(p.KubeConfig) == "" {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.kubeConfig", ValidValue: "not empty"}
+		}
+
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.KubernetesCredentialResourceProperties{
+			Kind: datamodel.KubernetesKubeConfigCredentialKind,
+			KubernetesCredential: &datamodel.KubernetesCredentialProperties{
+				Kind:       datamodel.KubernetesKubeConfigCredentialKind,
+				KubeConfig: to.String(p.KubeConfig),
+			},
+			Storage: storage,
+		}, nil
+	default:
+		return nil, v1.ErrInvalidModelConversion
+	}
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Credential resource.
+func (dst *KubernetesCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.KubernetesCredential)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = &dm.ID
+	dst.Name = &dm.Name
+	dst.Type = &dm.Type
+	dst.Location = &dm.Location
+	dst.Tags = *to.StringMapPtr(dm.Tags)
+
+	storage, err := fromCredentialStorageDataModel(dm.Properties.Storage)
+	if err != nil {
+		return err
+	}
+
+	// DO NOT convert any secret values to versioned model.
+	switch dm.Properties.Kind {
+	case datamodel.KubernetesKubeConfigCredentialKind:
+		dst.Properties = &KubernetesKubeConfigCredentialProperties{
+			Kind:    to.Ptr(KubernetesCredentialKind(dm.Properties.Kind)),
+			Storage: storage,
+		}
+	default:
+		return v1.ErrInvalidModelConversion
+	}
+
+	return nil
+}
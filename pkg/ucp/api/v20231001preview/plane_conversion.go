@@ -50,7 +50,7 @@ func (src *PlaneResource) ConvertTo() (v1.DataModelInterface, error) {
 	} else if *src.Properties.Kind == PlaneKindAzure && (src.Properties.URL == nil || *src.Properties.URL == "") {
 		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.URL", ValidValue: "non-empty string"}
 	}
-	// No validation for AWS plane.
+	// No validation for AWS and GCP planes.
 
 	converted := &datamodel.Plane{
 		BaseResource: v1.BaseResource{
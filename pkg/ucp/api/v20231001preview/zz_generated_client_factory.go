@@ -44,6 +44,16 @@ func (c *ClientFactory) NewAzureCredentialsClient() *AzureCredentialsClient {
 	return subClient
 }
 
+func (c *ClientFactory) NewGcpCredentialsClient() *GcpCredentialsClient {
+	subClient, _ := NewGcpCredentialsClient(c.credential, c.options)
+	return subClient
+}
+
+func (c *ClientFactory) NewKubernetesCredentialsClient() *KubernetesCredentialsClient {
+	subClient, _ := NewKubernetesCredentialsClient(c.credential, c.options)
+	return subClient
+}
+
 func (c *ClientFactory) NewPlanesClient() *PlanesClient {
 	subClient, _ := NewPlanesClient(c.credential, c.options)
 	return subClient
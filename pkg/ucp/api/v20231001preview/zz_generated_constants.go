@@ -18,12 +18,15 @@ type AWSCredentialKind string
 const (
 	// AWSCredentialKindAccessKey - The AWS Access Key credential
 	AWSCredentialKindAccessKey AWSCredentialKind = "AccessKey"
+	// AWSCredentialKindIRSA - The AWS IAM Roles for Service Accounts credential
+	AWSCredentialKindIRSA AWSCredentialKind = "IRSA"
 )
 
 // PossibleAWSCredentialKindValues returns the possible values for the AWSCredentialKind const type.
 func PossibleAWSCredentialKindValues() []AWSCredentialKind {
 	return []AWSCredentialKind{	
 		AWSCredentialKindAccessKey,
+		AWSCredentialKindIRSA,
 	}
 }
 
@@ -33,12 +36,15 @@ type AzureCredentialKind string
 const (
 	// AzureCredentialKindServicePrincipal - The Service Principal Credential
 	AzureCredentialKindServicePrincipal AzureCredentialKind = "ServicePrincipal"
+	// AzureCredentialKindWorkloadIdentity - The Workload Identity Credential
+	AzureCredentialKindWorkloadIdentity AzureCredentialKind = "WorkloadIdentity"
 )
 
 // PossibleAzureCredentialKindValues returns the possible values for the AzureCredentialKind const type.
 func PossibleAzureCredentialKindValues() []AzureCredentialKind {
 	return []AzureCredentialKind{	
 		AzureCredentialKindServicePrincipal,
+		AzureCredentialKindWorkloadIdentity,
 	}
 }
 
@@ -77,6 +83,39 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// GCPCredentialKind - GCP credential kind
+type GCPCredentialKind string
+
+const (
+	// GCPCredentialKindServiceAccountKey - The GCP service account key credential
+	GCPCredentialKindServiceAccountKey GCPCredentialKind = "ServiceAccountKey"
+	// GCPCredentialKindWorkloadIdentityFederation - The GCP workload identity federation credential
+	GCPCredentialKindWorkloadIdentityFederation GCPCredentialKind = "WorkloadIdentityFederation"
+)
+
+// PossibleGCPCredentialKindValues returns the possible values for the GCPCredentialKind const type.
+func PossibleGCPCredentialKindValues() []GCPCredentialKind {
+	return []GCPCredentialKind{	
+		GCPCredentialKindServiceAccountKey,
+		GCPCredentialKindWorkloadIdentityFederation,
+	}
+}
+
+// KubernetesCredentialKind - Kubernetes credential kind
+type KubernetesCredentialKind string
+
+const (
+	// KubernetesCredentialKindKubeConfig - The Kubernetes kubeconfig credential
+	KubernetesCredentialKindKubeConfig KubernetesCredentialKind = "KubeConfig"
+)
+
+// PossibleKubernetesCredentialKindValues returns the possible values for the KubernetesCredentialKind const type.
+func PossibleKubernetesCredentialKindValues() []KubernetesCredentialKind {
+	return []KubernetesCredentialKind{	
+		KubernetesCredentialKindKubeConfig,
+	}
+}
+
 // PlaneKind - Plane kinds supported.
 type PlaneKind string
 
@@ -85,6 +124,10 @@ const (
 	PlaneKindAWS PlaneKind = "AWS"
 	// PlaneKindAzure - Azure Plane
 	PlaneKindAzure PlaneKind = "Azure"
+	// PlaneKindGCP - GCP Plane
+	PlaneKindGCP PlaneKind = "GCP"
+	// PlaneKindKubernetes - Kubernetes Plane
+	PlaneKindKubernetes PlaneKind = "Kubernetes"
 	// PlaneKindUCPNative - UCP Native Plane
 	PlaneKindUCPNative PlaneKind = "UCPNative"
 )
@@ -94,6 +137,8 @@ func PossiblePlaneKindValues() []PlaneKind {
 	return []PlaneKind{	
 		PlaneKindAWS,
 		PlaneKindAzure,
+		PlaneKindGCP,
+		PlaneKindKubernetes,
 		PlaneKindUCPNative,
 	}
 }
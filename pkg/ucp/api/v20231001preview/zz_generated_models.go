@@ -87,6 +87,29 @@ type AwsCredentialResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// AwsIRSACredentialProperties - AWS IRSA credential storage properties
+type AwsIRSACredentialProperties struct {
+	// REQUIRED; The AWS credential kind
+	Kind *AWSCredentialKind
+
+	// REQUIRED; RoleARN for AWS IRSA identity
+	RoleARN *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsIRSACredentialProperties.
+func (a *AwsIRSACredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
+	return &AwsCredentialProperties{
+		Kind: a.Kind,
+		ProvisioningState: a.ProvisioningState,
+	}
+}
+
 // AzureCredentialProperties - The base properties of Azure Credential
 type AzureCredentialProperties struct {
 	// REQUIRED; The kind of Azure credential
@@ -167,6 +190,32 @@ func (a *AzureServicePrincipalProperties) GetAzureCredentialProperties() *AzureC
 	}
 }
 
+// AzureWorkloadIdentityProperties - The properties of Workload Identity credential storage
+type AzureWorkloadIdentityProperties struct {
+	// REQUIRED; clientId for WorkloadIdentity
+	ClientID *string
+
+	// REQUIRED; The kind of Azure credential
+	Kind *AzureCredentialKind
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// REQUIRED; tenantId for WorkloadIdentity
+	TenantID *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureWorkloadIdentityProperties.
+func (a *AzureWorkloadIdentityProperties) GetAzureCredentialProperties() *AzureCredentialProperties {
+	return &AzureCredentialProperties{
+		Kind: a.Kind,
+		ProvisioningState: a.ProvisioningState,
+	}
+}
+
 // ComponentsKhmx01SchemasGenericresourceAllof0 - Concrete proxy resource types can be created by aliasing this type using
 // a specific property type.
 type ComponentsKhmx01SchemasGenericresourceAllof0 struct {
@@ -229,6 +278,107 @@ type ErrorResponse struct {
 	Error *ErrorDetail
 }
 
+// GcpCredentialProperties - GCP Credential properties
+type GcpCredentialProperties struct {
+	// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpCredentialProperties.
+func (g *GcpCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties { return g }
+
+// GcpCredentialResource - Concrete tracked resource types can be created by aliasing this type using a specific property
+// type.
+type GcpCredentialResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The resource-specific properties for this resource.
+	Properties GcpCredentialPropertiesClassification
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// GcpCredentialResourceListResult - The response of a GcpCredentialResource list operation.
+type GcpCredentialResourceListResult struct {
+	// REQUIRED; The GcpCredentialResource items on this page
+	Value []*GcpCredentialResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// GcpCredentialResourceTagsUpdate - The type used for updating tags in GcpCredentialResource resources.
+type GcpCredentialResourceTagsUpdate struct {
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// GcpServiceAccountKeyCredentialProperties - GCP service account key credential storage properties
+type GcpServiceAccountKeyCredentialProperties struct {
+	// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+	// REQUIRED; The JSON key of the GCP service account
+	ServiceAccountKey *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpServiceAccountKeyCredentialProperties.
+func (g *GcpServiceAccountKeyCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties {
+	return &GcpCredentialProperties{
+		Kind: g.Kind,
+		ProvisioningState: g.ProvisioningState,
+	}
+}
+
+// GcpWorkloadIdentityFederationCredentialProperties - GCP workload identity federation credential storage properties
+type GcpWorkloadIdentityFederationCredentialProperties struct {
+	// REQUIRED; The audience of the workload identity pool provider, for example '//iam.googleapis.com/projects/{projectNumber}/locations/global/workloadIdentityPools/{pool}/providers/{provider}'
+	Audience *string
+
+	// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// The email of the GCP service account impersonated with the federated token
+	ServiceAccountEmail *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpWorkloadIdentityFederationCredentialProperties.
+func (g *GcpWorkloadIdentityFederationCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties {
+	return &GcpCredentialProperties{
+		Kind: g.Kind,
+		ProvisioningState: g.ProvisioningState,
+	}
+}
+
 // GenericResource - Represents resource data.
 type GenericResource struct {
 	// The resource-specific properties for this resource.
@@ -272,6 +422,81 @@ func (i *InternalCredentialStorageProperties) GetCredentialStorageProperties() *
 	}
 }
 
+// KubernetesCredentialProperties - Kubernetes Credential properties
+type KubernetesCredentialProperties struct {
+	// REQUIRED; The Kubernetes credential kind
+	Kind *KubernetesCredentialKind
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetKubernetesCredentialProperties implements the KubernetesCredentialPropertiesClassification interface for type KubernetesCredentialProperties.
+func (k *KubernetesCredentialProperties) GetKubernetesCredentialProperties() *KubernetesCredentialProperties { return k }
+
+// KubernetesCredentialResource - Concrete tracked resource types can be created by aliasing this type using a specific property
+// type.
+type KubernetesCredentialResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The resource-specific properties for this resource.
+	Properties KubernetesCredentialPropertiesClassification
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.k. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// KubernetesCredentialResourceListResult - The response of a KubernetesCredentialResource list operation.
+type KubernetesCredentialResourceListResult struct {
+	// REQUIRED; The KubernetesCredentialResource items on this page
+	Value []*KubernetesCredentialResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// KubernetesCredentialResourceTagsUpdate - The type used for updating tags in KubernetesCredentialResource resources.
+type KubernetesCredentialResourceTagsUpdate struct {
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// KubernetesKubeConfigCredentialProperties - Kubernetes kubeconfig credential storage properties
+type KubernetesKubeConfigCredentialProperties struct {
+	// REQUIRED; The Kubernetes credential kind
+	Kind *KubernetesCredentialKind
+
+	// REQUIRED; The kubeconfig used to connect to the Kubernetes cluster. Certificates, keys and tokens must be provided inline; exec plugins, auth providers and file paths are not supported.
+	KubeConfig *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetKubernetesCredentialProperties implements the KubernetesCredentialPropertiesClassification interface for type KubernetesKubeConfigCredentialProperties.
+func (k *KubernetesKubeConfigCredentialProperties) GetKubernetesCredentialProperties() *KubernetesCredentialProperties {
+	return &KubernetesCredentialProperties{
+		Kind: k.Kind,
+		ProvisioningState: k.ProvisioningState,
+	}
+}
+
 // PlaneResource - The plane resource
 type PlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -59,6 +59,58 @@ type AzureCredentialsClientUpdateOptions struct {
 	// placeholder for future optional parameters
 }
 
+// GcpCredentialsClientCreateOrUpdateOptions contains the optional parameters for the GcpCredentialsClient.CreateOrUpdate
+// method.
+type GcpCredentialsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientDeleteOptions contains the optional parameters for the GcpCredentialsClient.Delete method.
+type GcpCredentialsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientGetOptions contains the optional parameters for the GcpCredentialsClient.Get method.
+type GcpCredentialsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientListOptions contains the optional parameters for the GcpCredentialsClient.NewListPager method.
+type GcpCredentialsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientUpdateOptions contains the optional parameters for the GcpCredentialsClient.Update method.
+type GcpCredentialsClientUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientCreateOrUpdateOptions contains the optional parameters for the KubernetesCredentialsClient.CreateOrUpdate
+// method.
+type KubernetesCredentialsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientDeleteOptions contains the optional parameters for the KubernetesCredentialsClient.Delete method.
+type KubernetesCredentialsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientGetOptions contains the optional parameters for the KubernetesCredentialsClient.Get method.
+type KubernetesCredentialsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientListOptions contains the optional parameters for the KubernetesCredentialsClient.NewListPager method.
+type KubernetesCredentialsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientUpdateOptions contains the optional parameters for the KubernetesCredentialsClient.Update method.
+type KubernetesCredentialsClientUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // PlanesClientBeginCreateOrUpdateOptions contains the optional parameters for the PlanesClient.BeginCreateOrUpdate method.
 type PlanesClientBeginCreateOrUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -21,6 +21,8 @@ func unmarshalAwsCredentialPropertiesClassification(rawMsg json.RawMessage) (Aws
 	switch m["kind"] {
 	case string(AWSCredentialKindAccessKey):
 		b = &AwsAccessKeyCredentialProperties{}
+	case string(AWSCredentialKindIRSA):
+		b = &AwsIRSACredentialProperties{}
 	default:
 		b = &AwsCredentialProperties{}
 	}
@@ -42,6 +44,8 @@ func unmarshalAzureCredentialPropertiesClassification(rawMsg json.RawMessage) (A
 	switch m["kind"] {
 	case string(AzureCredentialKindServicePrincipal):
 		b = &AzureServicePrincipalProperties{}
+	case string(AzureCredentialKindWorkloadIdentity):
+		b = &AzureWorkloadIdentityProperties{}
 	default:
 		b = &AzureCredentialProperties{}
 	}
@@ -72,3 +76,47 @@ func unmarshalCredentialStoragePropertiesClassification(rawMsg json.RawMessage)
 	return b, nil
 }
 
+
+func unmarshalGcpCredentialPropertiesClassification(rawMsg json.RawMessage) (GcpCredentialPropertiesClassification, error) {
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(rawMsg, &m); err != nil {
+		return nil, err
+	}
+	var b GcpCredentialPropertiesClassification
+	switch m["kind"] {
+	case string(GCPCredentialKindServiceAccountKey):
+		b = &GcpServiceAccountKeyCredentialProperties{}
+	case string(GCPCredentialKindWorkloadIdentityFederation):
+		b = &GcpWorkloadIdentityFederationCredentialProperties{}
+	default:
+		b = &GcpCredentialProperties{}
+	}
+	if err := json.Unmarshal(rawMsg, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func unmarshalKubernetesCredentialPropertiesClassification(rawMsg json.RawMessage) (KubernetesCredentialPropertiesClassification, error) {
+	if rawMsg == nil {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(rawMsg, &m); err != nil {
+		return nil, err
+	}
+	var b KubernetesCredentialPropertiesClassification
+	switch m["kind"] {
+	case string(KubernetesCredentialKindKubeConfig):
+		b = &KubernetesKubeConfigCredentialProperties{}
+	default:
+		b = &KubernetesCredentialProperties{}
+	}
+	if err := json.Unmarshal(rawMsg, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
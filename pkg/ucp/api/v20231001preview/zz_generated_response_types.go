@@ -65,6 +65,64 @@ type AzureCredentialsClientUpdateResponse struct {
 	AzureCredentialResource
 }
 
+// GcpCredentialsClientCreateOrUpdateResponse contains the response from method GcpCredentialsClient.CreateOrUpdate.
+type GcpCredentialsClientCreateOrUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpCredentialsClientDeleteResponse contains the response from method GcpCredentialsClient.Delete.
+type GcpCredentialsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// GcpCredentialsClientGetResponse contains the response from method GcpCredentialsClient.Get.
+type GcpCredentialsClientGetResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpCredentialsClientListResponse contains the response from method GcpCredentialsClient.NewListPager.
+type GcpCredentialsClientListResponse struct {
+	// The response of a GcpCredentialResource list operation.
+	GcpCredentialResourceListResult
+}
+
+// GcpCredentialsClientUpdateResponse contains the response from method GcpCredentialsClient.Update.
+type GcpCredentialsClientUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// KubernetesCredentialsClientCreateOrUpdateResponse contains the response from method KubernetesCredentialsClient.CreateOrUpdate.
+type KubernetesCredentialsClientCreateOrUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	KubernetesCredentialResource
+}
+
+// KubernetesCredentialsClientDeleteResponse contains the response from method KubernetesCredentialsClient.Delete.
+type KubernetesCredentialsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// KubernetesCredentialsClientGetResponse contains the response from method KubernetesCredentialsClient.Get.
+type KubernetesCredentialsClientGetResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	KubernetesCredentialResource
+}
+
+// KubernetesCredentialsClientListResponse contains the response from method KubernetesCredentialsClient.NewListPager.
+type KubernetesCredentialsClientListResponse struct {
+	// The response of a KubernetesCredentialResource list operation.
+	KubernetesCredentialResourceListResult
+}
+
+// KubernetesCredentialsClientUpdateResponse contains the response from method KubernetesCredentialsClient.Update.
+type KubernetesCredentialsClientUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	KubernetesCredentialResource
+}
+
 // PlanesClientCreateOrUpdateResponse contains the response from method PlanesClient.BeginCreateOrUpdate.
 type PlanesClientCreateOrUpdateResponse struct {
 	// The plane resource
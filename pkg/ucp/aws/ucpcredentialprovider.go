@@ -20,9 +20,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -33,6 +36,9 @@ var _ aws.CredentialsProvider = (*UCPCredentialProvider)(nil)
 const (
 	// DefaultExpireDuration is the default access key expiry duration.
 	DefaultExpireDuration = time.Minute * time.Duration(15)
+
+	// defaultSTSRegion is the region of the STS endpoint used to assume the IRSA role when AWS_REGION is not set.
+	defaultSTSRegion = "us-east-1"
 )
 
 // UCPCredentialProvider is the implementation of aws.CredentialsProvider
@@ -48,6 +54,10 @@ type UCPCredentialOptions struct {
 
 	// Duration is the duration for the secret keys.
 	Duration time.Duration
+
+	// STSClient is the client used to assume the role of IRSA credentials. A client for the region of the
+	// AWS_REGION environment variable is created when nil.
+	STSClient stscreds.AssumeRoleWithWebIdentityAPIClient
 }
 
 // NewUCPCredentialProvider creates UCPCredentialProvider provider to fetch Secret Access key using UCP credential APIs.
@@ -73,6 +83,10 @@ func (c *UCPCredentialProvider) Retrieve(ctx context.Context) (aws.Credentials,
 		return aws.Credentials{}, err
 	}
 
+	if s.IsIRSA() {
+		return c.retrieveIRSA(ctx, s)
+	}
+
 	if s.AccessKeyID == "" || s.SecretAccessKey == "" {
 		return aws.Credentials{}, errors.New("invalid access key info")
 	}
@@ -90,3 +104,39 @@ func (c *UCPCredentialProvider) Retrieve(ctx context.Context) (aws.Credentials,
 
 	return value, nil
 }
+
+// retrieveIRSA assumes the IAM role of the IRSA credential with the web identity token projected into the pod and
+// returns the temporary credentials of the role.
+func (c *UCPCredentialProvider) retrieveIRSA(ctx context.Context, s *sdk_cred.AWSCredential) (aws.Credentials, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if s.RoleARN == "" {
+		return aws.Credentials{}, errors.New("invalid IRSA role info")
+	}
+
+	client := c.options.STSClient
+	if client == nil {
+		region := os.Getenv("AWS_REGION")
+		if region == "" {
+			region = defaultSTSRegion
+		}
+		client = sts.New(sts.Options{Region: region})
+	}
+
+	provider := stscreds.NewWebIdentityRoleProvider(client, s.RoleARN, stscreds.IdentityTokenFile(sdk_cred.AWSWebIdentityTokenFile()))
+	value, err := provider.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("failed to assume IRSA role %s: %w", s.RoleARN, err)
+	}
+
+	logger.Info(fmt.Sprintf("Retreived AWS Credential - IRSA RoleARN: %s", s.RoleARN))
+
+	value.Source = "radiusucp"
+	value.CanExpire = true
+	// Fetch the UCP credential again after Duration so that updates of the credential are applied.
+	if expires := time.Now().UTC().Add(c.options.Duration); value.Expires.IsZero() || value.Expires.After(expires) {
+		value.Expires = expires
+	}
+
+	return value, nil
+}
@@ -19,9 +19,14 @@ package aws
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/stretchr/testify/require"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
@@ -49,6 +54,25 @@ func newMockProvider() *mockProvider {
 	}
 }
 
+type fakeSTSClient struct {
+	roleARN string
+	token   string
+}
+
+// AssumeRoleWithWebIdentity records the role and the web identity token and returns fake temporary credentials.
+func (c *fakeSTSClient) AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	c.roleARN = aws.ToString(params.RoleArn)
+	c.token = aws.ToString(params.WebIdentityToken)
+	return &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &types.Credentials{
+			AccessKeyId:     aws.String("irsaid"),
+			SecretAccessKey: aws.String("irsasecret"),
+			SessionToken:    aws.String("irsasession"),
+			Expiration:      aws.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil
+}
+
 func TestNewUCPCredentialProvider(t *testing.T) {
 	p := NewUCPCredentialProvider(newMockProvider(), 0)
 	require.Equal(t, DefaultExpireDuration, p.options.Duration)
@@ -80,3 +104,49 @@ func TestRetrieve(t *testing.T) {
 		require.GreaterOrEqual(t, cred.Expires.Unix(), expectedExpiry.Unix())
 	})
 }
+
+func TestRetrieve_IRSA(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	err := os.WriteFile(tokenFile, []byte("webidentitytoken"), 0600)
+	require.NoError(t, err)
+	t.Setenv(sdk_cred.AWSWebIdentityTokenFileEnvVar, tokenFile)
+
+	t.Run("valid credential", func(t *testing.T) {
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:    "IRSA",
+				RoleARN: "arn:aws:iam::000000000000:role/radius",
+			},
+		}
+		stsClient := &fakeSTSClient{}
+		cp := NewUCPCredentialProvider(p, DefaultExpireDuration)
+		cp.options.STSClient = stsClient
+
+		expectedExpiry := time.Now().UTC().Add(DefaultExpireDuration)
+		cred, err := cp.Retrieve(context.TODO())
+		require.NoError(t, err)
+
+		require.Equal(t, "arn:aws:iam::000000000000:role/radius", stsClient.roleARN)
+		require.Equal(t, "webidentitytoken", stsClient.token)
+		require.Equal(t, "irsaid", cred.AccessKeyID)
+		require.Equal(t, "irsasecret", cred.SecretAccessKey)
+		require.Equal(t, "irsasession", cred.SessionToken)
+		require.Equal(t, "radiusucp", cred.Source)
+		require.True(t, cred.CanExpire)
+		require.GreaterOrEqual(t, cred.Expires.Unix(), expectedExpiry.Unix())
+		require.Less(t, cred.Expires.Unix(), time.Now().Add(time.Hour).Unix())
+	})
+
+	t.Run("missing role", func(t *testing.T) {
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind: "IRSA",
+			},
+		}
+		cp := NewUCPCredentialProvider(p, DefaultExpireDuration)
+		cp.options.STSClient = &fakeSTSClient{}
+
+		_, err := cp.Retrieve(context.TODO())
+		require.ErrorContains(t, err, "invalid IRSA role info")
+	})
+}
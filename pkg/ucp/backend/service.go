@@ -55,7 +55,9 @@ func (w *Service) Run(ctx context.Context) error {
 		return err
 	}
 
-	workerOpts := worker.Options{}
+	workerOpts := worker.Options{
+		DeadLetterScope: worker.DeadLetterScope("System.Resources", w.Options.Config.Env.RoleLocation),
+	}
 	if w.Options.Config.WorkerServer != nil {
 		if w.Options.Config.WorkerServer.MaxOperationConcurrency != nil {
 			workerOpts.MaxOperationConcurrency = *w.Options.Config.WorkerServer.MaxOperationConcurrency
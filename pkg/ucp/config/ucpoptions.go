@@ -48,6 +48,10 @@ type UCPOptions struct {
 type UCPDirectConnectionOptions struct {
 	// Endpoint is the URL endpoint for the connection.
 	Endpoint string `yaml:"endpoint"`
+
+	// TokenFile is the path to the file storing the bearer token used to authenticate with UCP when UCP requires
+	// authentication, for example the projected service account token "/var/run/secrets/kubernetes.io/serviceaccount/token".
+	TokenFile string `yaml:"tokenFile,omitempty"`
 }
 
 // NewConnectionFromUCPConfig creates a Connection for UCP endpoint. It checks if the connection kind is direct and if so,
@@ -58,6 +62,9 @@ func NewConnectionFromUCPConfig(option *UCPOptions, k8sConfig *rest.Config) (sdk
 		if option.Direct == nil || option.Direct.Endpoint == "" {
 			return nil, errors.New("the property .ucp.direct.endpoint is required when using a direct connection")
 		}
+		if option.Direct.TokenFile != "" {
+			return sdk.NewDirectConnectionWithCredentials(option.Direct.Endpoint, sdk.NewTokenFileCredentials(option.Direct.TokenFile))
+		}
 		return sdk.NewDirectConnection(option.Direct.Endpoint)
 	}
 	return sdk.NewKubernetesConnectionFromConfig(k8sConfig)
@@ -51,10 +51,10 @@ func NewAzureCredentialProvider(provider *provider.SecretProvider, ucpConn sdk.C
 	}, nil
 }
 
-// Fetch fetches the Azure service principal credentials from UCP and the internal storage (e.g.
+// Fetch fetches the Azure service principal or workload identity credentials from UCP and the internal storage (e.g.
 // Kubernetes secret store) and returns an AzureCredential struct. If an error occurs, an error is returned.
 func (p *AzureCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*AzureCredential, error) {
-	// 1. Fetch the secret name of Azure service principal credentials from UCP.
+	// 1. Fetch the secret name of Azure credentials from UCP.
 	cred, err := p.client.Get(ctx, planeName, name, &ucpapi.AzureCredentialsClientGetOptions{})
 	if err != nil {
 		return nil, err
@@ -71,6 +71,13 @@ func (p *AzureCredentialProvider) Fetch(ctx context.Context, planeName, name str
 		default:
 			return nil, errors.New("invalid AzureServicePrincipalProperties")
 		}
+	case *ucpapi.AzureWorkloadIdentityProperties:
+		switch c := p.Storage.(type) {
+		case *ucpapi.InternalCredentialStorageProperties:
+			storage = c
+		default:
+			return nil, errors.New("invalid AzureWorkloadIdentityProperties")
+		}
 	default:
 		return nil, errors.New("invalid InternalCredentialStorageProperties")
 	}
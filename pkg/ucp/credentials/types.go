@@ -18,6 +18,7 @@ package credentials
 
 import (
 	"context"
+	"os"
 
 	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
 )
@@ -28,6 +29,27 @@ const (
 
 	// AWSPublic represents the aws public cloud plane name for UCP.
 	AWSPublic = "aws"
+
+	// GCPPublic represents the gcp public cloud plane name for UCP.
+	GCPPublic = "gcp"
+
+	// AzureFederatedTokenFileEnvVar is the environment variable set by the Azure workload identity webhook to the path
+	// of the federated token projected into the pod.
+	AzureFederatedTokenFileEnvVar = "AZURE_FEDERATED_TOKEN_FILE"
+	// DefaultAzureFederatedTokenFile is the path of the federated token projected by the Azure workload identity webhook.
+	DefaultAzureFederatedTokenFile = "/var/run/secrets/azure/tokens/azure-identity-token"
+
+	// AWSWebIdentityTokenFileEnvVar is the environment variable set by the EKS pod identity webhook to the path of
+	// the web identity token projected into the pod.
+	AWSWebIdentityTokenFileEnvVar = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	// DefaultAWSWebIdentityTokenFile is the path of the web identity token projected by the EKS pod identity webhook.
+	DefaultAWSWebIdentityTokenFile = "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"
+
+	// GCPWorkloadIdentityTokenFileEnvVar is the environment variable to override the path of the Kubernetes service
+	// account token exchanged for GCP credentials by workload identity federation.
+	GCPWorkloadIdentityTokenFileEnvVar = "GCP_WORKLOAD_IDENTITY_TOKEN_FILE"
+	// DefaultGCPWorkloadIdentityTokenFile is the path of the Kubernetes service account token of the pod.
+	DefaultGCPWorkloadIdentityTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 )
 
 type (
@@ -35,6 +57,10 @@ type (
 	AzureCredential = ucp_dm.AzureCredentialProperties
 	// AWSCredential represents a credential for AWS IAM.
 	AWSCredential = ucp_dm.AWSCredentialProperties
+	// GCPCredential represents a credential for GCP IAM.
+	GCPCredential = ucp_dm.GCPCredentialProperties
+	// KubernetesCredential represents a credential for a Kubernetes cluster.
+	KubernetesCredential = ucp_dm.KubernetesCredentialProperties
 )
 
 // CredentialProvider is an UCP credential provider interface.
@@ -42,3 +68,27 @@ type CredentialProvider[T any] interface {
 	// Fetch gets the credentials from secret storage.
 	Fetch(ctx context.Context, planeName, name string) (*T, error)
 }
+
+// AzureFederatedTokenFile returns the path of the federated token used by the Azure workload identity credentials.
+func AzureFederatedTokenFile() string {
+	if path := os.Getenv(AzureFederatedTokenFileEnvVar); path != "" {
+		return path
+	}
+	return DefaultAzureFederatedTokenFile
+}
+
+// AWSWebIdentityTokenFile returns the path of the web identity token used by the AWS IRSA credentials.
+func AWSWebIdentityTokenFile() string {
+	if path := os.Getenv(AWSWebIdentityTokenFileEnvVar); path != "" {
+		return path
+	}
+	return DefaultAWSWebIdentityTokenFile
+}
+
+// GCPWorkloadIdentityTokenFile returns the path of the token used by the GCP workload identity federation credentials.
+func GCPWorkloadIdentityTokenFile() string {
+	if path := os.Getenv(GCPWorkloadIdentityTokenFileEnvVar); path != "" {
+		return path
+	}
+	return DefaultGCPWorkloadIdentityTokenFile
+}
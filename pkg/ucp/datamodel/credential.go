@@ -23,8 +23,19 @@ const (
 	InternalStorageKind = "Internal"
 	// AzureCredentialKind represents ucp credential kind for azure credentials.
 	AzureCredentialKind = "ServicePrincipal"
+	// AzureWorkloadIdentityCredentialKind represents ucp credential kind for azure workload identity credentials.
+	AzureWorkloadIdentityCredentialKind = "WorkloadIdentity"
 	// AWSCredentialKind represents ucp credential kind for aws credentials.
 	AWSCredentialKind = "AccessKey"
+	// AWSIRSACredentialKind represents ucp credential kind for aws IAM roles for service accounts (IRSA) credentials.
+	AWSIRSACredentialKind = "IRSA"
+	// GCPServiceAccountKeyCredentialKind represents ucp credential kind for gcp service account key credentials.
+	GCPServiceAccountKeyCredentialKind = "ServiceAccountKey"
+	// GCPWorkloadIdentityFederationCredentialKind represents ucp credential kind for gcp workload identity federation
+	// credentials.
+	GCPWorkloadIdentityFederationCredentialKind = "WorkloadIdentityFederation"
+	// KubernetesKubeConfigCredentialKind represents ucp credential kind for kubernetes kubeconfig credentials.
+	KubernetesKubeConfigCredentialKind = "KubeConfig"
 )
 
 // Credential represents UCP Credential.
@@ -51,11 +62,35 @@ func (c *AWSCredential) ResourceTypeName() string {
 	return c.Type
 }
 
+// Credential represents UCP Credential.
+type GCPCredential struct {
+	v1.BaseResource
+
+	Properties *GCPCredentialResourceProperties `json:"properties,omitempty"`
+}
+
+// ResourceTypeName gives the type of ucp resource.
+func (c *GCPCredential) ResourceTypeName() string {
+	return c.Type
+}
+
+// Credential represents UCP Credential.
+type KubernetesCredential struct {
+	v1.BaseResource
+
+	Properties *KubernetesCredentialResourceProperties `json:"properties,omitempty"`
+}
+
+// ResourceTypeName gives the type of ucp resource.
+func (c *KubernetesCredential) ResourceTypeName() string {
+	return c.Type
+}
+
 // Azure Credential Properties represents UCP Credential Properties.
 type AzureCredentialResourceProperties struct {
 	// Kind is the kind of azure credential resource.
 	Kind string `json:"kind,omitempty"`
-	// AzureCredential is the azure service principal credentials.
+	// AzureCredential is the azure service principal or workload identity credentials.
 	AzureCredential *AzureCredentialProperties `json:"azureCredential,omitempty"`
 	// Storage contains the properties of the storage associated with the kind.
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
@@ -65,28 +100,91 @@ type AzureCredentialResourceProperties struct {
 type AWSCredentialResourceProperties struct {
 	// Kind is the kind of aws credential resource.
 	Kind string `json:"kind,omitempty"`
-	// AWSCredential is the aws iam credentials.
+	// AWSCredential is the aws iam access keys or IRSA credentials.
 	AWSCredential *AWSCredentialProperties `json:"awsCredential,omitempty"`
 	// Storage contains the properties of the storage associated with the kind.
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
 }
 
+// GCP Credential Properties represents UCP Credential Properties.
+type GCPCredentialResourceProperties struct {
+	// Kind is the kind of gcp credential resource.
+	Kind string `json:"kind,omitempty"`
+	// GCPCredential is the gcp service account key or workload identity federation credentials.
+	GCPCredential *GCPCredentialProperties `json:"gcpCredential,omitempty"`
+	// Storage contains the properties of the storage associated with the kind.
+	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+}
+
+// Kubernetes Credential Properties represents UCP Credential Properties.
+type KubernetesCredentialResourceProperties struct {
+	// Kind is the kind of kubernetes credential resource.
+	Kind string `json:"kind,omitempty"`
+	// KubernetesCredential is the kubeconfig credential used to connect to the cluster.
+	KubernetesCredential *KubernetesCredentialProperties `json:"kubernetesCredential,omitempty"`
+	// Storage contains the properties of the storage associated with the kind.
+	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+}
+
 // AzureCredentialProperties contains ucp Azure credential properties.
 type AzureCredentialProperties struct {
-	// TenantID represents the tenantId of azure service principal.
+	// Kind is the kind of azure credential. The credentials saved without kind are service principal credentials.
+	Kind string `json:"kind,omitempty"`
+	// TenantID represents the tenantId of azure service principal or workload identity.
 	TenantID string `json:"tenantId"`
-	// ClientID represents the clientId of azure service principal.
+	// ClientID represents the clientId of azure service principal or workload identity.
 	ClientID string `json:"clientId"`
-	// ClientSecret represents the client secret of service principal.
+	// ClientSecret represents the client secret of service principal. Workload identity uses the federated token
+	// projected into the pod instead of a secret.
 	ClientSecret string `json:"clientSecret,omitempty"`
 }
 
+// IsWorkloadIdentity returns true if the credential is an azure workload identity credential.
+func (c *AzureCredentialProperties) IsWorkloadIdentity() bool {
+	return c.Kind == AzureWorkloadIdentityCredentialKind
+}
+
 // AWSCredentialProperties contains ucp AWS credential properties.
 type AWSCredentialProperties struct {
+	// Kind is the kind of aws credential. The credentials saved without kind are access key credentials.
+	Kind string `json:"kind,omitempty"`
 	// AccessKeyID contains aws access key for iam.
-	AccessKeyID string `json:"accessKeyId"`
+	AccessKeyID string `json:"accessKeyId,omitempty"`
 	// SecretAccessKey contains secret access key for iam.
 	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	// RoleARN is the ARN of the IAM role assumed with the web identity token projected into the pod for IRSA.
+	RoleARN string `json:"roleARN,omitempty"`
+}
+
+// IsIRSA returns true if the credential is an aws IAM roles for service accounts (IRSA) credential.
+func (c *AWSCredentialProperties) IsIRSA() bool {
+	return c.Kind == AWSIRSACredentialKind
+}
+
+// GCPCredentialProperties contains ucp GCP credential properties.
+type GCPCredentialProperties struct {
+	// Kind is the kind of gcp credential.
+	Kind string `json:"kind"`
+	// ServiceAccountKey contains the JSON key of the gcp service account.
+	ServiceAccountKey string `json:"serviceAccountKey,omitempty"`
+	// Audience is the audience of the workload identity pool provider that the token projected into the pod is
+	// exchanged with for workload identity federation.
+	Audience string `json:"audience,omitempty"`
+	// ServiceAccountEmail is the email of the service account impersonated with the federated token.
+	ServiceAccountEmail string `json:"serviceAccountEmail,omitempty"`
+}
+
+// IsWorkloadIdentityFederation returns true if the credential is a gcp workload identity federation credential.
+func (c *GCPCredentialProperties) IsWorkloadIdentityFederation() bool {
+	return c.Kind == GCPWorkloadIdentityFederationCredentialKind
+}
+
+// KubernetesCredentialProperties contains ucp Kubernetes credential properties.
+type KubernetesCredentialProperties struct {
+	// Kind is the kind of kubernetes credential.
+	Kind string `json:"kind"`
+	// KubeConfig contains the kubeconfig used to connect to the cluster.
+	KubeConfig string `json:"kubeConfig,omitempty"`
 }
 
 // CredentialStorageProperties contains ucp credential storage properties.
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// KubernetesResource represents any resource in a Kubernetes cluster.
+// KubernetesResource is not a tracked resource, so it does not implement ResourceDataModel.
+// However need to implement the methods to satisfy the interface.
+type KubernetesResource struct {
+}
+
+// GetSystemData is not implemented for Kubernetes proxy resource.
+func (k *KubernetesResource) GetSystemData() *v1.SystemData {
+	return nil
+}
+
+// GetBaseResource is not implemented for Kubernetes proxy resource.
+func (k *KubernetesResource) GetBaseResource() *v1.BaseResource {
+	return nil
+}
+
+// ProvisioningState is not implemented for Kubernetes proxy resource.
+func (k *KubernetesResource) ProvisioningState() v1.ProvisioningState {
+	return v1.ProvisioningState("")
+}
+
+// SetProvisioningState is not implemented for Kubernetes proxy resource.
+func (k *KubernetesResource) SetProvisioningState(state v1.ProvisioningState) {
+
+}
+
+// UpdateMetadata is not implemented for Kubernetes proxy resource.
+func (k *KubernetesResource) UpdateMetadata(ctx *v1.ARMRequestContext, oldResource *v1.BaseResource) {
+
+}
+
+// ResourceTypeName returns the resource type name.
+func (k *KubernetesResource) ResourceTypeName() string {
+	return "UCP/KubernetesResource"
+}
+
+// KubernetesOperation records an asynchronous operation on a Kubernetes resource. The cluster does not track
+// operations, so the record is used to evaluate the status of the operation from the state of the resource.
+type KubernetesOperation struct {
+	// ID is the resource ID of the operation status.
+	ID string `json:"id"`
+
+	// LinkedResourceID is the UCP ID of the Kubernetes resource the operation applies to.
+	LinkedResourceID string `json:"resourceID"`
+
+	// APIVersion is the Kubernetes apiVersion of the resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Method is the method of the operation, PUT or DELETE.
+	Method string `json:"method"`
+
+	// StartTime is the time the operation started.
+	StartTime time.Time `json:"startTime"`
+
+	// UID is the UID of the resource written or deleted by the operation.
+	UID string `json:"uid,omitempty"`
+
+	// Generation is the generation of the resource written by the operation.
+	Generation int64 `json:"generation,omitempty"`
+
+	// Deadline is the time after which the operation fails if it has not completed.
+	Deadline time.Time `json:"deadline"`
+
+	// Status is the terminal status of the operation. It is recorded when the operation completes.
+	Status v1.ProvisioningState `json:"status,omitempty"`
+
+	// EndTime is the time the operation completed. It is nil while the operation is in progress.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Error is the error of the operation if it failed.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+}
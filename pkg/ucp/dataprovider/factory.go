@@ -18,26 +18,35 @@ package dataprovider
 
 import (
 	context "context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/radius-project/radius/pkg/kubeutil"
 	store "github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/apiserverstore"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/radius-project/radius/pkg/ucp/store/cosmosdb"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
+	"github.com/radius-project/radius/pkg/ucp/store/postgresstore"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	// Registers the "postgres" database/sql driver.
+	_ "github.com/lib/pq"
 )
 
 type storageFactoryFunc func(context.Context, StorageProviderOptions, string) (store.StorageClient, error)
 
 var storageClientFactory = map[StorageProviderType]storageFactoryFunc{
-	TypeAPIServer: initAPIServerClient,
-	TypeCosmosDB:  initCosmosDBClient,
-	TypeETCD:      InitETCDClient,
+	TypeAPIServer:  initAPIServerClient,
+	TypeCosmosDB:   initCosmosDBClient,
+	TypeETCD:       InitETCDClient,
+	TypePostgreSQL: initPostgreSQLClient,
+	TypeBolt:       initBoltClient,
 }
 
 func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
@@ -117,3 +126,60 @@ func InitETCDClient(ctx context.Context, opt StorageProviderOptions, _ string) (
 	etcdClient := etcdstore.NewETCDClient(client)
 	return etcdClient, nil
 }
+
+var (
+	// postgresDBs holds the connection pools used by PostgreSQL storage clients. The storage provider creates one client per
+	// resource type, but all resource types share the same table, so we share one pool per connection URL.
+	postgresDBs   = map[string]*sql.DB{}
+	postgresDBsMu sync.Mutex
+)
+
+func initPostgreSQLClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	if opt.PostgreSQL.URL == "" {
+		return nil, errors.New("failed to initialize PostgreSQL client: url is required")
+	}
+
+	postgresDBsMu.Lock()
+	defer postgresDBsMu.Unlock()
+
+	db, ok := postgresDBs[opt.PostgreSQL.URL]
+	if !ok {
+		var err error
+		db, err = sql.Open("postgres", opt.PostgreSQL.URL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize PostgreSQL client - configuration may be invalid: %w", err)
+		}
+
+		if opt.PostgreSQL.MaxOpenConnections > 0 {
+			db.SetMaxOpenConns(opt.PostgreSQL.MaxOpenConnections)
+		}
+
+		if err = db.PingContext(ctx); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("failed to connect to PostgreSQL: %w", err)
+		}
+
+		postgresDBs[opt.PostgreSQL.URL] = db
+	}
+
+	client := postgresstore.NewPostgresClient(db)
+	if err := client.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func initBoltClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	db, err := boltstore.OpenDB(opt.Bolt.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	client := boltstore.NewBoltClient(db)
+	if err := client.Init(ctx); err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	return client, nil
+}
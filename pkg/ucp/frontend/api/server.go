@@ -19,12 +19,15 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
@@ -35,6 +38,8 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
 	azure_frontend "github.com/radius-project/radius/pkg/ucp/frontend/azure"
+	gcp_frontend "github.com/radius-project/radius/pkg/ucp/frontend/gcp"
+	kubernetes_frontend "github.com/radius-project/radius/pkg/ucp/frontend/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	radius_frontend "github.com/radius-project/radius/pkg/ucp/frontend/radius"
 	"github.com/radius-project/radius/pkg/ucp/frontend/versions"
@@ -50,6 +55,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	kube_rest "k8s.io/client-go/rest"
 )
 
 const (
@@ -74,6 +80,9 @@ type ServiceOptions struct {
 	UCPConnection           sdk.Connection
 	Location                string
 
+	// KubeConfig is the configuration of the connection to Kubernetes, used by the TokenReview authentication.
+	KubeConfig *kube_rest.Config
+
 	// Modules is a list of modules that will be registered with the router.
 	Modules []modules.Initializer
 }
@@ -91,6 +100,8 @@ func DefaultModules(options modules.Options) []modules.Initializer {
 	return []modules.Initializer{
 		aws_frontend.NewModule(options),
 		azure_frontend.NewModule(options),
+		gcp_frontend.NewModule(options),
+		kubernetes_frontend.NewModule(options),
 		radius_frontend.NewModule(options),
 	}
 }
@@ -135,6 +146,7 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		SecretProvider: s.secretProvider,
 		SpecLoader:     specLoader,
 		UCPConnection:  s.options.UCPConnection,
+		KubeConfig:     s.options.KubeConfig,
 	}
 
 	modules := DefaultModules(moduleOptions)
@@ -152,8 +164,34 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		return nil, err
 	}
 
+	if s.options.Config.Authorization.Enabled && !s.options.Config.Authentication.Enabled {
+		return nil, authorization.ErrAuthenticationRequired
+	}
+
+	authenticator, err := authentication.New(s.options.Config.Authentication, s.options.KubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizer, err := authorization.NewAuthorizer(s.options.Config.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
 	app := http.Handler(r)
+	if authorizer != nil {
+		app = authorization.Authorize(authorizer)(app)
+	}
 	app = servicecontext.ARMRequestCtx(s.options.PathBase, "global")(app)
+	if authenticator != nil {
+		// The discovery documents are fetched by the Kubernetes API server to serve the APIService of UCP.
+		app = authentication.Authenticate(authenticator,
+			"/version",
+			"/healthz",
+			s.options.PathBase,
+			s.options.PathBase+"/openapi/v2",
+			s.options.PathBase+"/openapi/v3")(app)
+	}
 	app = middleware.WithLogger(app)
 
 	app = otelhttp.NewHandler(
@@ -177,6 +215,12 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 			return ctx
 		},
 	}
+
+	if s.options.Config.Authentication.RequestsClientCertificate() {
+		// The client certificate is verified by the authenticator of the requests proxied by the Kubernetes API server.
+		server.TLSConfig = &tls.Config{ClientAuth: tls.RequestClientCert}
+	}
+
 	return server, nil
 }
 
@@ -108,10 +108,12 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		// Note that the API validation is not applied for CatchAllPath(/*).
 		{
 			// Method deliberately omitted. This is a catch-all route for proxying.
-			ParentRouter:      baseRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
-			ControllerFactory: planes_ctrl.NewProxyController,
+			ParentRouter:  baseRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return planes_ctrl.NewProxyController(opt, nil)
+			},
 		},
 	}
 
@@ -52,14 +52,18 @@ func NewCreateOrUpdateAWSCredential(opts armrpc_controller.Options, secretClient
 
 // CreateOrUpdateAWSCredential validates the request, saves the AWS credential secret, and saves the resource in the
 // metadata store. If an error occurs, it returns an error response.
-func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (resp armrpc_rest.Response, err error) {
+	defer func() { c.RecordSyncOperation(ctx, resp, err) }()
+
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := c.GetResourceFromRequest(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	if newResource.Properties.Kind != datamodel.AWSCredentialKind {
+	switch newResource.Properties.Kind {
+	case datamodel.AWSCredentialKind, datamodel.AWSIRSACredentialKind:
+	default:
 		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
 	}
 
@@ -63,6 +63,15 @@ func Test_AWS_Credential(t *testing.T) {
 			fn:         setupCredentialSuccessMocks,
 			err:        nil,
 		},
+		{
+			name:       "test_irsa_credential_creation",
+			filename:   "aws-irsa-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/aws/awscloud/providers/System.AWS/credentials/default?api-version=2023-10-01-preview",
+			expected:   getAwsIRSAResponse(),
+			fn:         setupCredentialSuccessMocks,
+			err:        nil,
+		},
 		{
 			name:       "test_invalid_version_credential_resource",
 			filename:   "aws-credential.json",
@@ -165,6 +174,26 @@ func getAwsResponse() armrpc_rest.Response {
 	}, map[string]string{"ETag": ""})
 }
 
+func getAwsIRSAResponse() armrpc_rest.Response {
+	return armrpc_rest.NewOKResponseWithHeaders(&v20231001preview.AwsCredentialResource{
+		Location: to.Ptr("West US"),
+		ID:       to.Ptr("/planes/aws/awscloud/providers/System.AWS/credentials/default"),
+		Name:     to.Ptr("default"),
+		Type:     to.Ptr("System.AWS/credentials"),
+		Tags: map[string]*string{
+			"env": to.Ptr("dev"),
+		},
+		Properties: &v20231001preview.AwsIRSACredentialProperties{
+			RoleARN: to.Ptr("arn:aws:iam::000000000000:role/radius"),
+			Kind:    to.Ptr(v20231001preview.AWSCredentialKindIRSA),
+			Storage: &v20231001preview.InternalCredentialStorageProperties{
+				Kind:       to.Ptr(v20231001preview.CredentialStorageKindInternal),
+				SecretName: to.Ptr("aws-awscloud-default"),
+			},
+		},
+	}, map[string]string{"ETag": ""})
+}
+
 func setupCredentialSuccessMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
 	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
 		return nil, &store.ErrNotFound{ID: id}
@@ -56,7 +56,9 @@ func NewDeleteAWSCredential(opts armrpc_controller.Options, secretClient secret.
 
 // Run() checks if the AWS Credential exists, deletes the associated secret, and then deletes the AWS Credential from storage.
 // If the AWS Credential does not exist, it returns a No Content response. If an error occurs, it returns an error.
-func (c *DeleteAWSCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+func (c *DeleteAWSCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (resp armrpc_rest.Response, err error) {
+	defer func() { c.RecordSyncOperation(ctx, resp, err) }()
+
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -54,14 +54,18 @@ func NewCreateOrUpdateAzureCredential(opts armrpc_controller.Options, secretClie
 // metadata store with the new resource, setting the provisioning state to succeeded. If an invalid credential kind is
 // provided, a bad request response is returned. If an error occurs while saving the secret or the resource, an error is
 // returned.
-func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (resp armrpc_rest.Response, err error) {
+	defer func() { c.RecordSyncOperation(ctx, resp, err) }()
+
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := c.GetResourceFromRequest(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	if newResource.Properties.Kind != datamodel.AzureCredentialKind {
+	switch newResource.Properties.Kind {
+	case datamodel.AzureCredentialKind, datamodel.AzureWorkloadIdentityCredentialKind:
+	default:
 		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
 	}
 
@@ -56,7 +56,9 @@ func NewDeleteAzureCredential(opts armrpc_controller.Options, secretClient secre
 
 // "Run" retrieves the existing credential, deletes the associated secret, and then deletes the
 // credential from storage, returning an OK response if successful or an error if not.
-func (c *DeleteAzureCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+func (c *DeleteAzureCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (resp armrpc_rest.Response, err error) {
+	defer func() { c.RecordSyncOperation(ctx, resp, err) }()
+
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -0,0 +1,217 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/golang/mock/gomock"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Kubernetes_Credential(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockStorageClient := store.NewMockStorageClient(mockCtrl)
+	mockSecretClient := secret.NewMockClient(mockCtrl)
+
+	credentialCtrl, err := NewCreateOrUpdateKubernetesCredential(armrpc_controller.Options{StorageClient: mockStorageClient}, mockSecretClient)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		filename   string
+		headerfile string
+		url        string
+		expected   armrpc_rest.Response
+		fn         func(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient)
+		err        error
+	}{
+		{
+			name:       "test_credential_creation",
+			filename:   "kubernetes-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default?api-version=2023-10-01-preview",
+			expected:   getKubernetesResponse(),
+			fn:         setupCredentialSuccessMocks,
+			err:        nil,
+		},
+		{
+			name:       "test_invalid_version_credential_resource",
+			filename:   "kubernetes-credential.json",
+			headerfile: testHeaderFileWithBadAPIVersion,
+			url:        "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default?api-version=bad",
+			expected:   nil,
+			fn:         setupEmptyMocks,
+			err:        v1.ErrUnsupportedAPIVersion,
+		},
+		{
+			name:       "test_invalid_credential_request",
+			filename:   "invalid-request-kubernetes-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default?api-version=2023-10-01-preview",
+			expected:   nil,
+			fn:         setupEmptyMocks,
+			err: &v1.ErrModelConversion{
+				PropertyName: "$.properties",
+				ValidValue:   "not nil",
+			},
+		},
+		{
+			name:       "test_credential_exec_kubeconfig",
+			filename:   "exec-kubernetes-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default?api-version=2023-10-01-preview",
+			expected:   armrpc_rest.NewBadRequestResponse(`Invalid kubeconfig: user "user1": exec is not supported, use token or client-certificate-data instead`),
+			fn:         setupEmptyMocks,
+			err:        nil,
+		},
+		{
+			name:       "test_credential_created",
+			filename:   "kubernetes-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default?api-version=2023-10-01-preview",
+			expected:   getKubernetesResponse(),
+			fn:         setupCredentialNotFoundMocks,
+			err:        nil,
+		},
+		{
+			name:       "test_credential_notFoundError",
+			filename:   "kubernetes-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default?api-version=2023-10-01-preview",
+			fn:         setupCredentialNotFoundErrorMocks,
+			err:        errors.New("Error"),
+		},
+		{
+			name:       "test_credential_get_failure",
+			filename:   "kubernetes-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default?api-version=2023-10-01-preview",
+			fn:         setupCredentialGetFailMocks,
+			err:        errors.New("Failed Get"),
+		},
+		{
+			name:       "test_credential_secret_save_failure",
+			filename:   "kubernetes-credential.json",
+			headerfile: testHeaderFile,
+			url:        "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default?api-version=2023-10-01-preview",
+			fn:         setupCredentialSecretSaveFailMocks,
+			err:        errors.New("Secret Save Failure"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(*mockStorageClient, *mockSecretClient)
+
+			credentialVersionedInput := &v20231001preview.KubernetesCredentialResource{}
+			credentialInput := testutil.ReadFixture(tt.filename)
+			err = json.Unmarshal(credentialInput, credentialVersionedInput)
+			require.NoError(t, err)
+
+			request, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, tt.headerfile, credentialVersionedInput)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(request)
+
+			response, err := credentialCtrl.Run(ctx, nil, request)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, response)
+			}
+		})
+	}
+
+}
+
+func getKubernetesResponse() armrpc_rest.Response {
+	return armrpc_rest.NewOKResponseWithHeaders(&v20231001preview.KubernetesCredentialResource{
+		Location: to.Ptr("West US"),
+		ID:       to.Ptr("/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default"),
+		Name:     to.Ptr("default"),
+		Type:     to.Ptr("System.Kubernetes/credentials"),
+		Tags: map[string]*string{
+			"env": to.Ptr("dev"),
+		},
+		Properties: &v20231001preview.KubernetesKubeConfigCredentialProperties{
+			Kind: to.Ptr(v20231001preview.KubernetesCredentialKindKubeConfig),
+			Storage: &v20231001preview.InternalCredentialStorageProperties{
+				Kind:       to.Ptr(v20231001preview.CredentialStorageKindInternal),
+				SecretName: to.Ptr("kubernetes-cluster1-default"),
+			},
+		},
+	}, map[string]string{"ETag": ""})
+}
+
+func setupCredentialSuccessMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+		return nil, &store.ErrNotFound{ID: id}
+	})
+	mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+}
+
+func setupEmptyMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+}
+
+func setupCredentialNotFoundMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+			return nil, &store.ErrNotFound{ID: id}
+		}).Times(1)
+	mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+}
+
+func setupCredentialNotFoundErrorMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+			return nil, errors.New("Error")
+		}).Times(1)
+}
+
+func setupCredentialGetFailMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+			return nil, errors.New("Failed Get")
+		}).Times(1)
+}
+
+func setupCredentialSecretSaveFailMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+			return nil, &store.ErrNotFound{ID: id}
+		}).Times(1)
+	mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Secret Save Failure")).Times(1)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	http "net/http"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8s_json "k8s.io/apimachinery/pkg/util/json"
+)
+
+var _ armrpc_controller.Controller = (*CreateOrUpdateKubernetesResource)(nil)
+
+// CreateOrUpdateKubernetesResource is the controller implementation to create/update a Kubernetes resource.
+type CreateOrUpdateKubernetesResource struct {
+	armrpc_controller.Operation[*datamodel.KubernetesResource, datamodel.KubernetesResource]
+	clientProvider ucp_kubernetes.ClientProvider
+}
+
+// NewCreateOrUpdateKubernetesResource creates a new CreateOrUpdateKubernetesResource.
+func NewCreateOrUpdateKubernetesResource(opts armrpc_controller.Options, clientProvider ucp_kubernetes.ClientProvider) (armrpc_controller.Controller, error) {
+	return &CreateOrUpdateKubernetesResource{
+		armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.KubernetesResource]{}),
+		clientProvider,
+	}, nil
+}
+
+// Run reads the manifest of the resource from the properties of the request body, creates the resource or updates
+// the existing resource in the cluster, and returns an async operation response. The status of the operation is
+// evaluated from the readiness of the resource.
+func (p *CreateOrUpdateKubernetesResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	defer req.Body.Close()
+	content, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Kubernetes JSON decoder preserves integers as int64, which the dynamic client expects.
+	body := map[string]any{}
+	err = k8s_json.Unmarshal(content, &body)
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse("failed to read request body"), nil
+	}
+
+	properties, ok := body["properties"].(map[string]any)
+	if !ok {
+		return armrpc_rest.NewBadRequestResponse("$.properties must contain the Kubernetes manifest of the resource"), nil
+	}
+
+	desired := &unstructured.Unstructured{Object: properties}
+	target, err := resolveTarget(ctx, p.clientProvider, serviceCtx.ResourceID, desired.GetAPIVersion())
+	if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	if errResponse := validateManifest(desired, target); errResponse != nil {
+		return errResponse, nil
+	}
+
+	desired.SetGroupVersionKind(target.mapping.GroupVersionKind)
+	desired.SetNamespace(target.namespace)
+	desired.SetName(target.name)
+
+	client := target.resourceClient()
+	var obj *unstructured.Unstructured
+	existing, err := client.Get(ctx, target.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		obj, err = client.Create(ctx, desired, metav1.CreateOptions{FieldManager: fieldManager})
+	} else if err == nil {
+		desired.SetResourceVersion(existing.GetResourceVersion())
+		obj, err = client.Update(ctx, desired, metav1.UpdateOptions{FieldManager: fieldManager})
+	}
+	if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	operationID := uuid.New()
+	if err := saveOperation(ctx, p.StorageClient(), newOperation(serviceCtx, operationID, http.MethodPut, obj)); err != nil {
+		return nil, err
+	}
+
+	responseBody := toResponseBody(serviceCtx.ResourceID, obj)
+	properties = responseBody["properties"].(map[string]any)
+	properties["provisioningState"] = v1.ProvisioningStateProvisioning
+
+	resp := armrpc_rest.NewAsyncOperationResponse(responseBody, v1.LocationGlobal, 201, serviceCtx.ResourceID, operationID, "", serviceCtx.ResourceID.RootScope(), p.Options().PathBase)
+	return resp, nil
+}
+
+// validateManifest checks that the kind, namespace and name of the manifest, when specified, match the resource ID.
+func validateManifest(desired *unstructured.Unstructured, target *kubernetesTarget) armrpc_rest.Response {
+	if kind := desired.GetKind(); kind != "" && kind != target.mapping.GroupVersionKind.Kind {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("$.properties.kind %q does not match the resource type %q", kind, target.mapping.GroupVersionKind.Kind))
+	}
+
+	if namespace := desired.GetNamespace(); namespace != "" && namespace != target.namespace {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("$.properties.metadata.namespace %q does not match the namespace %q", namespace, target.namespace))
+	}
+
+	if name := desired.GetName(); name != "" && name != target.name {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("$.properties.metadata.name %q does not match the resource name %q", name, target.name))
+	}
+
+	return nil
+}
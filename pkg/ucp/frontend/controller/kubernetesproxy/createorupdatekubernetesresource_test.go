@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_CreateOrUpdateKubernetesResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing bool
+	}{
+		{name: "create", existing: false},
+		{name: "update", existing: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientProvider := newTestClientProvider()
+			if tt.existing {
+				clientProvider = newTestClientProvider(newTestDeployment("test-deployment", 0))
+			}
+
+			var saved *datamodel.KubernetesOperation
+			storageClient := store.NewMockStorageClient(gomock.NewController(t))
+			storageClient.EXPECT().
+				Save(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+					saved = obj.Data.(*datamodel.KubernetesOperation)
+					require.Equal(t, saved.ID, obj.ID)
+					return nil
+				})
+
+			controller, err := NewCreateOrUpdateKubernetesResource(armrpc_controller.Options{StorageClient: storageClient}, clientProvider)
+			require.NoError(t, err)
+
+			body := map[string]any{
+				"properties": map[string]any{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"spec": map[string]any{
+						"replicas": int64(3),
+					},
+				},
+			}
+			request := newTestRequest(t, http.MethodPut, testResourcePath, body)
+
+			ctx := rpctest.NewARMRequestContext(request)
+			actualResponse, err := controller.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			response, ok := actualResponse.(*armrpc_rest.AsyncOperationResponse)
+			require.True(t, ok)
+			require.Equal(t, 201, response.Code)
+			require.Equal(t, "/planes/kubernetes/cluster1/namespaces/default", response.RootScope)
+
+			responseBody := response.Body.(map[string]any)
+			require.Equal(t, testResourcePath, responseBody["id"])
+			require.Equal(t, "test-deployment", responseBody["name"])
+			require.Equal(t, "apps/Deployment", responseBody["type"])
+			require.Equal(t, v1.ProvisioningStateProvisioning, responseBody["properties"].(map[string]any)["provisioningState"])
+
+			require.NotNil(t, saved)
+			require.True(t, strings.HasPrefix(saved.ID, "/planes/kubernetes/cluster1/namespaces/default/providers/apps/locations/global/operationstatuses/"))
+			require.True(t, strings.HasSuffix(saved.ID, response.OperationID.String()))
+			require.Equal(t, testResourcePath, saved.LinkedResourceID)
+			require.Equal(t, "apps/v1", saved.APIVersion)
+			require.Equal(t, http.MethodPut, saved.Method)
+			require.False(t, saved.Deadline.IsZero())
+
+			obj, err := clientProvider.clients.Dynamic.Resource(deploymentGVR).Namespace("default").Get(context.Background(), "test-deployment", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Equal(t, map[string]any{"replicas": int64(3)}, obj.Object["spec"])
+		})
+	}
+}
+
+func Test_CreateOrUpdateKubernetesResource_InvalidManifest(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]any
+	}{
+		{name: "kind mismatch", properties: map[string]any{"kind": "StatefulSet"}},
+		{name: "namespace mismatch", properties: map[string]any{"metadata": map[string]any{"namespace": "other"}}},
+		{name: "name mismatch", properties: map[string]any{"metadata": map[string]any{"name": "other"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller, err := NewCreateOrUpdateKubernetesResource(armrpc_controller.Options{}, newTestClientProvider())
+			require.NoError(t, err)
+
+			request := newTestRequest(t, http.MethodPut, testResourcePath, map[string]any{"properties": tt.properties})
+
+			ctx := rpctest.NewARMRequestContext(request)
+			actualResponse, err := controller.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			response, ok := actualResponse.(*armrpc_rest.BadRequestResponse)
+			require.True(t, ok)
+			require.Contains(t, response.Body.Error.Message, "does not match")
+		})
+	}
+}
+
+func Test_CreateOrUpdateKubernetesResource_ClusterNotRegistered(t *testing.T) {
+	clientProvider := &testClientProvider{err: &ucp_kubernetes.ErrClusterNotRegistered{PlaneName: "cluster1"}}
+	controller, err := NewCreateOrUpdateKubernetesResource(armrpc_controller.Options{}, clientProvider)
+	require.NoError(t, err)
+
+	request := newTestRequest(t, http.MethodPut, testResourcePath, map[string]any{"properties": map[string]any{}})
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	_, ok := actualResponse.(*armrpc_rest.NotFoundResponse)
+	require.True(t, ok)
+}
+
+func newTestRequest(t *testing.T, method string, path string, body any) *http.Request {
+	b, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(method, path, bytes.NewBuffer(b))
+	require.NoError(t, err)
+	return request
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	http "net/http"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ armrpc_controller.Controller = (*DeleteKubernetesResource)(nil)
+
+// DeleteKubernetesResource is the controller implementation to delete a Kubernetes resource.
+type DeleteKubernetesResource struct {
+	armrpc_controller.Operation[*datamodel.KubernetesResource, datamodel.KubernetesResource]
+	clientProvider ucp_kubernetes.ClientProvider
+}
+
+// NewDeleteKubernetesResource creates a new DeleteKubernetesResource controller with the given options and Kubernetes client provider.
+func NewDeleteKubernetesResource(opts armrpc_controller.Options, clientProvider ucp_kubernetes.ClientProvider) (armrpc_controller.Controller, error) {
+	return &DeleteKubernetesResource{
+		Operation:      armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.KubernetesResource]{}),
+		clientProvider: clientProvider,
+	}, nil
+}
+
+// Run deletes the resource from the cluster and returns an async operation response that completes once the resource
+// is gone. It returns a NoContentResponse if the resource does not exist.
+func (p *DeleteKubernetesResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	target, err := resolveTarget(ctx, p.clientProvider, serviceCtx.ResourceID, "")
+	if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	client := target.resourceClient()
+	existing, err := client.Get(ctx, target.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return armrpc_rest.NewNoContentResponse(), nil
+	} else if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	// Foreground deletion keeps the resource until its dependents are deleted, so the operation completes once
+	// everything owned by the resource is gone. The UID precondition ensures that the operation deletes the resource
+	// it recorded.
+	err = client.Delete(ctx, target.name, metav1.DeleteOptions{
+		PropagationPolicy: to.Ptr(metav1.DeletePropagationForeground),
+		Preconditions:     &metav1.Preconditions{UID: to.Ptr(existing.GetUID())},
+	})
+	if apierrors.IsNotFound(err) {
+		return armrpc_rest.NewNoContentResponse(), nil
+	} else if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	operationID := uuid.New()
+	if err := saveOperation(ctx, p.StorageClient(), newOperation(serviceCtx, operationID, http.MethodDelete, existing)); err != nil {
+		return nil, err
+	}
+
+	resp := armrpc_rest.NewAsyncOperationResponse(map[string]any{}, v1.LocationGlobal, 202, serviceCtx.ResourceID, operationID, "", serviceCtx.ResourceID.RootScope(), p.Options().PathBase)
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_DeleteKubernetesResource(t *testing.T) {
+	clientProvider := newTestClientProvider(newTestDeployment("test-deployment", 2))
+
+	var saved *datamodel.KubernetesOperation
+	storageClient := store.NewMockStorageClient(gomock.NewController(t))
+	storageClient.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			saved = obj.Data.(*datamodel.KubernetesOperation)
+			return nil
+		})
+
+	controller, err := NewDeleteKubernetesResource(armrpc_controller.Options{StorageClient: storageClient}, clientProvider)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodDelete, testResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	response, ok := actualResponse.(*armrpc_rest.AsyncOperationResponse)
+	require.True(t, ok)
+	require.Equal(t, 202, response.Code)
+
+	require.NotNil(t, saved)
+	require.Equal(t, testResourcePath, saved.LinkedResourceID)
+	require.Equal(t, http.MethodDelete, saved.Method)
+	require.Equal(t, "test-deployment-uid", saved.UID)
+	require.False(t, saved.Deadline.IsZero())
+
+	_, err = clientProvider.clients.Dynamic.Resource(deploymentGVR).Namespace("default").Get(context.Background(), "test-deployment", metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_DeleteKubernetesResource_NotFound(t *testing.T) {
+	controller, err := NewDeleteKubernetesResource(armrpc_controller.Options{}, newTestClientProvider())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodDelete, testResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	require.Equal(t, armrpc_rest.NewNoContentResponse(), actualResponse)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetKubernetesOperationResults(t *testing.T) {
+	operation := &datamodel.KubernetesOperation{
+		ID:               testOperationStatusID,
+		LinkedResourceID: testResourcePath,
+		APIVersion:       "apps/v1",
+		Method:           http.MethodPut,
+		StartTime:        time.Now().UTC(),
+		UID:              "test-deployment-uid",
+		Generation:       1,
+		Deadline:         time.Now().UTC().Add(time.Hour),
+	}
+
+	t.Run("in progress", func(t *testing.T) {
+		storageClient, _ := setupOperation(t, operation)
+		controller, err := NewGetKubernetesOperationResults(armrpc_controller.Options{StorageClient: storageClient}, newTestClientProvider(newTestDeployment("test-deployment", 0)))
+		require.NoError(t, err)
+
+		request, err := http.NewRequest(http.MethodGet, testOperationResultsPath, nil)
+		require.NoError(t, err)
+
+		ctx := rpctest.NewARMRequestContext(request)
+		actualResponse, err := controller.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		expectedResponse := armrpc_rest.NewAsyncOperationResultResponse(map[string]string{
+			"Location":    testOperationResultsPath,
+			"Retry-After": v1.DefaultRetryAfter,
+		})
+		require.Equal(t, expectedResponse, actualResponse)
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		storageClient, _ := setupOperation(t, operation)
+		controller, err := NewGetKubernetesOperationResults(armrpc_controller.Options{StorageClient: storageClient}, newTestClientProvider(newTestDeployment("test-deployment", 2)))
+		require.NoError(t, err)
+
+		request, err := http.NewRequest(http.MethodGet, testOperationResultsPath, nil)
+		require.NoError(t, err)
+
+		ctx := rpctest.NewARMRequestContext(request)
+		actualResponse, err := controller.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		require.Equal(t, armrpc_rest.NewNoContentResponse(), actualResponse)
+	})
+}
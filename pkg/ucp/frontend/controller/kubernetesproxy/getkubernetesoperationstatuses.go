@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+)
+
+var _ armrpc_controller.Controller = (*GetKubernetesOperationStatuses)(nil)
+
+// GetKubernetesOperationStatuses is the controller implementation to get Kubernetes resource operation status.
+type GetKubernetesOperationStatuses struct {
+	armrpc_controller.Operation[*datamodel.KubernetesResource, datamodel.KubernetesResource]
+	clientProvider ucp_kubernetes.ClientProvider
+}
+
+// NewGetKubernetesOperationStatuses creates a new GetKubernetesOperationStatuses controller which is used to get the statuses of Kubernetes operations.
+func NewGetKubernetesOperationStatuses(opts armrpc_controller.Options, clientProvider ucp_kubernetes.ClientProvider) (armrpc_controller.Controller, error) {
+	return &GetKubernetesOperationStatuses{
+		Operation:      armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.KubernetesResource]{}),
+		clientProvider: clientProvider,
+	}, nil
+}
+
+// Run loads the operation and evaluates its status from the state of the resource in the cluster. If the operation
+// is not found, it returns a NotFoundResponse.
+func (p *GetKubernetesOperationStatuses) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	status, err := getOperationStatus(ctx, p.StorageClient(), p.clientProvider, operationStatusIDFromRequest(serviceCtx.ResourceID))
+	if isNotFound(err) {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	return armrpc_rest.NewOKResponse(status), nil
+}
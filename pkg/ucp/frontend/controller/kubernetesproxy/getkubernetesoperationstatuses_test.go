@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func Test_GetKubernetesOperationStatuses(t *testing.T) {
+	startTime := time.Now().UTC().Add(-time.Minute)
+	endTime := startTime.Add(time.Second)
+
+	replaced := newTestDeployment("test-deployment", 2)
+	replaced.SetUID("other-uid")
+
+	updated := newTestDeployment("test-deployment", 2)
+	updated.SetGeneration(2)
+
+	tests := []struct {
+		name      string
+		method    string
+		objs      []runtime.Object
+		deadline  time.Time
+		recorded  *datamodel.KubernetesOperation
+		expected  v1.ProvisioningState
+		errorCode string
+	}{
+		{
+			name:     "put in progress",
+			method:   http.MethodPut,
+			objs:     []runtime.Object{newTestDeployment("test-deployment", 1)},
+			expected: v1.ProvisioningStateProvisioning,
+		},
+		{
+			name:     "put succeeded",
+			method:   http.MethodPut,
+			objs:     []runtime.Object{newTestDeployment("test-deployment", 2)},
+			expected: v1.ProvisioningStateSucceeded,
+		},
+		{
+			name:      "put resource removed",
+			method:    http.MethodPut,
+			expected:  v1.ProvisioningStateFailed,
+			errorCode: v1.CodeNotFound,
+		},
+		{
+			name:      "put resource replaced",
+			method:    http.MethodPut,
+			objs:      []runtime.Object{replaced},
+			expected:  v1.ProvisioningStateCanceled,
+			errorCode: v1.CodeOperationCanceled,
+		},
+		{
+			name:      "put superseded by another update",
+			method:    http.MethodPut,
+			objs:      []runtime.Object{updated},
+			expected:  v1.ProvisioningStateCanceled,
+			errorCode: v1.CodeOperationCanceled,
+		},
+		{
+			name:      "put timed out",
+			method:    http.MethodPut,
+			objs:      []runtime.Object{newTestDeployment("test-deployment", 1)},
+			deadline:  startTime,
+			expected:  v1.ProvisioningStateFailed,
+			errorCode: v1.CodeInternal,
+		},
+		{
+			name:     "delete in progress",
+			method:   http.MethodDelete,
+			objs:     []runtime.Object{newTestDeployment("test-deployment", 2)},
+			expected: v1.ProvisioningStateDeleting,
+		},
+		{
+			name:     "delete succeeded",
+			method:   http.MethodDelete,
+			expected: v1.ProvisioningStateSucceeded,
+		},
+		{
+			name:     "delete succeeded and resource recreated",
+			method:   http.MethodDelete,
+			objs:     []runtime.Object{replaced},
+			expected: v1.ProvisioningStateSucceeded,
+		},
+		{
+			name:     "recorded result",
+			method:   http.MethodPut,
+			objs:     []runtime.Object{newTestDeployment("test-deployment", 1)},
+			recorded: &datamodel.KubernetesOperation{Status: v1.ProvisioningStateSucceeded, EndTime: &endTime},
+			expected: v1.ProvisioningStateSucceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := &datamodel.KubernetesOperation{
+				ID:               testOperationStatusID,
+				LinkedResourceID: testResourcePath,
+				APIVersion:       "apps/v1",
+				Method:           tt.method,
+				StartTime:        startTime,
+				UID:              "test-deployment-uid",
+				Generation:       1,
+				Deadline:         tt.deadline,
+			}
+			if tt.deadline.IsZero() {
+				operation.Deadline = startTime.Add(time.Hour)
+			}
+			if tt.recorded != nil {
+				operation.Status = tt.recorded.Status
+				operation.EndTime = tt.recorded.EndTime
+			}
+			storageClient, saved := setupOperation(t, operation)
+
+			controller, err := NewGetKubernetesOperationStatuses(armrpc_controller.Options{StorageClient: storageClient}, newTestClientProvider(tt.objs...))
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodGet, testOperationStatusesPath, nil)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(request)
+			actualResponse, err := controller.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			response, ok := actualResponse.(*armrpc_rest.OKResponse)
+			require.True(t, ok)
+
+			status := response.Body.(*v1.AsyncOperationStatus)
+			require.Equal(t, testOperationStatusID, status.ID)
+			require.Equal(t, testOperationID, status.Name)
+			require.Equal(t, startTime, status.StartTime)
+			require.Equal(t, tt.expected, status.Status)
+			require.Equal(t, tt.expected.IsTerminal(), status.EndTime != nil)
+			if tt.errorCode == "" {
+				require.Nil(t, status.Error)
+			} else {
+				require.Equal(t, tt.errorCode, status.Error.Code)
+			}
+
+			// The result of an operation is recorded once when it completes.
+			if tt.expected.IsTerminal() && tt.recorded == nil {
+				require.NotNil(t, *saved)
+				require.Equal(t, tt.expected, (*saved).Status)
+				require.Equal(t, status.EndTime, (*saved).EndTime)
+			} else {
+				require.Nil(t, *saved)
+			}
+		})
+	}
+}
+
+func Test_GetKubernetesOperationStatuses_NotFound(t *testing.T) {
+	storageClient, _ := setupOperation(t, nil)
+
+	controller, err := NewGetKubernetesOperationStatuses(armrpc_controller.Options{StorageClient: storageClient}, newTestClientProvider())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testOperationStatusesPath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	id, err := resources.Parse(testOperationStatusesPath)
+	require.NoError(t, err)
+	require.Equal(t, armrpc_rest.NewNotFoundResponse(id), actualResponse)
+}
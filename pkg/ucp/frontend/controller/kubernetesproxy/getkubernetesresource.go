@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ armrpc_controller.Controller = (*GetKubernetesResource)(nil)
+
+// GetKubernetesResource is the controller implementation to get a Kubernetes resource.
+type GetKubernetesResource struct {
+	armrpc_controller.Operation[*datamodel.KubernetesResource, datamodel.KubernetesResource]
+	clientProvider ucp_kubernetes.ClientProvider
+}
+
+// NewGetKubernetesResource creates a new GetKubernetesResource controller with the given options and Kubernetes client provider.
+func NewGetKubernetesResource(opts armrpc_controller.Options, clientProvider ucp_kubernetes.ClientProvider) (armrpc_controller.Controller, error) {
+	return &GetKubernetesResource{
+		Operation:      armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.KubernetesResource]{}),
+		clientProvider: clientProvider,
+	}, nil
+}
+
+// Run gets the resource from the cluster registered as the plane of the request and returns it, or a NotFoundResponse
+// if the resource does not exist.
+func (p *GetKubernetesResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	target, err := resolveTarget(ctx, p.clientProvider, serviceCtx.ResourceID, "")
+	if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	obj, err := target.resourceClient().Get(ctx, target.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	return armrpc_rest.NewOKResponse(toResponseBody(serviceCtx.ResourceID, obj)), nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"net/http"
+	"testing"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetKubernetesResource(t *testing.T) {
+	deployment := newTestDeployment("test-deployment", 2)
+	controller, err := NewGetKubernetesResource(armrpc_controller.Options{}, newTestClientProvider(deployment))
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"id":         testResourcePath,
+		"name":       "test-deployment",
+		"type":       "apps/Deployment",
+		"properties": deployment.Object,
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+}
+
+func Test_GetKubernetesResource_NotFound(t *testing.T) {
+	controller, err := NewGetKubernetesResource(armrpc_controller.Options{}, newTestClientProvider())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	id, err := resources.ParseResource(testResourcePath)
+	require.NoError(t, err)
+	require.Equal(t, armrpc_rest.NewNotFoundResponse(id), actualResponse)
+}
+
+func Test_GetKubernetesResource_UnknownKind(t *testing.T) {
+	controller, err := NewGetKubernetesResource(armrpc_controller.Options{}, newTestClientProvider())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, "/planes/kubernetes/cluster1/namespaces/default/providers/example.com/Widget/test", nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	_, ok := actualResponse.(*armrpc_rest.BadRequestResponse)
+	require.True(t, ok)
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// fieldManager is the field manager used by UCP when writing resources to the cluster.
+	fieldManager = "ucp"
+
+	// operationTimeout is the time after which an operation on a Kubernetes resource fails if it has not completed.
+	operationTimeout = 30 * time.Minute
+
+	// operationRetention is the time the record of an operation is kept after it completes.
+	operationRetention = 24 * time.Hour
+
+	// operationCollectionInterval is the interval at which expired operation records are deleted.
+	operationCollectionInterval = time.Hour
+)
+
+// kubernetesTarget is the Kubernetes resource (or collection of resources) addressed by a UCP resource ID.
+type kubernetesTarget struct {
+	clients   *ucp_kubernetes.Clients
+	mapping   *meta.RESTMapping
+	namespace string
+	name      string
+}
+
+// resourceClient returns the dynamic client for the kind of the target in its namespace.
+func (t *kubernetesTarget) resourceClient() dynamic.ResourceInterface {
+	return t.clients.Dynamic.Resource(t.mapping.Resource).Namespace(t.namespace)
+}
+
+// resolveTarget fetches the clients of the cluster registered as the plane of the given resource ID and resolves the
+// kind of the resource. apiVersion may be empty, in which case the version preferred by the cluster is used.
+func resolveTarget(ctx context.Context, clientProvider ucp_kubernetes.ClientProvider, id resources.ID, apiVersion string) (*kubernetesTarget, error) {
+	clients, err := clientProvider.GetClients(ctx, id.FindScope(resources_kubernetes.PlaneTypeKubernetes))
+	if err != nil {
+		return nil, err
+	}
+
+	group, kind, namespace, name := resources_kubernetes.ToParts(id)
+	versions := []string{}
+	if apiVersion != "" {
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, gv.Version)
+	}
+
+	mapping, err := clients.RESTMapper.RESTMapping(schema.GroupKind{Group: group, Kind: kind}, versions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kubernetesTarget{clients: clients, mapping: mapping, namespace: namespace, name: name}, nil
+}
+
+// operationStatusID returns the ID of the operation status of the given operation. The ID matches the
+// operationStatuses URL returned in the Azure-AsyncOperation header.
+func operationStatusID(rootScope string, providerNamespace string, location string, operationID string) string {
+	return fmt.Sprintf("%s/providers/%s/locations/%s/operationstatuses/%s", rootScope, providerNamespace, location, operationID)
+}
+
+// operationStatusIDFromRequest returns the ID of the operation status addressed by an operationStatuses or
+// operationResults request.
+func operationStatusIDFromRequest(id resources.ID) string {
+	location := v1.LocationGlobal
+	if types := id.TypeSegments(); len(types) > 0 {
+		location = types[0].Name
+	}
+	return operationStatusID(id.RootScope(), id.ProviderNamespace(), location, id.Name())
+}
+
+// newOperation creates the record of an operation on the given Kubernetes object. obj is the object written by a PUT
+// operation or the object being deleted by a DELETE operation.
+func newOperation(serviceCtx *v1.ARMRequestContext, operationID uuid.UUID, method string, obj *unstructured.Unstructured) *datamodel.KubernetesOperation {
+	now := time.Now().UTC()
+	return &datamodel.KubernetesOperation{
+		ID:               operationStatusID(serviceCtx.ResourceID.RootScope(), serviceCtx.ResourceID.ProviderNamespace(), v1.LocationGlobal, operationID.String()),
+		LinkedResourceID: serviceCtx.ResourceID.String(),
+		APIVersion:       obj.GetAPIVersion(),
+		Method:           method,
+		StartTime:        now,
+		UID:              string(obj.GetUID()),
+		Generation:       obj.GetGeneration(),
+		Deadline:         now.Add(operationTimeout),
+	}
+}
+
+// saveOperation records an operation on a Kubernetes resource so that its status can be evaluated later.
+func saveOperation(ctx context.Context, storageClient store.StorageClient, operation *datamodel.KubernetesOperation, options ...store.SaveOptions) error {
+	return storageClient.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: operation.ID},
+		Data:     operation,
+	}, options...)
+}
+
+// getOperationStatus loads the operation record for the given operation status ID and returns the status of the
+// operation. The status of an operation in progress is evaluated from the current state of the resource, and is
+// recorded once the operation reaches a terminal state so that later changes to the resource do not affect it.
+func getOperationStatus(ctx context.Context, storageClient store.StorageClient, clientProvider ucp_kubernetes.ClientProvider, id string) (*v1.AsyncOperationStatus, error) {
+	obj, err := storageClient.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := &datamodel.KubernetesOperation{}
+	if err := obj.As(operation); err != nil {
+		return nil, err
+	}
+
+	if operation.EndTime == nil {
+		if err := evaluateOperation(ctx, clientProvider, operation, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+
+		// A concurrent request may have recorded the result first, the result is the same in that case.
+		if operation.EndTime != nil {
+			err := saveOperation(ctx, storageClient, operation, store.WithETag(obj.ETag))
+			if err != nil && !errors.Is(err, &store.ErrConcurrency{}) {
+				return nil, err
+			}
+		}
+	}
+
+	return &v1.AsyncOperationStatus{
+		ID:        operation.ID,
+		Name:      resources.MustParse(operation.ID).Name(),
+		Status:    operation.Status,
+		StartTime: operation.StartTime,
+		EndTime:   operation.EndTime,
+		Error:     operation.Error,
+	}, nil
+}
+
+// evaluateOperation updates the status of an operation in progress from the current state of the resource. The UID
+// and generation recorded by the operation are compared with the resource, so that an operation superseded by another
+// operation on the same resource does not report the status of the other operation.
+func evaluateOperation(ctx context.Context, clientProvider ucp_kubernetes.ClientProvider, operation *datamodel.KubernetesOperation, now time.Time) error {
+	resourceID, err := resources.ParseResource(operation.LinkedResourceID)
+	if err != nil {
+		return err
+	}
+
+	target, err := resolveTarget(ctx, clientProvider, resourceID, operation.APIVersion)
+	if err != nil {
+		return err
+	}
+
+	obj, err := target.resourceClient().Get(ctx, target.name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	operation.Error = nil
+	if operation.Method == http.MethodDelete {
+		if err != nil || string(obj.GetUID()) != operation.UID {
+			operation.Status = v1.ProvisioningStateSucceeded
+		} else {
+			operation.Status = v1.ProvisioningStateDeleting
+		}
+	} else if err != nil {
+		operation.Status = v1.ProvisioningStateFailed
+		operation.Error = &v1.ErrorDetails{Code: v1.CodeNotFound, Message: fmt.Sprintf("the resource %s was deleted before the operation completed", operation.LinkedResourceID)}
+	} else if string(obj.GetUID()) != operation.UID {
+		operation.Status = v1.ProvisioningStateCanceled
+		operation.Error = &v1.ErrorDetails{Code: v1.CodeOperationCanceled, Message: fmt.Sprintf("the resource %s was replaced before the operation completed", operation.LinkedResourceID)}
+	} else if obj.GetGeneration() != operation.Generation {
+		operation.Status = v1.ProvisioningStateCanceled
+		operation.Error = &v1.ErrorDetails{Code: v1.CodeOperationCanceled, Message: fmt.Sprintf("the resource %s was updated by another operation before the operation completed", operation.LinkedResourceID)}
+	} else {
+		operation.Status = v1.ProvisioningStateProvisioning
+		state, message := ucp_kubernetes.CheckReadiness(obj)
+		switch state {
+		case ucp_kubernetes.ReadinessStateReady:
+			operation.Status = v1.ProvisioningStateSucceeded
+		case ucp_kubernetes.ReadinessStateFailed:
+			operation.Status = v1.ProvisioningStateFailed
+			operation.Error = &v1.ErrorDetails{Code: v1.CodeInternal, Message: message}
+		}
+	}
+
+	if !operation.Status.IsTerminal() && now.After(operation.Deadline) {
+		operation.Status = v1.ProvisioningStateFailed
+		operation.Error = &v1.ErrorDetails{Code: v1.CodeInternal, Message: fmt.Sprintf("the operation on the resource %s did not complete within %s", operation.LinkedResourceID, operationTimeout)}
+	}
+
+	if operation.Status.IsTerminal() {
+		operation.EndTime = &now
+	}
+
+	return nil
+}
+
+// CollectOperations deletes the records of operations on Kubernetes resources that completed, or passed their
+// deadline, more than the retention period before now.
+func CollectOperations(ctx context.Context, storageClient store.StorageClient, now time.Time) error {
+	token := ""
+	for {
+		result, err := storageClient.Query(ctx, store.Query{RootScope: "/planes/" + resources_kubernetes.PlaneTypeKubernetes, ScopeRecursive: true}, store.WithPaginationToken(token))
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range result.Items {
+			id, err := resources.ParseResource(obj.ID)
+			if err != nil || !isOperationStatusType(id) {
+				continue
+			}
+
+			operation := &datamodel.KubernetesOperation{}
+			if err := obj.As(operation); err != nil {
+				return err
+			}
+
+			completed := operation.Deadline
+			if operation.EndTime != nil {
+				completed = *operation.EndTime
+			}
+			if now.Sub(completed) < operationRetention {
+				continue
+			}
+
+			err = storageClient.Delete(ctx, obj.ID)
+			if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+				return err
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+// StartOperationCollector collects expired operation records periodically until the context is canceled.
+func StartOperationCollector(ctx context.Context, storageClient store.StorageClient) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	go func() {
+		ticker := time.NewTicker(operationCollectionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := CollectOperations(ctx, storageClient, time.Now().UTC()); err != nil {
+					logger.Error(err, "failed to delete expired Kubernetes operation records")
+				}
+			}
+		}
+	}()
+}
+
+// isOperationStatusType returns true if the given ID is the ID of an operation record.
+func isOperationStatusType(id resources.ID) bool {
+	return strings.EqualFold(id.Type(), id.ProviderNamespace()+"/locations/operationstatuses")
+}
+
+// isNotFound returns true if the error is a not found error from the cluster or from the operation store.
+func isNotFound(err error) bool {
+	return apierrors.IsNotFound(err) || errors.Is(err, &store.ErrNotFound{})
+}
+
+// toResponseBody converts a Kubernetes object to the body of a UCP resource.
+func toResponseBody(id resources.ID, obj *unstructured.Unstructured) map[string]any {
+	return map[string]any{
+		"id":         id.String(),
+		"name":       obj.GetName(),
+		"type":       id.Type(),
+		"properties": obj.Object,
+	}
+}
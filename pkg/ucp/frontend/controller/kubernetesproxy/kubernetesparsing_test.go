@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CollectOperations(t *testing.T) {
+	now := time.Now().UTC()
+	operationsPath := "/planes/kubernetes/cluster1/namespaces/default/providers/apps/locations/global/operationstatuses/"
+
+	completedAt := func(endTime time.Time) *datamodel.KubernetesOperation {
+		return &datamodel.KubernetesOperation{Deadline: endTime.Add(-time.Minute), EndTime: &endTime}
+	}
+
+	firstPage := []store.Object{
+		{Metadata: store.Metadata{ID: operationsPath + "expired"}, Data: completedAt(now.Add(-2 * operationRetention))},
+		{Metadata: store.Metadata{ID: operationsPath + "recent"}, Data: completedAt(now.Add(-time.Minute))},
+		{Metadata: store.Metadata{ID: "/planes/kubernetes/cluster1/providers/System.Kubernetes/credentials/default"}, Data: map[string]any{}},
+	}
+	secondPage := []store.Object{
+		{Metadata: store.Metadata{ID: operationsPath + "abandoned"}, Data: &datamodel.KubernetesOperation{Deadline: now.Add(-2 * operationRetention)}},
+		{Metadata: store.Metadata{ID: operationsPath + "pending"}, Data: &datamodel.KubernetesOperation{Deadline: now.Add(time.Minute)}},
+	}
+
+	storageClient := store.NewMockStorageClient(gomock.NewController(t))
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/kubernetes", ScopeRecursive: true}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			cfg := store.NewQueryConfig(options...)
+			if cfg.PaginationToken == "" {
+				return &store.ObjectQueryResult{Items: firstPage, PaginationToken: "next"}, nil
+			}
+			return &store.ObjectQueryResult{Items: secondPage}, nil
+		}).
+		Times(2)
+
+	deleted := []string{}
+	storageClient.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.DeleteOptions) error {
+			deleted = append(deleted, id)
+			return nil
+		}).
+		AnyTimes()
+
+	err := CollectOperations(context.Background(), storageClient, now)
+	require.NoError(t, err)
+	require.Equal(t, []string{operationsPath + "expired", operationsPath + "abandoned"}, deleted)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ armrpc_controller.Controller = (*ListKubernetesResources)(nil)
+
+// ListKubernetesResources is the controller implementation to list Kubernetes resources of a kind in a namespace.
+type ListKubernetesResources struct {
+	armrpc_controller.Operation[*datamodel.KubernetesResource, datamodel.KubernetesResource]
+	clientProvider ucp_kubernetes.ClientProvider
+}
+
+// NewListKubernetesResources creates a new ListKubernetesResources controller with the given options and Kubernetes client provider.
+func NewListKubernetesResources(opts armrpc_controller.Options, clientProvider ucp_kubernetes.ClientProvider) (armrpc_controller.Controller, error) {
+	return &ListKubernetesResources{
+		Operation:      armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.KubernetesResource]{}),
+		clientProvider: clientProvider,
+	}, nil
+}
+
+// Run lists the resources of the kind in the namespace of the request and returns a response with a page of the list
+// of resources. The size of the page is set by the top query parameter.
+func (p *ListKubernetesResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	target, err := resolveTarget(ctx, p.clientProvider, serviceCtx.ResourceID, "")
+	if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	// The continue token of the cluster is used as the skipToken of the nextLink.
+	list, err := target.resourceClient().List(ctx, metav1.ListOptions{Limit: int64(serviceCtx.Top), Continue: serviceCtx.SkipToken})
+	if apierrors.IsResourceExpired(err) {
+		return armrpc_rest.NewBadRequestResponse("the skipToken has expired, restart the list operation without a skipToken"), nil
+	} else if err != nil {
+		return ucp_kubernetes.HandleKubernetesError(err)
+	}
+
+	planeName := serviceCtx.ResourceID.FindScope(resources_kubernetes.PlaneTypeKubernetes)
+	items := []any{}
+	for i := range list.Items {
+		obj := &list.Items[i]
+		id := resources_kubernetes.IDFromParts(planeName, target.mapping.GroupVersionKind.Group, target.mapping.GroupVersionKind.Kind, obj.GetNamespace(), obj.GetName())
+		items = append(items, toResponseBody(id, obj))
+	}
+
+	return armrpc_rest.NewOKResponse(&v1.PaginatedList{
+		Value:    items,
+		NextLink: armrpc_controller.GetNextLinkURL(ctx, req, list.GetContinue()),
+	}), nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func Test_ListKubernetesResources(t *testing.T) {
+	first := newTestDeployment("first", 2)
+	second := newTestDeployment("second", 2)
+	controller, err := NewListKubernetesResources(armrpc_controller.Options{}, newTestClientProvider(first, second))
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResourceCollectionPath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(&v1.PaginatedList{
+		Value: []any{
+			map[string]any{
+				"id":         testResourceCollectionPath + "/first",
+				"name":       "first",
+				"type":       "apps/Deployment",
+				"properties": first.Object,
+			},
+			map[string]any{
+				"id":         testResourceCollectionPath + "/second",
+				"name":       "second",
+				"type":       "apps/Deployment",
+				"properties": second.Object,
+			},
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+}
+
+func Test_ListKubernetesResources_Empty(t *testing.T) {
+	controller, err := NewListKubernetesResources(armrpc_controller.Options{}, newTestClientProvider())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResourceCollectionPath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	require.Equal(t, armrpc_rest.NewOKResponse(&v1.PaginatedList{Value: []any{}}), actualResponse)
+}
+
+func Test_ListKubernetesResources_Pagination(t *testing.T) {
+	clientProvider := newTestClientProvider(newTestDeployment("first", 2))
+	clientProvider.clients.Dynamic.(*fake.FakeDynamicClient).PrependReactor("list", "deployments", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		list := &unstructured.UnstructuredList{Object: map[string]any{"apiVersion": "apps/v1", "kind": "DeploymentList"}}
+		list.Items = []unstructured.Unstructured{*newTestDeployment("first", 2)}
+		list.SetContinue("next-page")
+		return true, list, nil
+	})
+
+	controller, err := NewListKubernetesResources(armrpc_controller.Options{}, clientProvider)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, "http://localhost"+testResourceCollectionPath+"?api-version=2023-10-01-preview&top=5", nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	response, ok := actualResponse.(*armrpc_rest.OKResponse)
+	require.True(t, ok)
+
+	list := response.Body.(*v1.PaginatedList)
+	require.Len(t, list.Value, 1)
+
+	nextLink, err := url.Parse(list.NextLink)
+	require.NoError(t, err)
+	require.Equal(t, testResourceCollectionPath, nextLink.Path)
+	require.Equal(t, "next-page", nextLink.Query().Get("skipToken"))
+	require.Equal(t, "5", nextLink.Query().Get("top"))
+}
+
+func Test_ListKubernetesResources_ExpiredSkipToken(t *testing.T) {
+	clientProvider := newTestClientProvider()
+	clientProvider.clients.Dynamic.(*fake.FakeDynamicClient).PrependReactor("list", "deployments", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewResourceExpired("the continue token has expired")
+	})
+
+	controller, err := NewListKubernetesResources(armrpc_controller.Options{}, clientProvider)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, "http://localhost"+testResourceCollectionPath+"?api-version=2023-10-01-preview&skipToken=expired", nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := controller.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	response, ok := actualResponse.(*armrpc_rest.BadRequestResponse)
+	require.True(t, ok)
+	require.Contains(t, response.Body.Error.Message, "skipToken has expired")
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesproxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+const (
+	testResourceCollectionPath = "/planes/kubernetes/cluster1/namespaces/default/providers/apps/Deployment"
+	testResourcePath           = testResourceCollectionPath + "/test-deployment"
+	testOperationID            = "79b9f0da-4882-4dc8-a367-6fd3bc122ded"
+	testOperationStatusID      = "/planes/kubernetes/cluster1/namespaces/default/providers/apps/locations/global/operationstatuses/" + testOperationID
+	testOperationStatusesPath  = "/planes/kubernetes/cluster1/namespaces/default/providers/apps/locations/global/operationStatuses/" + testOperationID
+	testOperationResultsPath   = "/planes/kubernetes/cluster1/namespaces/default/providers/apps/locations/global/operationResults/" + testOperationID
+)
+
+var deploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+type testClientProvider struct {
+	clients *ucp_kubernetes.Clients
+	err     error
+}
+
+// GetClients returns the fake clients or the configured error.
+func (p *testClientProvider) GetClients(ctx context.Context, planeName string) (*ucp_kubernetes.Clients, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.clients, nil
+}
+
+// newTestClientProvider returns a client provider for a fake cluster serving apps/v1 Deployments and containing
+// the given objects.
+func newTestClientProvider(objs ...runtime.Object) *testClientProvider {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Group: "apps", Version: "v1"}})
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		deploymentGVR: "DeploymentList",
+	}, objs...)
+
+	return &testClientProvider{
+		clients: &ucp_kubernetes.Clients{
+			Dynamic:    dynamicClient,
+			RESTMapper: mapper,
+		},
+	}
+}
+
+func newTestDeployment(name string, readyReplicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]any{
+				"name":       name,
+				"namespace":  "default",
+				"uid":        name + "-uid",
+				"generation": int64(1),
+			},
+			"spec": map[string]any{
+				"replicas": int64(2),
+			},
+			"status": map[string]any{
+				"readyReplicas": readyReplicas,
+			},
+		},
+	}
+}
+
+// setupOperation configures the mock storage client to return the given operation for the test operation status ID.
+// It returns the mock and a pointer to the operation recorded by the last call to Save.
+func setupOperation(t *testing.T, operation *datamodel.KubernetesOperation) (*store.MockStorageClient, **datamodel.KubernetesOperation) {
+	var saved *datamodel.KubernetesOperation
+	storageClient := store.NewMockStorageClient(gomock.NewController(t))
+	storageClient.EXPECT().
+		Get(gomock.Any(), testOperationStatusID).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			if operation == nil {
+				return nil, &store.ErrNotFound{ID: id}
+			}
+			return &store.Object{Metadata: store.Metadata{ID: id, ETag: "etag"}, Data: operation}, nil
+		})
+	storageClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			saved = obj.Data.(*datamodel.KubernetesOperation)
+			return nil
+		}).
+		AnyTimes()
+	return storageClient, &saved
+}
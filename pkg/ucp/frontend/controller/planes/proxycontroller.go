@@ -22,6 +22,7 @@ import (
 	"net/url"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/middleware"
@@ -42,13 +43,22 @@ var _ armrpc_controller.Controller = (*ProxyController)(nil)
 // ProxyController is the controller implementation to proxy requests to appropriate RP or URL.
 type ProxyController struct {
 	armrpc_controller.Operation[*datamodel.Plane, datamodel.Plane]
+
+	// transport sends the requests to the resource providers of UCP-native planes.
+	transport http.RoundTripper
 }
 
 // NewProxyController creates a new ProxyPlane controller with the given options and returns it, or returns an error if the
-// controller cannot be created.
-func NewProxyController(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+// controller cannot be created. transport is used to send the requests to the resource providers of UCP-native planes,
+// http.DefaultTransport is used when nil.
+func NewProxyController(opts armrpc_controller.Options, transport http.RoundTripper) (armrpc_controller.Controller, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
 	return &ProxyController{
 		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.Plane]{}),
+		transport: transport,
 	}, nil
 }
 
@@ -146,8 +156,13 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return nil, err
 	}
 
+	transport := http.DefaultTransport
+	if plane.Properties.Kind == rest.PlaneKindUCPNative {
+		transport = p.transport
+	}
+
 	options := proxy.ReverseProxyOptions{
-		RoundTripper: otelhttp.NewTransport(http.DefaultTransport),
+		RoundTripper: otelhttp.NewTransport(transport),
 	}
 
 	refererURL := url.URL{
@@ -180,6 +195,9 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		if plane.Properties.Kind != rest.PlaneKindUCPNative {
 			// If we're proxying to Azure then remove the planes prefix.
 			builder.Directors = append(builder.Directors, trimPlanesPrefix)
+		} else if principal := authentication.PrincipalFromContext(ctx); principal != nil {
+			// The resource providers authenticate and authorize the caller authenticated by UCP.
+			builder.Directors = append(builder.Directors, forwardIdentity(principal))
 		}
 	})
 
@@ -189,6 +207,17 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	return nil, nil
 }
 
+// forwardIdentity returns a director which passes the identity of the caller to the resource provider in the
+// X-Remote-User and X-Remote-Group headers. The headers set by the caller are removed by the proxy before.
+func forwardIdentity(principal *authentication.Principal) proxy.DirectorFunc {
+	return func(r *http.Request) {
+		r.Header.Set(authentication.RemoteUserHeader, principal.Name)
+		for _, group := range principal.Groups {
+			r.Header.Add(authentication.RemoteGroupHeader, group)
+		}
+	}
+}
+
 // trimPlanesPrefix trims the planes prefix from the request URL path.
 func trimPlanesPrefix(r *http.Request) {
 	_, _, remainder, err := resources.ExtractPlanesPrefixFromURLPath(r.URL.Path)
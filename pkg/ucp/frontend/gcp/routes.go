@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	gcp_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/gcp"
+	"github.com/radius-project/radius/pkg/validator"
+)
+
+const (
+	planeScope               = "/planes/gcp/{planeName}"
+	credentialResourcePath   = "/providers/System.GCP/credentials/{credentialName}"
+	credentialCollectionPath = "/providers/System.GCP/credentials"
+)
+
+// Initialize initializes the GCP module. GCP resources are deployed by Terraform recipes with the credentials of the
+// plane, so the module serves only the System.GCP/credentials resources and does not proxy requests to GCP.
+func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
+	secretClient, err := m.options.SecretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseRouter := server.NewSubrouter(m.router, m.options.PathBase+planeScope)
+
+	// URL for operations on System.GCP provider.
+	apiValidator := validator.APIValidator(validator.Options{
+		SpecLoader:         m.options.SpecLoader,
+		ResourceTypeGetter: validator.UCPResourceTypeGetter,
+	})
+
+	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
+	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
+
+	handlerOptions := []server.HandlerOptions{
+		{
+			ParentRouter: credentialCollectionRouter,
+			ResourceType: v20231001preview.GCPCredentialType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return defaultoperation.NewListResources(opt,
+					armrpc_controller.ResourceOptions[datamodel.GCPCredential]{
+						RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+						ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			ResourceType: v20231001preview.GCPCredentialType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return defaultoperation.NewGetResource(opt,
+					armrpc_controller.ResourceOptions[datamodel.GCPCredential]{
+						RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+						ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationPut,
+			ResourceType: v20231001preview.GCPCredentialType,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return gcp_credential_ctrl.NewCreateOrUpdateGCPCredential(opt, secretClient)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationDelete,
+			ResourceType: v20231001preview.GCPCredentialType,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return gcp_credential_ctrl.NewDeleteGCPCredential(opt, secretClient)
+			},
+		},
+	}
+
+	ctrlOpts := armrpc_controller.Options{
+		Address:      m.options.Address,
+		PathBase:     m.options.PathBase,
+		DataProvider: m.options.DataProvider,
+	}
+
+	for _, h := range handlerOptions {
+		if err := server.RegisterHandler(ctx, h, ctrlOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.router, nil
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	kubernetes_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/kubernetes"
+	kubernetesproxy_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetesproxy"
+	"github.com/radius-project/radius/pkg/ucp/hostoptions"
+	ucp_kubernetes "github.com/radius-project/radius/pkg/ucp/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/pkg/validator"
+)
+
+const (
+	planeScope = "/planes/kubernetes/{planeName}"
+
+	resourceCollectionPath = "/namespaces/{namespace}/providers/{providerNamespace}/{resourceType}"
+	operationResultsPath   = "/namespaces/{namespace}/providers/{providerNamespace}/locations/{location}/operationResults/{operationId}"
+	operationStatusesPath  = "/namespaces/{namespace}/providers/{providerNamespace}/locations/{location}/operationStatuses/{operationId}"
+
+	credentialResourcePath   = "/providers/System.Kubernetes/credentials/{credentialName}"
+	credentialCollectionPath = "/providers/System.Kubernetes/credentials"
+
+	// OperationTypeKubernetesResource is the operation type for CRUDL operations on Kubernetes resources.
+	OperationTypeKubernetesResource = "KUBERNETESRESOURCE"
+)
+
+// Initialize initializes the Kubernetes module. Each Kubernetes plane is a cluster, the module proxies operations on
+// resources of any kind to the cluster using the kubeconfig registered as the credential of the plane.
+func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
+	secretClient, err := m.options.SecretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Support override of the client provider for testing.
+	if m.ClientProvider == nil {
+		clientProvider, err := m.newClientProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m.ClientProvider = clientProvider
+	}
+
+	// Operations on Kubernetes resources are recorded in the UCP store, delete the records once they expire.
+	storageClient, err := m.options.DataProvider.GetStorageClient(ctx, OperationStatusResourceType)
+	if err != nil {
+		return nil, err
+	}
+	kubernetesproxy_ctrl.StartOperationCollector(ctx, storageClient)
+
+	baseRouter := server.NewSubrouter(m.router, m.options.PathBase+planeScope)
+
+	handlerOptions := []server.HandlerOptions{
+		{
+			// URLs for standard UCP resource async status result.
+			ParentRouter:  server.NewSubrouter(baseRouter, operationResultsPath),
+			Method:        v1.OperationGet,
+			OperationType: &v1.OperationType{Type: OperationResultsResourceType, Method: v1.OperationGet},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return kubernetesproxy_ctrl.NewGetKubernetesOperationResults(opts, m.ClientProvider)
+			},
+		},
+		{
+			// URLs for standard UCP resource async status.
+			ParentRouter:  server.NewSubrouter(baseRouter, operationStatusesPath),
+			Method:        v1.OperationGet,
+			OperationType: &v1.OperationType{Type: OperationStatusResourceType, Method: v1.OperationGet},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return kubernetesproxy_ctrl.NewGetKubernetesOperationStatuses(opts, m.ClientProvider)
+			},
+		},
+	}
+
+	resourceCollectionRouter := server.NewSubrouter(baseRouter, resourceCollectionPath)
+	handlerOptions = append(handlerOptions, []server.HandlerOptions{
+		{
+			// URLs for standard UCP resource lifecycle operations.
+			ParentRouter:  resourceCollectionRouter,
+			Method:        v1.OperationList,
+			OperationType: &v1.OperationType{Type: OperationTypeKubernetesResource, Method: v1.OperationList},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return kubernetesproxy_ctrl.NewListKubernetesResources(opts, m.ClientProvider)
+			},
+		},
+		{
+			ParentRouter:  resourceCollectionRouter,
+			Path:          "/{resourceName}",
+			Method:        v1.OperationPut,
+			OperationType: &v1.OperationType{Type: OperationTypeKubernetesResource, Method: v1.OperationPut},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return kubernetesproxy_ctrl.NewCreateOrUpdateKubernetesResource(opts, m.ClientProvider)
+			},
+		},
+		{
+			ParentRouter:  resourceCollectionRouter,
+			Path:          "/{resourceName}",
+			Method:        v1.OperationDelete,
+			OperationType: &v1.OperationType{Type: OperationTypeKubernetesResource, Method: v1.OperationDelete},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return kubernetesproxy_ctrl.NewDeleteKubernetesResource(opts, m.ClientProvider)
+			},
+		},
+		{
+			ParentRouter:  resourceCollectionRouter,
+			Path:          "/{resourceName}",
+			Method:        v1.OperationGet,
+			OperationType: &v1.OperationType{Type: OperationTypeKubernetesResource, Method: v1.OperationGet},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return kubernetesproxy_ctrl.NewGetKubernetesResource(opts, m.ClientProvider)
+			},
+		},
+	}...)
+
+	// URLs for operations on Kubernetes credential resources.
+	//
+	// These use the OpenAPI spec validator. General Kubernetes operations DO NOT use the spec validator
+	// because we rely on the validation of the API server.
+	apiValidator := validator.APIValidator(validator.Options{
+		SpecLoader:         m.options.SpecLoader,
+		ResourceTypeGetter: validator.UCPResourceTypeGetter,
+	})
+
+	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
+	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
+
+	handlerOptions = append(handlerOptions, []server.HandlerOptions{
+		{
+			ParentRouter: credentialCollectionRouter,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opts,
+					controller.ResourceOptions[datamodel.KubernetesCredential]{
+						RequestConverter:  converter.KubernetesCredentialDataModelFromVersioned,
+						ResponseConverter: converter.KubernetesCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts,
+					controller.ResourceOptions[datamodel.KubernetesCredential]{
+						RequestConverter:  converter.KubernetesCredentialDataModelFromVersioned,
+						ResponseConverter: converter.KubernetesCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationPut,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return kubernetes_credential_ctrl.NewCreateOrUpdateKubernetesCredential(opts, secretClient)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationDelete,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return kubernetes_credential_ctrl.NewDeleteKubernetesCredential(opts, secretClient)
+			},
+		},
+	}...)
+
+	ctrlOpts := controller.Options{
+		Address:      m.options.Address,
+		PathBase:     m.options.PathBase,
+		DataProvider: m.options.DataProvider,
+	}
+
+	for _, h := range handlerOptions {
+		if err := server.RegisterHandler(ctx, h, ctrlOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.router, nil
+}
+
+func (m *Module) newClientProvider(ctx context.Context) (ucp_kubernetes.ClientProvider, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	var credentialProvider sdk_cred.CredentialProvider[sdk_cred.KubernetesCredential]
+	switch m.options.Config.Identity.AuthMethod {
+	case hostoptions.AuthUCPCredential:
+		provider, err := sdk_cred.NewKubernetesCredentialProvider(m.options.SecretProvider, m.options.UCPConnection, &aztoken.AnonymousCredential{})
+		if err != nil {
+			return nil, err
+		}
+		credentialProvider = provider
+		logger.Info("Configuring 'UCPCredential' authentication mode using UCP Credential API")
+
+	default:
+		logger.Info("UCP credentials are not configured, only the local Kubernetes plane is available.")
+	}
+
+	return ucp_kubernetes.NewUCPClientProvider(credentialProvider, m.options.KubeConfig), nil
+}
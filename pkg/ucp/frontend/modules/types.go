@@ -26,6 +26,7 @@ import (
 	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
 	secretprovider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/validator"
+	"k8s.io/client-go/rest"
 )
 
 // Initializer is an interface that can be implemented by modules that want to provide functionality for a plane.
@@ -73,4 +74,7 @@ type Options struct {
 
 	// UCPConnection is the connection used to communicate with UCP APIs.
 	UCPConnection sdk.Connection
+
+	// KubeConfig is the connection to the Kubernetes cluster hosting UCP. This may be nil when UCP does not run in a cluster.
+	KubeConfig *rest.Config
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
@@ -53,6 +54,17 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	resourceGroupCollectionRouter := server.NewSubrouter(baseRouter, resourceGroupCollectionPath, apiValidator)
 	resourceGroupResourceRouter := server.NewSubrouter(baseRouter, resourceGroupResourcePath, apiValidator)
 
+	// The resource providers trust the identity of the callers forwarded by UCP only from the requests presenting the
+	// proxy client certificate.
+	var transport http.RoundTripper
+	if m.options.Config != nil && m.options.Config.ProxyClient != nil {
+		var err error
+		transport, err = proxy.NewClientCertificateTransport(*m.options.Config.ProxyClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	handlerOptions := []server.HandlerOptions{
 		{
 			ParentRouter:      resourceGroupCollectionRouter,
@@ -114,17 +126,21 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		// Note that the API validation is not applied for CatchAllPath(/*).
 		{
 			// Proxy request should use CatchAllPath(/*) to process all requests under /planes/radius/{planeName}/resourcegroups/{resourceGroupName}.
-			ParentRouter:      resourceGroupResourceRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
-			ControllerFactory: planes_ctrl.NewProxyController,
+			ParentRouter:  resourceGroupResourceRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return planes_ctrl.NewProxyController(opt, transport)
+			},
 		},
 		{
 			// Proxy request should use CatchAllPath(/*) to process all requests under /planes/radius/{planeName}/.
-			ParentRouter:      baseRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
-			ControllerFactory: planes_ctrl.NewProxyController,
+			ParentRouter:  baseRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return planes_ctrl.NewProxyController(opt, transport)
+			},
 		},
 	}
 
@@ -17,11 +17,14 @@ limitations under the License.
 package hostoptions
 
 import (
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/authorization"
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
 	"github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/proxy"
 	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
 	"github.com/radius-project/radius/pkg/ucp/rest"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
@@ -41,6 +44,17 @@ type UCPConfig struct {
 	Identity         Identity                                 `yaml:"identity,omitempty"`
 	UCP              config.UCPOptions                        `yaml:"ucp"`
 	Location         string                                   `yaml:"location"`
+
+	// Authentication configures the authentication of the callers of the UCP API.
+	Authentication authentication.Options `yaml:"authentication,omitempty"`
+
+	// Authorization configures the role-based authorization of the callers of the UCP API.
+	Authorization authorization.Options `yaml:"authorization,omitempty"`
+
+	// ProxyClient configures the client certificate UCP presents to the resource providers when it proxies the requests
+	// of authenticated callers. The identity of the caller is passed in the X-Remote-User and X-Remote-Group headers,
+	// which the resource providers trust only from the requests presenting this certificate.
+	ProxyClient *proxy.ClientCertificateOptions `yaml:"proxyClient,omitempty"`
 }
 
 const (
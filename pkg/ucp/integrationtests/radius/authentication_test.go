@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radius
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/frontend/api"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testrp"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testserver"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "test-token"
+
+// testAuthenticator accepts the bearer token testToken.
+type testAuthenticator struct{}
+
+func (testAuthenticator) Authenticate(r *http.Request) (*authentication.Principal, error) {
+	switch r.Header.Get(authentication.AuthorizationHeader) {
+	case "":
+		return nil, nil
+	case "Bearer " + testToken:
+		return &authentication.Principal{Name: "alice", Groups: []string{"developers", "testers"}, Authenticator: "test"}, nil
+	default:
+		return nil, errors.New("invalid token")
+	}
+}
+
+func Test_RadiusPlane_Proxy_Authentication(t *testing.T) {
+	ucp := testserver.StartWithETCD(t, api.DefaultModules, testserver.WithAuthenticator(testAuthenticator{}))
+	rp := testrp.Start(t)
+
+	received := make(chan http.Header, 1)
+	rp.Handler = func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"` + testResourceID + `","name":"test-resource","type":"System.Test/testResources"}`))
+	}
+
+	authenticated := http.Header{authentication.AuthorizationHeader: []string{"Bearer " + testToken}}
+	makeTypedRequest := func(method string, pathAndQuery string, body any, headers http.Header) *testserver.TestResponse {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		return ucp.MakeRequestWithHeaders(method, pathAndQuery, b, headers)
+	}
+
+	response := makeTypedRequest(http.MethodPut, testRadiusPlaneID+"?"+apiVersionParameter, v20231001preview.PlaneResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.PlaneResourceProperties{
+			Kind:              to.Ptr(v20231001preview.PlaneKindUCPNative),
+			ResourceProviders: map[string]*string{testResourceNamespace: to.Ptr("http://" + rp.Address())},
+		},
+	}, authenticated)
+	response.EqualsStatusCode(http.StatusOK)
+
+	response = makeTypedRequest(http.MethodPut, testResourceGroupID+"?"+apiVersionParameter, v20231001preview.ResourceGroupResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.ResourceGroupProperties{},
+	}, authenticated)
+	response.EqualsStatusCode(http.StatusOK)
+
+	t.Run("unauthenticated request is rejected by UCP", func(t *testing.T) {
+		response := ucp.MakeRequest(http.MethodGet, testResourceID+"?api-version="+testrp.Version, nil)
+		response.EqualsStatusCode(http.StatusUnauthorized)
+		require.Empty(t, received)
+	})
+
+	t.Run("invalid token is rejected by UCP", func(t *testing.T) {
+		headers := http.Header{authentication.AuthorizationHeader: []string{"Bearer invalid"}}
+		response := ucp.MakeRequestWithHeaders(http.MethodGet, testResourceID+"?api-version="+testrp.Version, nil, headers)
+		response.EqualsStatusCode(http.StatusUnauthorized)
+		require.Empty(t, received)
+	})
+
+	t.Run("authenticated request is proxied with the identity of the caller", func(t *testing.T) {
+		headers := authenticated.Clone()
+		headers.Set(authentication.RemoteUserHeader, "admin")
+		headers.Set(authentication.RemoteGroupHeader, "system:masters")
+
+		response := ucp.MakeRequestWithHeaders(http.MethodGet, testResourceID+"?api-version="+testrp.Version, nil, headers)
+		response.EqualsStatusCode(http.StatusOK)
+
+		// The resource provider receives the identity authenticated by UCP instead of the credentials of the caller,
+		// and the identity headers set by the caller are not forwarded.
+		header := <-received
+		require.Empty(t, header.Get(authentication.AuthorizationHeader))
+		require.Equal(t, "alice", header.Get(authentication.RemoteUserHeader))
+		require.Equal(t, []string{"developers", "testers"}, header.Values(authentication.RemoteGroupHeader))
+	})
+}
@@ -37,6 +37,7 @@ import (
 	etcdclient "go.etcd.io/etcd/client/v3"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/middleware"
@@ -62,6 +63,21 @@ func NoModules(options modules.Options) []modules.Initializer {
 	return nil
 }
 
+// Option configures a TestServer.
+type Option func(*serverOptions)
+
+type serverOptions struct {
+	authenticator authentication.Authenticator
+}
+
+// WithAuthenticator configures the TestServer to authenticate the requests with the given authenticator, like UCP does
+// when authentication is enabled.
+func WithAuthenticator(authenticator authentication.Authenticator) Option {
+	return func(o *serverOptions) {
+		o.authenticator = authenticator
+	}
+}
+
 // TestServer can run a UCP server using the Go httptest package. It provides access to an isolated ETCD instances for storage
 // of resources and secrets. Alteratively, it can also be used with gomock.
 //
@@ -213,7 +229,12 @@ func StartWithMocks(t *testing.T, configureModules func(options modules.Options)
 }
 
 // StartWithETCD creates and starts a new TestServer that used an embedded ETCD instance for storage.
-func StartWithETCD(t *testing.T, configureModules func(options modules.Options) []modules.Initializer) *TestServer {
+func StartWithETCD(t *testing.T, configureModules func(options modules.Options) []modules.Initializer, opts ...Option) *TestServer {
+	serverOpts := serverOptions{}
+	for _, option := range opts {
+		option(&serverOpts)
+	}
+
 	config := hosting.NewAsyncValue[etcdclient.Client]()
 	etcd := data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{
 		ClientConfigSink:  config,
@@ -268,7 +289,11 @@ func StartWithETCD(t *testing.T, configureModules func(options modules.Options)
 	router := chi.NewRouter()
 	router.Use(servicecontext.ARMRequestCtx(pathBase, "global"))
 
-	app := middleware.NormalizePath(router)
+	app := http.Handler(router)
+	if serverOpts.authenticator != nil {
+		app = authentication.Authenticate(serverOpts.authenticator)(app)
+	}
+	app = middleware.NormalizePath(app)
 	server := httptest.NewUnstartedServer(app)
 	server.Config.BaseContext = func(l net.Listener) context.Context {
 		return ctx
@@ -358,10 +383,21 @@ func (ts *TestServer) MakeTypedRequest(method string, pathAndQuery string, body
 
 // MakeRequest sends a request to the server.
 func (ts *TestServer) MakeRequest(method string, pathAndQuery string, body []byte) *TestResponse {
+	return ts.MakeRequestWithHeaders(method, pathAndQuery, body, nil)
+}
+
+// MakeRequestWithHeaders sends a request with additional headers to the server.
+func (ts *TestServer) MakeRequestWithHeaders(method string, pathAndQuery string, body []byte, headers http.Header) *TestResponse {
 	client := ts.Server.Client()
 	request, err := rpctest.NewHTTPRequestWithContent(context.Background(), method, ts.BaseURL+pathAndQuery, body)
 	require.NoError(ts.t, err, "creating request failed")
 
+	for key, values := range headers {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+
 	ctx := rpctest.NewARMRequestContext(request)
 	request = request.WithContext(ctx)
 
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+)
+
+// Clients contains the clients used to manage resources in a Kubernetes cluster.
+type Clients struct {
+	// Dynamic is the Kubernetes dynamic client used to manage resources of arbitrary kinds.
+	Dynamic dynamic.Interface
+
+	// RESTMapper maps the group and kind of a resource to the resource served by the cluster.
+	RESTMapper meta.RESTMapper
+}
+
+// ClientProvider provides the clients for the Kubernetes clusters registered as UCP planes.
+type ClientProvider interface {
+	// GetClients returns the clients for the cluster registered as the given Kubernetes plane.
+	GetClients(ctx context.Context, planeName string) (*Clients, error)
+}
+
+// NewClients creates the dynamic client and a discovery based RESTMapper for the cluster described by the given config.
+func NewClients(config *rest.Config) (*Clients, error) {
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Clients{
+		Dynamic:    dynamicClient,
+		RESTMapper: &resettingRESTMapper{DeferredDiscoveryRESTMapper: restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))},
+	}, nil
+}
+
+// resettingRESTMapper is a discovery based RESTMapper that refreshes its cached discovery information when a kind is
+// not found, so that resources of CRDs installed after the clients were created can be mapped. Clients are cached for
+// the lifetime of the process so the cache would otherwise never be refreshed.
+type resettingRESTMapper struct {
+	*restmapper.DeferredDiscoveryRESTMapper
+}
+
+// RESTMapping returns the mapping of the given kind, refreshing the discovery information if the kind is not found.
+func (m *resettingRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	mapping, err := m.DeferredDiscoveryRESTMapper.RESTMapping(gk, versions...)
+	if meta.IsNoMatchError(err) {
+		m.Reset()
+		mapping, err = m.DeferredDiscoveryRESTMapper.RESTMapping(gk, versions...)
+	}
+	return mapping, err
+}
+
+// RESTMappings returns the mappings of the given kind, refreshing the discovery information if the kind is not found.
+func (m *resettingRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	mappings, err := m.DeferredDiscoveryRESTMapper.RESTMappings(gk, versions...)
+	if meta.IsNoMatchError(err) {
+		m.Reset()
+		mappings, err = m.DeferredDiscoveryRESTMapper.RESTMappings(gk, versions...)
+	}
+	return mappings, err
+}
+
+// KindFor returns the kind of the given resource, refreshing the discovery information if the resource is not found.
+func (m *resettingRESTMapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	gvk, err := m.DeferredDiscoveryRESTMapper.KindFor(resource)
+	if meta.IsNoMatchError(err) {
+		m.Reset()
+		gvk, err = m.DeferredDiscoveryRESTMapper.KindFor(resource)
+	}
+	return gvk, err
+}
+
+// ResourceFor returns the resource matching the given partial resource, refreshing the discovery information if the
+// resource is not found.
+func (m *resettingRESTMapper) ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	gvr, err := m.DeferredDiscoveryRESTMapper.ResourceFor(input)
+	if meta.IsNoMatchError(err) {
+		m.Reset()
+		gvr, err = m.DeferredDiscoveryRESTMapper.ResourceFor(input)
+	}
+	return gvr, err
+}
+
+var _ error = (*ErrClusterNotRegistered)(nil)
+
+// ErrClusterNotRegistered represents error when no kubeconfig credential is registered for a Kubernetes plane.
+type ErrClusterNotRegistered struct {
+	PlaneName string
+}
+
+// Error returns the error message.
+func (e *ErrClusterNotRegistered) Error() string {
+	return fmt.Sprintf("no kubeconfig credential is registered for the Kubernetes plane %q", e.PlaneName)
+}
+
+// Is checks if the error is of type ErrClusterNotRegistered.
+func (e *ErrClusterNotRegistered) Is(target error) bool {
+	_, ok := target.(*ErrClusterNotRegistered)
+	return ok
+}
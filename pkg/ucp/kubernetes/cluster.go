@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"k8s.io/client-go/rest"
+)
+
+// Cluster is the connection to a Kubernetes cluster targeted by the resources of an environment.
+type Cluster struct {
+	// Config is the REST config of the cluster.
+	Config *rest.Config
+
+	// Clients is the collection of clients of the cluster.
+	Clients *kubeutil.Clients
+}
+
+// NewCluster creates the clients of the cluster with the given REST config.
+func NewCluster(config *rest.Config) (*Cluster, error) {
+	clients, err := kubeutil.NewClients(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cluster{Config: config, Clients: clients}, nil
+}
+
+// ClusterProvider provides the connections to the Kubernetes clusters targeted by environments. A cluster is
+// identified by the name of its Kubernetes plane.
+type ClusterProvider interface {
+	// GetCluster returns the connection to the cluster of the given Kubernetes plane. It returns
+	// ErrClusterNotRegistered if the plane is neither the local plane nor registered with UCP.
+	GetCluster(ctx context.Context, planeName string) (*Cluster, error)
+}
+
+var _ ClusterProvider = (*clusterProvider)(nil)
+
+type clusterProvider struct {
+	local  *Cluster
+	remote *KubeConfigClientProvider[*Cluster]
+}
+
+// NewClusterProvider creates a ClusterProvider. local is the connection to the cluster hosting Radius, which is
+// always used for the local plane. The connections to the other clusters are created from the kubeconfig registered
+// as the credential of their plane. credentialProvider may be nil when UCP credentials are not configured, in which
+// case only the local plane is available.
+func NewClusterProvider(local *Cluster, credentialProvider credentials.CredentialProvider[credentials.KubernetesCredential]) ClusterProvider {
+	return &clusterProvider{
+		local:  local,
+		remote: NewKubeConfigClientProvider(credentialProvider, nil, newRemoteCluster),
+	}
+}
+
+// NewUCPClusterProvider creates a ClusterProvider which fetches the kubeconfigs of the registered clusters from UCP
+// with the given connection. local is the connection to the cluster hosting Radius. ucpConn may be nil when the host
+// is not connected to UCP, in which case only the local plane is available.
+func NewUCPClusterProvider(local *Cluster, ucpConn sdk.Connection, secretProvider *provider.SecretProvider) (ClusterProvider, error) {
+	if ucpConn == nil {
+		return NewClusterProvider(local, nil), nil
+	}
+
+	credentialProvider, err := credentials.NewKubernetesCredentialProvider(secretProvider, ucpConn, &aztoken.AnonymousCredential{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClusterProvider(local, credentialProvider), nil
+}
+
+// GetCluster returns the connection to the cluster of the given Kubernetes plane.
+func (p *clusterProvider) GetCluster(ctx context.Context, planeName string) (*Cluster, error) {
+	if IsLocalPlane(planeName) {
+		return p.local, nil
+	}
+
+	return p.remote.GetClients(ctx, planeName)
+}
+
+// newRemoteCluster creates the connection to a registered cluster, using the same client rate limits as the
+// connection to the cluster hosting Radius.
+func newRemoteCluster(config *rest.Config) (*Cluster, error) {
+	config.QPS = kubeutil.DefaultServerQPS
+	config.Burst = kubeutil.DefaultServerBurst
+	return NewCluster(config)
+}
+
+// IsLocalPlane returns true if the given Kubernetes plane is the plane of the cluster hosting Radius.
+func IsLocalPlane(planeName string) bool {
+	return planeName == "" || strings.EqualFold(planeName, resources_kubernetes.PlaneNameLocal)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+func newTestClusterProvider(credentialProvider sdk_cred.CredentialProvider[sdk_cred.KubernetesCredential]) (*clusterProvider, *Cluster) {
+	local := &Cluster{Config: &rest.Config{Host: "https://local.example.com"}}
+	p := NewClusterProvider(local, credentialProvider).(*clusterProvider)
+	p.remote.newClients = func(config *rest.Config) (*Cluster, error) {
+		return &Cluster{Config: config}, nil
+	}
+	return p, local
+}
+
+func TestGetCluster(t *testing.T) {
+	t.Run("local plane", func(t *testing.T) {
+		p, local := newTestClusterProvider(&mockProvider{err: &secret.ErrNotFound{}})
+
+		for _, planeName := range []string{"", "local", "Local"} {
+			cluster, err := p.GetCluster(context.Background(), planeName)
+			require.NoError(t, err)
+			require.Same(t, local, cluster)
+		}
+	})
+
+	t.Run("registered cluster", func(t *testing.T) {
+		credential := &mockProvider{fakeCredential: &sdk_cred.KubernetesCredential{Kind: "KubeConfig", KubeConfig: testKubeConfig}}
+		p, _ := newTestClusterProvider(credential)
+
+		cluster, err := p.GetCluster(context.Background(), "cluster1")
+		require.NoError(t, err)
+		require.Equal(t, "https://cluster1.example.com", cluster.Config.Host)
+		require.Equal(t, "fake-token", cluster.Config.BearerToken)
+	})
+
+	t.Run("unregistered cluster", func(t *testing.T) {
+		p, _ := newTestClusterProvider(&mockProvider{err: &secret.ErrNotFound{}})
+
+		_, err := p.GetCluster(context.Background(), "cluster2")
+		require.ErrorIs(t, err, &ErrClusterNotRegistered{})
+	})
+
+	t.Run("credentials not configured", func(t *testing.T) {
+		p, _ := newTestClusterProvider(nil)
+
+		_, err := p.GetCluster(context.Background(), "cluster1")
+		require.ErrorIs(t, err, &ErrClusterNotRegistered{})
+	})
+}
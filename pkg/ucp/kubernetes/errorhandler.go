@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"errors"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// HandleKubernetesError takes in an error and returns an ARMResponse and an error. Errors reported by the API server
+// and unregistered clusters are converted to an error response, any other error is returned as is.
+func HandleKubernetesError(err error) (armrpc_rest.Response, error) {
+	if errors.Is(err, &ErrClusterNotRegistered{}) {
+		return armrpc_rest.NewNotFoundMessageResponse(err.Error()), nil
+	}
+
+	if meta.IsNoMatchError(err) {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	var statusErr apierrors.APIStatus
+	if !errors.As(err, &statusErr) {
+		return nil, err
+	}
+
+	status := statusErr.Status()
+	e := v1.ErrorResponse{
+		Error: v1.ErrorDetails{
+			Code:    string(status.Reason),
+			Message: status.Message,
+		},
+	}
+
+	switch status.Code {
+	case http.StatusNotFound:
+		return armrpc_rest.NewNotFoundMessageResponse(status.Message), nil
+	case http.StatusConflict:
+		return armrpc_rest.NewConflictResponse(status.Message), nil
+	case http.StatusForbidden:
+		return armrpc_rest.NewForbiddenResponse(status.Message), nil
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return armrpc_rest.NewBadRequestARMResponse(e), nil
+	}
+
+	return armrpc_rest.NewInternalServerErrorARMResponse(e), nil
+}
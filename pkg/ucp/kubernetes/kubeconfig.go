@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// ValidateKubeConfig validates a kubeconfig registered as the credential of a Kubernetes plane. UCP loads the kubeconfig
+// in its own process, so the kubeconfig must be self-contained: certificates, keys and tokens must be provided inline,
+// and settings that read files or run commands on the UCP host (exec plugins, auth providers, tokenFile and the file
+// path variants of certificates and keys) are rejected.
+func ValidateKubeConfig(kubeConfig string) error {
+	config, err := clientcmd.Load([]byte(kubeConfig))
+	if err != nil {
+		return fmt.Errorf("failed to parse the kubeconfig: %w", err)
+	}
+
+	errs := []error{}
+	for _, name := range sortedKeys(config.Clusters) {
+		cluster := config.Clusters[name]
+		if cluster.CertificateAuthority != "" {
+			errs = append(errs, fmt.Errorf("cluster %q: certificate-authority is not supported, use certificate-authority-data instead", name))
+		}
+	}
+
+	for _, name := range sortedKeys(config.AuthInfos) {
+		user := config.AuthInfos[name]
+		if user.ClientCertificate != "" {
+			errs = append(errs, fmt.Errorf("user %q: client-certificate is not supported, use client-certificate-data instead", name))
+		}
+		if user.ClientKey != "" {
+			errs = append(errs, fmt.Errorf("user %q: client-key is not supported, use client-key-data instead", name))
+		}
+		if user.TokenFile != "" {
+			errs = append(errs, fmt.Errorf("user %q: tokenFile is not supported, use token instead", name))
+		}
+		if user.Exec != nil {
+			errs = append(errs, fmt.Errorf("user %q: exec is not supported, use token or client-certificate-data instead", name))
+		}
+		if user.AuthProvider != nil {
+			errs = append(errs, fmt.Errorf("user %q: auth-provider is not supported, use token or client-certificate-data instead", name))
+		}
+		if user.Username != "" || user.Password != "" {
+			errs = append(errs, fmt.Errorf("user %q: username and password are not supported, use token or client-certificate-data instead", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func sortedKeys[T any](m map[string]*T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
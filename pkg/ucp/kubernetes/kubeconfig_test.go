@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateKubeConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		kubeConfig string
+		err        []string
+	}{
+		{
+			name:       "token",
+			kubeConfig: testKubeConfig,
+		},
+		{
+			name: "inline data",
+			kubeConfig: `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster1
+  cluster:
+    server: https://cluster1.example.com
+    certificate-authority-data: Y2E=
+users:
+- name: user1
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+`,
+		},
+		{
+			name:       "invalid kubeconfig",
+			kubeConfig: "not a kubeconfig",
+			err:        []string{"failed to parse the kubeconfig"},
+		},
+		{
+			name: "file paths",
+			kubeConfig: `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster1
+  cluster:
+    server: https://cluster1.example.com
+    certificate-authority: /etc/kubernetes/ca.crt
+users:
+- name: user1
+  user:
+    client-certificate: /etc/kubernetes/client.crt
+    client-key: /etc/kubernetes/client.key
+    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token
+`,
+			err: []string{
+				`cluster "cluster1": certificate-authority is not supported`,
+				`user "user1": client-certificate is not supported`,
+				`user "user1": client-key is not supported`,
+				`user "user1": tokenFile is not supported`,
+			},
+		},
+		{
+			name: "exec and auth provider",
+			kubeConfig: `apiVersion: v1
+kind: Config
+users:
+- name: user1
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: /bin/sh
+- name: user2
+  user:
+    auth-provider:
+      name: oidc
+`,
+			err: []string{
+				`user "user1": exec is not supported`,
+				`user "user2": auth-provider is not supported`,
+			},
+		},
+		{
+			name: "basic authentication",
+			kubeConfig: `apiVersion: v1
+kind: Config
+users:
+- name: user1
+  user:
+    username: admin
+    password: password
+`,
+			err: []string{`user "user1": username and password are not supported`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKubeConfig(tt.kubeConfig)
+			if len(tt.err) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, msg := range tt.err {
+				require.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ReadinessState is the readiness of a Kubernetes resource.
+type ReadinessState string
+
+const (
+	// ReadinessStateReady indicates that the resource has been reconciled and is ready.
+	ReadinessStateReady ReadinessState = "Ready"
+
+	// ReadinessStateInProgress indicates that the resource is still being reconciled.
+	ReadinessStateInProgress ReadinessState = "InProgress"
+
+	// ReadinessStateFailed indicates that the resource failed to reconcile.
+	ReadinessStateFailed ReadinessState = "Failed"
+)
+
+// CheckReadiness evaluates the readiness of a Kubernetes resource from its status. It returns the state and, when the
+// state is not ready, a message describing why.
+//
+// The checks are generic so that they apply to arbitrary kinds:
+//
+// - The controller must have observed the latest generation of the resource.
+// - A Ready condition must be True, or a Failed condition fails the resource.
+// - Workloads must have all of their desired replicas ready.
+//
+// Resources without status, such as ConfigMaps, are ready once they exist.
+func CheckReadiness(obj *unstructured.Unstructured) (ReadinessState, string) {
+	if obj.GetDeletionTimestamp() != nil {
+		return ReadinessStateInProgress, "resource is being deleted"
+	}
+
+	if observed, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); found && observed < obj.GetGeneration() {
+		return ReadinessStateInProgress, fmt.Sprintf("waiting for generation %d to be observed, current generation is %d", obj.GetGeneration(), observed)
+	}
+
+	conditions := getConditions(obj)
+	for _, failedType := range []string{"Failed", "Stalled"} {
+		if c, ok := conditions[failedType]; ok && c.status == "True" {
+			return ReadinessStateFailed, c.describe()
+		}
+	}
+
+	if c, ok := conditions["Progressing"]; ok && c.reason == "ProgressDeadlineExceeded" {
+		return ReadinessStateFailed, c.describe()
+	}
+
+	switch obj.GetKind() {
+	case "Job":
+		if c, ok := conditions["Complete"]; ok && c.status == "True" {
+			return ReadinessStateReady, ""
+		}
+		return ReadinessStateInProgress, "waiting for job to complete"
+
+	case "Pod":
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		switch phase {
+		case "Succeeded":
+			return ReadinessStateReady, ""
+		case "Failed":
+			message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+			return ReadinessStateFailed, fmt.Sprintf("pod failed: %s", message)
+		}
+
+	case "PersistentVolumeClaim":
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		if phase != "Bound" {
+			return ReadinessStateInProgress, "waiting for persistent volume claim to be bound"
+		}
+		return ReadinessStateReady, ""
+
+	case "DaemonSet":
+		desired, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberReady")
+		if ready < desired {
+			return ReadinessStateInProgress, fmt.Sprintf("waiting for daemon set pods to be ready, %d of %d are ready", ready, desired)
+		}
+		return ReadinessStateReady, ""
+	}
+
+	if c, ok := conditions["Ready"]; ok && c.status != "True" {
+		return ReadinessStateInProgress, c.describe()
+	}
+
+	if c, ok := conditions["Available"]; ok && c.status != "True" {
+		return ReadinessStateInProgress, c.describe()
+	}
+
+	if replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found {
+		ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+		if ready < replicas {
+			return ReadinessStateInProgress, fmt.Sprintf("waiting for replicas to be ready, %d of %d are ready", ready, replicas)
+		}
+		if updated, found, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas"); found && updated < replicas {
+			return ReadinessStateInProgress, fmt.Sprintf("waiting for replicas to be updated, %d of %d are updated", updated, replicas)
+		}
+	}
+
+	return ReadinessStateReady, ""
+}
+
+type condition struct {
+	conditionType string
+	status        string
+	reason        string
+	message       string
+}
+
+func (c condition) describe() string {
+	if c.message == "" {
+		return fmt.Sprintf("condition %s is %s with reason %q", c.conditionType, c.status, c.reason)
+	}
+	return fmt.Sprintf("condition %s is %s: %s", c.conditionType, c.status, c.message)
+}
+
+func getConditions(obj *unstructured.Unstructured) map[string]condition {
+	result := map[string]condition{}
+	items, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		c := condition{}
+		c.conditionType, _, _ = unstructured.NestedString(m, "type")
+		c.status, _, _ = unstructured.NestedString(m, "status")
+		c.reason, _, _ = unstructured.NestedString(m, "reason")
+		c.message, _, _ = unstructured.NestedString(m, "message")
+		if c.conditionType != "" {
+			result[c.conditionType] = c
+		}
+	}
+
+	return result
+}
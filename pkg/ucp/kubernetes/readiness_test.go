@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCheckReadiness(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      map[string]any
+		expected ReadinessState
+	}{
+		{
+			name:     "resource without status",
+			obj:      map[string]any{"kind": "ConfigMap", "metadata": map[string]any{"name": "test"}},
+			expected: ReadinessStateReady,
+		},
+		{
+			name: "generation not observed",
+			obj: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "test", "generation": int64(2)},
+				"status":   map[string]any{"observedGeneration": int64(1)},
+			},
+			expected: ReadinessStateInProgress,
+		},
+		{
+			name: "deployment replicas not ready",
+			obj: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "test", "generation": int64(1)},
+				"spec":     map[string]any{"replicas": int64(3)},
+				"status":   map[string]any{"observedGeneration": int64(1), "readyReplicas": int64(1), "updatedReplicas": int64(3)},
+			},
+			expected: ReadinessStateInProgress,
+		},
+		{
+			name: "deployment ready",
+			obj: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "test", "generation": int64(1)},
+				"spec":     map[string]any{"replicas": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(1),
+					"readyReplicas":      int64(3),
+					"updatedReplicas":    int64(3),
+					"conditions": []any{
+						map[string]any{"type": "Available", "status": "True"},
+					},
+				},
+			},
+			expected: ReadinessStateReady,
+		},
+		{
+			name: "deployment progress deadline exceeded",
+			obj: map[string]any{
+				"kind":     "Deployment",
+				"metadata": map[string]any{"name": "test"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			expected: ReadinessStateFailed,
+		},
+		{
+			name: "ready condition false",
+			obj: map[string]any{
+				"kind":     "Certificate",
+				"metadata": map[string]any{"name": "test"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "False", "message": "issuing"},
+					},
+				},
+			},
+			expected: ReadinessStateInProgress,
+		},
+		{
+			name: "failed condition",
+			obj: map[string]any{
+				"kind":     "Job",
+				"metadata": map[string]any{"name": "test"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded"},
+					},
+				},
+			},
+			expected: ReadinessStateFailed,
+		},
+		{
+			name: "job complete",
+			obj: map[string]any{
+				"kind":     "Job",
+				"metadata": map[string]any{"name": "test"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Complete", "status": "True"},
+					},
+				},
+			},
+			expected: ReadinessStateReady,
+		},
+		{
+			name: "pod failed",
+			obj: map[string]any{
+				"kind":     "Pod",
+				"metadata": map[string]any{"name": "test"},
+				"status":   map[string]any{"phase": "Failed"},
+			},
+			expected: ReadinessStateFailed,
+		},
+		{
+			name: "persistent volume claim pending",
+			obj: map[string]any{
+				"kind":     "PersistentVolumeClaim",
+				"metadata": map[string]any{"name": "test"},
+				"status":   map[string]any{"phase": "Pending"},
+			},
+			expected: ReadinessStateInProgress,
+		},
+		{
+			name: "daemon set ready",
+			obj: map[string]any{
+				"kind":     "DaemonSet",
+				"metadata": map[string]any{"name": "test"},
+				"status":   map[string]any{"desiredNumberScheduled": int64(2), "numberReady": int64(2)},
+			},
+			expected: ReadinessStateReady,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, message := CheckReadiness(&unstructured.Unstructured{Object: tt.obj})
+			require.Equal(t, tt.expected, state)
+			if tt.expected == ReadinessStateReady {
+				require.Empty(t, message)
+			} else {
+				require.NotEmpty(t, message)
+			}
+		})
+	}
+
+	t.Run("resource being deleted", func(t *testing.T) {
+		obj := &unstructured.Unstructured{Object: map[string]any{"kind": "ConfigMap", "metadata": map[string]any{"name": "test"}}}
+		now := metav1.Now()
+		obj.SetDeletionTimestamp(&now)
+
+		state, _ := CheckReadiness(obj)
+		require.Equal(t, ReadinessStateInProgress, state)
+	})
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// DefaultCredentialName is the name of the credential holding the kubeconfig of a Kubernetes plane.
+	DefaultCredentialName = "default"
+)
+
+var _ ClientProvider = (*UCPClientProvider)(nil)
+
+// UCPClientProvider provides the clients for a Kubernetes plane using the kubeconfig registered as its UCP credential.
+//
+// The cluster hosting UCP can be targeted without registering a credential: the local plane falls back to the
+// connection used by UCP itself.
+type UCPClientProvider = KubeConfigClientProvider[*Clients]
+
+// KubeConfigClientProvider creates the clients of type T for a Kubernetes plane from the kubeconfig registered as its
+// UCP credential, and caches them per plane.
+type KubeConfigClientProvider[T any] struct {
+	credentialProvider credentials.CredentialProvider[credentials.KubernetesCredential]
+	localConfig        *rest.Config
+
+	// newClients creates the clients from a config. This field can be overridden by tests.
+	newClients func(config *rest.Config) (T, error)
+
+	mu    sync.Mutex
+	cache map[string]cachedClients[T]
+}
+
+type cachedClients[T any] struct {
+	kubeConfig string
+	clients    T
+}
+
+// NewUCPClientProvider creates a new UCPClientProvider. credentialProvider may be nil when UCP credentials are not
+// configured, in which case only the local plane is available. localConfig is the connection of the cluster hosting
+// UCP and may be nil when UCP does not run in a cluster.
+func NewUCPClientProvider(credentialProvider credentials.CredentialProvider[credentials.KubernetesCredential], localConfig *rest.Config) *UCPClientProvider {
+	return NewKubeConfigClientProvider(credentialProvider, localConfig, NewClients)
+}
+
+// NewKubeConfigClientProvider creates a new KubeConfigClientProvider which creates the clients with newClients. See
+// NewUCPClientProvider for the credentialProvider and localConfig parameters.
+func NewKubeConfigClientProvider[T any](credentialProvider credentials.CredentialProvider[credentials.KubernetesCredential], localConfig *rest.Config, newClients func(config *rest.Config) (T, error)) *KubeConfigClientProvider[T] {
+	return &KubeConfigClientProvider[T]{
+		credentialProvider: credentialProvider,
+		localConfig:        localConfig,
+		newClients:         newClients,
+		cache:              map[string]cachedClients[T]{},
+	}
+}
+
+// GetClients fetches the kubeconfig credential of the given plane and returns the clients for its cluster. Clients are
+// cached per plane and rebuilt when the registered kubeconfig changes. It returns ErrClusterNotRegistered if the plane
+// has no credential and is not the local plane.
+func (p *KubeConfigClientProvider[T]) GetClients(ctx context.Context, planeName string) (T, error) {
+	var zero T
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	kubeConfig := ""
+	cred, err := p.fetchCredential(ctx, planeName)
+	if clientv2.Is404Error(err) || errors.Is(err, &secret.ErrNotFound{}) || (err == nil && cred.KubeConfig == "") {
+		if planeName != resources_kubernetes.PlaneNameTODO || p.localConfig == nil {
+			return zero, &ErrClusterNotRegistered{PlaneName: planeName}
+		}
+		logger.V(ucplog.LevelDebug).Info("Kubernetes credential is not registered, using the local cluster.", "planeName", planeName)
+	} else if err != nil {
+		return zero, err
+	} else {
+		kubeConfig = cred.KubeConfig
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cached, ok := p.cache[planeName]; ok && cached.kubeConfig == kubeConfig {
+		return cached.clients, nil
+	}
+
+	config := p.localConfig
+	if kubeConfig != "" {
+		// Credentials are validated when they are registered. Validate them again in case they were written
+		// directly to the store without going through the credential API.
+		if err := ValidateKubeConfig(kubeConfig); err != nil {
+			return zero, fmt.Errorf("invalid kubeconfig for the Kubernetes plane %q: %w", planeName, err)
+		}
+		config, err = clientcmd.RESTConfigFromKubeConfig([]byte(kubeConfig))
+		if err != nil {
+			return zero, fmt.Errorf("failed to load the kubeconfig of the Kubernetes plane %q: %w", planeName, err)
+		}
+	}
+
+	clients, err := p.newClients(config)
+	if err != nil {
+		return zero, err
+	}
+
+	p.cache[planeName] = cachedClients[T]{kubeConfig: kubeConfig, clients: clients}
+	return clients, nil
+}
+
+func (p *KubeConfigClientProvider[T]) fetchCredential(ctx context.Context, planeName string) (*credentials.KubernetesCredential, error) {
+	if p.credentialProvider == nil {
+		return nil, &secret.ErrNotFound{}
+	}
+	return p.credentialProvider.Fetch(ctx, planeName, DefaultCredentialName)
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster1
+  cluster:
+    server: https://cluster1.example.com
+contexts:
+- name: cluster1
+  context:
+    cluster: cluster1
+    user: user1
+current-context: cluster1
+users:
+- name: user1
+  user:
+    token: fake-token
+`
+
+type mockProvider struct {
+	fakeCredential *sdk_cred.KubernetesCredential
+	err            error
+}
+
+// Fetch returns the fake Kubernetes credential or the configured error.
+func (p *mockProvider) Fetch(ctx context.Context, planeName, name string) (*sdk_cred.KubernetesCredential, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.fakeCredential, nil
+}
+
+func newTestProvider(credentialProvider *mockProvider, localConfig *rest.Config) (*UCPClientProvider, *[]*rest.Config) {
+	configs := []*rest.Config{}
+	p := NewUCPClientProvider(credentialProvider, localConfig)
+	p.newClients = func(config *rest.Config) (*Clients, error) {
+		configs = append(configs, config)
+		return &Clients{}, nil
+	}
+	return p, &configs
+}
+
+func TestGetClients(t *testing.T) {
+	localConfig := &rest.Config{Host: "https://local.example.com"}
+
+	t.Run("registered cluster", func(t *testing.T) {
+		credential := &mockProvider{fakeCredential: &sdk_cred.KubernetesCredential{Kind: "KubeConfig", KubeConfig: testKubeConfig}}
+		p, configs := newTestProvider(credential, localConfig)
+
+		clients, err := p.GetClients(context.Background(), "cluster1")
+		require.NoError(t, err)
+		require.NotNil(t, clients)
+		require.Len(t, *configs, 1)
+		require.Equal(t, "https://cluster1.example.com", (*configs)[0].Host)
+		require.Equal(t, "fake-token", (*configs)[0].BearerToken)
+
+		// Clients are reused while the kubeconfig does not change.
+		cached, err := p.GetClients(context.Background(), "cluster1")
+		require.NoError(t, err)
+		require.Same(t, clients, cached)
+		require.Len(t, *configs, 1)
+	})
+
+	t.Run("kubeconfig rotated", func(t *testing.T) {
+		credential := &mockProvider{fakeCredential: &sdk_cred.KubernetesCredential{Kind: "KubeConfig", KubeConfig: testKubeConfig}}
+		p, configs := newTestProvider(credential, localConfig)
+
+		_, err := p.GetClients(context.Background(), "cluster1")
+		require.NoError(t, err)
+
+		credential.fakeCredential = &sdk_cred.KubernetesCredential{Kind: "KubeConfig", KubeConfig: testKubeConfig + "preferences: {}\n"}
+		_, err = p.GetClients(context.Background(), "cluster1")
+		require.NoError(t, err)
+		require.Len(t, *configs, 2)
+	})
+
+	t.Run("local plane without credential", func(t *testing.T) {
+		p, configs := newTestProvider(&mockProvider{err: &secret.ErrNotFound{}}, localConfig)
+
+		_, err := p.GetClients(context.Background(), "local")
+		require.NoError(t, err)
+		require.Len(t, *configs, 1)
+		require.Same(t, localConfig, (*configs)[0])
+	})
+
+	t.Run("unregistered cluster", func(t *testing.T) {
+		p, _ := newTestProvider(&mockProvider{err: &secret.ErrNotFound{}}, localConfig)
+
+		_, err := p.GetClients(context.Background(), "cluster2")
+		require.ErrorIs(t, err, &ErrClusterNotRegistered{})
+	})
+
+	t.Run("local plane outside of a cluster", func(t *testing.T) {
+		p, _ := newTestProvider(&mockProvider{err: &secret.ErrNotFound{}}, nil)
+
+		_, err := p.GetClients(context.Background(), "local")
+		require.ErrorIs(t, err, &ErrClusterNotRegistered{})
+	})
+
+	t.Run("credentials not configured", func(t *testing.T) {
+		p := NewUCPClientProvider(nil, localConfig)
+		p.newClients = func(config *rest.Config) (*Clients, error) {
+			return &Clients{}, nil
+		}
+
+		_, err := p.GetClients(context.Background(), "local")
+		require.NoError(t, err)
+
+		_, err = p.GetClients(context.Background(), "cluster1")
+		require.ErrorIs(t, err, &ErrClusterNotRegistered{})
+	})
+
+	t.Run("invalid kubeconfig", func(t *testing.T) {
+		credential := &mockProvider{fakeCredential: &sdk_cred.KubernetesCredential{Kind: "KubeConfig", KubeConfig: "not a kubeconfig"}}
+		p, _ := newTestProvider(credential, localConfig)
+
+		_, err := p.GetClients(context.Background(), "cluster1")
+		require.Error(t, err)
+	})
+
+	t.Run("kubeconfig with exec plugin", func(t *testing.T) {
+		kubeConfig := testKubeConfig + "    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: /bin/sh\n"
+		credential := &mockProvider{fakeCredential: &sdk_cred.KubernetesCredential{Kind: "KubeConfig", KubeConfig: kubeConfig}}
+		p, configs := newTestProvider(credential, localConfig)
+
+		_, err := p.GetClients(context.Background(), "cluster1")
+		require.ErrorContains(t, err, "exec is not supported")
+		require.Empty(t, *configs)
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		p, _ := newTestProvider(&mockProvider{err: errors.New("failed to fetch credential")}, localConfig)
+
+		_, err := p.GetClients(context.Background(), "local")
+		require.EqualError(t, err, "failed to fetch credential")
+	})
+}
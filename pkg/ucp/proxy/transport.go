@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// ClientCertificateOptions represents the client certificate presented by the reverse proxy to the downstream servers.
+type ClientCertificateOptions struct {
+	// CertFile is the path to the PEM encoded client certificate.
+	CertFile string `yaml:"certFile"`
+
+	// KeyFile is the path to the PEM encoded private key of the client certificate.
+	KeyFile string `yaml:"keyFile"`
+
+	// CAFile is the path to the PEM bundle of the certificate authority which issues the serving certificates of the
+	// downstream servers. The system roots are used when empty.
+	CAFile string `yaml:"caFile,omitempty"`
+}
+
+// NewClientCertificateTransport creates a transport which presents the client certificate described by options to the
+// downstream servers. The certificate is read again for each new connection so that it can be rotated without
+// restarting the proxy.
+func NewClientCertificateTransport(options ClientCertificateOptions) (http.RoundTripper, error) {
+	if options.CertFile == "" || options.KeyFile == "" {
+		return nil, errors.New("the certificate and key files of the client certificate are required")
+	}
+
+	// Report an invalid certificate on startup rather than on the first proxied request.
+	if _, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile); err != nil {
+		return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+			}
+			return &cert, nil
+		},
+	}
+
+	if options.CAFile != "" {
+		pem, err := os.ReadFile(options.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA file: %w", err)
+		}
+
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("the CA file %q doesn't contain any certificate", options.CAFile)
+		}
+		tlsConfig.RootCAs = roots
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
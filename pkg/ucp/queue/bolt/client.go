@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bolt is a durable queue implementation backed by an embedded bbolt database file. It is intended for
+// running the control-plane as plain processes for local development, where messages need to survive a restart.
+//
+// Each queue is stored as its own bucket. Message keys are built from the enqueue time and a random suffix so
+// that iterating the bucket visits messages in FIFO order:
+//
+//	00000001656452659000000000.70a6f0f8003943a6abe3319c5a4f1b9d
+//	-------------------------- --------------------------------
+//	   enqueue time (nanosec)           random number
+//
+// bbolt serializes write transactions, so Dequeue can find the first visible message and lease it within a
+// single transaction without the retry logic needed by the apiserver implementation. As with the apiserver
+// implementation, DequeueCount is used as the revision of the lease so that a client cannot extend or finish
+// a message that has been requeued and leased by another client.
+package bolt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+
+	bucketPrefix = "queue|"
+)
+
+var _ client.Client = (*Client)(nil)
+
+// Client is the durable queue client backed by bbolt.
+type Client struct {
+	db   *bbolt.DB
+	opts Options
+}
+
+// Options is the options to create bolt queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue backed by the given bbolt database. name is unique name for each service which will consume the queue.
+func New(db *bbolt.DB, options Options) (*Client, error) {
+	if db == nil || options.Name == "" {
+		return nil, errors.New("database and Name are required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	c := &Client{db: db, opts: options}
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(c.bucketName())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Client) bucketName() []byte {
+	return []byte(bucketPrefix + c.opts.Name)
+}
+
+func generateID(now time.Time) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%026d.%32x", now.UnixNano(), b), nil
+}
+
+// Enqueue stores the message in the queue. The message is visible to Dequeue immediately.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	if msg.ContentType != client.JSONContentType {
+		return client.ErrUnsupportedContentType
+	}
+
+	now := time.Now()
+	id, err := generateID(now)
+	if err != nil {
+		return err
+	}
+
+	stored := &client.Message{
+		Metadata: client.Metadata{
+			ID:            id,
+			DequeueCount:  0,
+			EnqueueAt:     now.UTC(),
+			ExpireAt:      now.Add(c.opts.ExpiryDuration).UTC(),
+			NextVisibleAt: now.UTC(),
+		},
+		ContentType: client.JSONContentType,
+		Data:        msg.Data,
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		return putMessage(tx.Bucket(c.bucketName()), stored)
+	})
+}
+
+// Dequeue leases the first visible message in the queue. The message will be invisible to the other clients until
+// the message lock expires.
+func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
+	now := time.Now()
+
+	var result *client.Message
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		cursor := bucket.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			msg := &client.Message{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+
+			if msg.NextVisibleAt.After(now) {
+				// Leased by another client.
+				continue
+			}
+
+			msg.DequeueCount += 1
+			msg.NextVisibleAt = now.Add(c.opts.MessageLockDuration).UTC()
+			result = msg
+			return putMessage(bucket, msg)
+		}
+
+		return client.ErrMessageNotFound
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FinishMessage deletes the leased message from the queue.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		_, err := getLeasedMessage(bucket, msg)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Delete([]byte(msg.ID))
+	})
+}
+
+// ExtendMessage extends the lock of the leased message. Returns ErrInvalidMessage if the lock has already expired.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	now := time.Now()
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		stored, err := getLeasedMessage(bucket, msg)
+		if err != nil {
+			return err
+		}
+
+		// We cannot extend the message which was requeued.
+		if stored.NextVisibleAt.Before(now) {
+			return client.ErrInvalidMessage
+		}
+
+		stored.NextVisibleAt = now.Add(c.opts.MessageLockDuration).UTC()
+		if err := putMessage(bucket, stored); err != nil {
+			return err
+		}
+
+		*msg = *stored
+		return nil
+	})
+}
+
+// getLeasedMessage returns the stored message for msg, and validates that it has not been leased by another client.
+func getLeasedMessage(bucket *bbolt.Bucket, msg *client.Message) (*client.Message, error) {
+	v := bucket.Get([]byte(msg.ID))
+	if v == nil {
+		return nil, client.ErrInvalidMessage
+	}
+
+	stored := &client.Message{}
+	if err := json.Unmarshal(v, stored); err != nil {
+		return nil, err
+	}
+
+	// DequeueCount must be mismatched if another client leased this message.
+	if stored.DequeueCount != msg.DequeueCount {
+		return nil, client.ErrDequeuedMessage
+	}
+
+	return stored, nil
+}
+
+func putMessage(bucket *bbolt.Bucket, msg *client.Message) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put([]byte(msg.ID), b)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestGenerateID(t *testing.T) {
+	now := time.Now()
+	id1, err := generateID(now)
+	require.NoError(t, err)
+	require.Equal(t, 59, len(id1))
+
+	id2, err := generateID(now.Add(time.Nanosecond))
+	require.NoError(t, err)
+	require.Less(t, id1, id2)
+}
+
+func TestNew_Invalid(t *testing.T) {
+	_, err := New(nil, Options{Name: "applications.core"})
+	require.Error(t, err)
+}
+
+func TestClient(t *testing.T) {
+	db, err := boltstore.OpenDB(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+
+	cli, err := New(db, Options{Name: "applications.core", MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		err := db.Update(func(tx *bbolt.Tx) error {
+			if err := tx.DeleteBucket(cli.bucketName()); err != nil {
+				return err
+			}
+			_, err := tx.CreateBucket(cli.bucketName())
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clear)
+}
+
+func TestClient_Durable(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	path := filepath.Join(t.TempDir(), "radius.db")
+	db, err := bbolt.Open(path, 0600, nil)
+	require.NoError(t, err)
+
+	cli, err := New(db, Options{Name: "applications.core"})
+	require.NoError(t, err)
+
+	err = cli.Enqueue(ctx, client.NewMessage("{}"))
+	require.NoError(t, err)
+
+	msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Simulate a restart, the lease should survive.
+	db, err = bbolt.Open(path, 0600, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	cli, err = New(db, Options{Name: "applications.core"})
+	require.NoError(t, err)
+
+	_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+	require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+	err = cli.ExtendMessage(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, 1, msg.DequeueCount)
+
+	err = cli.FinishMessage(ctx, msg)
+	require.NoError(t, err)
+
+	err = cli.FinishMessage(ctx, msg)
+	require.ErrorIs(t, err, client.ErrInvalidMessage)
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+//go:generate mockgen -destination=./mock_client.go -package=deadletter -self_package github.com/radius-project/radius/pkg/ucp/queue/deadletter github.com/radius-project/radius/pkg/ucp/queue/deadletter Client
+
+// Client is an interface to manage the dead-letter queue.
+//
+// Entries are identified by resource ids so that the dead-letter queue can be stored in the same data store
+// as the resources. While a message is still being retried its entry is kept in the Tracking state and
+// only records the delivery history. The entry moves to the DeadLettered state when the message is dead-lettered.
+type Client interface {
+	// RecordAttempt appends the delivery attempt of the message to the history of the entry.
+	RecordAttempt(ctx context.Context, id string, msg *queue.Message, attempt Attempt) error
+
+	// DeadLetter moves the message to the dead-letter queue.
+	DeadLetter(ctx context.Context, id string, msg *queue.Message, reason Reason, lastError string) (*Message, error)
+
+	// Forget deletes the delivery history of the message if the message was not dead-lettered.
+	Forget(ctx context.Context, id string) error
+
+	// Get gets the dead-lettered message.
+	Get(ctx context.Context, id string) (*Message, error)
+
+	// List lists the dead-lettered messages of the resource type in the root scope.
+	List(ctx context.Context, rootScope string, resourceType string) ([]*Message, error)
+
+	// Delete deletes the dead-lettered message.
+	Delete(ctx context.Context, id string) error
+
+	// Restore saves the dead-lettered message again after it was deleted.
+	Restore(ctx context.Context, msg *Message) error
+}
+
+var _ Client = (*storeClient)(nil)
+
+type storeClient struct {
+	client store.StorageClient
+}
+
+// New creates a dead-letter queue client backed by the given storage client.
+func New(client store.StorageClient) Client {
+	return &storeClient{client: client}
+}
+
+// RecordAttempt appends the delivery attempt to the entry's history, creating the entry in the Tracking state if
+// it does not exist. An attempt with the same dequeue count replaces the existing attempt.
+func (c *storeClient) RecordAttempt(ctx context.Context, id string, msg *queue.Message, attempt Attempt) error {
+	if msg == nil {
+		return queue.ErrEmptyMessage
+	}
+
+	entry, etag, err := c.getEntry(ctx, id)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		entry = newEntry(id, msg)
+	} else if err != nil {
+		return err
+	}
+
+	entry.History = addAttempt(entry.History, attempt)
+	if attempt.Error != "" {
+		entry.LastError = attempt.Error
+	}
+	entry.DequeueCount = msg.DequeueCount
+
+	return c.saveEntry(ctx, entry, etag)
+}
+
+// DeadLetter moves the message to the dead-letter queue. The delivery history recorded by RecordAttempt is kept.
+func (c *storeClient) DeadLetter(ctx context.Context, id string, msg *queue.Message, reason Reason, lastError string) (*Message, error) {
+	if msg == nil {
+		return nil, queue.ErrEmptyMessage
+	}
+
+	entry, etag, err := c.getEntry(ctx, id)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		entry = newEntry(id, msg)
+	} else if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	entry.State = StateDeadLettered
+	entry.Reason = reason
+	entry.DequeueCount = msg.DequeueCount
+	entry.DeadLetteredAt = &now
+	entry.History = addAttempt(entry.History, Attempt{DequeueCount: msg.DequeueCount, DequeuedAt: now, Error: lastError})
+	if lastError != "" {
+		entry.LastError = lastError
+	}
+
+	if err := c.saveEntry(ctx, entry, etag); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Forget deletes the entry if it is in the Tracking state. It does nothing if the entry does not exist.
+func (c *storeClient) Forget(ctx context.Context, id string) error {
+	entry, etag, err := c.getEntry(ctx, id)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if entry.State == StateDeadLettered {
+		return nil
+	}
+
+	err = c.client.Delete(ctx, id, store.WithETag(etag))
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil
+	}
+	return err
+}
+
+// Get gets the dead-lettered message. It returns store.ErrNotFound if the message was not dead-lettered.
+func (c *storeClient) Get(ctx context.Context, id string) (*Message, error) {
+	entry, _, err := c.getEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.State != StateDeadLettered {
+		return nil, &store.ErrNotFound{ID: id}
+	}
+
+	return entry, nil
+}
+
+// List lists the dead-lettered messages of the resource type in the root scope.
+func (c *storeClient) List(ctx context.Context, rootScope string, resourceType string) ([]*Message, error) {
+	query := store.Query{
+		RootScope:    rootScope,
+		ResourceType: resourceType,
+		Filters: []store.QueryFilter{
+			{Field: "state", Value: string(StateDeadLettered)},
+		},
+	}
+
+	results := []*Message{}
+	token := ""
+	for {
+		result, err := c.client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			entry, err := decode(&item)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, entry)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		token = result.PaginationToken
+	}
+
+	return results, nil
+}
+
+// Delete deletes the dead-lettered message. It returns store.ErrNotFound if the message was not dead-lettered.
+func (c *storeClient) Delete(ctx context.Context, id string) error {
+	entry, etag, err := c.getEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if entry.State != StateDeadLettered {
+		return &store.ErrNotFound{ID: id}
+	}
+
+	return c.client.Delete(ctx, id, store.WithETag(etag))
+}
+
+// Restore saves the dead-lettered message returned by Get again, for example when the message was deleted to be
+// requeued and could not be queued. An existing entry with the same id is overwritten.
+func (c *storeClient) Restore(ctx context.Context, msg *Message) error {
+	if msg == nil || msg.State != StateDeadLettered {
+		return errors.New("only dead-lettered messages can be restored")
+	}
+
+	return c.saveEntry(ctx, msg, "")
+}
+
+func (c *storeClient) getEntry(ctx context.Context, id string) (*Message, store.ETag, error) {
+	obj, err := c.client.Get(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	entry, err := decode(obj)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return entry, obj.ETag, nil
+}
+
+func (c *storeClient) saveEntry(ctx context.Context, entry *Message, etag store.ETag) error {
+	obj := &store.Object{
+		Metadata: store.Metadata{ID: entry.ID},
+		Data:     entry,
+	}
+
+	options := []store.SaveOptions{}
+	if etag != "" {
+		options = append(options, store.WithETag(etag))
+	}
+
+	return c.client.Save(ctx, obj, options...)
+}
+
+func newEntry(id string, msg *queue.Message) *Message {
+	return &Message{
+		ID:           id,
+		State:        StateTracking,
+		MessageID:    msg.ID,
+		ContentType:  msg.ContentType,
+		Data:         payload(msg),
+		DequeueCount: msg.DequeueCount,
+		EnqueuedAt:   msg.EnqueueAt,
+	}
+}
+
+// payload returns the message data as a JSON value. Non-JSON data is stored as a JSON string so that the
+// original payload is never lost.
+func payload(msg *queue.Message) json.RawMessage {
+	if json.Valid(msg.Data) {
+		return json.RawMessage(msg.Data)
+	}
+
+	b, _ := json.Marshal(string(msg.Data))
+	return b
+}
+
+func addAttempt(history []Attempt, attempt Attempt) []Attempt {
+	for i := range history {
+		if history[i].DequeueCount == attempt.DequeueCount {
+			// Keep the error recorded by an earlier call if the new attempt does not have one.
+			if attempt.Error == "" {
+				attempt.Error = history[i].Error
+			}
+			history[i] = attempt
+			return history
+		}
+	}
+
+	return append(history, attempt)
+}
+
+// decode decodes the object using JSON rather than store.Object.As because the payload is kept as raw JSON.
+func decode(obj *store.Object) (*Message, error) {
+	b, err := json.Marshal(obj.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &Message{}
+	if err := json.Unmarshal(b, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// ResourceType returns the resource type of the dead-letter entries for the given resource provider namespace.
+func ResourceType(namespace string) string {
+	return namespace + "/deadletteredoperations"
+}
+
+// OperationID returns the id of the dead-letter entry for the operation status resource id.
+//
+// Dead-letter entries are stored next to the operation status of the async operation:
+//
+//	/planes/radius/local/providers/applications.core/locations/global/deadletteredoperations/{operationId}
+func OperationID(operationStatusID resources.ID) string {
+	return operationStatusID.Truncate().Append(resources.TypeSegment{Type: "deadletteredoperations", Name: operationStatusID.Name()}).String()
+}
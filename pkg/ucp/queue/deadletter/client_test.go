@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testID      = "/planes/radius/local/providers/applications.core/locations/global/deadletteredoperations/00000000-0000-0000-0000-000000000001"
+	testOtherID = "/planes/radius/local/providers/applications.core/locations/global/deadletteredoperations/00000000-0000-0000-0000-000000000002"
+)
+
+func newTestClient(t *testing.T) Client {
+	ctx := testcontext.New(t)
+
+	db, err := boltstore.OpenDB(filepath.Join(t.TempDir(), "radius.db"))
+	require.NoError(t, err)
+
+	sc := boltstore.NewBoltClient(db)
+	require.NoError(t, sc.Init(ctx))
+
+	return New(sc)
+}
+
+func newTestMessage(dequeueCount int) *queue.Message {
+	msg := queue.NewMessage(map[string]any{"operationId": "00000000-0000-0000-0000-000000000001"})
+	msg.ID = "message-1"
+	msg.DequeueCount = dequeueCount
+	msg.EnqueueAt = time.Now().UTC()
+	return msg
+}
+
+func Test_RecordAttemptAndDeadLetter(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestClient(t)
+
+	err := client.RecordAttempt(ctx, testID, newTestMessage(1), Attempt{DequeueCount: 1, DequeuedAt: time.Now(), Error: "panic"})
+	require.NoError(t, err)
+
+	err = client.RecordAttempt(ctx, testID, newTestMessage(2), Attempt{DequeueCount: 2, DequeuedAt: time.Now()})
+	require.NoError(t, err)
+
+	// Tracked messages are not visible until they are dead-lettered.
+	_, err = client.Get(ctx, testID)
+	require.ErrorIs(t, err, &store.ErrNotFound{})
+
+	listed, err := client.List(ctx, "/planes/radius/local", "applications.core/locations/deadletteredoperations")
+	require.NoError(t, err)
+	require.Empty(t, listed)
+
+	msg := newTestMessage(4)
+	dl, err := client.DeadLetter(ctx, testID, msg, ReasonMaxRetryCountExceeded, "exceeded max retry count")
+	require.NoError(t, err)
+	require.Equal(t, StateDeadLettered, dl.State)
+
+	got, err := client.Get(ctx, testID)
+	require.NoError(t, err)
+	require.Equal(t, testID, got.ID)
+	require.Equal(t, "message-1", got.MessageID)
+	require.Equal(t, ReasonMaxRetryCountExceeded, got.Reason)
+	require.Equal(t, "exceeded max retry count", got.LastError)
+	require.Equal(t, 4, got.DequeueCount)
+	require.NotNil(t, got.DeadLetteredAt)
+	require.JSONEq(t, string(msg.Data), string(got.Data))
+	require.Len(t, got.History, 3)
+	require.Equal(t, "panic", got.History[0].Error)
+	require.Equal(t, 2, got.History[1].DequeueCount)
+	require.Equal(t, 4, got.History[2].DequeueCount)
+
+	// Forget must not delete dead-lettered messages.
+	err = client.Forget(ctx, testID)
+	require.NoError(t, err)
+
+	listed, err = client.List(ctx, "/planes/radius/local", "applications.core/locations/deadletteredoperations")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, testID, listed[0].ID)
+
+	err = client.Delete(ctx, testID)
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, testID)
+	require.ErrorIs(t, err, &store.ErrNotFound{})
+}
+
+func Test_Forget(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestClient(t)
+
+	// Forget is a no-op when there is no entry.
+	err := client.Forget(ctx, testOtherID)
+	require.NoError(t, err)
+
+	err = client.RecordAttempt(ctx, testOtherID, newTestMessage(1), Attempt{DequeueCount: 1, DequeuedAt: time.Now(), Error: "requeued"})
+	require.NoError(t, err)
+
+	err = client.Forget(ctx, testOtherID)
+	require.NoError(t, err)
+
+	// The history is gone, so dead-lettering starts with a fresh entry.
+	dl, err := client.DeadLetter(ctx, testOtherID, newTestMessage(1), ReasonUnknownOperationType, "unknown operation")
+	require.NoError(t, err)
+	require.Len(t, dl.History, 1)
+}
+
+func Test_Delete_Tracking(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestClient(t)
+
+	err := client.RecordAttempt(ctx, testID, newTestMessage(1), Attempt{DequeueCount: 1, DequeuedAt: time.Now()})
+	require.NoError(t, err)
+
+	err = client.Delete(ctx, testID)
+	require.ErrorIs(t, err, &store.ErrNotFound{})
+}
+
+func Test_Restore(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestClient(t)
+
+	dl, err := client.DeadLetter(ctx, testID, newTestMessage(4), ReasonMaxRetryCountExceeded, "exceeded max retry count")
+	require.NoError(t, err)
+
+	err = client.Delete(ctx, testID)
+	require.NoError(t, err)
+
+	err = client.Restore(ctx, dl)
+	require.NoError(t, err)
+
+	restored, err := client.Get(ctx, testID)
+	require.NoError(t, err)
+	require.Equal(t, dl.Reason, restored.Reason)
+	require.Equal(t, dl.History, restored.History)
+
+	err = client.Restore(ctx, &Message{ID: testID, State: StateTracking})
+	require.Error(t, err)
+}
+
+func Test_Payload(t *testing.T) {
+	msg := &queue.Message{Data: []byte("not json")}
+	require.Equal(t, `"not json"`, string(payload(msg)))
+
+	msg = &queue.Message{Data: []byte(`{"a":1}`)}
+	require.Equal(t, `{"a":1}`, string(payload(msg)))
+}
+
+func Test_OperationID(t *testing.T) {
+	statusID := resources.MustParse("/planes/radius/local/providers/applications.core/locations/global/operationstatuses/00000000-0000-0000-0000-000000000001")
+	require.Equal(t, testID, OperationID(statusID))
+}
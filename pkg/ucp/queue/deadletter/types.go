@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Reason represents the reason why a message was moved to the dead-letter queue.
+type Reason string
+
+const (
+	// ReasonMaxRetryCountExceeded means that the message was dequeued more times than the maximum retry count.
+	ReasonMaxRetryCountExceeded Reason = "MaxRetryCountExceeded"
+
+	// ReasonUnknownOperationType means that no controller is registered for the operation type of the message.
+	ReasonUnknownOperationType Reason = "UnknownOperationType"
+
+	// ReasonMalformedMessage means that the payload of the message is not an async operation request.
+	ReasonMalformedMessage Reason = "MalformedMessage"
+
+	// ReasonInvalidRequest means that the async operation request of the message does not have a valid ARM request context.
+	ReasonInvalidRequest Reason = "InvalidRequest"
+)
+
+// State represents the state of an entry in the dead-letter store.
+type State string
+
+const (
+	// StateTracking means that the message is still being retried and only its delivery history is recorded.
+	StateTracking State = "Tracking"
+
+	// StateDeadLettered means that the message was moved to the dead-letter queue.
+	StateDeadLettered State = "DeadLettered"
+)
+
+// Attempt represents a single delivery of a message.
+type Attempt struct {
+	// DequeueCount is the dequeue count of the message for this delivery.
+	DequeueCount int `json:"dequeueCount"`
+	// DequeuedAt is the time when the message was processed.
+	DequeuedAt time.Time `json:"dequeuedAt"`
+	// Error is the error observed while processing the message, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Message represents a message in the dead-letter queue.
+type Message struct {
+	// ID is the resource id of the dead-letter entry.
+	ID string `json:"id"`
+	// State is the state of the entry.
+	State State `json:"state"`
+	// MessageID is the id of the original queue message.
+	MessageID string `json:"messageId"`
+	// ContentType is the content type of the original queue message.
+	ContentType string `json:"contentType"`
+	// Data is the original payload of the queue message.
+	Data json.RawMessage `json:"data"`
+	// Reason is the reason why the message was dead-lettered.
+	Reason Reason `json:"reason,omitempty"`
+	// LastError is the last error observed while processing the message.
+	LastError string `json:"lastError,omitempty"`
+	// DequeueCount is the dequeue count of the message when it was dead-lettered.
+	DequeueCount int `json:"dequeueCount"`
+	// EnqueuedAt is the time when the original message was enqueued.
+	EnqueuedAt time.Time `json:"enqueuedAt"`
+	// DeadLetteredAt is the time when the message was moved to the dead-letter queue.
+	DeadLetteredAt *time.Time `json:"deadLetteredAt,omitempty"`
+	// History is the delivery history of the message.
+	History []Attempt `json:"history,omitempty"`
+}
@@ -23,9 +23,11 @@ import (
 
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/ucp/queue/apiserver"
+	qbolt "github.com/radius-project/radius/pkg/ucp/queue/bolt"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	qinmem "github.com/radius-project/radius/pkg/ucp/queue/inmemory"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -35,6 +37,7 @@ type factoryFunc func(context.Context, QueueProviderOptions) (queue.Client, erro
 var clientFactory = map[QueueProviderType]factoryFunc{
 	TypeInmemory:  initInMemory,
 	TypeAPIServer: initAPIServer,
+	TypeBolt:      initBolt,
 }
 
 func initInMemory(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
@@ -80,3 +83,14 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		Namespace: opt.APIServer.Namespace,
 	})
 }
+
+func initBolt(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	db, err := boltstore.OpenDB(opt.Bolt.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	return qbolt.New(db, qbolt.Options{
+		Name: opt.Name,
+	})
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package provider
 
+import "github.com/radius-project/radius/pkg/ucp/dataprovider"
+
 // QueueProviderOptions represents the data storage provider options.
 type QueueProviderOptions struct {
 	// Provider configures the storage provider.
@@ -29,6 +31,9 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+
+	// Bolt configures options for the embedded bbolt queue. (Optional)
+	Bolt dataprovider.BoltOptions `yaml:"bolt,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
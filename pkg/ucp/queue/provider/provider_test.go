@@ -18,8 +18,10 @@ package provider
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,6 +40,18 @@ func TestGetClient_ValidQueue(t *testing.T) {
 	require.Equal(t, oldcli, newcli)
 }
 
+func TestGetClient_BoltQueue(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypeBolt,
+		Bolt:     dataprovider.BoltOptions{Path: filepath.Join(t.TempDir(), "radius.db")},
+	})
+
+	cli, err := p.GetClient(context.TODO())
+	require.NoError(t, err)
+	require.NotNil(t, cli)
+}
+
 func TestGetClient_InvalidQueue(t *testing.T) {
 	p := New(QueueProviderOptions{
 		Name:     "Applications.Core",
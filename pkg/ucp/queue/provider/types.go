@@ -25,4 +25,7 @@ const (
 
 	// TypeAPIServer represents the Kubernetes APIServer provider.
 	TypeAPIServer QueueProviderType = "apiserver"
+
+	// TypeBolt represents the durable queue provider backed by an embedded bbolt database.
+	TypeBolt QueueProviderType = "bolt"
 )
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsGCPResource(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected bool
+	}{
+		{id: "/planes/gcp/gcp/projects/radius-project/regions/us-central1/providers/Google.Storage/buckets/test", expected: true},
+		{id: "/planes/gcp/gcp/projects/radius-project/regions/us-central1", expected: false},
+		{id: "/planes/aws/aws/accounts/000000000000/regions/us-west-2/providers/AWS.S3/Bucket/test", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			id, err := resources.Parse(tt.id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, IsGCPResource(id))
+
+			if tt.expected {
+				require.Equal(t, "radius-project", id.FindScope(ScopeProjects))
+				require.Equal(t, "us-central1", id.FindScope(ScopeRegions))
+			}
+		})
+	}
+}
@@ -30,10 +30,13 @@ const (
 	// PlaneTypeKubernetes defines the type name of the Kubernetes plane.
 	PlaneTypeKubernetes = "kubernetes"
 
+	// PlaneNameLocal is the name of the Kubernetes plane of the cluster hosting Radius.
+	PlaneNameLocal = "local"
+
 	// PlaneNameTODO is the name of the Kubernetes plane to use when the plane name is not known.
-	// This is similar to context.TODO() in the Go standard library. When we support multiple kubernetes
-	// clusters in a single Radius instance, we will need to remove this and replace all occurrences.
-	PlaneNameTODO = "local"
+	// This is similar to context.TODO() in the Go standard library. Resources rendered with this plane
+	// are moved to the plane of the cluster targeted by their environment when they are deployed.
+	PlaneNameTODO = PlaneNameLocal
 
 	// ScopeTypeNamespaces defines the type name of the Kubernetes namespace scope.
 	ScopeNamespaces = "namespaces"
@@ -63,6 +66,39 @@ func ToParts(id resources.ID) (group, kind, namespace, name string) {
 	return group, kind, namespace, name
 }
 
+// PlaneNameFromID returns the name of the Kubernetes plane of the given UCP resource ID. It returns an empty
+// string if the ID does not belong to a Kubernetes plane.
+func PlaneNameFromID(id resources.ID) string {
+	scopes := id.ScopeSegments()
+	if !id.IsUCPQualfied() || len(scopes) == 0 || !strings.EqualFold(scopes[0].Type, PlaneTypeKubernetes) {
+		return ""
+	}
+
+	return scopes[0].Name
+}
+
+// ParsePlaneID parses the ID of a Kubernetes plane, such as /planes/kubernetes/cluster1, and returns the name of
+// the plane.
+func ParsePlaneID(id string) (string, error) {
+	parsed, err := resources.ParseScope(id)
+	if err != nil {
+		return "", err
+	}
+
+	planeName := PlaneNameFromID(parsed)
+	if planeName == "" || len(parsed.ScopeSegments()) != 1 {
+		return "", fmt.Errorf("%q is not a valid Kubernetes plane ID", id)
+	}
+
+	return planeName, nil
+}
+
+// WithPlaneName returns the UCP resource ID of the given Kubernetes object in the given plane.
+func WithPlaneName(id resources.ID, planeName string) resources.ID {
+	group, kind, namespace, name := ToParts(id)
+	return IDFromParts(planeName, group, kind, namespace, name)
+}
+
 // IDFromMeta returns the UCP resource ID for the given Kubernetes object specified by its GroupVersionKind
 // and ObjectMeta.
 func IDFromMeta(planeName string, gvk schema.GroupVersionKind, objectMeta metav1.ObjectMeta) resources.ID {
@@ -99,12 +135,12 @@ func IDFromParts(planeName string, group string, kind string, namespace string,
 	return resources.MustParse(resources.MakeUCPID(scopes, types, nil))
 }
 
-// ToUCPResourceID takes namespace, resourceType, resourceName, provider information and returns string representing UCP qualified resource ID.
-func ToUCPResourceID(namespace, resourceType, resourceName, provider string) (string, error) {
+// ToUCPResourceID takes planeName, namespace, resourceType, resourceName, provider information and returns string representing UCP qualified resource ID.
+func ToUCPResourceID(planeName, namespace, resourceType, resourceName, provider string) (string, error) {
 	if resourceType == "" || resourceName == "" {
 		return "", errors.New("resourceType or resourceName is empty")
 	}
-	ucpID := "/planes/kubernetes/local/"
+	ucpID := fmt.Sprintf("/planes/kubernetes/%s/", planeName)
 	if namespace != "" {
 		ucpID += fmt.Sprintf("namespaces/%s/", namespace)
 	}
@@ -19,6 +19,7 @@ package kubernetes
 import (
 	"testing"
 
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,7 +29,7 @@ func Test_ToUCPResourceID(t *testing.T) {
 		resourceType := "deployment"
 		resourceName := "test-deployment"
 		expectedID := "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/test-deployment"
-		ucpID, err := ToUCPResourceID(namespace, resourceType, resourceName, "")
+		ucpID, err := ToUCPResourceID(PlaneNameLocal, namespace, resourceType, resourceName, "")
 		require.NoError(t, err)
 		require.Equal(t, expectedID, ucpID)
 	})
@@ -39,7 +40,7 @@ func Test_ToUCPResourceID(t *testing.T) {
 		resourceName := "test-dapr-pubsub"
 		provider := "dapr.io"
 		expectedID := "/planes/kubernetes/local/namespaces/test-dapr/providers/dapr.io/Component/test-dapr-pubsub"
-		ucpID, err := ToUCPResourceID(namespace, resourceType, resourceName, provider)
+		ucpID, err := ToUCPResourceID(PlaneNameLocal, namespace, resourceType, resourceName, provider)
 		require.NoError(t, err)
 		require.Equal(t, expectedID, ucpID)
 	})
@@ -48,7 +49,14 @@ func Test_ToUCPResourceID(t *testing.T) {
 		resourceType := "deployment"
 		resourceName := "test-deployment"
 		expectedID := "/planes/kubernetes/local/providers/apps/Deployment/test-deployment"
-		ucpID, err := ToUCPResourceID("", resourceType, resourceName, "")
+		ucpID, err := ToUCPResourceID(PlaneNameLocal, "", resourceType, resourceName, "")
+		require.NoError(t, err)
+		require.Equal(t, expectedID, ucpID)
+	})
+
+	t.Run("remote cluster", func(t *testing.T) {
+		expectedID := "/planes/kubernetes/cluster1/namespaces/default/providers/apps/Deployment/test-deployment"
+		ucpID, err := ToUCPResourceID("cluster1", "default", "deployment", "test-deployment", "")
 		require.NoError(t, err)
 		require.Equal(t, expectedID, ucpID)
 	})
@@ -56,7 +64,54 @@ func Test_ToUCPResourceID(t *testing.T) {
 	t.Run("empty resource type", func(t *testing.T) {
 		namespace := "default"
 		resourceName := "test-deployment"
-		_, err := ToUCPResourceID(namespace, "", resourceName, "")
+		_, err := ToUCPResourceID(PlaneNameLocal, namespace, "", resourceName, "")
 		require.EqualError(t, err, "resourceType or resourceName is empty")
 	})
 }
+
+func Test_PlaneNameFromID(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected string
+	}{
+		{id: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/test", expected: "local"},
+		{id: "/planes/kubernetes/cluster1/providers/core/Namespace/default", expected: "cluster1"},
+		{id: "/planes/kubernetes/cluster1", expected: "cluster1"},
+		{id: "/planes/radius/local/resourceGroups/test-rg", expected: ""},
+		{id: "/subscriptions/sub/resourceGroups/test-rg/providers/Microsoft.ContainerService/managedClusters/test", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			id, err := resources.Parse(tt.id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, PlaneNameFromID(id))
+		})
+	}
+}
+
+func Test_ParsePlaneID(t *testing.T) {
+	t.Run("valid plane ID", func(t *testing.T) {
+		planeName, err := ParsePlaneID("/planes/kubernetes/cluster1")
+		require.NoError(t, err)
+		require.Equal(t, "cluster1", planeName)
+	})
+
+	invalid := []string{
+		"/planes/kubernetes/cluster1/namespaces/default",
+		"/planes/radius/local",
+		"/subscriptions/sub/resourceGroups/test-rg",
+		"invalid",
+	}
+	for _, id := range invalid {
+		t.Run(id, func(t *testing.T) {
+			_, err := ParsePlaneID(id)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_WithPlaneName(t *testing.T) {
+	id := resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/core/Service/test")
+	require.Equal(t, "/planes/kubernetes/cluster1/namespaces/default/providers/core/Service/test", WithPlaneName(id, "cluster1").String())
+}
@@ -26,9 +26,11 @@ type PlaneProperties struct {
 
 // Plane kinds
 const (
-	PlaneKindUCPNative = "UCPNative"
-	PlaneKindAzure     = "Azure"
-	PlaneKindAWS       = "AWS"
+	PlaneKindUCPNative  = "UCPNative"
+	PlaneKindAzure      = "Azure"
+	PlaneKindAWS        = "AWS"
+	PlaneKindGCP        = "GCP"
+	PlaneKindKubernetes = "Kubernetes"
 )
 
 type Plane struct {
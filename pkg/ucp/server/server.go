@@ -67,6 +67,7 @@ type Options struct {
 	Identity                hostoptions.Identity
 	UCPConnection           sdk.Connection
 	Location                string
+	KubeConfig              *kube_rest.Config
 }
 
 const UCPProviderName = "ucp"
@@ -111,7 +112,7 @@ func NewServerOptionsFromEnvironment() (Options, error) {
 	}
 
 	var cfg *kube_rest.Config
-	if opts.Config.UCP.Kind == config.UCPConnectionKindKubernetes {
+	if opts.Config.UCP.Kind == config.UCPConnectionKindKubernetes || opts.Config.Authentication.RequiresKubernetesClient() {
 		cfg, err = kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
 			// TODO: Allow to use custom context via configuration. - https://github.com/radius-project/radius/issues/5433
 			ContextName: "",
@@ -144,6 +145,7 @@ func NewServerOptionsFromEnvironment() (Options, error) {
 		Identity:                identity,
 		UCPConnection:           ucpConn,
 		Location:                location,
+		KubeConfig:              cfg,
 	}, nil
 }
 
@@ -164,6 +166,7 @@ func NewServer(options *Options) (*hosting.Host, error) {
 			InitialPlanes:          options.InitialPlanes,
 			Identity:               options.Identity,
 			UCPConnection:          options.UCPConnection,
+			KubeConfig:             options.KubeConfig,
 		}),
 	}
 
@@ -0,0 +1,343 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package boltstore stores resources in an embedded bbolt database file. This is intended for local development
+// and testing where running etcd or a Kubernetes API Server is not desirable.
+//
+// We use the same key scheme as etcdstore. bbolt keeps keys sorted, so a query can seek to the longest prefix
+// that matches the query and scan forward, with client-side filtering for anything the prefix can't express.
+//
+//	scope|/planes/radius/local/|/resourceGroups/cool-group/
+//	resource|/planes/radius/local/resourceGroups/cool-group/|/Applications.Core/applications/cool-app/
+//
+// Each value is stored along with a version number taken from the bucket's sequence. The version is used
+// as the ETag for optimistic concurrency. Since the sequence is never reused, deleting and re-creating a
+// resource will never produce the same ETag.
+package boltstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of a key.
+	SectionSeparator = "|"
+
+	// BucketName is the name of the bucket used to store resources.
+	BucketName = "resources"
+)
+
+// entry is the value stored for each key.
+type entry struct {
+	Version uint64       `json:"version"`
+	Object  store.Object `json:"object"`
+}
+
+// NewBoltClient creates a new BoltClient instance with the given database.
+func NewBoltClient(db *bbolt.DB) *BoltClient {
+	return &BoltClient{db: db}
+}
+
+var _ store.StorageClient = (*BoltClient)(nil)
+
+type BoltClient struct {
+	db *bbolt.DB
+}
+
+// Init creates the bucket used by the client if it does not already exist.
+func (c *BoltClient) Init(ctx context.Context) error {
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(BucketName))
+		return err
+	})
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// Results are paginated when the MaxQueryItemCount option is provided.
+func (c *BoltClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	config := store.NewQueryConfig(options...)
+	after, err := base64.RawURLEncoding.DecodeString(config.PaginationToken)
+	if err != nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+	}
+
+	prefix := []byte(keyFromQuery(query))
+	results := store.ObjectQueryResult{}
+	err = c.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket([]byte(BucketName)).Cursor()
+
+		var k, v, last []byte
+		if len(after) > 0 {
+			k, v = cursor.Seek(after)
+			if bytes.Equal(k, after) {
+				k, v = cursor.Next()
+			}
+		} else {
+			k, v = cursor.Seek(prefix)
+		}
+
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			if !keyMatchesQuery(k, query) {
+				continue
+			}
+
+			e := entry{}
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+
+			match, err := e.Object.MatchesFilters(query.Filters)
+			if err != nil {
+				return err
+			} else if !match {
+				continue
+			}
+
+			if config.MaxQueryItemCount > 0 && len(results.Items) == config.MaxQueryItemCount {
+				results.PaginationToken = base64.RawURLEncoding.EncodeToString(last)
+				return nil
+			}
+
+			e.Object.ETag = etag.NewFromRevision(int64(e.Version))
+			results.Items = append(results.Items, e.Object)
+
+			// Keys are only valid for the lifetime of the transaction, so we need a copy.
+			last = append(last[:0], k...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
+// the store and returns it, or an error if the object is not found or an error occurs.
+func (c *BoltClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var e *entry
+	err = c.db.View(func(tx *bbolt.Tx) error {
+		e, err = getEntry(tx.Bucket([]byte(BucketName)), keyFromID(parsed))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if e == nil {
+		return nil, &store.ErrNotFound{ID: id}
+	}
+
+	e.Object.ETag = etag.NewFromRevision(int64(e.Version))
+	return &e.Object, nil
+}
+
+// Delete checks if the given resource ID is valid, and if so, deletes it from the store, returning an error if the
+// resource does not exist or if an ETag is provided and does not match.
+func (c *BoltClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	key := keyFromID(parsed)
+	config := store.NewDeleteConfig(options...)
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketName))
+		e, err := getEntry(bucket, key)
+		if err != nil {
+			return err
+		}
+
+		if config.ETag != "" && (e == nil || !etagMatches(config.ETag, e.Version)) {
+			return &store.ErrConcurrency{}
+		} else if e == nil {
+			return &store.ErrNotFound{ID: id}
+		}
+
+		return bucket.Delete([]byte(key))
+	})
+}
+
+// Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
+// the store, and sets the object's ETag. If an ETag is provided, the save only succeeds when the stored version matches.
+func (c *BoltClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.Metadata.ID)
+	if err != nil {
+		return err
+	}
+
+	key := keyFromID(parsed)
+	config := store.NewSaveConfig(options...)
+
+	var version uint64
+	err = c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketName))
+		if config.ETag != "" {
+			e, err := getEntry(bucket, key)
+			if err != nil {
+				return err
+			}
+
+			if e == nil || !etagMatches(config.ETag, e.Version) {
+				return &store.ErrConcurrency{}
+			}
+		}
+
+		version, err = bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		b, err := json.Marshal(entry{Version: version, Object: *obj})
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(key), b)
+	})
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = etag.NewFromRevision(int64(version))
+	return nil
+}
+
+func getEntry(bucket *bbolt.Bucket, key string) (*entry, error) {
+	v := bucket.Get([]byte(key))
+	if v == nil {
+		return nil, nil
+	}
+
+	e := entry{}
+	err := json.Unmarshal(v, &e)
+	if err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+func etagMatches(value store.ETag, version uint64) bool {
+	revision, err := etag.ParseRevision(value)
+	if err != nil {
+		// Treat an invalid ETag as a concurrency failure, since it will never match.
+		return false
+	}
+
+	return uint64(revision) == version
+}
+
+func parseID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the key to use for an ID. They key should be used as an exact match.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// keyFromQuery returns the key to use for an for executing a query. The key should be used as a prefix.
+func keyFromQuery(query store.Query) string {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	if query.ScopeRecursive {
+		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope)
+	}
+
+	return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope) + SectionSeparator + storeutil.NormalizePart(query.RoutingScopePrefix)
+}
+
+func keyMatchesQuery(key []byte, query store.Query) bool {
+	parts := strings.Split(string(key), SectionSeparator)
+	if len(parts) != 3 {
+		// Ignore invalid keys, we don't expect to find them.
+		return false
+	}
+
+	var id resources.ID
+	var err error
+	switch parts[0] {
+	case storeutil.ScopePrefix:
+		id, err = resources.Parse(parts[1] + strings.TrimPrefix(parts[2], resources.SegmentSeparator))
+	case storeutil.ResourcePrefix:
+		id, err = resources.Parse(parts[1] + resources.ProvidersSegment + parts[2])
+	default:
+		return false
+	}
+	if err != nil {
+		return false
+	}
+
+	return storeutil.IDMatchesQuery(id, query)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// openTimeout is the time to wait for the file lock when opening a database. bbolt holds an exclusive
+	// lock on the file, so this will only time out when another process is using the same file.
+	openTimeout = 10 * time.Second
+)
+
+var (
+	databases   = map[string]*bbolt.DB{}
+	databasesMu sync.Mutex
+)
+
+// OpenDB opens (or creates) the bbolt database file at the given path. The storage, queue and secret providers
+// share a single database file, and bbolt only allows one open handle per file, so handles are cached for the
+// lifetime of the process.
+func OpenDB(path string) (*bbolt.DB, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+
+	if db, ok := databases[abs]; ok {
+		return db, nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(abs), 0755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory for %q: %w", abs, err)
+	}
+
+	db, err := bbolt.Open(abs, 0600, &bbolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", abs, err)
+	}
+
+	databases[abs] = db
+	return db, nil
+}
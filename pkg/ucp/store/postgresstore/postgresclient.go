@@ -0,0 +1,397 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgresstore stores resources in a PostgreSQL database. All resources and scopes are stored in
+// a single table. Each row is keyed using the same scheme as etcdstore so that lookups are exact matches
+// on the primary key:
+//
+//	scope|/planes/radius/local/|/resourceGroups/cool-group/
+//	resource|/planes/radius/local/resourceGroups/cool-group/|/Applications.Core/applications/cool-app/
+//
+// The key segments are also stored as separate columns so that queries can be expressed as SQL predicates
+// rather than client-side filtering. Property filters are evaluated by PostgreSQL using the JSONB operators.
+//
+// Optimistic concurrency is implemented using a version column populated from a database sequence. The
+// version is encoded as the ETag of the object. Using a sequence (rather than a per-row counter) guarantees
+// that a deleted and re-created resource never reuses an ETag.
+//
+// Query results are ordered by key, which allows us to implement keyset pagination. The pagination token
+// is the (opaque) key of the last item returned.
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of a key.
+	SectionSeparator = "|"
+
+	// DefaultMaxQueryItemCount is the page size used when the caller does not specify one.
+	DefaultMaxQueryItemCount = 1000
+)
+
+const schema = `
+CREATE SEQUENCE IF NOT EXISTS ucp_resource_version;
+
+CREATE TABLE IF NOT EXISTS ucp_resources (
+	key           TEXT PRIMARY KEY,
+	prefix        TEXT NOT NULL,
+	root_scope    TEXT NOT NULL,
+	routing_scope TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	version       BIGINT NOT NULL,
+	object        JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ucp_resources_scope_idx ON ucp_resources (prefix, root_scope, routing_scope);
+`
+
+// NewPostgresClient creates a new PostgresClient instance with the given database handle.
+func NewPostgresClient(db *sql.DB) *PostgresClient {
+	return &PostgresClient{db: db}
+}
+
+var _ store.StorageClient = (*PostgresClient)(nil)
+
+type PostgresClient struct {
+	db *sql.DB
+}
+
+// Init creates the table, index and sequence used by the client if they do not already exist.
+func (c *PostgresClient) Init(ctx context.Context) error {
+	_, err := c.db.ExecContext(ctx, schema)
+	if err != nil {
+		return fmt.Errorf("failed to initialize PostgreSQL schema: %w", err)
+	}
+
+	return nil
+}
+
+// Query retrieves objects from the store that match the given query and filters, and returns them in a store.ObjectQueryResult.
+// Results are paginated using the MaxQueryItemCount and PaginationToken options.
+func (c *PostgresClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+
+	config := store.NewQueryConfig(options...)
+	after, err := parsePaginationToken(config.PaginationToken)
+	if err != nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'PaginationToken' is invalid"}
+	}
+
+	limit := config.MaxQueryItemCount
+	if limit <= 0 {
+		limit = DefaultMaxQueryItemCount
+	}
+
+	// Fetch one extra row so we know whether there is another page.
+	statement, args := buildQuery(query, after, limit+1)
+	rows, err := c.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := store.ObjectQueryResult{}
+	lastKey := ""
+	for rows.Next() {
+		if len(results.Items) == limit {
+			results.PaginationToken = newPaginationToken(lastKey)
+			break
+		}
+
+		var key string
+		var version int64
+		var b []byte
+		if err := rows.Scan(&key, &version, &b); err != nil {
+			return nil, err
+		}
+
+		value := store.Object{}
+		if err := json.Unmarshal(b, &value); err != nil {
+			return nil, err
+		}
+
+		value.ETag = etag.NewFromRevision(version)
+		results.Items = append(results.Items, value)
+		lastKey = key
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
+// the store and returns it, or an error if the object is not found or an error occurs.
+func (c *PostgresClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var version int64
+	var b []byte
+	err = c.db.QueryRowContext(ctx, "SELECT version, object FROM ucp_resources WHERE key = $1", keyFromID(parsed)).Scan(&version, &b)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &store.ErrNotFound{ID: id}
+	} else if err != nil {
+		return nil, err
+	}
+
+	value := store.Object{}
+	err = json.Unmarshal(b, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	value.ETag = etag.NewFromRevision(version)
+
+	return &value, nil
+}
+
+// Delete checks if the given resource ID is valid, and if so, deletes it from the store, returning an error if the
+// resource does not exist or if an ETag is provided and does not match.
+func (c *PostgresClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	key := keyFromID(parsed)
+	config := store.NewDeleteConfig(options...)
+
+	// If we have an ETag then we need to match the version as part of the delete.
+	if config.ETag != "" {
+		revision, err := etag.ParseRevision(config.ETag)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return &store.ErrConcurrency{}
+		}
+
+		result, err := c.db.ExecContext(ctx, "DELETE FROM ucp_resources WHERE key = $1 AND version = $2", key, revision)
+		if err != nil {
+			return err
+		}
+
+		deleted, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if deleted == 0 {
+			return &store.ErrConcurrency{}
+		}
+
+		return nil
+	}
+
+	result, err := c.db.ExecContext(ctx, "DELETE FROM ucp_resources WHERE key = $1", key)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return &store.ErrNotFound{ID: id}
+	}
+
+	return nil
+}
+
+// Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
+// the store, and sets the object's ETag. If an ETag is provided, the save only succeeds when the stored version matches.
+func (c *PostgresClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	id := obj.Metadata.ID
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	prefix, rootScope, routingScope, resourceType := storeutil.ExtractStorageParts(parsed)
+	key := prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+	config := store.NewSaveConfig(options...)
+
+	var version int64
+
+	// If we have an ETag then we can only update an existing row with a matching version.
+	if config.ETag != "" {
+		revision, err := etag.ParseRevision(config.ETag)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return &store.ErrConcurrency{}
+		}
+
+		err = c.db.QueryRowContext(ctx,
+			`UPDATE ucp_resources SET version = nextval('ucp_resource_version'), object = $3
+			WHERE key = $1 AND version = $2
+			RETURNING version`,
+			key, revision, b).Scan(&version)
+		if errors.Is(err, sql.ErrNoRows) {
+			return &store.ErrConcurrency{}
+		} else if err != nil {
+			return err
+		}
+
+		obj.ETag = etag.NewFromRevision(version)
+		return nil
+	}
+
+	// If we don't have an ETag then this is an upsert.
+	err = c.db.QueryRowContext(ctx,
+		`INSERT INTO ucp_resources (key, prefix, root_scope, routing_scope, resource_type, version, object)
+		VALUES ($1, $2, $3, $4, $5, nextval('ucp_resource_version'), $6)
+		ON CONFLICT (key) DO UPDATE SET version = EXCLUDED.version, object = EXCLUDED.object
+		RETURNING version`,
+		key, prefix, rootScope, routingScope, resourceType, b).Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = etag.NewFromRevision(version)
+
+	return nil
+}
+
+func parseID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the primary key to use for an ID.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// buildQuery returns the SQL statement and arguments for a query. The semantics match storeutil.IDMatchesQuery and
+// store.Object.MatchesFilters.
+func buildQuery(query store.Query, after string, limit int) (string, []any) {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	predicates := []string{}
+	args := []any{}
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	predicates = append(predicates, "prefix = "+arg(prefix))
+
+	// starts_with is used instead of LIKE because resource ids may legally contain LIKE wildcards (eg: '_').
+	if query.ScopeRecursive {
+		predicates = append(predicates, "starts_with(root_scope, "+arg(storeutil.NormalizePart(query.RootScope))+")")
+	} else {
+		predicates = append(predicates, "root_scope = "+arg(storeutil.NormalizePart(query.RootScope)))
+	}
+
+	if query.RoutingScopePrefix != "" {
+		predicates = append(predicates, "starts_with(routing_scope, "+arg(storeutil.NormalizePart(query.RoutingScopePrefix))+")")
+	}
+
+	if query.ResourceType != "" {
+		predicates = append(predicates, "resource_type = "+arg(strings.ToLower(query.ResourceType)))
+	}
+
+	// Only string values can match a filter, see store.Object.MatchesFilters.
+	for _, filter := range query.Filters {
+		path := arg(pq.Array(strings.Split(filter.Field, ".")))
+		predicates = append(predicates, fmt.Sprintf("jsonb_typeof(object->'Data' #> %s::text[]) = 'string'", path))
+		predicates = append(predicates, fmt.Sprintf("object->'Data' #>> %s::text[] = %s", path, arg(filter.Value)))
+	}
+
+	if after != "" {
+		predicates = append(predicates, "key > "+arg(after))
+	}
+
+	statement := "SELECT key, version, object FROM ucp_resources WHERE " + strings.Join(predicates, " AND ") +
+		" ORDER BY key LIMIT " + arg(limit)
+	return statement, args
+}
+
+func newPaginationToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func parsePaginationToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -19,6 +19,7 @@ package storetest
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -589,3 +590,53 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 		})
 	})
 }
+
+// RunPaginationTest tests that the StorageClient's Query method honors the MaxQueryItemCount and PaginationToken options
+// by saving a set of resources and reading them back one page at a time. This is separate from RunTest because not all
+// of our data-store implementations support pagination.
+func RunPaginationTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	clear(t)
+
+	expected := []store.Object{}
+	for i := 0; i < 5; i++ {
+		id := parseOrPanic(fmt.Sprintf("%s/providers/%s/resource%d", ResourceGroup1Scope, ResourceType1, i))
+		obj := createObject(id, Data1)
+		err := client.Save(ctx, &obj)
+		require.NoError(t, err)
+		expected = append(expected, obj)
+	}
+
+	// This one is in a different scope and should not be returned.
+	other := createObject(Resource2ID, Data2)
+	err := client.Save(ctx, &other)
+	require.NoError(t, err)
+
+	actual := []store.Object{}
+	token := ""
+	pages := 0
+	for {
+		options := []store.QueryOptions{store.WithMaxQueryItemCount(2)}
+		if token != "" {
+			options = append(options, store.WithPaginationToken(token))
+		}
+
+		objs, err := client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, ResourceType: ResourceType1}, options...)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(objs.Items), 2)
+
+		actual = append(actual, objs.Items...)
+		pages++
+
+		if objs.PaginationToken == "" {
+			break
+		}
+
+		token = objs.PaginationToken
+	}
+
+	require.Equal(t, 3, pages)
+	CompareObjectLists(t, expected, actual)
+}